@@ -0,0 +1,21 @@
+package admin
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewAdminCommand creates the admin subcommand
+func NewAdminCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Run maestro administrative tasks",
+		Long: `Run maestro administrative tasks against the maestro database.
+
+Commands:
+  gc - Purge the events, status events and event instances that are out of their retention`,
+	}
+
+	cmd.AddCommand(newGCCommand())
+
+	return cmd
+}
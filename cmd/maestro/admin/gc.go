@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+	"github.com/openshift-online/maestro/cmd/maestro/environments"
+	"github.com/openshift-online/maestro/pkg/config"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/db/db_session"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+var (
+	dbConfig      = config.NewDatabaseConfig()
+	eventGCConfig = config.NewEventGCConfig()
+)
+
+func newGCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Purge the events, status events, event instances, completed operations and resource revisions that are out of their retention",
+		Long: `Run a garbage collection of the event tables with the same retention as the GC controller of
+the maestro server, and show the number of the rows of each table and the rows purged from it.
+
+With --dry-run the rows to purge are only counted.
+
+Examples:
+  maestro admin gc --dry-run --db-host-file secrets/db.host
+  maestro admin gc --event-max-age 1h --status-event-max-count 100000
+  maestro admin gc --dry-run --output json`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runGC(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	dbConfig.AddFlags(flags)
+	eventGCConfig.AddFlags(flags)
+	// the interval only applies to the GC controller of the server
+	_ = flags.MarkHidden("event-gc-interval")
+	flags.Bool("dry-run", false, "Only count the rows to purge without purging them")
+
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runGC(cmd *cobra.Command, _ []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := dbConfig.ReadFiles(); err != nil {
+		return err
+	}
+
+	var sessionFactory db.SessionFactory = db_session.NewProdFactory(dbConfig)
+	defer sessionFactory.Close()
+
+	eventGC := services.NewEventGCService(dao.NewEventGCDao(&sessionFactory), environments.EventGCPolicy(eventGCConfig))
+	results, svcErr := eventGC.Run(context.Background(), dryRun)
+	if svcErr != nil {
+		return svcErr.AsError()
+	}
+
+	if format == output.FormatTable {
+		return output.PrintEventGCResults(os.Stdout, results, dryRun)
+	}
+
+	return output.PrintJSON(os.Stdout, results)
+}
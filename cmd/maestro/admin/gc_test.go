@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGCCommandFlags(t *testing.T) {
+	cmd := newGCCommand()
+
+	for _, name := range []string{"dry-run", "output", "db-host-file", "event-max-age", "event-max-count",
+		"status-event-max-age", "status-event-max-count", "resource-tombstone-max-age", "resource-tombstone-max-count",
+		"dead-instance-grace-period"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("gc command missing flag --%s", name)
+		}
+	}
+
+	interval := cmd.Flags().Lookup("event-gc-interval")
+	if interval == nil || !interval.Hidden {
+		t.Errorf("gc command expected hidden flag --event-gc-interval")
+	}
+}
+
+func TestRunGCInvalidOutput(t *testing.T) {
+	cmd := newGCCommand()
+	if err := cmd.Flags().Set("output", "yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := runGC(cmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "yaml") {
+		t.Errorf("runGC() expected an unsupported format error, got %v", err)
+	}
+}
@@ -3,7 +3,10 @@ package agent
 import (
 	"context"
 	"fmt"
+	"net"
+	"os"
 
+	"github.com/openshift/library-go/pkg/controller/controllercmd"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -16,11 +19,15 @@ import (
 	"open-cluster-management.io/ocm/pkg/features"
 	"open-cluster-management.io/ocm/pkg/work/spoke"
 	cemetrics "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/metrics"
+
+	"github.com/openshift-online/maestro/pkg/agent/drift"
+	"github.com/openshift-online/maestro/pkg/client/cloudevents/kafka"
 )
 
 var (
 	commonOptions = commonoptions.NewAgentOptions()
 	agentOption   = spoke.NewWorkloadAgentOptions()
+	driftOptions  = drift.NewOptions()
 )
 
 func init() {
@@ -31,12 +38,15 @@ func init() {
 // by default uses 1M as the limit for state feedback
 const maxJSONRawLength int32 = 1024 * 1024
 
+// kafkaDriver is the workload source driver of a Kafka cluster, the agent connects to it with a relay.
+const kafkaDriver = "kafka"
+
 func NewAgentCommand() *cobra.Command {
 	agentOption.MaxJSONRawLength = maxJSONRawLength
 	agentOption.CloudEventsClientCodecs = []string{"manifestbundle"}
 	cfg := spoke.NewWorkAgentConfig(commonOptions, agentOption)
 	cmdConfig := commonOptions.CommonOpts.
-		NewControllerCommandConfig("maestro-agent", version.Get(), cfg.RunWorkloadAgent, clock.RealClock{})
+		NewControllerCommandConfig("maestro-agent", version.Get(), runAgent(cfg), clock.RealClock{})
 
 	cmd := cmdConfig.NewCommandWithContext(context.TODO())
 	cmd.Use = "agent"
@@ -53,6 +63,8 @@ func NewAgentCommand() *cobra.Command {
 	agentOption.AddFlags(flags)
 	// add alias flags
 	addFlags(flags)
+	// add drift flags
+	driftOptions.AddFlags(flags)
 
 	// add pre-run to set feature gates
 	cmd.PreRun = func(cmd *cobra.Command, args []string) {
@@ -63,6 +75,58 @@ func NewAgentCommand() *cobra.Command {
 	return cmd
 }
 
+// runAgent runs the work agent with the drift controller, the drift controller is started only if the drift
+// policy is set.
+func runAgent(cfg *spoke.WorkAgentConfig) func(context.Context, *controllercmd.ControllerContext) error {
+	return func(ctx context.Context, controllerContext *controllercmd.ControllerContext) error {
+		if agentOption.WorkloadSourceDriver == kafkaDriver {
+			if err := startKafkaRelay(ctx); err != nil {
+				return fmt.Errorf("failed to start the kafka relay: %v", err)
+			}
+		}
+		if err := driftOptions.Start(ctx, controllerContext.KubeConfig, agentOption.WorkloadSourceDriver,
+			agentOption.WorkloadSourceConfig, commonOptions.SpokeClusterName, agentOption.CloudEventsClientID); err != nil {
+			return fmt.Errorf("failed to start the drift controller: %v", err)
+		}
+		return cfg.RunWorkloadAgent(ctx, controllerContext)
+	}
+}
+
+// startKafkaRelay starts the relay of the Kafka cluster in the workload source config, the work agent and the
+// drift controller connect to the relay with the gRPC driver, since their clients do not support Kafka.
+func startKafkaRelay(ctx context.Context) error {
+	kafkaOptions, err := kafka.BuildKafkaOptionsFromFlags(agentOption.WorkloadSourceConfig)
+	if err != nil {
+		return fmt.Errorf("failed to load the kafka config: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	agentID := agentOption.CloudEventsClientID
+	if agentID == "" {
+		agentID = fmt.Sprintf("%s-work-agent", commonOptions.SpokeClusterName)
+	}
+	if err := kafka.NewRelay(kafkaOptions, commonOptions.SpokeClusterName, agentID).Start(ctx, listener); err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	configFile, err := os.CreateTemp("", "maestro-kafka-relay-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer configFile.Close()
+	if _, err := fmt.Fprintf(configFile, "url: %s\n", listener.Addr().String()); err != nil {
+		return err
+	}
+
+	agentOption.WorkloadSourceDriver = "grpc"
+	agentOption.WorkloadSourceConfig = configFile.Name()
+	return nil
+}
+
 // addFlags overrides cluster name and leader election flags from the agentOption
 func addFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&commonOptions.SpokeClusterName, "consumer-name",
@@ -0,0 +1,37 @@
+package clients
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+const (
+	// Apply flag names
+	FlagFieldManager = "field-manager"
+	FlagForce        = "force"
+)
+
+// AddApplyFlags adds the flags to create or update the resource bundles on behalf of a field manager to a command
+func AddApplyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagFieldManager, "", "The field manager that owns the applied manifests, an update only replaces or removes the manifests it owns")
+	cmd.Flags().Bool(FlagForce, false, "Take the ownership of the applied manifests that are owned by other field managers instead of failing with a conflict, requires --field-manager")
+}
+
+// ApplyOptionsFromFlags returns the apply options of the --field-manager and --force flags
+func ApplyOptionsFromFlags(cmd *cobra.Command) (api.ApplyOptions, error) {
+	fieldManager, err := cmd.Flags().GetString(FlagFieldManager)
+	if err != nil {
+		return api.ApplyOptions{}, fmt.Errorf("failed to read --%s flag: %w", FlagFieldManager, err)
+	}
+	force, err := cmd.Flags().GetBool(FlagForce)
+	if err != nil {
+		return api.ApplyOptions{}, fmt.Errorf("failed to read --%s flag: %w", FlagForce, err)
+	}
+	if force && fieldManager == "" {
+		return api.ApplyOptions{}, fmt.Errorf("--%s requires --%s", FlagForce, FlagFieldManager)
+	}
+	return api.ApplyOptions{FieldManager: fieldManager, Force: force}, nil
+}
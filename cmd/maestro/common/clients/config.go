@@ -14,6 +14,7 @@ const (
 	FlagRESTURL            = "rest-url"
 	FlagInsecureSkipVerify = "insecure-skip-verify"
 	FlagTimeout            = "timeout"
+	FlagRESTTokenFile      = "rest-token-file"
 
 	// gRPC flag names
 	FlagGRPCServerAddress = "grpc-server-address"
@@ -27,6 +28,7 @@ const (
 	EnvRESTURL            = "MAESTRO_REST_URL"
 	EnvInsecureSkipVerify = "MAESTRO_REST_INSECURE_SKIP_VERIFY"
 	EnvTimeout            = "MAESTRO_REST_TIMEOUT"
+	EnvRESTTokenFile      = "MAESTRO_REST_TOKEN_FILE"
 
 	// gRPC environment variable names
 	EnvGRPCServerAddress = "MAESTRO_GRPC_SERVER_ADDRESS"
@@ -48,6 +50,7 @@ type RESTConfig struct {
 	BaseURL            string
 	InsecureSkipVerify bool
 	Timeout            time.Duration
+	TokenFile          string
 }
 
 // GRPCConfig holds gRPC client configuration
@@ -65,6 +68,7 @@ func AddRESTClientFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(FlagRESTURL, "https://127.0.0.1:30080", "Maestro REST API base URL (env: MAESTRO_REST_URL)")
 	cmd.PersistentFlags().Bool(FlagInsecureSkipVerify, false, "Skip TLS certificate verification for REST API (env: MAESTRO_REST_INSECURE_SKIP_VERIFY)")
 	cmd.PersistentFlags().Duration(FlagTimeout, 30*time.Second, "HTTP client timeout for REST API (env: MAESTRO_REST_TIMEOUT)")
+	cmd.PersistentFlags().String(FlagRESTTokenFile, "", "Path to bearer token file for REST API authentication (env: MAESTRO_REST_TOKEN_FILE)")
 }
 
 // AddGRPCClientFlags adds gRPC client flags to a command
@@ -131,10 +135,21 @@ func LoadRESTConfigFromFlags(cmd *cobra.Command) (*RESTConfig, error) {
 		return nil, fmt.Errorf("--%s must be greater than 0", FlagTimeout)
 	}
 
+	tokenFile, err := cmd.Flags().GetString(FlagRESTTokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --%s: %w", FlagRESTTokenFile, err)
+	}
+	if !cmd.Flags().Changed(FlagRESTTokenFile) {
+		if v := os.Getenv(EnvRESTTokenFile); v != "" {
+			tokenFile = v
+		}
+	}
+
 	return &RESTConfig{
 		BaseURL:            restURL,
 		InsecureSkipVerify: insecureSkipVerify,
 		Timeout:            timeout,
+		TokenFile:          tokenFile,
 	}, nil
 }
 
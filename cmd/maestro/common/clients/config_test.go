@@ -397,7 +397,7 @@ func TestAddRESTClientFlags(t *testing.T) {
 	AddRESTClientFlags(cmd)
 
 	// Verify flags are added
-	flags := []string{FlagRESTURL, FlagInsecureSkipVerify, FlagTimeout}
+	flags := []string{FlagRESTURL, FlagInsecureSkipVerify, FlagTimeout, FlagRESTTokenFile}
 	for _, flag := range flags {
 		if cmd.PersistentFlags().Lookup(flag) == nil {
 			t.Errorf("Flag %s not added", flag)
@@ -17,20 +17,23 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/credentials/oauth"
+	"google.golang.org/grpc/metadata"
 	"k8s.io/klog/v2"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
 	grpcprotocol "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protocol"
 	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 
+	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
 // GRPCClient handles gRPC CloudEvents communication
 type GRPCClient struct {
-	conn     *grpc.ClientConn
-	client   pbv1.CloudEventServiceClient
-	sourceID string
+	conn         *grpc.ClientConn
+	client       pbv1.CloudEventServiceClient
+	sourceID     string
+	applyOptions api.ApplyOptions
 }
 
 // loadCA loads and validates CA certificate, returns error if CA is not provided
@@ -171,7 +174,7 @@ func NewGRPCClient(cfg *Config) (*GRPCClient, error) {
 }
 
 // publish a CloudEvent to the gRPC server
-func (c *GRPCClient) publish(ctx context.Context, evt *cloudevents.Event) error {
+func (c *GRPCClient) publish(ctx context.Context, evt *cloudevents.Event, opts ...grpc.CallOption) error {
 	// Convert CloudEvent to protobuf format
 	pbEvt := &pbv1.CloudEvent{}
 	if err := grpcprotocol.WritePBMessage(ctx, binding.ToMessage(evt), pbEvt); err != nil {
@@ -179,7 +182,7 @@ func (c *GRPCClient) publish(ctx context.Context, evt *cloudevents.Event) error
 	}
 
 	// Publish the event
-	_, err := c.client.Publish(ctx, &pbv1.PublishRequest{Event: pbEvt})
+	_, err := c.client.Publish(ctx, &pbv1.PublishRequest{Event: pbEvt}, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to publish CloudEvent: %w", err)
 	}
@@ -196,23 +199,73 @@ func (c *GRPCClient) Close() error {
 	return nil
 }
 
-// Apply creates or updates a resource bundle via CloudEvent
-func (c *GRPCClient) Apply(ctx context.Context, bundle *openapi.ResourceBundle, action cetypes.EventAction) error {
+// SetApplyOptions sets the field manager that the resource bundles are created or updated on behalf of,
+// an update only replaces or removes the manifests that are owned by the field manager
+func (c *GRPCClient) SetApplyOptions(options api.ApplyOptions) {
+	c.applyOptions = options
+}
+
+// Apply creates or updates a resource bundle via CloudEvent, and returns the id of the operation that
+// tracks the request, the id is empty if the server does not return one
+func (c *GRPCClient) Apply(ctx context.Context, bundle *openapi.ResourceBundle, action cetypes.EventAction) (string, error) {
+	evt, err := c.newApplyEvent(bundle, action)
+	if err != nil {
+		return "", err
+	}
+
+	// Publish the CloudEvent, the operation id is sent back in the response header
+	var header metadata.MD
+	if err := c.publish(ctx, evt, grpc.Header(&header)); err != nil {
+		return "", fmt.Errorf("failed to publish CloudEvent: %w", err)
+	}
+
+	return operationIDFromHeader(header), nil
+}
+
+// DryRun validates the create or update of a resource bundle via CloudEvent without applying it,
+// and returns the difference between the stored resource bundle and the given one
+func (c *GRPCClient) DryRun(ctx context.Context, bundle *openapi.ResourceBundle, action cetypes.EventAction) (*openapi.ResourceBundleDiff, error) {
+	evt, err := c.newApplyEvent(bundle, action)
+	if err != nil {
+		return nil, err
+	}
+	evt.SetExtension(api.ExtensionDryRun, true)
+
+	// The diff is sent back in the response header
+	var header metadata.MD
+	if err := c.publish(ctx, evt, grpc.Header(&header)); err != nil {
+		return nil, fmt.Errorf("failed to publish CloudEvent: %w", err)
+	}
+
+	values := header.Get(api.DryRunDiffHeader)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no resource bundle diff received from the server")
+	}
+	diff := &openapi.ResourceBundleDiff{}
+	if err := json.Unmarshal([]byte(values[0]), diff); err != nil {
+		return nil, fmt.Errorf("failed to decode resource bundle diff: %w", err)
+	}
+
+	return diff, nil
+}
+
+// newApplyEvent builds the CloudEvent to create or update a resource bundle
+func (c *GRPCClient) newApplyEvent(bundle *openapi.ResourceBundle, action cetypes.EventAction) (*cloudevents.Event, error) {
 	// Validate required fields
 	if bundle == nil {
-		return fmt.Errorf("resource bundle is required")
+		return nil, fmt.Errorf("resource bundle is required")
 	}
 	if bundle.Id == nil || *bundle.Id == "" {
-		return fmt.Errorf("resource bundle ID is required")
+		return nil, fmt.Errorf("resource bundle ID is required")
 	}
 	if bundle.Version == nil {
-		return fmt.Errorf("resource bundle version is required")
+		return nil, fmt.Errorf("resource bundle version is required")
 	}
 	if bundle.ConsumerName == nil || *bundle.ConsumerName == "" {
-		return fmt.Errorf("consumer name is required")
+		return nil, fmt.Errorf("consumer name is required")
 	}
 	if len(bundle.Manifests) == 0 {
-		return fmt.Errorf("manifest must specify at least one item in 'manifests'")
+		return nil, fmt.Errorf("manifest must specify at least one item in 'manifests'")
 	}
 
 	resourceID := *bundle.Id
@@ -234,7 +287,7 @@ func (c *GRPCClient) Apply(ctx context.Context, bundle *openapi.ResourceBundle,
 	case cetypes.CreateRequestAction, cetypes.UpdateRequestAction:
 		// supported
 	default:
-		return fmt.Errorf("unsupported action for Apply: %s", action)
+		return nil, fmt.Errorf("unsupported action for Apply: %s", action)
 	}
 
 	// Create CloudEvent
@@ -260,31 +313,35 @@ func (c *GRPCClient) Apply(ctx context.Context, bundle *openapi.ResourceBundle,
 	if bundle.Metadata != nil {
 		metadataBytes, err := json.Marshal(bundle.Metadata)
 		if err != nil {
-			return fmt.Errorf("failed to marshal metadata: %w", err)
+			return nil, fmt.Errorf("failed to marshal metadata: %w", err)
 		}
 		evt.SetExtension(cetypes.ExtensionWorkMeta, string(metadataBytes))
 	}
 
-	// Set data
-	if err := evt.SetData(cloudevents.ApplicationJSON, data); err != nil {
-		return fmt.Errorf("failed to set CloudEvent data: %w", err)
+	// Apply on behalf of the field manager if it is set
+	if c.applyOptions.FieldManager != "" {
+		evt.SetExtension(api.ExtensionFieldManager, c.applyOptions.FieldManager)
+		if c.applyOptions.Force {
+			evt.SetExtension(api.ExtensionForceApply, true)
+		}
 	}
 
-	// Publish the CloudEvent
-	if err := c.publish(ctx, &evt); err != nil {
-		return fmt.Errorf("failed to publish CloudEvent: %w", err)
+	// Set data
+	if err := evt.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, fmt.Errorf("failed to set CloudEvent data: %w", err)
 	}
 
-	return nil
+	return &evt, nil
 }
 
-// Delete deletes a resource bundle via CloudEvent
-func (c *GRPCClient) Delete(ctx context.Context, resourceID, consumerName string, resourceVersion int32) error {
+// Delete deletes a resource bundle via CloudEvent, and returns the id of the operation that tracks the
+// deletion, the id is empty if the server does not return one
+func (c *GRPCClient) Delete(ctx context.Context, resourceID, consumerName string, resourceVersion int32) (string, error) {
 	if resourceID == "" {
-		return fmt.Errorf("resource ID is required")
+		return "", fmt.Errorf("resource ID is required")
 	}
 	if consumerName == "" {
-		return fmt.Errorf("consumer name is required")
+		return "", fmt.Errorf("consumer name is required")
 	}
 
 	// Create CloudEvent
@@ -308,10 +365,20 @@ func (c *GRPCClient) Delete(ctx context.Context, resourceID, consumerName string
 
 	// No data payload needed for delete
 
-	// Publish the CloudEvent
-	if err := c.publish(ctx, &evt); err != nil {
-		return fmt.Errorf("failed to publish delete CloudEvent: %w", err)
+	// Publish the CloudEvent, the operation id is sent back in the response header
+	var header metadata.MD
+	if err := c.publish(ctx, &evt, grpc.Header(&header)); err != nil {
+		return "", fmt.Errorf("failed to publish delete CloudEvent: %w", err)
 	}
 
-	return nil
+	return operationIDFromHeader(header), nil
+}
+
+// operationIDFromHeader returns the operation id from the response header of a publish request
+func operationIDFromHeader(header metadata.MD) string {
+	values := header.Get(api.OperationIDHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
 }
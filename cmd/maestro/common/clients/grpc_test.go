@@ -9,6 +9,7 @@ import (
 	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 
 	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
@@ -198,7 +199,7 @@ func TestGRPCClient_Apply(t *testing.T) {
 			grpcServer.ClearPublishedEvents()
 
 			ctx := context.Background()
-			err := client.Apply(ctx, tt.bundle, tt.action)
+			operationID, err := client.Apply(ctx, tt.bundle, tt.action)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Apply() error = %v, wantErr %v", err, tt.wantErr)
@@ -217,6 +218,9 @@ func TestGRPCClient_Apply(t *testing.T) {
 				if len(events) != 1 {
 					t.Errorf("Expected 1 published event, got %d", len(events))
 				}
+				if operationID != mock.OperationID {
+					t.Errorf("Apply() operation id = %q, want %q", operationID, mock.OperationID)
+				}
 			}
 		})
 	}
@@ -280,7 +284,7 @@ func TestGRPCClient_Delete(t *testing.T) {
 			grpcServer.ClearPublishedEvents()
 
 			ctx := context.Background()
-			err := client.Delete(ctx, tt.resourceID, tt.consumerName, tt.resourceVersion)
+			operationID, err := client.Delete(ctx, tt.resourceID, tt.consumerName, tt.resourceVersion)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
@@ -299,6 +303,9 @@ func TestGRPCClient_Delete(t *testing.T) {
 				if len(events) != 1 {
 					t.Errorf("Expected 1 published event, got %d", len(events))
 				}
+				if operationID != mock.OperationID {
+					t.Errorf("Delete() operation id = %q, want %q", operationID, mock.OperationID)
+				}
 			}
 		})
 	}
@@ -369,7 +376,7 @@ func TestGRPCClient_PublishWithMetadata(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	err = client.Apply(ctx, bundle, cetypes.CreateRequestAction)
+	_, err = client.Apply(ctx, bundle, cetypes.CreateRequestAction)
 	if err != nil {
 		t.Errorf("Apply() error = %v", err)
 	}
@@ -381,6 +388,55 @@ func TestGRPCClient_PublishWithMetadata(t *testing.T) {
 	}
 }
 
+func TestGRPCClient_ApplyWithFieldManager(t *testing.T) {
+	grpcServer, err := mock.NewGRPCServer()
+	if err != nil {
+		t.Fatalf("Failed to create mock gRPC server: %v", err)
+	}
+	defer grpcServer.Stop()
+
+	cfg := &Config{
+		GRPCConfig: GRPCConfig{
+			ServerAddress: grpcServer.Address(),
+			SourceID:      "test-source",
+		},
+	}
+
+	client, err := NewGRPCClient(cfg)
+	if err != nil {
+		t.Fatalf("NewGRPCClient() failed: %v", err)
+	}
+	defer client.Close()
+	client.SetApplyOptions(api.ApplyOptions{FieldManager: "test-controller", Force: true})
+
+	bundle := &openapi.ResourceBundle{
+		Id:           openapi.PtrString("test-bundle-1"),
+		ConsumerName: openapi.PtrString("consumer1"),
+		Version:      openapi.PtrInt32(1),
+		Manifests: []map[string]interface{}{
+			{"kind": "ConfigMap"},
+		},
+	}
+
+	_, err = client.Apply(context.Background(), bundle, cetypes.UpdateRequestAction)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	events := grpcServer.GetPublishedEvents()
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 published event, got %d", len(events))
+	}
+	// the extensions are prefixed in the protobuf attributes
+	attributes := events[0].Attributes
+	if fieldManager := attributes["ce-"+api.ExtensionFieldManager].GetCeString(); fieldManager != "test-controller" {
+		t.Errorf("Expected field manager test-controller, got %q", fieldManager)
+	}
+	if force := attributes["ce-"+api.ExtensionForceApply].GetCeBoolean(); !force {
+		t.Errorf("Expected force apply, got %v", force)
+	}
+}
+
 func TestGRPCClient_ApplyWithTimeout(t *testing.T) {
 	grpcServer, err := mock.NewGRPCServer()
 	if err != nil {
@@ -414,7 +470,7 @@ func TestGRPCClient_ApplyWithTimeout(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	err = client.Apply(ctx, bundle, cetypes.CreateRequestAction)
+	_, err = client.Apply(ctx, bundle, cetypes.CreateRequestAction)
 	if err != nil {
 		t.Errorf("Apply() with timeout error = %v", err)
 	}
@@ -2,17 +2,28 @@ package mock
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"sync"
 
+	"github.com/cloudevents/sdk-go/v2/binding"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
+	grpcprotocol "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protocol"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
+// OperationID is the id of the operation that is returned for every create, update and delete request
+const OperationID = "operation-2"
+
 // GRPCServer is a mock gRPC CloudEvent server for testing
 type GRPCServer struct {
 	pbv1.UnimplementedCloudEventServiceServer
@@ -70,6 +81,41 @@ func (s *GRPCServer) Publish(ctx context.Context, req *pbv1.PublishRequest) (*em
 	}
 
 	s.publishedEvents = append(s.publishedEvents, req.Event)
+
+	evt, err := binding.ToEvent(ctx, grpcprotocol.NewMessage(req.Event))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to convert protobuf to cloudevent: %v", err)
+	}
+
+	// Reply a dry-run request with a diff that reports every manifest as added
+	if _, ok := evt.Extensions()[api.ExtensionDryRun]; ok {
+		diff := openapi.ResourceBundleDiff{
+			ResourceId: openapi.PtrString(fmt.Sprintf("%v", evt.Extensions()[cetypes.ExtensionResourceID])),
+			Changed:    openapi.PtrBool(true),
+			Manifests: []openapi.ManifestDiff{
+				{
+					Change:     openapi.PtrString("Added"),
+					ApiVersion: openapi.PtrString("v1"),
+					Kind:       openapi.PtrString("ConfigMap"),
+					Name:       openapi.PtrString("test-cm"),
+				},
+			},
+		}
+		data, err := json.Marshal(diff)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal diff: %v", err)
+		}
+		if err := grpc.SetHeader(ctx, metadata.Pairs(api.DryRunDiffHeader, string(data))); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	// Reply the other requests with the operation that tracks them
+	if err := grpc.SetHeader(ctx, metadata.Pairs(api.OperationIDHeader, OperationID)); err != nil {
+		return nil, err
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
@@ -28,6 +29,11 @@ func NewMaestroServer() *Server {
 		// Resource Bundle endpoints
 		case method == "GET" && path == "/api/maestro/v1/resource-bundles":
 			handleListResourceBundles(w, r)
+		case method == "GET" && path == "/api/maestro/v1/resource-bundles/summary":
+			handleSummarizeResourceBundles(w, r)
+		case method == "POST" && strings.HasPrefix(path, "/api/maestro/v1/resource-bundles/") &&
+			strings.HasSuffix(path, "/rollback"):
+			handleRollbackResourceBundle(w, r)
 		case method == "GET" && strings.HasPrefix(path, "/api/maestro/v1/resource-bundles/"):
 			handleGetResourceBundle(w, r)
 		case method == "DELETE" && strings.HasPrefix(path, "/api/maestro/v1/resource-bundles/"):
@@ -45,6 +51,28 @@ func NewMaestroServer() *Server {
 		case method == "DELETE" && strings.HasPrefix(path, "/api/maestro/v1/consumers/"):
 			handleDeleteConsumer(w, r)
 
+		// Consumer set endpoints
+		case method == "GET" && path == "/api/maestro/v1/consumer-sets":
+			handleListConsumerSets(w, r)
+		case method == "GET" && strings.HasPrefix(path, "/api/maestro/v1/consumer-sets/"):
+			handleGetConsumerSet(w, r)
+		case method == "POST" && path == "/api/maestro/v1/consumer-sets":
+			handleCreateConsumerSet(w, r)
+		case method == "PATCH" && strings.HasPrefix(path, "/api/maestro/v1/consumer-sets/"):
+			handleUpdateConsumerSet(w, r)
+		case method == "DELETE" && strings.HasPrefix(path, "/api/maestro/v1/consumer-sets/"):
+			handleDeleteConsumerSet(w, r)
+
+		// Operation endpoints
+		case method == "GET" && strings.HasPrefix(path, "/api/maestro/v1/operations/"):
+			handleGetOperation(w, r)
+
+		// Placement endpoints
+		case method == "POST" && strings.HasPrefix(path, "/api/maestro/v1/placements/"):
+			handlePlacementRollout(w, r)
+		case method == "GET" && strings.HasPrefix(path, "/api/maestro/v1/placements/"):
+			handleGetPlacement(w, r)
+
 		default:
 			w.WriteHeader(http.StatusNotFound)
 		}
@@ -131,6 +159,53 @@ func handleGetResourceBundle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func handleSummarizeResourceBundles(w http.ResponseWriter, r *http.Request) {
+	groupBy := r.URL.Query().Get("group_by")
+	label := r.URL.Query().Get("label")
+
+	switch label {
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	if (groupBy == "label") != (label != "") {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	summary := openapi.ResourceBundleSummary{
+		Kind:    openapi.PtrString("ResourceBundleSummary"),
+		GroupBy: openapi.PtrString(groupBy),
+		Total: &openapi.ResourceBundleSummaryItem{
+			Total:       openapi.PtrInt64(3),
+			Applied:     openapi.PtrInt64(2),
+			Available:   openapi.PtrInt64(1),
+			Degraded:    openapi.PtrInt64(1),
+			Deleting:    openapi.PtrInt64(0),
+			NotReported: openapi.PtrInt64(1),
+		},
+		Items: []openapi.ResourceBundleSummaryItem{
+			{
+				Key:         openapi.PtrString("test-consumer"),
+				Total:       openapi.PtrInt64(3),
+				Applied:     openapi.PtrInt64(2),
+				Available:   openapi.PtrInt64(1),
+				Degraded:    openapi.PtrInt64(1),
+				Deleting:    openapi.PtrInt64(0),
+				NotReported: openapi.PtrInt64(1),
+			},
+		},
+	}
+	if label != "" {
+		summary.Label = openapi.PtrString(label)
+	}
+
+	json.NewEncoder(w).Encode(summary)
+}
+
 func handleDeleteResourceBundle(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/resource-bundles/")
 
@@ -148,6 +223,45 @@ func handleDeleteResourceBundle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func handleRollbackResourceBundle(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/resource-bundles/"), "/rollback")
+
+	var rollback openapi.ResourceBundleRollbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&rollback); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch id {
+	case "bundle-1":
+		if rollback.Version != 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		now := time.Now()
+		bundle := openapi.ResourceBundle{
+			Id:           openapi.PtrString("bundle-1"),
+			Name:         openapi.PtrString("test-bundle-1"),
+			ConsumerName: openapi.PtrString("test-consumer"),
+			Version:      openapi.PtrInt32(3),
+			CreatedAt:    &now,
+			UpdatedAt:    &now,
+		}
+		w.Header().Set(api.OperationIDHeader, OperationID)
+		json.NewEncoder(w).Encode(bundle)
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "conflict":
+		w.WriteHeader(http.StatusConflict)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
 func handleListConsumers(w http.ResponseWriter, r *http.Request) {
 	page := r.URL.Query().Get("page")
 	size := r.URL.Query().Get("size")
@@ -277,6 +391,19 @@ func handleDeleteConsumer(w http.ResponseWriter, r *http.Request) {
 
 	switch id {
 	case "consumer-1":
+		if r.URL.Query().Get("cascade") == "true" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(openapi.Operation{
+				Id:       openapi.PtrString("operation-1"),
+				Kind:     openapi.PtrString("Operation"),
+				Type:     openapi.PtrString("DeleteConsumer"),
+				TargetId: openapi.PtrString(id),
+				Phase:    openapi.PtrString("Running"),
+				Total:    openapi.PtrInt32(2),
+			})
+			return
+		}
 		w.WriteHeader(http.StatusNoContent)
 	case "not-found":
 		w.WriteHeader(http.StatusNotFound)
@@ -290,3 +417,222 @@ func handleDeleteConsumer(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func handleGetOperation(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/operations/")
+
+	operation := openapi.Operation{
+		Id:        openapi.PtrString(id),
+		Kind:      openapi.PtrString("Operation"),
+		Total:     openapi.PtrInt32(1),
+		Completed: openapi.PtrInt32(1),
+	}
+	switch id {
+	case "operation-1", OperationID:
+		operation.Phase = openapi.PtrString("Succeeded")
+	case "operation-failed":
+		operation.Phase = openapi.PtrString("Failed")
+		operation.Completed = openapi.PtrInt32(0)
+		operation.Message = openapi.PtrString("the resource is deleted before it is applied")
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+		return
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(operation)
+}
+
+func newPlacement(phase string) openapi.Placement {
+	now := time.Now()
+	return openapi.Placement{
+		Id:      openapi.PtrString("placement-1"),
+		Name:    openapi.PtrString("test-placement-1"),
+		Version: openapi.PtrInt32(2),
+		RolloutStrategy: &openapi.PlacementRolloutStrategy{
+			Type:      openapi.PtrString("Progressive"),
+			BatchSize: openapi.PtrInt32(2),
+		},
+		RolloutPhase: openapi.PtrString(phase),
+		Status: &openapi.PlacementStatus{
+			Desired:         openapi.PtrInt32(5),
+			Current:         openapi.PtrInt32(5),
+			Updated:         openapi.PtrInt32(2),
+			Applied:         openapi.PtrInt32(2),
+			Available:       openapi.PtrInt32(1),
+			ObservedVersion: openapi.PtrInt32(2),
+		},
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+}
+
+func handleGetPlacement(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/placements/")
+
+	switch id {
+	case "placement-1":
+		json.NewEncoder(w).Encode(newPlacement("Progressing"))
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// handlePlacementRollout handles the pause, resume and abort requests, the rollout of placement-1
+// is in progress, so it can be paused or aborted but not resumed.
+func handlePlacementRollout(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/placements/"), "/")
+	if len(parts) != 2 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	id, action := parts[0], parts[1]
+
+	switch id {
+	case "placement-1":
+		switch action {
+		case "pause":
+			json.NewEncoder(w).Encode(newPlacement("Paused"))
+		case "abort":
+			json.NewEncoder(w).Encode(newPlacement("Aborted"))
+		case "resume":
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func newConsumerSet(members []string, consumerSelector map[string]interface{}) openapi.ConsumerSet {
+	now := time.Now()
+	return openapi.ConsumerSet{
+		Id:               openapi.PtrString("consumer-set-1"),
+		Name:             openapi.PtrString("test-consumer-set-1"),
+		Members:          members,
+		ConsumerSelector: consumerSelector,
+		Status: &openapi.ConsumerSetStatus{
+			Members:         openapi.PtrInt32(2),
+			ResourceBundles: openapi.PtrInt32(4),
+			Applied:         openapi.PtrInt32(3),
+			Available:       openapi.PtrInt32(2),
+			Degraded:        openapi.PtrInt32(1),
+		},
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+}
+
+func handleListConsumerSets(w http.ResponseWriter, r *http.Request) {
+	list := openapi.ConsumerSetList{
+		Kind:  "ConsumerSetList",
+		Items: []openapi.ConsumerSet{newConsumerSet([]string{"cluster1", "cluster2"}, nil)},
+		Page:  1,
+		Size:  1,
+		Total: 1,
+	}
+
+	// Simple search filter
+	if search := r.URL.Query().Get("search"); search != "" && !strings.Contains(*list.Items[0].Name, search) {
+		list.Items = []openapi.ConsumerSet{}
+		list.Size = 0
+		list.Total = 0
+	}
+
+	json.NewEncoder(w).Encode(list)
+}
+
+func handleGetConsumerSet(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/consumer-sets/")
+
+	switch id {
+	case "consumer-set-1":
+		json.NewEncoder(w).Encode(newConsumerSet([]string{"cluster1", "cluster2"}, nil))
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func handleCreateConsumerSet(w http.ResponseWriter, r *http.Request) {
+	var consumerSet openapi.ConsumerSet
+	if err := json.NewDecoder(r.Body).Decode(&consumerSet); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch consumerSet.GetName() {
+	case "conflict":
+		w.WriteHeader(http.StatusConflict)
+	case "bad-request":
+		w.WriteHeader(http.StatusBadRequest)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		created := newConsumerSet(consumerSet.Members, consumerSet.ConsumerSelector)
+		created.Name = consumerSet.Name
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(created)
+	}
+}
+
+func handleUpdateConsumerSet(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/consumer-sets/")
+
+	var patch openapi.ConsumerSetPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch id {
+	case "consumer-set-1":
+		json.NewEncoder(w).Encode(newConsumerSet(patch.Members, patch.ConsumerSelector))
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func handleDeleteConsumerSet(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/consumer-sets/")
+
+	switch id {
+	case "consumer-set-1":
+		w.WriteHeader(http.StatusNoContent)
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
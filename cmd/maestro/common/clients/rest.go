@@ -5,7 +5,11 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
@@ -25,8 +29,17 @@ func NewRESTClient(cfg *RESTConfig) (*RESTClient, error) {
 		return nil, fmt.Errorf("REST base URL is required")
 	}
 
+	defaultHeader := make(map[string]string)
+	if cfg.TokenFile != "" {
+		token, err := os.ReadFile(cfg.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read REST token file %s: %w", cfg.TokenFile, err)
+		}
+		defaultHeader["Authorization"] = "Bearer " + strings.TrimSpace(string(token))
+	}
+
 	client := openapi.NewAPIClient(&openapi.Configuration{
-		DefaultHeader:    make(map[string]string),
+		DefaultHeader:    defaultHeader,
 		UserAgent:        "OpenAPI-Generator/1.0.0/go",
 		Debug:            false,
 		Servers:          openapi.ServerConfigurations{{URL: cfg.BaseURL}},
@@ -134,6 +147,71 @@ func (c *RESTClient) DeleteResourceBundle(ctx context.Context, id string) error
 	}
 }
 
+// RollbackResourceBundle rolls back a resource bundle to the given version, the rollback is
+// applied as a new version of the resource bundle. It returns the rolled back resource bundle and
+// the id of the operation that tracks the rollback
+func (c *RESTClient) RollbackResourceBundle(ctx context.Context, id string, version int32) (*openapi.ResourceBundle, string, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, id).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(version)).Execute()
+	if resp == nil {
+		return nil, "", fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to decode resource bundle response: %w", err)
+		}
+		return result, resp.Header.Get(api.OperationIDHeader), nil
+	case http.StatusNotFound:
+		return nil, "", fmt.Errorf("resource bundle or version %d not found", version)
+	case http.StatusBadRequest:
+		return nil, "", fmt.Errorf("bad request, err=%w", err)
+	case http.StatusConflict:
+		return nil, "", fmt.Errorf("resource bundle is being deleted")
+	case http.StatusUnauthorized:
+		return nil, "", fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, "", fmt.Errorf("permission denied")
+	default:
+		return nil, "", fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// SummarizeResourceBundles returns the number of the resource bundles in each state grouped by consumer,
+// source or the value of a label
+func (c *RESTClient) SummarizeResourceBundles(ctx context.Context, groupBy, label string) (*openapi.ResourceBundleSummary, error) {
+	req := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesSummaryGet(ctx).GroupBy(groupBy)
+	if label != "" {
+		req = req.Label(label)
+	}
+
+	result, resp, err := req.Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode resource bundle summary response: %w", err)
+		}
+		return result, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("bad request, err=%w", err)
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
 // ListConsumers lists consumers with pagination and filtering
 func (c *RESTClient) ListConsumers(ctx context.Context, page, size int, search string) (*openapi.ConsumerList, error) {
 	req := c.client.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).
@@ -253,7 +331,7 @@ func (c *RESTClient) UpdateConsumer(ctx context.Context, id string, consumer ope
 
 // DeleteConsumer deletes a consumer by ID
 func (c *RESTClient) DeleteConsumer(ctx context.Context, id string) error {
-	resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, id).Execute()
+	_, resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, id).Execute()
 	if resp == nil {
 		return fmt.Errorf("no HTTP response received, err=%w", err)
 	}
@@ -275,3 +353,258 @@ func (c *RESTClient) DeleteConsumer(ctx context.Context, id string) error {
 		return fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
 	}
 }
+
+// DeleteConsumerCascade starts the deletion of a consumer and its resource bundles, the returned
+// operation is completed once the consumer is deleted
+func (c *RESTClient) DeleteConsumerCascade(ctx context.Context, id string) (*openapi.Operation, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, id).Cascade(true).Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode operation response: %w", err)
+		}
+		return result, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("consumer not found")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// GetOperation retrieves a single operation by ID
+func (c *RESTClient) GetOperation(ctx context.Context, id string) (*openapi.Operation, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1OperationsIdGet(ctx, id).Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode operation response: %w", err)
+		}
+		return result, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("operation not found")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// WaitForOperation polls an operation with the given interval until it is succeeded or failed, or the
+// context is done. An error is returned if the operation is failed
+func (c *RESTClient) WaitForOperation(ctx context.Context, id string, interval time.Duration) (*openapi.Operation, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		operation, err := c.GetOperation(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch operation.GetPhase() {
+		case string(api.OperationSucceeded):
+			return operation, nil
+		case string(api.OperationFailed):
+			return operation, fmt.Errorf("operation %s failed: %s", id, operation.GetMessage())
+		}
+
+		select {
+		case <-ctx.Done():
+			return operation, fmt.Errorf("timed out waiting for operation %s: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// ListConsumerSets retrieves a list of consumer sets with optional filtering
+func (c *RESTClient) ListConsumerSets(ctx context.Context, page, size int, search string) (*openapi.ConsumerSetList, error) {
+	req := c.client.DefaultAPI.ApiMaestroV1ConsumerSetsGet(ctx).
+		Page(int32(page)).
+		Size(int32(size))
+
+	if search != "" {
+		req = req.Search(search)
+	}
+
+	result, resp, err := req.Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode consumer set list response: %w", err)
+		}
+		return result, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("bad request, err=%w", err)
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// GetConsumerSet retrieves a single consumer set by ID
+func (c *RESTClient) GetConsumerSet(ctx context.Context, id string) (*openapi.ConsumerSet, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumerSetsIdGet(ctx, id).Execute()
+	return consumerSetResult(result, resp, err, http.StatusOK)
+}
+
+// CreateConsumerSet creates a new consumer set
+func (c *RESTClient) CreateConsumerSet(ctx context.Context, consumerSet openapi.ConsumerSet) (*openapi.ConsumerSet, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumerSetsPost(ctx).ConsumerSet(consumerSet).Execute()
+	return consumerSetResult(result, resp, err, http.StatusCreated)
+}
+
+// UpdateConsumerSet replaces the members or the consumer selector of a consumer set
+func (c *RESTClient) UpdateConsumerSet(ctx context.Context, id string, patch openapi.ConsumerSetPatchRequest) (*openapi.ConsumerSet, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumerSetsIdPatch(ctx, id).ConsumerSetPatchRequest(patch).Execute()
+	return consumerSetResult(result, resp, err, http.StatusOK)
+}
+
+// DeleteConsumerSet deletes a consumer set by ID
+func (c *RESTClient) DeleteConsumerSet(ctx context.Context, id string) error {
+	resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumerSetsIdDelete(ctx, id).Execute()
+	if resp == nil {
+		return fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("consumer set not found")
+	case http.StatusUnauthorized:
+		return fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return fmt.Errorf("permission denied")
+	default:
+		return fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// consumerSetResult handles the response of the get, create and update consumer set requests
+func consumerSetResult(result *openapi.ConsumerSet, resp *http.Response, err error, expected int) (*openapi.ConsumerSet, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case expected:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode consumer set response: %w", err)
+		}
+		return result, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("consumer set not found")
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("bad request, err=%w", err)
+	case http.StatusConflict:
+		return nil, fmt.Errorf("consumer set already exists")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// GetPlacement retrieves a single placement by ID
+func (c *RESTClient) GetPlacement(ctx context.Context, id string) (*openapi.Placement, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1PlacementsIdGet(ctx, id).Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode placement response: %w", err)
+		}
+		return result, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("placement not found")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// PausePlacementRollout pauses the rollout of a placement
+func (c *RESTClient) PausePlacementRollout(ctx context.Context, id string) (*openapi.Placement, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1PlacementsIdPausePost(ctx, id).Execute()
+	return placementRolloutResult(result, resp, err)
+}
+
+// ResumePlacementRollout resumes the paused rollout of a placement
+func (c *RESTClient) ResumePlacementRollout(ctx context.Context, id string) (*openapi.Placement, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1PlacementsIdResumePost(ctx, id).Execute()
+	return placementRolloutResult(result, resp, err)
+}
+
+// AbortPlacementRollout aborts the rollout of a placement
+func (c *RESTClient) AbortPlacementRollout(ctx context.Context, id string) (*openapi.Placement, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1PlacementsIdAbortPost(ctx, id).Execute()
+	return placementRolloutResult(result, resp, err)
+}
+
+// placementRolloutResult handles the response of the pause, resume and abort rollout requests
+func placementRolloutResult(result *openapi.Placement, resp *http.Response, err error) (*openapi.Placement, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode placement response: %w", err)
+		}
+		return result, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("placement not found")
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("bad request, err=%w", err)
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
@@ -2,6 +2,8 @@ package clients
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -31,6 +33,30 @@ func TestNewRESTClient(t *testing.T) {
 	}
 }
 
+func TestNewRESTClientWithTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("test-token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewRESTClient(&RESTConfig{
+		BaseURL:   "https://example.com",
+		Timeout:   30 * time.Second,
+		TokenFile: tokenFile,
+	})
+	if err != nil {
+		t.Fatalf("NewRESTClient() failed: %v", err)
+	}
+
+	if got := client.client.GetConfig().DefaultHeader["Authorization"]; got != "Bearer test-token" {
+		t.Errorf("Authorization header = %q, want %q", got, "Bearer test-token")
+	}
+
+	if _, err := NewRESTClient(&RESTConfig{BaseURL: "https://example.com", TokenFile: filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Error("NewRESTClient() should fail when the token file does not exist")
+	}
+}
+
 func TestListResourceBundles(t *testing.T) {
 	server := mock.NewMaestroServer()
 	defer server.Close()
@@ -220,6 +246,234 @@ func TestDeleteResourceBundle(t *testing.T) {
 	}
 }
 
+func TestRollbackResourceBundle(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	cfg := &RESTConfig{
+		BaseURL:            server.URL,
+		InsecureSkipVerify: true,
+		Timeout:            10 * time.Second,
+	}
+
+	client, err := NewRESTClient(cfg)
+	if err != nil {
+		t.Fatalf("NewRESTClient() failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		version     int32
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "rollback existing resource bundle",
+			id:      "bundle-1",
+			version: 1,
+			wantErr: false,
+		},
+		{
+			name:        "rollback to non-existent version",
+			id:          "bundle-1",
+			version:     5,
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name:        "rollback non-existent resource bundle",
+			id:          "not-found",
+			version:     1,
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name:        "rollback deleting resource bundle",
+			id:          "conflict",
+			version:     1,
+			wantErr:     true,
+			errContains: "being deleted",
+		},
+		{
+			name:        "forbidden request",
+			id:          "forbidden",
+			version:     1,
+			wantErr:     true,
+			errContains: "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			result, operationID, err := client.RollbackResourceBundle(ctx, tt.id, tt.version)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RollbackResourceBundle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("RollbackResourceBundle() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+
+			if !tt.wantErr && result == nil {
+				t.Error("RollbackResourceBundle() returned nil result")
+			}
+			if !tt.wantErr && operationID != mock.OperationID {
+				t.Errorf("RollbackResourceBundle() operation id = %q, want %q", operationID, mock.OperationID)
+			}
+		})
+	}
+}
+
+func TestSummarizeResourceBundles(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	cfg := &RESTConfig{
+		BaseURL:            server.URL,
+		InsecureSkipVerify: true,
+		Timeout:            10 * time.Second,
+	}
+
+	client, err := NewRESTClient(cfg)
+	if err != nil {
+		t.Fatalf("NewRESTClient() failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		groupBy     string
+		label       string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "summarize by consumer",
+			groupBy: "consumer",
+			wantErr: false,
+		},
+		{
+			name:    "summarize by label",
+			groupBy: "label",
+			label:   "app",
+			wantErr: false,
+		},
+		{
+			name:        "summarize by label without the label",
+			groupBy:     "label",
+			wantErr:     true,
+			errContains: "bad request",
+		},
+		{
+			name:        "unauthorized request",
+			groupBy:     "label",
+			label:       "unauthorized",
+			wantErr:     true,
+			errContains: "authentication failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			result, err := client.SummarizeResourceBundles(ctx, tt.groupBy, tt.label)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SummarizeResourceBundles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("SummarizeResourceBundles() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+
+			if !tt.wantErr && result.GetGroupBy() != tt.groupBy {
+				t.Errorf("SummarizeResourceBundles() group by = %q, want %q", result.GetGroupBy(), tt.groupBy)
+			}
+		})
+	}
+}
+
+func TestPlacementRollout(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	cfg := &RESTConfig{
+		BaseURL:            server.URL,
+		InsecureSkipVerify: true,
+		Timeout:            10 * time.Second,
+	}
+
+	client, err := NewRESTClient(cfg)
+	if err != nil {
+		t.Fatalf("NewRESTClient() failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		action      func(ctx context.Context, id string) (*openapi.Placement, error)
+		wantPhase   string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "pause a rollout in progress",
+			id:        "placement-1",
+			action:    client.PausePlacementRollout,
+			wantPhase: "Paused",
+		},
+		{
+			name:      "abort a rollout in progress",
+			id:        "placement-1",
+			action:    client.AbortPlacementRollout,
+			wantPhase: "Aborted",
+		},
+		{
+			name:        "resume a rollout that is not paused",
+			id:          "placement-1",
+			action:      client.ResumePlacementRollout,
+			wantErr:     true,
+			errContains: "bad request",
+		},
+		{
+			name:        "pause non-existent placement",
+			id:          "not-found",
+			action:      client.PausePlacementRollout,
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.action(context.Background(), tt.id)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("action error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("action error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+
+			if !tt.wantErr && *result.RolloutPhase != tt.wantPhase {
+				t.Errorf("action rollout phase = %s, want %s", *result.RolloutPhase, tt.wantPhase)
+			}
+		})
+	}
+}
+
 func TestListConsumers(t *testing.T) {
 	server := mock.NewMaestroServer()
 	defer server.Close()
@@ -590,3 +844,66 @@ func TestDeleteConsumer(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForOperation(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	cfg := &RESTConfig{
+		BaseURL:            server.URL,
+		InsecureSkipVerify: true,
+		Timeout:            5 * time.Second,
+	}
+
+	client, err := NewRESTClient(cfg)
+	if err != nil {
+		t.Fatalf("NewRESTClient() failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "succeeded operation",
+			id:      mock.OperationID,
+			wantErr: false,
+		},
+		{
+			name:        "failed operation",
+			id:          "operation-failed",
+			wantErr:     true,
+			errContains: "deleted before it is applied",
+		},
+		{
+			name:        "non-existent operation",
+			id:          "not-found",
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			operation, err := client.WaitForOperation(ctx, tt.id, 10*time.Millisecond)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForOperation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("WaitForOperation() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+
+			if !tt.wantErr && operation.GetPhase() != "Succeeded" {
+				t.Errorf("WaitForOperation() phase = %s, want Succeeded", operation.GetPhase())
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+const (
+	// Wait flag names
+	FlagWait        = "wait"
+	FlagWaitTimeout = "wait-timeout"
+)
+
+// waitInterval is the interval to poll the operation when waiting for it
+var waitInterval = 2 * time.Second
+
+// AddWaitFlags adds the flags to wait for the operation of a request to a command
+func AddWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagWait, false, "Wait until the agent has applied or removed the resource bundles of the request")
+	cmd.Flags().Duration(FlagWaitTimeout, 5*time.Minute, "The maximum time to wait when --wait is set")
+}
+
+// WaitForOperationFromFlags waits for the operation if the --wait flag is set, it returns nil without
+// waiting if the flag is not set
+func WaitForOperationFromFlags(ctx context.Context, cmd *cobra.Command, restClient *RESTClient, operationID string) (*openapi.Operation, error) {
+	wait, err := cmd.Flags().GetBool(FlagWait)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagWait, err)
+	}
+	if !wait {
+		return nil, nil
+	}
+
+	timeout, err := cmd.Flags().GetDuration(FlagWaitTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --%s flag: %w", FlagWaitTimeout, err)
+	}
+
+	if operationID == "" {
+		return nil, fmt.Errorf("no operation is returned by the server to wait for")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return restClient.WaitForOperation(ctx, operationID, waitInterval)
+}
@@ -1,12 +1,14 @@
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
 	"text/tabwriter"
 	"time"
 
+	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
@@ -90,17 +92,19 @@ func PrintConsumerList(w io.Writer, consumers []openapi.Consumer) (err error) {
 	}()
 
 	// Print header
-	fmt.Fprintln(printer.writer, "ID\tNAME\tLABELS\tCREATED")
+	fmt.Fprintln(printer.writer, "ID\tNAME\tLABELS\tCONNECTED\tLAST SEEN\tCREATED")
 
 	// Print rows
 	for _, consumer := range consumers {
 		id := getStringPtr(consumer.Id)
 		name := getStringPtr(consumer.Name)
 		labels := formatLabels(consumer.Labels)
+		connected := getBoolPtr(consumer.Connected)
+		lastSeen := formatTime(consumer.LastSeen)
 		created := formatTime(consumer.CreatedAt)
 
-		fmt.Fprintf(printer.writer, "%s\t%s\t%s\t%s\n",
-			id, name, labels, created)
+		fmt.Fprintf(printer.writer, "%s\t%s\t%s\t%t\t%s\t%s\n",
+			id, name, labels, connected, lastSeen, created)
 	}
 
 	return nil
@@ -123,14 +127,188 @@ func PrintConsumer(w io.Writer, consumer *openapi.Consumer) (err error) {
 	fmt.Fprintf(printer.writer, "ID\t%s\n", getStringPtr(consumer.Id))
 	fmt.Fprintf(printer.writer, "Name\t%s\n", getStringPtr(consumer.Name))
 	fmt.Fprintf(printer.writer, "Labels\t%s\n", formatLabels(consumer.Labels))
+	fmt.Fprintf(printer.writer, "Connected\t%t\n", getBoolPtr(consumer.Connected))
+	fmt.Fprintf(printer.writer, "Last Seen\t%s\n", formatTime(consumer.LastSeen))
 	fmt.Fprintf(printer.writer, "Created\t%s\n", formatTime(consumer.CreatedAt))
 	fmt.Fprintf(printer.writer, "Updated\t%s\n", formatTime(consumer.UpdatedAt))
 
 	return nil
 }
 
+// PrintPlacement prints a single placement and its rollout status as a table
+func PrintPlacement(w io.Writer, placement *openapi.Placement) (err error) {
+	if placement == nil {
+		return fmt.Errorf("placement is required")
+	}
+
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	strategy := "All"
+	if placement.RolloutStrategy != nil && getStringPtr(placement.RolloutStrategy.Type) != "" {
+		strategy = getStringPtr(placement.RolloutStrategy.Type)
+		if batchSize := getInt32Ptr(placement.RolloutStrategy.BatchSize); batchSize > 0 {
+			strategy = fmt.Sprintf("%s (batch size %d)", strategy, batchSize)
+		}
+	}
+
+	fmt.Fprintln(printer.writer, "FIELD\tVALUE")
+	fmt.Fprintf(printer.writer, "ID\t%s\n", getStringPtr(placement.Id))
+	fmt.Fprintf(printer.writer, "Name\t%s\n", getStringPtr(placement.Name))
+	fmt.Fprintf(printer.writer, "Version\t%d\n", getInt32Ptr(placement.Version))
+	fmt.Fprintf(printer.writer, "Rollout Strategy\t%s\n", strategy)
+	fmt.Fprintf(printer.writer, "Rollout Phase\t%s\n", getStringPtr(placement.RolloutPhase))
+	if status := placement.Status; status != nil {
+		fmt.Fprintf(printer.writer, "Desired\t%d\n", getInt32Ptr(status.Desired))
+		fmt.Fprintf(printer.writer, "Current\t%d\n", getInt32Ptr(status.Current))
+		fmt.Fprintf(printer.writer, "Updated\t%d\n", getInt32Ptr(status.Updated))
+		fmt.Fprintf(printer.writer, "Applied\t%d\n", getInt32Ptr(status.Applied))
+		fmt.Fprintf(printer.writer, "Available\t%d\n", getInt32Ptr(status.Available))
+		fmt.Fprintf(printer.writer, "Failed\t%d\n", getInt32Ptr(status.Failed))
+		if message := getStringPtr(status.Message); message != "" {
+			fmt.Fprintf(printer.writer, "Message\t%s\n", message)
+		}
+	}
+	fmt.Fprintf(printer.writer, "Created\t%s\n", formatTime(placement.CreatedAt))
+	fmt.Fprintf(printer.writer, "Updated At\t%s\n", formatTime(placement.UpdatedAt))
+
+	return nil
+}
+
+// PrintConsumerSetList prints a list of consumer sets and their aggregate status as a table
+func PrintConsumerSetList(w io.Writer, consumerSets []openapi.ConsumerSet) (err error) {
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	// Print header
+	fmt.Fprintln(printer.writer, "ID\tNAME\tMEMBERS\tBUNDLES\tAPPLIED\tAVAILABLE\tDEGRADED\tCREATED")
+
+	// Print rows
+	for _, consumerSet := range consumerSets {
+		status := consumerSet.Status
+		if status == nil {
+			status = &openapi.ConsumerSetStatus{}
+		}
+
+		fmt.Fprintf(printer.writer, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%s\n",
+			getStringPtr(consumerSet.Id), getStringPtr(consumerSet.Name), getInt32Ptr(status.Members),
+			getInt32Ptr(status.ResourceBundles), getInt32Ptr(status.Applied), getInt32Ptr(status.Available),
+			getInt32Ptr(status.Degraded), formatTime(consumerSet.CreatedAt))
+	}
+
+	return nil
+}
+
+// PrintConsumerSet prints a single consumer set and its aggregate status as a table
+func PrintConsumerSet(w io.Writer, consumerSet *openapi.ConsumerSet) (err error) {
+	if consumerSet == nil {
+		return fmt.Errorf("consumer set is required")
+	}
+
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	fmt.Fprintln(printer.writer, "FIELD\tVALUE")
+	fmt.Fprintf(printer.writer, "ID\t%s\n", getStringPtr(consumerSet.Id))
+	fmt.Fprintf(printer.writer, "Name\t%s\n", getStringPtr(consumerSet.Name))
+	if len(consumerSet.Members) > 0 {
+		fmt.Fprintf(printer.writer, "Static Members\t%s\n", strings.Join(consumerSet.Members, ","))
+	}
+	if len(consumerSet.ConsumerSelector) > 0 {
+		selector, err := json.Marshal(consumerSet.ConsumerSelector)
+		if err != nil {
+			return fmt.Errorf("failed to format consumer selector: %w", err)
+		}
+		fmt.Fprintf(printer.writer, "Consumer Selector\t%s\n", selector)
+	}
+	if status := consumerSet.Status; status != nil {
+		fmt.Fprintf(printer.writer, "Members\t%d\n", getInt32Ptr(status.Members))
+		fmt.Fprintf(printer.writer, "Resource Bundles\t%d\n", getInt32Ptr(status.ResourceBundles))
+		fmt.Fprintf(printer.writer, "Applied\t%d\n", getInt32Ptr(status.Applied))
+		fmt.Fprintf(printer.writer, "Available\t%d\n", getInt32Ptr(status.Available))
+		fmt.Fprintf(printer.writer, "Degraded\t%d\n", getInt32Ptr(status.Degraded))
+	}
+	fmt.Fprintf(printer.writer, "Created\t%s\n", formatTime(consumerSet.CreatedAt))
+	fmt.Fprintf(printer.writer, "Updated\t%s\n", formatTime(consumerSet.UpdatedAt))
+
+	return nil
+}
+
 // Helper functions
 
+// PrintResourceBundleSummary prints the number of the resource bundles in each state per group as a table,
+// followed by the total of all groups
+func PrintResourceBundleSummary(w io.Writer, summary *openapi.ResourceBundleSummary) (err error) {
+	if summary == nil {
+		return fmt.Errorf("resource bundle summary is required")
+	}
+
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	// Print header
+	group := strings.ToUpper(summary.GetGroupBy())
+	if summary.Label != nil {
+		group = fmt.Sprintf("%s %s", group, summary.GetLabel())
+	}
+	fmt.Fprintf(printer.writer, "%s\tTOTAL\tAPPLIED\tAVAILABLE\tDEGRADED\tDELETING\tNOT REPORTED\n", group)
+
+	// Print rows
+	printItem := func(key string, item openapi.ResourceBundleSummaryItem) {
+		fmt.Fprintf(printer.writer, "%s\t%d\t%d\t%d\t%d\t%d\t%d\n", key, item.GetTotal(), item.GetApplied(),
+			item.GetAvailable(), item.GetDegraded(), item.GetDeleting(), item.GetNotReported())
+	}
+	for _, item := range summary.Items {
+		key := item.GetKey()
+		if key == "" {
+			key = "<none>"
+		}
+		printItem(key, item)
+	}
+	printItem("TOTAL", summary.GetTotal())
+
+	return nil
+}
+
+// PrintEventGCResults prints the rows of the event tables and the rows purged by a garbage collection as a table,
+// the rows are only to be purged if dryRun is true
+func PrintEventGCResults(w io.Writer, results []api.EventGCResult, dryRun bool) (err error) {
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	purged := "PURGED"
+	if dryRun {
+		purged = "TO PURGE"
+	}
+	fmt.Fprintf(printer.writer, "TABLE\tROWS\tEXPIRED\tEXCEEDED\tORPHANED\t%s\n", purged)
+	for _, result := range results {
+		fmt.Fprintf(printer.writer, "%s\t%d\t%d\t%d\t%d\t%d\n", result.Table, result.Rows, result.Expired,
+			result.Exceeded, result.Orphaned, result.Purged())
+	}
+
+	return nil
+}
+
 func getStringPtr(ptr *string) string {
 	if ptr == nil {
 		return ""
@@ -215,6 +393,46 @@ func PrintResourceBundleStatus(w io.Writer, bundleID string, status map[string]i
 	return nil
 }
 
+// PrintResourceBundleDiff prints the changes of a resource bundle dry-run as a table
+func PrintResourceBundleDiff(w io.Writer, diff *openapi.ResourceBundleDiff) (err error) {
+	if diff == nil {
+		return fmt.Errorf("resource bundle diff is required")
+	}
+
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	fmt.Fprintln(printer.writer, "CHANGE	APIVERSION	KIND	NAMESPACE	NAME")
+	for _, manifest := range diff.Manifests {
+		fmt.Fprintf(printer.writer, "%s\t%s\t%s\t%s\t%s\n",
+			getStringPtr(manifest.Change), getStringPtr(manifest.ApiVersion), getStringPtr(manifest.Kind),
+			getStringPtr(manifest.Namespace), getStringPtr(manifest.Name))
+	}
+
+	if getBoolPtr(diff.MetadataChanged) {
+		fmt.Fprintln(printer.writer, "Modified\t\tmetadata\t\t")
+	}
+	if getBoolPtr(diff.ManifestConfigsChanged) {
+		fmt.Fprintln(printer.writer, "Modified\t\tmanifest_configs\t\t")
+	}
+	if getBoolPtr(diff.DeleteOptionChanged) {
+		fmt.Fprintln(printer.writer, "Modified\t\tdelete_option\t\t")
+	}
+
+	return nil
+}
+
+func getBoolPtr(ptr *bool) bool {
+	if ptr == nil {
+		return false
+	}
+	return *ptr
+}
+
 func getStatusFromMap(status map[string]interface{}) string {
 	if len(status) == 0 {
 		return "Unknown"
@@ -231,6 +449,24 @@ func getStatusFromMap(status map[string]interface{}) string {
 		return "Unknown"
 	}
 
+	// A stale status is not trusted, whatever the other conditions are
+	drifted := false
+	for _, condInterface := range conditions {
+		cond, ok := condInterface.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		condType, _ := cond["type"].(string)
+		condStatus, _ := cond["status"].(string)
+		if condType == "Stale" && condStatus == "True" {
+			return "Stale"
+		}
+		if condType == "Drifted" && condStatus == "True" {
+			drifted = true
+		}
+	}
+
 	// Find the Applied condition
 	for _, condInterface := range conditions {
 		cond, ok := condInterface.(map[string]interface{})
@@ -242,6 +478,10 @@ func getStatusFromMap(status map[string]interface{}) string {
 		if condType == "Applied" {
 			condStatus, _ := cond["status"].(string)
 			if condStatus == "True" {
+				// The applied live objects are diverged from the manifests
+				if drifted {
+					return "Drifted"
+				}
 				return "Applied"
 			}
 			return "Pending"
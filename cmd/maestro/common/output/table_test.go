@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
@@ -150,6 +151,8 @@ func TestPrintConsumerList(t *testing.T) {
 			Id:        openapi.PtrString("consumer-1"),
 			Name:      openapi.PtrString("test-consumer-1"),
 			Labels:    &labels,
+			Connected: openapi.PtrBool(true),
+			LastSeen:  &now,
 			CreatedAt: &now,
 		},
 		{
@@ -178,6 +181,12 @@ func TestPrintConsumerList(t *testing.T) {
 	if !strings.Contains(output, "LABELS") {
 		t.Error("PrintConsumerList() output missing LABELS header")
 	}
+	if !strings.Contains(output, "CONNECTED") || !strings.Contains(output, "LAST SEEN") {
+		t.Error("PrintConsumerList() output missing liveness headers")
+	}
+	if !strings.Contains(output, "true") || !strings.Contains(output, "false") {
+		t.Error("PrintConsumerList() output missing connected states")
+	}
 
 	// Verify data is present
 	if !strings.Contains(output, "consumer-1") {
@@ -233,6 +242,115 @@ func TestPrintConsumer(t *testing.T) {
 	}
 }
 
+func TestPrintPlacement(t *testing.T) {
+	now := time.Now()
+	placement := &openapi.Placement{
+		Id:      openapi.PtrString("placement-1"),
+		Name:    openapi.PtrString("test-placement"),
+		Version: openapi.PtrInt32(2),
+		RolloutStrategy: &openapi.PlacementRolloutStrategy{
+			Type:      openapi.PtrString("Progressive"),
+			BatchSize: openapi.PtrInt32(2),
+		},
+		RolloutPhase: openapi.PtrString("Paused"),
+		Status: &openapi.PlacementStatus{
+			Desired: openapi.PtrInt32(5),
+			Failed:  openapi.PtrInt32(1),
+			Message: openapi.PtrString("the rollout is paused, the resources failed on the consumers: cluster1"),
+		},
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	var buf bytes.Buffer
+	err := PrintPlacement(&buf, placement)
+
+	if err != nil {
+		t.Fatalf("PrintPlacement() error = %v", err)
+	}
+
+	output := buf.String()
+
+	// Verify fields are present
+	for _, expected := range []string{"placement-1", "test-placement", "Progressive (batch size 2)", "Paused", "cluster1"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("PrintPlacement() output missing %s", expected)
+		}
+	}
+
+	if err := PrintPlacement(&buf, nil); err == nil {
+		t.Error("PrintPlacement() expected error for nil placement")
+	}
+}
+
+func TestPrintConsumerSetList(t *testing.T) {
+	now := time.Now()
+	consumerSets := []openapi.ConsumerSet{
+		{
+			Id:   openapi.PtrString("consumer-set-1"),
+			Name: openapi.PtrString("prod"),
+			Status: &openapi.ConsumerSetStatus{
+				Members:         openapi.PtrInt32(3),
+				ResourceBundles: openapi.PtrInt32(6),
+				Applied:         openapi.PtrInt32(5),
+				Available:       openapi.PtrInt32(4),
+				Degraded:        openapi.PtrInt32(1),
+			},
+			CreatedAt: &now,
+		},
+		{
+			Id:   openapi.PtrString("consumer-set-2"),
+			Name: openapi.PtrString("dev"),
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := PrintConsumerSetList(&buf, consumerSets); err != nil {
+		t.Fatalf("PrintConsumerSetList() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"MEMBERS", "DEGRADED", "consumer-set-1", "prod", "consumer-set-2", "dev"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("PrintConsumerSetList() output missing %s", expected)
+		}
+	}
+}
+
+func TestPrintConsumerSet(t *testing.T) {
+	now := time.Now()
+	consumerSet := &openapi.ConsumerSet{
+		Id:               openapi.PtrString("consumer-set-1"),
+		Name:             openapi.PtrString("prod"),
+		ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "prod"}},
+		Status: &openapi.ConsumerSetStatus{
+			Members:  openapi.PtrInt32(3),
+			Degraded: openapi.PtrInt32(1),
+		},
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	var buf bytes.Buffer
+	if err := PrintConsumerSet(&buf, consumerSet); err != nil {
+		t.Fatalf("PrintConsumerSet() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"consumer-set-1", "prod", `{"matchLabels":{"env":"prod"}}`, "Degraded"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("PrintConsumerSet() output missing %s", expected)
+		}
+	}
+	if strings.Contains(output, "Static Members") {
+		t.Error("PrintConsumerSet() should not print static members for a selector set")
+	}
+
+	if err := PrintConsumerSet(&buf, nil); err == nil {
+		t.Error("PrintConsumerSet() expected error for nil consumer set")
+	}
+}
+
 func TestPrintResourceBundleStatus(t *testing.T) {
 	status := map[string]interface{}{
 		"conditions": []interface{}{
@@ -439,6 +557,38 @@ func TestGetStatusFromMap(t *testing.T) {
 			},
 			want: "Pending",
 		},
+		{
+			name: "Stale status",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":   "Applied",
+						"status": "True",
+					},
+					map[string]interface{}{
+						"type":   "Stale",
+						"status": "True",
+					},
+				},
+			},
+			want: "Stale",
+		},
+		{
+			name: "Drifted status",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":   "Applied",
+						"status": "True",
+					},
+					map[string]interface{}{
+						"type":   "Drifted",
+						"status": "True",
+					},
+				},
+			},
+			want: "Drifted",
+		},
 		{
 			name:   "empty status",
 			status: map[string]interface{}{},
@@ -538,3 +688,108 @@ func TestPrintResourceBundleStatus_NoConditions(t *testing.T) {
 		t.Error("PrintResourceBundleStatus() should show Unknown for empty status")
 	}
 }
+
+func TestPrintResourceBundleDiff(t *testing.T) {
+	diff := &openapi.ResourceBundleDiff{
+		ResourceId:          openapi.PtrString("bundle-1"),
+		Changed:             openapi.PtrBool(true),
+		DeleteOptionChanged: openapi.PtrBool(true),
+		Manifests: []openapi.ManifestDiff{
+			{
+				Change:     openapi.PtrString("Modified"),
+				ApiVersion: openapi.PtrString("apps/v1"),
+				Kind:       openapi.PtrString("Deployment"),
+				Namespace:  openapi.PtrString("default"),
+				Name:       openapi.PtrString("nginx"),
+			},
+			{
+				Change:     openapi.PtrString("Removed"),
+				ApiVersion: openapi.PtrString("v1"),
+				Kind:       openapi.PtrString("ConfigMap"),
+				Namespace:  openapi.PtrString("default"),
+				Name:       openapi.PtrString("nginx"),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := PrintResourceBundleDiff(&buf, diff); err != nil {
+		t.Fatalf("PrintResourceBundleDiff() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"CHANGE", "Modified", "Deployment", "Removed", "ConfigMap", "delete_option"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("PrintResourceBundleDiff() output missing %s", expected)
+		}
+	}
+	if strings.Contains(output, "manifest_configs") {
+		t.Error("PrintResourceBundleDiff() output should not contain unchanged manifest_configs")
+	}
+
+	if err := PrintResourceBundleDiff(&buf, nil); err == nil {
+		t.Error("PrintResourceBundleDiff() expected error for nil diff")
+	}
+}
+
+func TestPrintEventGCResults(t *testing.T) {
+	results := []api.EventGCResult{
+		{Table: api.EventsTable, Rows: 10, Expired: 3, Exceeded: 2},
+		{Table: api.EventInstancesTable, Rows: 4, Orphaned: 1},
+	}
+
+	var buf bytes.Buffer
+	if err := PrintEventGCResults(&buf, results, true); err != nil {
+		t.Fatalf("PrintEventGCResults() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("PrintEventGCResults() expected 3 lines, got %d", len(lines))
+	}
+	if !strings.Contains(lines[0], "TO PURGE") {
+		t.Errorf("PrintEventGCResults() header missing TO PURGE: %s", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); fields[0] != api.EventsTable || fields[len(fields)-1] != "5" {
+		t.Errorf("PrintEventGCResults() unexpected events row: %s", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); fields[0] != api.EventInstancesTable || fields[len(fields)-1] != "1" {
+		t.Errorf("PrintEventGCResults() unexpected event instances row: %s", lines[2])
+	}
+
+	buf.Reset()
+	if err := PrintEventGCResults(&buf, results, false); err != nil {
+		t.Fatalf("PrintEventGCResults() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "PURGED") {
+		t.Errorf("PrintEventGCResults() header missing PURGED")
+	}
+}
+
+func TestPrintResourceBundleSummary(t *testing.T) {
+	summary := &openapi.ResourceBundleSummary{
+		GroupBy: openapi.PtrString("label"),
+		Label:   openapi.PtrString("env"),
+		Total:   &openapi.ResourceBundleSummaryItem{Total: openapi.PtrInt64(3), Applied: openapi.PtrInt64(2)},
+		Items: []openapi.ResourceBundleSummaryItem{
+			{Key: openapi.PtrString(""), Total: openapi.PtrInt64(1), NotReported: openapi.PtrInt64(1)},
+			{Key: openapi.PtrString("prod"), Total: openapi.PtrInt64(2), Applied: openapi.PtrInt64(2)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := PrintResourceBundleSummary(&buf, summary); err != nil {
+		t.Fatalf("PrintResourceBundleSummary() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, expected := range []string{"LABEL env", "NOT REPORTED", "<none>", "prod", "TOTAL"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("PrintResourceBundleSummary() output missing %s", expected)
+		}
+	}
+
+	if err := PrintResourceBundleSummary(&buf, nil); err == nil {
+		t.Errorf("PrintResourceBundleSummary() expected error for nil summary")
+	}
+}
@@ -21,11 +21,16 @@ func newDeleteCommand() *cobra.Command {
 By default, this command will prompt for confirmation before deleting.
 Use the --yes flag to skip the confirmation prompt.
 
-Note: A consumer cannot be deleted if it has existing resource bundles.
+Note: A consumer cannot be deleted if it has existing resource bundles. Use the
+--cascade flag to delete the resource bundles of the consumer first, the consumer
+is deleted once the agent acknowledges the deletion of all of its resource bundles.
+With --wait, the command blocks until the consumer is deleted.
 
 Examples:
   maestro consumer delete <consumer-id>
-  maestro consumer delete <consumer-id> --yes`,
+  maestro consumer delete <consumer-id> --yes
+  maestro consumer delete <consumer-id> --cascade
+  maestro consumer delete <consumer-id> --cascade --wait`,
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runDelete(cmd, args); err != nil {
@@ -36,6 +41,8 @@ Examples:
 	}
 
 	cmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	cmd.Flags().Bool("cascade", false, "Delete the resource bundles of the consumer before the consumer")
+	clients.AddWaitFlags(cmd)
 
 	return cmd
 }
@@ -46,10 +53,18 @@ func runDelete(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to read --yes flag: %w", err)
 	}
+	cascade, err := cmd.Flags().GetBool("cascade")
+	if err != nil {
+		return fmt.Errorf("failed to read --cascade flag: %w", err)
+	}
 
 	// Confirmation prompt
 	if !skipConfirm {
-		fmt.Printf("Are you sure you want to delete consumer %s? (y/N): ", consumerID)
+		if cascade {
+			fmt.Printf("Are you sure you want to delete consumer %s and all of its resource bundles? (y/N): ", consumerID)
+		} else {
+			fmt.Printf("Are you sure you want to delete consumer %s? (y/N): ", consumerID)
+		}
 		reader := bufio.NewReader(os.Stdin)
 		response, err := reader.ReadString('\n')
 		if err != nil {
@@ -75,8 +90,29 @@ func runDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to create REST client: %w", err)
 	}
 
-	// Delete the consumer
 	ctx := context.Background()
+	if cascade {
+		// Delete the resource bundles of the consumer and then the consumer
+		operation, err := restClient.DeleteConsumerCascade(ctx, consumerID)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Deletion of consumer %s started, operation %s is deleting %d resource bundle(s)\n",
+			consumerID, operation.GetId(), operation.GetTotal())
+
+		// Wait for the consumer to be deleted
+		completed, err := clients.WaitForOperationFromFlags(ctx, cmd, restClient, operation.GetId())
+		if err != nil {
+			return err
+		}
+		if completed != nil {
+			fmt.Printf("Consumer %s deleted successfully\n", consumerID)
+		}
+		return nil
+	}
+
+	// Delete the consumer
 	if err := restClient.DeleteConsumer(ctx, consumerID); err != nil {
 		return err
 	}
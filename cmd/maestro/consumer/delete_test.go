@@ -19,6 +19,8 @@ func TestRunDelete(t *testing.T) {
 		name        string
 		args        []string
 		skipConfirm bool
+		cascade     bool
+		wait        bool
 		wantErr     bool
 		errContains string
 	}{
@@ -42,6 +44,29 @@ func TestRunDelete(t *testing.T) {
 			wantErr:     true,
 			errContains: "conflict",
 		},
+		{
+			name:        "cascade delete",
+			args:        []string{"consumer-1"},
+			skipConfirm: true,
+			cascade:     true,
+			wantErr:     false,
+		},
+		{
+			name:        "cascade delete and wait",
+			args:        []string{"consumer-1"},
+			skipConfirm: true,
+			cascade:     true,
+			wait:        true,
+			wantErr:     false,
+		},
+		{
+			name:        "cascade delete non-existent consumer",
+			args:        []string{"not-found"},
+			skipConfirm: true,
+			cascade:     true,
+			wantErr:     true,
+			errContains: "not found",
+		},
 	}
 
 	for _, tt := range tests {
@@ -52,6 +77,8 @@ func TestRunDelete(t *testing.T) {
 			cmd := &cobra.Command{}
 			clients.AddRESTClientFlags(cmd)
 			cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+			cmd.Flags().Bool("cascade", false, "Delete the resource bundles of the consumer")
+			clients.AddWaitFlags(cmd)
 
 			// Parse flags to initialize them
 			if err := cmd.ParseFlags([]string{}); err != nil {
@@ -61,6 +88,12 @@ func TestRunDelete(t *testing.T) {
 			if tt.skipConfirm {
 				cmd.Flags().Set("yes", "true")
 			}
+			if tt.cascade {
+				cmd.Flags().Set("cascade", "true")
+			}
+			if tt.wait {
+				cmd.Flags().Set(clients.FlagWait, "true")
+			}
 
 			err := runDelete(cmd, tt.args)
 
@@ -105,6 +138,8 @@ func TestRunDelete_WithConfirmation(t *testing.T) {
 		cmd := &cobra.Command{}
 		clients.AddRESTClientFlags(cmd)
 		cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+		cmd.Flags().Bool("cascade", false, "Delete the resource bundles of the consumer")
+		clients.AddWaitFlags(cmd)
 
 		// Parse flags to initialize them
 		if err := cmd.ParseFlags([]string{}); err != nil {
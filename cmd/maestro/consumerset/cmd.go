@@ -0,0 +1,47 @@
+package consumerset
+
+import (
+	"flag"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+)
+
+// NewConsumerSetCommand creates the consumerset subcommand
+func NewConsumerSetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumerset",
+		Short: "Manage consumer sets and their aggregate status",
+		Long: `Manage Maestro consumer sets.
+
+A consumer set is a named group of consumers, the members of a set are either listed
+statically or selected by a label selector over the consumer labels. The status of a
+consumer set aggregates the resource bundles of its members: how many bundles target
+the set, and how many of them are applied, available or degraded.
+
+This command provides create, get, list, update and delete operations via the Maestro REST API.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			// Suppress verbose logs by default for CLI commands
+			// Only suppress if user hasn't set -v flag
+			userSetVerbosity := cmd.Flags().Changed("v") || (cmd.Parent() != nil && cmd.Parent().Flags().Changed("v"))
+			if !userSetVerbosity {
+				_ = flag.Set("logtostderr", "false")
+			}
+		},
+	}
+
+	// Add common client flags
+	clients.AddRESTClientFlags(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(
+		newGetCommand(),
+		newListCommand(),
+		newCreateCommand(),
+		newUpdateCommand(),
+		newDeleteCommand(),
+	)
+
+	return cmd
+}
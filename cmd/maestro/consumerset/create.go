@@ -0,0 +1,135 @@
+package consumerset
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+const (
+	flagMember   = "member"
+	flagSelector = "selector"
+)
+
+func newCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new consumer set",
+		Long: `Create a new consumer set with the specified name.
+
+The members of the set are either listed with the --member flag (can be used multiple
+times), or selected by the consumer labels with the --selector flag (can be used multiple
+times). Each selector should be in the format key=value, a consumer is selected if it
+has all of the labels.
+
+Examples:
+  maestro consumerset create canary --member cluster-01 --member cluster-02
+  maestro consumerset create production --selector env=production
+  maestro consumerset create us-east-prod --selector env=production --selector region=us-east --output json`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runCreate(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	addMembershipFlags(cmd)
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runCreate(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	members, consumerSelector, err := membershipFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Create the consumer set
+	ctx := context.Background()
+	created, err := restClient.CreateConsumerSet(ctx, openapi.ConsumerSet{
+		Name:             &name,
+		Members:          members,
+		ConsumerSelector: consumerSelector,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Output the result
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		return output.PrintConsumerSet(os.Stdout, created)
+	}
+
+	return output.PrintJSON(os.Stdout, created)
+}
+
+// addMembershipFlags adds the flags of the static members and the consumer selector of a consumer set.
+func addMembershipFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagMember, []string{}, "Names of the consumers in the set (can be specified multiple times)")
+	cmd.Flags().StringSlice(flagSelector, []string{}, "Consumer labels in key=value format that select the consumers in the set (can be specified multiple times)")
+}
+
+// membershipFromFlags reads the static members or the consumer selector of a consumer set, exactly one
+// of them is required.
+func membershipFromFlags(cmd *cobra.Command) ([]string, map[string]interface{}, error) {
+	members, err := cmd.Flags().GetStringSlice(flagMember)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read --%s: %w", flagMember, err)
+	}
+	selectors, err := cmd.Flags().GetStringSlice(flagSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read --%s: %w", flagSelector, err)
+	}
+
+	switch {
+	case len(members) == 0 && len(selectors) == 0:
+		return nil, nil, fmt.Errorf("either --%s or --%s must be specified", flagMember, flagSelector)
+	case len(members) > 0 && len(selectors) > 0:
+		return nil, nil, fmt.Errorf("--%s and --%s cannot be specified together", flagMember, flagSelector)
+	case len(members) > 0:
+		return members, nil, nil
+	}
+
+	matchLabels := map[string]interface{}{}
+	for _, selector := range selectors {
+		parts := strings.SplitN(selector, "=", 2)
+		if len(parts) != 2 {
+			return nil, nil, fmt.Errorf("invalid selector format: %s (expected key=value)", selector)
+		}
+		key := strings.TrimSpace(parts[0])
+		if key == "" {
+			return nil, nil, fmt.Errorf("invalid selector format: %s (key cannot be empty)", selector)
+		}
+		matchLabels[key] = parts[1]
+	}
+	return nil, map[string]interface{}{"matchLabels": matchLabels}, nil
+}
@@ -0,0 +1,153 @@
+package consumerset
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func setupTestEnv(_ *testing.T, server *mock.Server) func() {
+	os.Setenv(clients.EnvRESTURL, server.URL)
+	return func() {
+		os.Unsetenv(clients.EnvRESTURL)
+	}
+}
+
+// newMembershipCommand creates a command with the REST client, output and membership flags.
+func newMembershipCommand(t *testing.T, outputFormat string, members, selectors []string) *cobra.Command {
+	cmd := &cobra.Command{}
+	clients.AddRESTClientFlags(cmd)
+	output.AddFormatFlag(cmd)
+	addMembershipFlags(cmd)
+
+	// Parse flags to initialize them
+	if err := cmd.ParseFlags([]string{}); err != nil {
+		t.Fatalf("Failed to parse flags: %v", err)
+	}
+
+	cmd.Flags().Set(output.FlagOutput, outputFormat)
+	for _, member := range members {
+		cmd.Flags().Set(flagMember, member)
+	}
+	for _, selector := range selectors {
+		cmd.Flags().Set(flagSelector, selector)
+	}
+	return cmd
+}
+
+func TestRunCreate(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		args        []string
+		members     []string
+		selectors   []string
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "successful create with members",
+			args:    []string{"canary"},
+			members: []string{"cluster1", "cluster2"},
+			output:  "table",
+			wantErr: false,
+		},
+		{
+			name:      "successful create with selector",
+			args:      []string{"production"},
+			selectors: []string{"env=prod", "region=us-east"},
+			output:    "json",
+			wantErr:   false,
+		},
+		{
+			name:        "create without members nor selector",
+			args:        []string{"empty"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "either --member or --selector must be specified",
+		},
+		{
+			name:        "create with both members and selector",
+			args:        []string{"both"},
+			members:     []string{"cluster1"},
+			selectors:   []string{"env=prod"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "cannot be specified together",
+		},
+		{
+			name:        "create with invalid selector format",
+			args:        []string{"invalid"},
+			selectors:   []string{"invalid-selector-format"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "invalid selector format",
+		},
+		{
+			name:        "create with conflict",
+			args:        []string{"conflict"},
+			members:     []string{"cluster1"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "already exists",
+		},
+		{
+			name:        "create with bad request",
+			args:        []string{"bad-request"},
+			members:     []string{"cluster1"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "bad request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server)
+			defer cleanup()
+
+			cmd := newMembershipCommand(t, tt.output, tt.members, tt.selectors)
+			err := runCreate(cmd, tt.args)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runCreate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runCreate() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
+
+func TestMembershipFromFlags(t *testing.T) {
+	cmd := newMembershipCommand(t, "table", nil, []string{"env=prod", "region=us-east=1"})
+
+	members, consumerSelector, err := membershipFromFlags(cmd)
+	if err != nil {
+		t.Fatalf("membershipFromFlags() error = %v", err)
+	}
+	if members != nil {
+		t.Errorf("membershipFromFlags() members = %v, want nil", members)
+	}
+
+	matchLabels, ok := consumerSelector["matchLabels"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("membershipFromFlags() consumer selector = %v, want matchLabels", consumerSelector)
+	}
+	if matchLabels["env"] != "prod" || matchLabels["region"] != "us-east=1" {
+		t.Errorf("membershipFromFlags() matchLabels = %v", matchLabels)
+	}
+}
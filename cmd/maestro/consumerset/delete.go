@@ -0,0 +1,86 @@
+package consumerset
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+)
+
+func newDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a consumer set by ID",
+		Long: `Delete a consumer set by its ID.
+
+The consumers in the set and their resource bundles are not affected.
+
+By default, this command will prompt for confirmation before deleting.
+Use the --yes flag to skip the confirmation prompt.
+
+Examples:
+  maestro consumerset delete <consumer-set-id>
+  maestro consumerset delete <consumer-set-id> --yes`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runDelete(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	consumerSetID := args[0]
+	skipConfirm, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return fmt.Errorf("failed to read --yes flag: %w", err)
+	}
+
+	// Confirmation prompt
+	if !skipConfirm {
+		fmt.Printf("Are you sure you want to delete consumer set %s? (y/N): ", consumerSetID)
+		reader := bufio.NewReader(os.Stdin)
+		response, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read confirmation: %w", err)
+		}
+
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Println("Deletion cancelled")
+			return nil
+		}
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Delete the consumer set
+	ctx := context.Background()
+	if err := restClient.DeleteConsumerSet(ctx, consumerSetID); err != nil {
+		return err
+	}
+
+	fmt.Printf("Consumer set %s deleted successfully\n", consumerSetID)
+	return nil
+}
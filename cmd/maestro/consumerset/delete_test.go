@@ -0,0 +1,71 @@
+package consumerset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+)
+
+func TestRunDelete(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		args        []string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "successful delete with --yes flag",
+			args:    []string{"consumer-set-1"},
+			wantErr: false,
+		},
+		{
+			name:        "delete non-existent consumer set",
+			args:        []string{"not-found"},
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name:        "delete forbidden",
+			args:        []string{"forbidden"},
+			wantErr:     true,
+			errContains: "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server)
+			defer cleanup()
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+
+			// Parse flags to initialize them
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			cmd.Flags().Set("yes", "true")
+
+			err := runDelete(cmd, tt.args)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runDelete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runDelete() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package consumerset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func TestRunList(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		page        string
+		size        string
+		search      string
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "successful list with table format",
+			output:  "table",
+			wantErr: false,
+		},
+		{
+			name:    "successful list with search",
+			search:  "test-consumer-set",
+			output:  "json",
+			wantErr: false,
+		},
+		{
+			name:        "invalid page",
+			page:        "0",
+			output:      "table",
+			wantErr:     true,
+			errContains: "--page must be >= 1",
+		},
+		{
+			name:        "invalid size",
+			size:        "0",
+			output:      "table",
+			wantErr:     true,
+			errContains: "--size must be >= 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server)
+			defer cleanup()
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			output.AddFormatFlag(cmd)
+			cmd.Flags().Int("page", 1, "Page number")
+			cmd.Flags().Int("size", 100, "Page size")
+			cmd.Flags().String("search", "", "Search filter")
+
+			// Parse flags to initialize them
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			cmd.Flags().Set(output.FlagOutput, tt.output)
+			if tt.page != "" {
+				cmd.Flags().Set("page", tt.page)
+			}
+			if tt.size != "" {
+				cmd.Flags().Set("size", tt.size)
+			}
+			if tt.search != "" {
+				cmd.Flags().Set("search", tt.search)
+			}
+
+			err := runList(cmd, nil)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runList() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
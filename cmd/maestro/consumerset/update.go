@@ -0,0 +1,83 @@
+package consumerset
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+func newUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update the membership of a consumer set",
+		Long: `Replace the members or the consumer selector of a consumer set.
+
+The membership is replaced as a whole, a static set can be changed to a selector set
+and vice versa.
+
+Examples:
+  maestro consumerset update <consumer-set-id> --member cluster-01 --member cluster-03
+  maestro consumerset update <consumer-set-id> --selector env=staging --output json`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runUpdate(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	addMembershipFlags(cmd)
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runUpdate(cmd *cobra.Command, args []string) error {
+	consumerSetID := args[0]
+
+	members, consumerSelector, err := membershipFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Update the consumer set
+	ctx := context.Background()
+	updated, err := restClient.UpdateConsumerSet(ctx, consumerSetID, openapi.ConsumerSetPatchRequest{
+		Members:          members,
+		ConsumerSelector: consumerSelector,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Output the result
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		return output.PrintConsumerSet(os.Stdout, updated)
+	}
+
+	return output.PrintJSON(os.Stdout, updated)
+}
@@ -0,0 +1,74 @@
+package consumerset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+)
+
+func TestRunUpdate(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		args        []string
+		members     []string
+		selectors   []string
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "successful update with members",
+			args:    []string{"consumer-set-1"},
+			members: []string{"cluster1", "cluster3"},
+			output:  "table",
+			wantErr: false,
+		},
+		{
+			name:      "successful update with selector",
+			args:      []string{"consumer-set-1"},
+			selectors: []string{"env=staging"},
+			output:    "json",
+			wantErr:   false,
+		},
+		{
+			name:        "update without members nor selector",
+			args:        []string{"consumer-set-1"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "either --member or --selector must be specified",
+		},
+		{
+			name:        "update non-existent consumer set",
+			args:        []string{"not-found"},
+			members:     []string{"cluster1"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server)
+			defer cleanup()
+
+			cmd := newMembershipCommand(t, tt.output, tt.members, tt.selectors)
+			err := runUpdate(cmd, tt.args)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runUpdate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runUpdate() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
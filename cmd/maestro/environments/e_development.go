@@ -44,5 +44,6 @@ func (e *devEnvImpl) Flags() map[string]string {
 		"server-hostname":      "localhost",
 		"http-server-bindport": "8000",
 		"source-id":            "maestro",
+		"http-authn-type":      "mock",
 	}
 }
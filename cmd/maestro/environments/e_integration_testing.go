@@ -49,5 +49,8 @@ func (e *testingEnvImpl) Flags() map[string]string {
 		"enable-https":         "false",
 		"enable-metrics-https": "false",
 		"source-id":            "maestro",
+		"http-authn-type":      "mock",
+		// bound the bulk deletions, so that the tests exceed the bound with a few resource bundles
+		"operation-max-resources": "5",
 	}
 }
@@ -11,10 +11,12 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	ceoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/builder"
 
 	envtypes "github.com/openshift-online/maestro/cmd/maestro/environments/types"
 	"github.com/openshift-online/maestro/pkg/client/cloudevents"
+	"github.com/openshift-online/maestro/pkg/client/cloudevents/kafka"
 	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 	"github.com/openshift-online/maestro/pkg/config"
 	"github.com/openshift-online/maestro/pkg/errors"
@@ -131,6 +133,11 @@ func (e *Env) LoadServices() {
 	e.Services.Events = NewEventServiceLocator(e)
 	e.Services.StatusEvents = NewStatusEventServiceLocator(e)
 	e.Services.Consumers = NewConsumerServiceLocator(e)
+	e.Services.ConsumerSets = NewConsumerSetServiceLocator(e)
+	e.Services.Placements = NewPlacementServiceLocator(e)
+	e.Services.Operations = NewOperationServiceLocator(e)
+	e.Services.Quotas = NewQuotaServiceLocator(e)
+	e.Services.EventGC = NewEventGCServiceLocator(e)
 }
 
 func (e *Env) LoadClients() error {
@@ -142,16 +149,9 @@ func (e *Env) LoadClients() error {
 		if !e.Config.MessageBroker.Disable {
 			// For gRPC message broker type, Maestro server does not require the source client to publish resources or subscribe to resource status.
 			if e.Config.MessageBroker.MessageBrokerType != "grpc" {
-				_, config, err := builder.NewConfigLoader(e.Config.MessageBroker.MessageBrokerType, e.Config.MessageBroker.MessageBrokerConfig).
-					LoadConfig()
+				cloudEventsSourceOptions, err := e.buildCloudEventsSourceOptions()
 				if err != nil {
-					return fmt.Errorf("Unable to load cloudevent config: %v", err)
-				}
-
-				cloudEventsSourceOptions, err := builder.BuildCloudEventsSourceOptions(config,
-					e.Config.MessageBroker.ClientID, e.Config.MessageBroker.SourceID, workpayload.ManifestBundleEventDataType)
-				if err != nil {
-					return fmt.Errorf("Unable to build cloudevent source options: %v", err)
+					return err
 				}
 				e.Clients.CloudEventsSource, err = cloudevents.NewSourceClient(cloudEventsSourceOptions, e.Services.Resources())
 				if err != nil {
@@ -161,31 +161,59 @@ func (e *Env) LoadClients() error {
 		}
 	}
 
-	// Create GRPC authorizer based on configuration
+	// Create GRPC authorizer based on configuration, it is shared by the gRPC server and the REST API
+	grpcAuthNType := "mock"
 	if e.Config.GRPCServer.EnableGRPCServer {
-		if e.Config.GRPCServer.GRPCAuthNType == "mock" {
-			klog.V(4).Info("Using Mock GRPC Authorizer")
-			e.Clients.GRPCAuthorizer = grpcauthorizer.NewMockGRPCAuthorizer()
-		} else {
-			kubeConfig, err := clientcmd.BuildConfigFromFlags("", e.Config.GRPCServer.GRPCAuthorizerConfig)
-			if err != nil {
-				klog.Warningf("Unable to load kubeconfig from file %s: %v, falling back to in-cluster config", e.Config.GRPCServer.GRPCAuthorizerConfig, err)
-				kubeConfig, err = rest.InClusterConfig()
-				if err != nil {
-					return fmt.Errorf("Unable to retrieve kube client config: %v", err)
-				}
-			}
-			kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+		grpcAuthNType = e.Config.GRPCServer.GRPCAuthNType
+	}
+	if grpcAuthNType == "mock" && e.Config.HTTPServer.HTTPAuthNType == "mock" {
+		klog.V(4).Info("Using Mock GRPC Authorizer")
+		e.Clients.GRPCAuthorizer = grpcauthorizer.NewMockGRPCAuthorizer()
+	} else {
+		kubeConfig, err := clientcmd.BuildConfigFromFlags("", e.Config.GRPCServer.GRPCAuthorizerConfig)
+		if err != nil {
+			klog.Warningf("Unable to load kubeconfig from file %s: %v, falling back to in-cluster config", e.Config.GRPCServer.GRPCAuthorizerConfig, err)
+			kubeConfig, err = rest.InClusterConfig()
 			if err != nil {
-				return fmt.Errorf("Unable to create kube client: %v", err)
+				return fmt.Errorf("Unable to retrieve kube client config: %v", err)
 			}
-			e.Clients.GRPCAuthorizer = grpcauthorizer.NewKubeGRPCAuthorizer(kubeClient)
 		}
+		kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+		if err != nil {
+			return fmt.Errorf("Unable to create kube client: %v", err)
+		}
+		e.Clients.GRPCAuthorizer = grpcauthorizer.NewKubeGRPCAuthorizer(kubeClient)
 	}
 
 	return nil
 }
 
+// buildCloudEventsSourceOptions builds the source options of the message broker. The Kafka options are built by
+// maestro, the sources that share the status subscription join the same consumer group.
+func (e *Env) buildCloudEventsSourceOptions() (*ceoptions.CloudEventsSourceOptions, error) {
+	if e.Config.MessageBroker.MessageBrokerType == "kafka" {
+		kafkaOptions, err := kafka.BuildKafkaOptionsFromFlags(e.Config.MessageBroker.MessageBrokerConfig)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load kafka config: %v", err)
+		}
+		shared := config.SubscriptionType(e.Config.EventServer.SubscriptionType) == config.SharedSubscriptionType
+		return kafka.NewSourceOptions(kafkaOptions, e.Config.MessageBroker.ClientID, e.Config.MessageBroker.SourceID, shared), nil
+	}
+
+	_, brokerConfig, err := builder.NewConfigLoader(e.Config.MessageBroker.MessageBrokerType, e.Config.MessageBroker.MessageBrokerConfig).
+		LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load cloudevent config: %v", err)
+	}
+
+	cloudEventsSourceOptions, err := builder.BuildCloudEventsSourceOptions(brokerConfig,
+		e.Config.MessageBroker.ClientID, e.Config.MessageBroker.SourceID, workpayload.ManifestBundleEventDataType)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to build cloudevent source options: %v", err)
+	}
+	return cloudEventsSourceOptions, nil
+}
+
 func (e *Env) Teardown() {
 	if e.Name != envtypes.TestingEnv {
 		if err := e.Database.SessionFactory.Close(); err != nil {
@@ -1,6 +1,8 @@
 package environments
 
 import (
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/config"
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/services"
@@ -13,8 +15,10 @@ func NewResourceServiceLocator(env *Env) ResourceServiceLocator {
 		return services.NewResourceService(
 			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
 			dao.NewResourceDao(&env.Database.SessionFactory),
+			dao.NewResourceRevisionDao(&env.Database.SessionFactory),
 			env.Services.Events(),
 			env.Services.Generic(),
+			env.Services.Quotas(),
 		)
 	}
 }
@@ -52,3 +56,78 @@ func NewConsumerServiceLocator(env *Env) ConsumerServiceLocator {
 		)
 	}
 }
+
+type ConsumerSetServiceLocator func() services.ConsumerSetService
+
+func NewConsumerSetServiceLocator(env *Env) ConsumerSetServiceLocator {
+	return func() services.ConsumerSetService {
+		return services.NewConsumerSetService(
+			dao.NewConsumerSetDao(&env.Database.SessionFactory),
+			dao.NewConsumerDao(&env.Database.SessionFactory),
+			dao.NewResourceDao(&env.Database.SessionFactory),
+		)
+	}
+}
+
+type PlacementServiceLocator func() services.PlacementService
+
+func NewPlacementServiceLocator(env *Env) PlacementServiceLocator {
+	return func() services.PlacementService {
+		return services.NewPlacementService(
+			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
+			dao.NewPlacementDao(&env.Database.SessionFactory),
+		)
+	}
+}
+
+type OperationServiceLocator func() services.OperationService
+
+func NewOperationServiceLocator(env *Env) OperationServiceLocator {
+	return func() services.OperationService {
+		return services.NewOperationService(
+			dao.NewOperationDao(&env.Database.SessionFactory),
+			dao.NewResourceDao(&env.Database.SessionFactory),
+			dao.NewEventDao(&env.Database.SessionFactory),
+		)
+	}
+}
+
+type QuotaServiceLocator func() services.QuotaService
+
+func NewQuotaServiceLocator(env *Env) QuotaServiceLocator {
+	return func() services.QuotaService {
+		quota := env.Config.Quota
+		return services.NewQuotaService(
+			dao.NewResourceDao(&env.Database.SessionFactory),
+			api.ResourceQuota{
+				MaxResourcesPerConsumer: quota.MaxResourceBundlesPerConsumer,
+				MaxResourcesPerSource:   quota.MaxResourceBundlesPerSource,
+				MaxManifestsPerResource: quota.MaxManifestsPerResourceBundle,
+				MaxPayloadBytes:         quota.MaxPayloadBytes,
+			},
+		)
+	}
+}
+
+type EventGCServiceLocator func() services.EventGCService
+
+func NewEventGCServiceLocator(env *Env) EventGCServiceLocator {
+	return func() services.EventGCService {
+		return services.NewEventGCService(
+			dao.NewEventGCDao(&env.Database.SessionFactory),
+			EventGCPolicy(env.Config.EventGC),
+		)
+	}
+}
+
+// EventGCPolicy returns the garbage collection policy of the event tables from its configuration.
+func EventGCPolicy(eventGC *config.EventGCConfig) api.EventGCPolicy {
+	return api.EventGCPolicy{
+		Events:                  api.EventRetention{MaxAge: eventGC.EventMaxAge, MaxCount: eventGC.EventMaxCount},
+		StatusEvents:            api.EventRetention{MaxAge: eventGC.StatusEventMaxAge, MaxCount: eventGC.StatusEventMaxCount},
+		ResourceTombstones:      api.EventRetention{MaxAge: eventGC.ResourceTombstoneMaxAge, MaxCount: eventGC.ResourceTombstoneMaxCount},
+		Operations:              api.EventRetention{MaxAge: eventGC.OperationMaxAge, MaxCount: eventGC.OperationMaxCount},
+		ResourceRevisions:       api.EventRetention{MaxCount: eventGC.ResourceRevisionMaxCount},
+		DeadInstanceGracePeriod: eventGC.DeadInstanceGracePeriod,
+	}
+}
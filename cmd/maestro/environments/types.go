@@ -42,6 +42,11 @@ type Services struct {
 	Events       EventServiceLocator
 	StatusEvents StatusEventServiceLocator
 	Consumers    ConsumerServiceLocator
+	ConsumerSets ConsumerSetServiceLocator
+	Placements   PlacementServiceLocator
+	Operations   OperationServiceLocator
+	Quotas       QuotaServiceLocator
+	EventGC      EventGCServiceLocator
 }
 
 type Clients struct {
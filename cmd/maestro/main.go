@@ -8,9 +8,12 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 
+	"github.com/openshift-online/maestro/cmd/maestro/admin"
 	"github.com/openshift-online/maestro/cmd/maestro/agent"
 	"github.com/openshift-online/maestro/cmd/maestro/consumer"
+	"github.com/openshift-online/maestro/cmd/maestro/consumerset"
 	"github.com/openshift-online/maestro/cmd/maestro/migrate"
+	"github.com/openshift-online/maestro/cmd/maestro/placement"
 	"github.com/openshift-online/maestro/cmd/maestro/resourcebundle"
 	"github.com/openshift-online/maestro/cmd/maestro/servecmd"
 )
@@ -42,10 +45,13 @@ func main() {
 	serveCmd := servecmd.NewServerCommand()
 	agentCmd := agent.NewAgentCommand()
 	consumerCmd := consumer.NewConsumerCommand()
+	consumerSetCmd := consumerset.NewConsumerSetCommand()
 	resourceBundleCmd := resourcebundle.NewResourceBundleCommand()
+	placementCmd := placement.NewPlacementCommand()
+	adminCmd := admin.NewAdminCommand()
 
 	// Add subcommand(s)
-	rootCmd.AddCommand(migrateCmd, serveCmd, agentCmd, consumerCmd, resourceBundleCmd)
+	rootCmd.AddCommand(migrateCmd, serveCmd, agentCmd, consumerCmd, consumerSetCmd, resourceBundleCmd, placementCmd, adminCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatalf("error running command: %v", err)
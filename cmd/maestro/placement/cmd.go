@@ -0,0 +1,43 @@
+package placement
+
+import (
+	"flag"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+)
+
+// NewPlacementCommand creates the placement subcommand
+func NewPlacementCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "placement",
+		Short: "Manage placements and their rollouts",
+		Long: `Manage Maestro placements.
+
+A placement fans out a resource bundle template to the consumers that match its
+consumer selector. This command shows the rollout status of a placement, and pauses,
+resumes or aborts its rollout via the Maestro REST API.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			// Suppress verbose logs by default for CLI commands
+			// Only suppress if user hasn't set -v flag
+			userSetVerbosity := cmd.Flags().Changed("v") || (cmd.Parent() != nil && cmd.Parent().Flags().Changed("v"))
+			if !userSetVerbosity {
+				_ = flag.Set("logtostderr", "false")
+			}
+		},
+	}
+
+	// Add common client flags
+	clients.AddRESTClientFlags(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(
+		newGetCommand(),
+		newPauseCommand(),
+		newResumeCommand(),
+		newAbortCommand(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+package placement
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+// rolloutAction changes the rollout phase of a placement through the REST client
+type rolloutAction func(c *clients.RESTClient, ctx context.Context, id string) (*openapi.Placement, error)
+
+func newPauseCommand() *cobra.Command {
+	return newRolloutCommand("pause", "Pause the rollout of a placement",
+		`Pause the rollout of a placement via REST API.
+
+No resource bundle of the placement is created or updated until the rollout is
+resumed. Only a rollout in progress can be paused.
+
+Example:
+  maestro placement pause <placement-id>`,
+		(*clients.RESTClient).PausePlacementRollout)
+}
+
+func newResumeCommand() *cobra.Command {
+	return newRolloutCommand("resume", "Resume the paused rollout of a placement",
+		`Resume the paused rollout of a placement via REST API.
+
+A progressive rollout that was paused because resource bundles failed on their
+consumers is paused again until the failures are fixed.
+
+Example:
+  maestro placement resume <placement-id>`,
+		(*clients.RESTClient).ResumePlacementRollout)
+}
+
+func newAbortCommand() *cobra.Command {
+	return newRolloutCommand("abort", "Abort the rollout of a placement",
+		`Abort the rollout of a placement via REST API.
+
+The resource bundles that are not updated yet keep the previous template until
+the placement is updated again, which starts a new rollout.
+
+Example:
+  maestro placement abort <placement-id>`,
+		(*clients.RESTClient).AbortPlacementRollout)
+}
+
+func newRolloutCommand(use, short, long string, action rolloutAction) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " <id>",
+		Short: short,
+		Long:  long,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runRollout(cmd, args, action); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runRollout(cmd *cobra.Command, args []string, action rolloutAction) error {
+	placementID := args[0]
+
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Change the rollout phase of the placement
+	ctx := context.Background()
+	placement, err := action(restClient, ctx, placementID)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		return output.PrintPlacement(os.Stdout, placement)
+	}
+
+	return output.PrintJSON(os.Stdout, placement)
+}
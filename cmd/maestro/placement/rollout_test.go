@@ -0,0 +1,96 @@
+package placement
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func TestRunRollout(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		args        []string
+		action      rolloutAction
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "successful pause with table format",
+			args:    []string{"placement-1"},
+			action:  (*clients.RESTClient).PausePlacementRollout,
+			output:  "table",
+			wantErr: false,
+		},
+		{
+			name:    "successful abort with json format",
+			args:    []string{"placement-1"},
+			action:  (*clients.RESTClient).AbortPlacementRollout,
+			output:  "json",
+			wantErr: false,
+		},
+		{
+			name:        "resume a rollout that is not paused",
+			args:        []string{"placement-1"},
+			action:      (*clients.RESTClient).ResumePlacementRollout,
+			output:      "table",
+			wantErr:     true,
+			errContains: "bad request",
+		},
+		{
+			name:        "placement not found",
+			args:        []string{"not-found"},
+			action:      (*clients.RESTClient).PausePlacementRollout,
+			output:      "table",
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name:        "forbidden request",
+			args:        []string{"forbidden"},
+			action:      (*clients.RESTClient).AbortPlacementRollout,
+			output:      "table",
+			wantErr:     true,
+			errContains: "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server)
+			defer cleanup()
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			output.AddFormatFlag(cmd)
+
+			// Parse flags to initialize them
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			cmd.Flags().Set(output.FlagOutput, tt.output)
+
+			err := runRollout(cmd, tt.args, tt.action)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runRollout() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runRollout() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
@@ -38,8 +38,19 @@ The manifest file should contain:
 - manifest_configs: Optional manifest configurations
 - delete_option: Optional delete options
 
+With --wait, the command blocks until the agent has applied the resource bundle.
+
+With --field-manager, the manifests are applied on behalf of the field manager, so
+multiple sources can co-manage a resource bundle:
+- An update only replaces or removes the manifests that are owned by the field
+  manager, the manifests of other field managers are kept
+- An update that changes a manifest owned by another field manager fails with a
+  conflict, use --force to take the ownership of it
+
 Examples:
   maestro resourcebundle apply -f bundle.json
+  maestro resourcebundle apply -f bundle.json --wait --wait-timeout 2m
+  maestro resourcebundle apply -f bundle.json --field-manager my-controller
   maestro resourcebundle apply -f bundle.json --grpc-server-address localhost:8090`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runApply(cmd, args); err != nil {
@@ -51,25 +62,21 @@ Examples:
 
 	cmd.Flags().StringP("file", "f", "", "Path to the manifest file (required)")
 	cmd.MarkFlagRequired("file")
+	clients.AddWaitFlags(cmd)
+	clients.AddApplyFlags(cmd)
 
 	return cmd
 }
 
 func runApply(cmd *cobra.Command, _ []string) error {
-	// Read and parse the manifest file
-	filePath, err := cmd.Flags().GetString("file")
+	bundle, err := readBundleFile(cmd)
 	if err != nil {
-		return fmt.Errorf("failed to read --file flag: %w", err)
-	}
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to read manifest file: %w", err)
+		return err
 	}
 
-	// Parse manifest file (JSON format only)
-	var bundle openapi.ResourceBundle
-	if err := json.Unmarshal(data, &bundle); err != nil {
-		return fmt.Errorf("failed to parse manifest file: %w", err)
+	applyOptions, err := clients.ApplyOptionsFromFlags(cmd)
+	if err != nil {
+		return err
 	}
 
 	// Load client configuration
@@ -90,44 +97,81 @@ func runApply(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to create gRPC client: %w", err)
 	}
 	defer grpcClient.Close()
+	grpcClient.SetApplyOptions(applyOptions)
 
 	ctx := context.Background()
 
-	// Determine action based on whether ID was provided
-	var action cetypes.EventAction
+	action, err := resolveApplyAction(ctx, restClient, bundle)
+	if err != nil {
+		return err
+	}
+
+	// Apply the resource bundle via gRPC
+	operationID, err := grpcClient.Apply(ctx, bundle, action)
+	if err != nil {
+		return fmt.Errorf("failed to apply resource bundle: %w", err)
+	}
 
+	fmt.Printf("Resource bundle applied successfully:\nID: %s\n", *bundle.Id)
+
+	// Wait for the agent to apply the resource bundle
+	operation, err := clients.WaitForOperationFromFlags(ctx, cmd, restClient, operationID)
+	if err != nil {
+		return err
+	}
+	if operation != nil {
+		fmt.Printf("Resource bundle %s is applied by the agent\n", *bundle.Id)
+	}
+
+	return nil
+}
+
+// readBundleFile reads and parses the manifest file given by the --file flag
+func readBundleFile(cmd *cobra.Command) (*openapi.ResourceBundle, error) {
+	filePath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --file flag: %w", err)
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	// Parse manifest file (JSON format only)
+	var bundle openapi.ResourceBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest file: %w", err)
+	}
+
+	return &bundle, nil
+}
+
+// resolveApplyAction determines whether applying the bundle creates or updates a resource bundle,
+// and sets the bundle ID and version accordingly
+func resolveApplyAction(ctx context.Context, restClient *clients.RESTClient, bundle *openapi.ResourceBundle) (cetypes.EventAction, error) {
 	// Check if ID was provided in the manifest
 	idWasProvided := bundle.Id != nil && *bundle.Id != ""
-	if idWasProvided {
-		// ID was provided in manifest - get existing resource and update it
-		existingBundle, err := restClient.GetResourceBundle(ctx, *bundle.Id)
-		if err != nil {
-			return fmt.Errorf("cannot update resource bundle %q: %w", *bundle.Id, err)
-		}
-
-		// Validate version for optimistic concurrency control
-		if bundle.Version == nil || *bundle.Version == 0 {
-			// Use existing version if not specified in manifest
-			bundle.Version = existingBundle.Version
-		} else if existingBundle.Version != nil && *bundle.Version != *existingBundle.Version {
-			// Version was specified but doesn't match - reject to prevent lost updates
-			return fmt.Errorf("version mismatch: manifest specifies version %d but resource bundle has version %d. Update your manifest with the current version or omit 'version' to use the latest", *bundle.Version, *existingBundle.Version)
-		}
-		action = cetypes.UpdateRequestAction
-	} else {
+	if !idWasProvided {
 		// ID was generated - create new resource bundle
 		resourceID := uuid.New().String()
 		bundle.Id = &resourceID
 		bundle.Version = openapi.PtrInt32(0)
-		action = cetypes.CreateRequestAction
+		return cetypes.CreateRequestAction, nil
 	}
 
-	// Apply the resource bundle via gRPC
-	if err := grpcClient.Apply(ctx, &bundle, action); err != nil {
-		return fmt.Errorf("failed to apply resource bundle: %w", err)
+	// ID was provided in manifest - get existing resource and update it
+	existingBundle, err := restClient.GetResourceBundle(ctx, *bundle.Id)
+	if err != nil {
+		return "", fmt.Errorf("cannot update resource bundle %q: %w", *bundle.Id, err)
 	}
 
-	fmt.Printf("Resource bundle applied successfully:\nID: %s\n", *bundle.Id)
-
-	return nil
+	// Validate version for optimistic concurrency control
+	if bundle.Version == nil || *bundle.Version == 0 {
+		// Use existing version if not specified in manifest
+		bundle.Version = existingBundle.Version
+	} else if existingBundle.Version != nil && *bundle.Version != *existingBundle.Version {
+		// Version was specified but doesn't match - reject to prevent lost updates
+		return "", fmt.Errorf("version mismatch: manifest specifies version %d but resource bundle has version %d. Update your manifest with the current version or omit 'version' to use the latest", *bundle.Version, *existingBundle.Version)
+	}
+	return cetypes.UpdateRequestAction, nil
 }
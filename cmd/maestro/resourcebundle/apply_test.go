@@ -111,6 +111,8 @@ func TestRunApply(t *testing.T) {
 			clients.AddRESTClientFlags(cmd)
 			clients.AddGRPCClientFlags(cmd, "test-source")
 			cmd.Flags().StringP("file", "f", "", "Path to the manifest file")
+			clients.AddApplyFlags(cmd)
+			clients.AddWaitFlags(cmd)
 
 			// Parse flags to initialize them
 			if err := cmd.ParseFlags([]string{}); err != nil {
@@ -152,6 +154,8 @@ func TestRunApply_FileNotFound(t *testing.T) {
 	clients.AddRESTClientFlags(cmd)
 	clients.AddGRPCClientFlags(cmd, "test-source")
 	cmd.Flags().StringP("file", "f", "", "Path to the manifest file")
+	clients.AddApplyFlags(cmd)
+	clients.AddWaitFlags(cmd)
 
 	// Parse flags to initialize them
 	if err := cmd.ParseFlags([]string{}); err != nil {
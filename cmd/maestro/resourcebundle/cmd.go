@@ -18,11 +18,14 @@ func NewResourceBundleCommand() *cobra.Command {
 Resource bundles are collections of Kubernetes manifests that are deployed to consumer clusters.
 
 Commands:
-  apply  - Create or update a resource bundle via gRPC
-  get    - Get a resource bundle by ID via REST API
-  list   - List resource bundles via REST API
-  delete - Delete a resource bundle via gRPC
-  status - Get resource bundle status via REST API`,
+  apply    - Create or update a resource bundle via gRPC
+  diff     - Show the changes applying a resource bundle would make via gRPC
+  get      - Get a resource bundle by ID via REST API
+  list     - List resource bundles via REST API
+  delete   - Delete a resource bundle via gRPC
+  status   - Get resource bundle status via REST API
+  summary  - Summarize the states of resource bundles via REST API
+  rollback - Roll back a resource bundle to a previous version via REST API`,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			// Suppress verbose logs by default for CLI commands
 			// Only suppress if user hasn't set -v flag
@@ -39,10 +42,13 @@ Commands:
 	// Add subcommands
 	cmd.AddCommand(
 		newApplyCommand(),
+		newDiffCommand(),
 		newGetCommand(),
 		newListCommand(),
 		newDeleteCommand(),
 		newStatusCommand(),
+		newSummaryCommand(),
+		newRollbackCommand(),
 	)
 
 	return cmd
@@ -19,11 +19,13 @@ func newDeleteCommand() *cobra.Command {
 		Long: `Delete a resource bundle by its ID.
 
 By default, this command will prompt for confirmation before deleting.
-Use the --yes flag to skip the confirmation prompt.
+Use the --yes flag to skip the confirmation prompt. With --wait, the command blocks
+until the agent has removed the resource bundle.
 
 Examples:
   maestro resourcebundle delete 2faPrp3ZoCMkzdHnBBWd9wqwVXd
-  maestro resourcebundle delete 2faPrp3ZoCMkzdHnBBWd9wqwVXd --yes`,
+  maestro resourcebundle delete 2faPrp3ZoCMkzdHnBBWd9wqwVXd --yes
+  maestro resourcebundle delete 2faPrp3ZoCMkzdHnBBWd9wqwVXd --yes --wait`,
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runDelete(cmd, args); err != nil {
@@ -34,6 +36,7 @@ Examples:
 	}
 
 	cmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	clients.AddWaitFlags(cmd)
 
 	return cmd
 }
@@ -94,10 +97,20 @@ func runDelete(cmd *cobra.Command, args []string) error {
 	defer grpcClient.Close()
 
 	// Delete the resource bundle via gRPC
-	if err := grpcClient.Delete(ctx, bundleID, consumerName, version); err != nil {
+	operationID, err := grpcClient.Delete(ctx, bundleID, consumerName, version)
+	if err != nil {
 		return fmt.Errorf("failed to delete resource bundle: %w", err)
 	}
 
 	fmt.Printf("Resource bundle %s deleted successfully\n", bundleID)
+
+	// Wait for the agent to remove the resource bundle
+	operation, err := clients.WaitForOperationFromFlags(ctx, cmd, restClient, operationID)
+	if err != nil {
+		return err
+	}
+	if operation != nil {
+		fmt.Printf("Resource bundle %s is removed by the agent\n", bundleID)
+	}
 	return nil
 }
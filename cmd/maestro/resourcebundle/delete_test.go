@@ -25,6 +25,7 @@ func TestRunDelete(t *testing.T) {
 		name        string
 		args        []string
 		skipConfirm bool
+		wait        bool
 		wantErr     bool
 		errContains string
 	}{
@@ -34,6 +35,13 @@ func TestRunDelete(t *testing.T) {
 			skipConfirm: true,
 			wantErr:     false,
 		},
+		{
+			name:        "successful delete and wait",
+			args:        []string{"bundle-1"},
+			skipConfirm: true,
+			wait:        true,
+			wantErr:     false,
+		},
 		{
 			name:        "delete non-existent bundle",
 			args:        []string{"not-found"},
@@ -52,6 +60,7 @@ func TestRunDelete(t *testing.T) {
 			clients.AddRESTClientFlags(cmd)
 			clients.AddGRPCClientFlags(cmd, "test-source")
 			cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+			clients.AddWaitFlags(cmd)
 
 			// Parse flags to initialize them
 			if err := cmd.ParseFlags([]string{}); err != nil {
@@ -61,6 +70,9 @@ func TestRunDelete(t *testing.T) {
 			if tt.skipConfirm {
 				cmd.Flags().Set("yes", "true")
 			}
+			if tt.wait {
+				cmd.Flags().Set(clients.FlagWait, "true")
+			}
 
 			err := runDelete(cmd, tt.args)
 
@@ -112,6 +124,7 @@ func TestRunDelete_WithConfirmation(t *testing.T) {
 		clients.AddRESTClientFlags(cmd)
 		clients.AddGRPCClientFlags(cmd, "test-source")
 		cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+		clients.AddWaitFlags(cmd)
 
 		// Parse flags to initialize them
 		if err := cmd.ParseFlags([]string{}); err != nil {
@@ -0,0 +1,105 @@
+package resourcebundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func newDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff -f <file>",
+		Short: "Show the changes applying a resource bundle would make",
+		Long: `Show the changes applying a resource bundle from a manifest file (JSON format) would make.
+
+This command sends the manifest file as a dry-run via gRPC, the server validates it in
+the same way as 'apply' and returns the difference between the stored resource bundle
+and the manifest file without persisting it or delivering it to the consumer.
+
+Each manifest is reported as Added, Removed, Modified or Unchanged, manifests are
+matched by their apiVersion, kind, namespace and name. Use '--output json' to see
+the JSON merge patch of the modified manifests.
+
+The manifest file has the same format as the one of 'apply'. With --field-manager, the
+changes are the ones applying the manifest file on behalf of the field manager would make.
+
+Examples:
+  maestro resourcebundle diff -f bundle.json
+  maestro resourcebundle diff -f bundle.json --output json
+  maestro resourcebundle diff -f bundle.json --field-manager my-controller`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runDiff(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to the manifest file (required)")
+	cmd.MarkFlagRequired("file")
+	output.AddFormatFlag(cmd)
+	clients.AddApplyFlags(cmd)
+
+	return cmd
+}
+
+func runDiff(cmd *cobra.Command, _ []string) error {
+	bundle, err := readBundleFile(cmd)
+	if err != nil {
+		return err
+	}
+
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	applyOptions, err := clients.ApplyOptionsFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Load client configuration
+	cfg, err := clients.LoadConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create rest client
+	restClient, err := clients.NewRESTClient(&cfg.RESTConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Create gRPC client
+	grpcClient, err := clients.NewGRPCClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+	defer grpcClient.Close()
+	grpcClient.SetApplyOptions(applyOptions)
+
+	ctx := context.Background()
+
+	action, err := resolveApplyAction(ctx, restClient, bundle)
+	if err != nil {
+		return err
+	}
+
+	// Dry-run the resource bundle via gRPC
+	diff, err := grpcClient.DryRun(ctx, bundle, action)
+	if err != nil {
+		return fmt.Errorf("failed to diff resource bundle: %w", err)
+	}
+
+	if format == output.FormatTable {
+		return output.PrintResourceBundleDiff(os.Stdout, diff)
+	}
+
+	return output.PrintJSON(os.Stdout, diff)
+}
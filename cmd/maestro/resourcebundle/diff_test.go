@@ -0,0 +1,149 @@
+package resourcebundle
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/spf13/cobra"
+	grpcprotocol "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protocol"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+func TestRunDiff(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	grpcServer, err := mock.NewGRPCServer()
+	if err != nil {
+		t.Fatalf("Failed to create gRPC server: %v", err)
+	}
+	defer grpcServer.Stop()
+
+	tests := []struct {
+		name        string
+		manifest    string
+		format      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "diff new resource bundle",
+			manifest: `{
+				"consumer_name": "test-consumer",
+				"manifests": [
+					{
+						"apiVersion": "v1",
+						"kind": "ConfigMap",
+						"metadata": {"name": "test-cm"}
+					}
+				]
+			}`,
+			format:  "table",
+			wantErr: false,
+		},
+		{
+			name: "diff existing resource bundle as json",
+			manifest: `{
+				"id": "bundle-1",
+				"consumer_name": "test-consumer",
+				"manifests": [
+					{
+						"apiVersion": "v1",
+						"kind": "ConfigMap",
+						"metadata": {"name": "test-cm"}
+					}
+				]
+			}`,
+			format:  "json",
+			wantErr: false,
+		},
+		{
+			name: "diff with version mismatch",
+			manifest: `{
+				"id": "bundle-1",
+				"consumer_name": "test-consumer",
+				"version": 2,
+				"manifests": [
+					{
+						"apiVersion": "v1",
+						"kind": "ConfigMap",
+						"metadata": {"name": "test-cm"}
+					}
+				]
+			}`,
+			format:      "table",
+			wantErr:     true,
+			errContains: "version mismatch",
+		},
+		{
+			name:        "invalid output format",
+			manifest:    `{"consumer_name": "test-consumer"}`,
+			format:      "yaml",
+			wantErr:     true,
+			errContains: "invalid output format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server, grpcServer)
+			defer cleanup()
+			grpcServer.ClearPublishedEvents()
+
+			tmpDir := t.TempDir()
+			manifestFile := filepath.Join(tmpDir, "manifest.json")
+			if err := os.WriteFile(manifestFile, []byte(tt.manifest), 0644); err != nil {
+				t.Fatalf("Failed to create manifest file: %v", err)
+			}
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			clients.AddGRPCClientFlags(cmd, "test-source")
+			cmd.Flags().StringP("file", "f", "", "Path to the manifest file")
+			clients.AddApplyFlags(cmd)
+			output.AddFormatFlag(cmd)
+
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			cmd.Flags().Set("file", manifestFile)
+			cmd.Flags().Set(output.FlagOutput, tt.format)
+
+			err := runDiff(cmd, []string{})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runDiff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errContains != "" && (err == nil || !strings.Contains(err.Error(), tt.errContains)) {
+					t.Errorf("runDiff() error = %v, should contain %v", err, tt.errContains)
+				}
+				return
+			}
+
+			// The published event must be marked as a dry-run
+			events := grpcServer.GetPublishedEvents()
+			if len(events) != 1 {
+				t.Fatalf("expected 1 published event, got %d", len(events))
+			}
+			evt, err := binding.ToEvent(context.Background(), grpcprotocol.NewMessage(events[0]))
+			if err != nil {
+				t.Fatalf("Failed to convert published event: %v", err)
+			}
+			if dryRun, ok := evt.Extensions()[api.ExtensionDryRun].(bool); !ok || !dryRun {
+				t.Errorf("expected the published event to have the %s extension", api.ExtensionDryRun)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package resourcebundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+const flagToVersion = "to-version"
+
+func newRollbackCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback <id> --to-version <version>",
+		Short: "Roll back a resource bundle to a previous version",
+		Long: `Roll back a resource bundle to a previous version via REST API.
+
+The manifests, metadata, manifest configs and delete option recorded for the given
+version are re-applied as a new version of the resource bundle, so the version of
+the resource bundle is increased rather than reset to the given version. With --wait,
+the command blocks until the agent has applied the rolled back resource bundle.
+
+Example:
+  maestro resourcebundle rollback 2faPrp3ZoCMkzdHnBBWd9wqwVXd --to-version 2
+  maestro resourcebundle rollback 2faPrp3ZoCMkzdHnBBWd9wqwVXd --to-version 2 --wait
+  maestro resourcebundle rollback 2faPrp3ZoCMkzdHnBBWd9wqwVXd --to-version 2 --output json`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runRollback(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().Int32(flagToVersion, 0, "The version to roll back to (required)")
+	cmd.MarkFlagRequired(flagToVersion)
+	output.AddFormatFlag(cmd)
+	clients.AddWaitFlags(cmd)
+
+	return cmd
+}
+
+func runRollback(cmd *cobra.Command, args []string) error {
+	bundleID := args[0]
+
+	version, err := cmd.Flags().GetInt32(flagToVersion)
+	if err != nil {
+		return err
+	}
+	if version <= 0 {
+		return fmt.Errorf("--%s must be greater than 0", flagToVersion)
+	}
+
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Roll back the resource bundle
+	ctx := context.Background()
+	bundle, operationID, err := restClient.RollbackResourceBundle(ctx, bundleID, version)
+	if err != nil {
+		return err
+	}
+
+	// Wait for the agent to apply the rolled back resource bundle
+	if _, err := clients.WaitForOperationFromFlags(ctx, cmd, restClient, operationID); err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		return output.PrintResourceBundle(os.Stdout, bundle)
+	}
+
+	return output.PrintJSON(os.Stdout, bundle)
+}
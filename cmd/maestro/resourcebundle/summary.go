@@ -0,0 +1,92 @@
+package resourcebundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func newSummaryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Summarize the states of resource bundles",
+		Long: `Show the number of resource bundles that are applied, available, degraded, being deleted or
+whose status has never been reported, grouped by consumer, source or the value of a label.
+
+The states are counted by the server, so the resource bundles are not downloaded.
+
+Examples:
+  maestro resourcebundle summary
+  maestro resourcebundle summary --group-by source
+  maestro resourcebundle summary --group-by label --label app
+  maestro resourcebundle summary --output json`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runSummary(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().String("group-by", "consumer", "Group the resource bundles by consumer, source or label")
+	cmd.Flags().String("label", "", "The label key to group the resource bundles by, required with --group-by label")
+
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runSummary(cmd *cobra.Command, _ []string) error {
+	groupBy, _ := cmd.Flags().GetString("group-by")
+	label, _ := cmd.Flags().GetString("label")
+
+	switch groupBy {
+	case "consumer", "source":
+		if label != "" {
+			return fmt.Errorf("--label is only supported with --group-by label")
+		}
+	case "label":
+		if label == "" {
+			return fmt.Errorf("--label is required with --group-by label")
+		}
+	default:
+		return fmt.Errorf("--group-by must be one of consumer, source or label")
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Summarize resource bundles
+	ctx := context.Background()
+	summary, err := restClient.SummarizeResourceBundles(ctx, groupBy, label)
+	if err != nil {
+		return err
+	}
+
+	// Output the result
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		return output.PrintResourceBundleSummary(os.Stdout, summary)
+	}
+
+	return output.PrintJSON(os.Stdout, summary)
+}
@@ -0,0 +1,99 @@
+package resourcebundle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func TestRunSummary(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		groupBy     string
+		label       string
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "successful summary with table format",
+			groupBy: "consumer",
+			output:  "table",
+			wantErr: false,
+		},
+		{
+			name:    "successful summary by label with json format",
+			groupBy: "label",
+			label:   "app",
+			output:  "json",
+			wantErr: false,
+		},
+		{
+			name:        "label is required to group by label",
+			groupBy:     "label",
+			output:      "table",
+			wantErr:     true,
+			errContains: "--label is required",
+		},
+		{
+			name:        "unsupported group",
+			groupBy:     "placement",
+			output:      "table",
+			wantErr:     true,
+			errContains: "--group-by must be one of",
+		},
+		{
+			name:        "permission denied",
+			groupBy:     "label",
+			label:       "forbidden",
+			output:      "table",
+			wantErr:     true,
+			errContains: "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server, nil)
+			defer cleanup()
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			output.AddFormatFlag(cmd)
+			cmd.Flags().String("group-by", "consumer", "Group the resource bundles by")
+			cmd.Flags().String("label", "", "The label key")
+
+			// Parse flags to initialize them
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			cmd.Flags().Set(output.FlagOutput, tt.output)
+			cmd.Flags().Set("group-by", tt.groupBy)
+			if tt.label != "" {
+				cmd.Flags().Set("label", tt.label)
+			}
+
+			err := runSummary(cmd, []string{})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runSummary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runSummary() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
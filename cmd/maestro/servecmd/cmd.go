@@ -85,7 +85,7 @@ func runServer(cmd *cobra.Command, args []string) {
 	apiserver := server.NewAPIServer(ctx, eventBroadcaster)
 	metricsServer := server.NewMetricsServer()
 	healthcheckServer := server.NewHealthCheckServer(ctx)
-	controllersServer := server.NewControllersServer(ctx, eventServer, eventFilter)
+	controllersServer := server.NewControllersServer(ctx, eventServer, eventFilter, eventBroadcaster)
 
 	tracingShutdown := func(context.Context) error { return nil }
 	if common.TracingEnabled() {
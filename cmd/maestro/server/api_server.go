@@ -2,9 +2,12 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/ghodss/yaml"
@@ -15,6 +18,7 @@ import (
 	"github.com/openshift-online/maestro/cmd/maestro/common"
 	"github.com/openshift-online/maestro/cmd/maestro/environments"
 	"github.com/openshift-online/maestro/data/generated/openapi"
+	"github.com/openshift-online/maestro/pkg/config"
 	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/event"
 )
@@ -33,7 +37,9 @@ func env() *environments.Env {
 func NewAPIServer(ctx context.Context, eventBroadcaster *event.EventBroadcaster) Server {
 	s := &apiServer{}
 
-	mainRouter := s.routes(ctx)
+	// the watches stream until the clients disconnect, they are ended when the server shuts down
+	watchCtx, stopWatches := context.WithCancel(ctx)
+	mainRouter := s.routes(ctx, eventBroadcaster, watchCtx.Done())
 
 	// referring to the router as type http.Handler allows us to add middleware via more handlers
 	var mainHandler http.Handler = mainRouter
@@ -68,13 +74,46 @@ func NewAPIServer(ctx context.Context, eventBroadcaster *event.EventBroadcaster)
 		Addr:    env().Config.HTTPServer.Hostname + ":" + env().Config.HTTPServer.BindPort,
 		Handler: mainHandler,
 	}
+	s.httpServer.RegisterOnShutdown(stopWatches)
+
+	if env().Config.HTTPServer.HTTPAuthNType == "mtls" {
+		s.httpServer.TLSConfig = clientCertTLSConfig(ctx, env().Config.HTTPServer)
+	}
 
 	if env().Config.GRPCServer.EnableGRPCServer {
-		s.grpcServer = NewGRPCServer(ctx, env().Services.Resources(), eventBroadcaster, *env().Config.GRPCServer, env().Clients.GRPCAuthorizer)
+		s.grpcServer = NewGRPCServer(ctx, env().Services.Resources(), env().Services.Operations(), env().Services.StatusEvents(), eventBroadcaster, *env().Config.GRPCServer, env().Clients.GRPCAuthorizer)
 	}
 	return s
 }
 
+// clientCertTLSConfig returns the TLS config that requires and verifies the client certificates
+// of the REST API against the configured client CA.
+func clientCertTLSConfig(ctx context.Context, httpConfig *config.HTTPServerConfig) *tls.Config {
+	if !httpConfig.EnableHTTPS {
+		check(ctx, fmt.Errorf("--enable-https is required when using mtls authentication type"), "Can't start https server")
+	}
+
+	if len(httpConfig.HTTPClientCAFile) == 0 {
+		check(ctx, fmt.Errorf("no client CA file specified when using mtls authentication type"), "Can't start https server")
+	}
+
+	// only the client certificates signed by the configured client CA are trusted, not the ones signed by a system CA
+	certPool := x509.NewCertPool()
+	caPEM, err := os.ReadFile(httpConfig.HTTPClientCAFile)
+	if err != nil {
+		check(ctx, fmt.Errorf("failed to read client CA file: %v", err), "Can't start https server")
+	}
+
+	if ok := certPool.AppendCertsFromPEM(caPEM); !ok {
+		check(ctx, fmt.Errorf("failed to append client CA to cert pool"), "Can't start https server")
+	}
+
+	return &tls.Config{
+		ClientCAs:  certPool,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+	}
+}
+
 // Serve start the blocking call to Serve.
 // Useful for breaking up ListenAndServer (Start) when you require the server to be listening before continuing
 func (s apiServer) Serve(ctx context.Context, listener net.Listener) {
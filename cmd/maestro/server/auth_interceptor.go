@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"strings"
 
@@ -13,23 +14,10 @@ import (
 	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 )
 
-// Context key type defined to avoid collisions in other pkgs using context
-// See https://golang.org/pkg/context/#WithValue
-type contextKey string
-
-const (
-	contextUserKey   contextKey = "user"
-	contextGroupsKey contextKey = "groups"
-)
-
-func newContextWithIdentity(ctx context.Context, user string, groups []string) context.Context {
-	ctx = context.WithValue(ctx, contextUserKey, user)
-	return context.WithValue(ctx, contextGroupsKey, groups)
-}
-
 // identityFromCertificate retrieves the user and groups from the client certificate if they are present.
 func identityFromCertificate(ctx context.Context) (string, []string, error) {
 	p, ok := peer.FromContext(ctx)
@@ -42,23 +30,30 @@ func identityFromCertificate(ctx context.Context) (string, []string, error) {
 		return "", nil, status.Error(codes.Unauthenticated, "unexpected peer transport credentials")
 	}
 
-	if len(tlsAuth.State.VerifiedChains) == 0 || len(tlsAuth.State.VerifiedChains[0]) == 0 {
-		return "", nil, status.Error(codes.Unauthenticated, "could not verify peer certificate")
+	user, groups, err := identityFromVerifiedChains(tlsAuth.State.VerifiedChains)
+	if err != nil {
+		return "", nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
-	if tlsAuth.State.VerifiedChains[0][0] == nil {
-		return "", nil, status.Error(codes.Unauthenticated, "could not verify peer certificate")
+	return user, groups, nil
+}
+
+// identityFromVerifiedChains retrieves the user and groups from the leaf of the first verified
+// certificate chain, the user is the subject common name and the groups are the subject organizations.
+func identityFromVerifiedChains(verifiedChains [][]*x509.Certificate) (string, []string, error) {
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 || verifiedChains[0][0] == nil {
+		return "", nil, fmt.Errorf("could not verify peer certificate")
 	}
 
-	user := tlsAuth.State.VerifiedChains[0][0].Subject.CommonName
-	groups := tlsAuth.State.VerifiedChains[0][0].Subject.Organization
+	user := verifiedChains[0][0].Subject.CommonName
+	groups := verifiedChains[0][0].Subject.Organization
 
 	if user == "" {
-		return "", nil, status.Error(codes.Unauthenticated, "could not find user in peer certificate")
+		return "", nil, fmt.Errorf("could not find user in peer certificate")
 	}
 
 	if len(groups) == 0 {
-		return "", nil, status.Error(codes.Unauthenticated, "could not find group in peer certificate")
+		return "", nil, fmt.Errorf("could not find group in peer certificate")
 	}
 
 	return user, groups, nil
@@ -86,9 +81,10 @@ func identityFromToken(ctx context.Context, grpcAuthorizer grpcauthorizer.GRPCAu
 // newAuthUnaryInterceptor creates a unary interceptor that retrieves the user and groups
 // based on the specified authentication type. It supports retrieving from either the access
 // token or the client certificate depending on the provided authNType.
-// The interceptor then adds the retrieved identity information (user and groups) to the
-// context and invokes the provided handler.
-func newAuthUnaryInterceptor(authNType string, authorizer grpcauthorizer.GRPCAuthorizer) grpc.UnaryServerInterceptor {
+// The interceptor then adds the retrieved identity information (user and groups) and the
+// sources the identity is allowed to access with the tenancy to the context and invokes the
+// provided handler.
+func newAuthUnaryInterceptor(authNType string, authorizer grpcauthorizer.GRPCAuthorizer, tenancy *auth.Tenancy) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -121,7 +117,7 @@ func newAuthUnaryInterceptor(authNType string, authorizer grpcauthorizer.GRPCAut
 		}
 
 		// call the handler with the new context containing the user and groups
-		return handler(newContextWithIdentity(ctx, user, groups), req)
+		return handler(auth.NewContextWithTenancy(auth.NewContextWithIdentity(ctx, user, groups), tenancy, user, groups), req)
 	}
 }
 
@@ -146,9 +142,10 @@ func newWrappedAuthStream(ctx context.Context, s grpc.ServerStream) grpc.ServerS
 // newAuthStreamInterceptor creates a stream interceptor that retrieves the user and groups
 // based on the specified authentication type. It supports retrieving from either the access
 // token or the client certificate depending on the provided authNType.
-// The interceptor then adds the retrieved identity information (user and groups) to the
-// context and invokes the provided handler.
-func newAuthStreamInterceptor(authNType string, authorizer grpcauthorizer.GRPCAuthorizer) grpc.StreamServerInterceptor {
+// The interceptor then adds the retrieved identity information (user and groups) and the
+// sources the identity is allowed to access with the tenancy to the context and invokes the
+// provided handler.
+func newAuthStreamInterceptor(authNType string, authorizer grpcauthorizer.GRPCAuthorizer, tenancy *auth.Tenancy) grpc.StreamServerInterceptor {
 	return func(
 		srv interface{},
 		ss grpc.ServerStream,
@@ -180,6 +177,7 @@ func newAuthStreamInterceptor(authNType string, authorizer grpcauthorizer.GRPCAu
 			return fmt.Errorf("unsupported authentication Type %s", authNType)
 		}
 
-		return handler(srv, newWrappedAuthStream(newContextWithIdentity(ss.Context(), user, groups), ss))
+		ctx := auth.NewContextWithTenancy(auth.NewContextWithIdentity(ss.Context(), user, groups), tenancy, user, groups)
+		return handler(srv, newWrappedAuthStream(ctx, ss))
 	}
 }
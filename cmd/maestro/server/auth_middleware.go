@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
+	"github.com/openshift-online/maestro/pkg/errors"
+	loggertracing "github.com/openshift-online/maestro/pkg/logger"
+)
+
+// newAuthnMiddleware creates a middleware that retrieves the user and groups of a REST request
+// based on the specified authentication type. It supports retrieving them from a bearer JWT
+// validated against a JWK set, from the client certificate, or from a bearer token reviewed by
+// the authorizer. The retrieved identity and the sources it is allowed to access with the tenancy
+// are added to the request context; requests that cannot be authenticated are rejected with 401.
+func newAuthnMiddleware(authNType string, jwtAuthenticator *auth.JWTAuthenticator, authorizer grpcauthorizer.GRPCAuthorizer,
+	tenancy *auth.Tenancy) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var user string
+			var groups []string
+			var err error
+
+			ctx := r.Context()
+			switch authNType {
+			case "jwt":
+				user, groups, err = identityFromBearerToken(r, jwtAuthenticator.Authenticate)
+			case "token":
+				user, groups, err = identityFromBearerToken(r, authorizer.TokenReview)
+			case "mtls":
+				if r.TLS == nil {
+					err = fmt.Errorf("no client certificate found")
+				} else {
+					user, groups, err = identityFromVerifiedChains(r.TLS.VerifiedChains)
+				}
+			case "mock":
+				user = "mock"
+				groups = []string{"mock-group"}
+			default:
+				sendAuthError(ctx, w, errors.GeneralError("unsupported authentication type %s", authNType))
+				return
+			}
+
+			if err != nil {
+				sendAuthError(ctx, w, errors.Unauthenticated("unable to authenticate the request: %s", err))
+				return
+			}
+
+			ctx = auth.NewContextWithTenancy(auth.NewContextWithIdentity(ctx, user, groups), tenancy, user, groups)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// newAuthzMiddleware creates a middleware that checks whether the authenticated identity is
+// allowed to perform the request on the given resource type. The action is derived from the
+// request method and whether the route addresses a single resource by {id}, and is checked with
+// the same GRPCAuthorizer.AccessReview used by the gRPC server. An identity without groups cannot
+// be reviewed and is rejected with 403. It must be registered after the authentication middleware.
+func newAuthzMiddleware(resourceType string, authorizer grpcauthorizer.GRPCAuthorizer) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			action, err := actionFromRequest(r.Method, id)
+			if err != nil {
+				sendAuthError(ctx, w, errors.Forbidden("%s", err))
+				return
+			}
+
+			user, groups := auth.IdentityFromContext(ctx)
+			if len(groups) == 0 {
+				sendAuthError(ctx, w, errors.Forbidden("%s is not allowed to %s %s %s without groups", user, action, resourceType, id))
+				return
+			}
+			allowed, err := authorizer.AccessReview(ctx, action, resourceType, id, user, groups)
+			if err != nil {
+				sendAuthError(ctx, w, errors.GeneralError("failed to authorize the request: %s", err))
+				return
+			}
+			if !allowed {
+				sendAuthError(ctx, w, errors.Forbidden("%s is not allowed to %s %s %s", user, action, resourceType, id))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// actionFromRequest maps the request method to the action that is checked by the authorizer.
+func actionFromRequest(method, id string) (string, error) {
+	switch method {
+	case http.MethodGet:
+		if id == "" {
+			return grpcauthorizer.ListAction, nil
+		}
+		return grpcauthorizer.GetAction, nil
+	case http.MethodPost:
+		// a POST on a single resource is an action on an existing resource, e.g. rollback
+		if id != "" {
+			return grpcauthorizer.UpdateAction, nil
+		}
+		return grpcauthorizer.CreateAction, nil
+	case http.MethodPut, http.MethodPatch:
+		return grpcauthorizer.UpdateAction, nil
+	case http.MethodDelete:
+		return grpcauthorizer.DeleteAction, nil
+	default:
+		return "", fmt.Errorf("unsupported method %s", method)
+	}
+}
+
+// identityFromBearerToken retrieves the user and groups from the bearer token of the request
+// with the given token reviewer.
+func identityFromBearerToken(r *http.Request,
+	review func(ctx context.Context, token string) (string, []string, error)) (string, []string, error) {
+	authorization := r.Header.Get("Authorization")
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return "", nil, fmt.Errorf("missing bearer token")
+	}
+
+	return review(r.Context(), token)
+}
+
+func sendAuthError(ctx context.Context, w http.ResponseWriter, err *errors.ServiceError) {
+	logger := klog.FromContext(ctx)
+	if err.HttpCode >= 400 && err.HttpCode <= 499 {
+		logger.Info("user request error", "error", err)
+	} else {
+		logger.Error(err, "user request error")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.HttpCode)
+	if err := json.NewEncoder(w).Encode(err.AsOpenapiError(loggertracing.GetOperationID(ctx))); err != nil {
+		logger.Error(err, "cannot send response body for request")
+	}
+}
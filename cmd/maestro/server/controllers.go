@@ -2,16 +2,22 @@ package server
 
 import (
 	"context"
+	"time"
 
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/controllers"
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/event"
 )
 
-func NewControllersServer(ctx context.Context, eventServer EventServer, eventFilter controllers.EventFilter) *ControllersServer {
+// quotaUsageRefreshPeriod is the period to refresh the quota metrics with the usage of the resource bundles.
+var quotaUsageRefreshPeriod = time.Minute
+
+func NewControllersServer(ctx context.Context, eventServer EventServer, eventFilter controllers.EventFilter, eventBroadcaster *event.EventBroadcaster) *ControllersServer {
 	logger := klog.FromContext(ctx)
 
 	s := &ControllersServer{
@@ -19,9 +25,48 @@ func NewControllersServer(ctx context.Context, eventServer EventServer, eventFil
 			env().Services.StatusEvents(),
 			dao.NewInstanceDao(&env().Database.SessionFactory),
 			dao.NewEventInstanceDao(&env().Database.SessionFactory),
+			env().Config.EventServer.StatusEventRetention,
+		),
+		PlacementController: controllers.NewPlacementController(
+			env().Services.Placements(),
+			env().Services.Resources(),
+			env().Services.Consumers(),
+			db.NewAdvisoryLockFactory(env().Database.SessionFactory),
+			env().Config.Placement.ProgressDeadline,
+		),
+		OperationController: controllers.NewOperationController(
+			env().Services.Operations(),
+			env().Services.Resources(),
+			env().Services.Consumers(),
+			env().Services.Events(),
+			db.NewAdvisoryLockFactory(env().Database.SessionFactory),
+			env().Config.Operation.Timeout,
+		),
+		ConsumerLivenessController: controllers.NewConsumerLivenessController(
+			env().Services.Consumers(),
+			eventBroadcaster,
+			ConnectedConsumers(eventServer),
+			env().Config.ConsumerLiveness.SilenceThreshold,
+			env().Config.ConsumerLiveness.WebhookURL,
 		),
 	}
 
+	if threshold := env().Config.StaleStatus.Threshold; threshold > 0 {
+		s.StaleStatusController = controllers.NewStaleStatusController(
+			env().Services.Resources(),
+			StatusResync(eventServer),
+			threshold,
+		)
+	}
+
+	if interval := env().Config.EventGC.Interval; interval > 0 {
+		s.EventGCController = controllers.NewEventGCController(
+			env().Services.EventGC(),
+			db.NewAdvisoryLockFactory(env().Database.SessionFactory),
+			interval,
+		)
+	}
+
 	// disable the spec controller if the message broker is disabled
 	if !env().Config.MessageBroker.Disable {
 		logger.V(4).Info("Message broker is enabled, setting up kind controller manager")
@@ -33,24 +78,50 @@ func NewControllersServer(ctx context.Context, eventServer EventServer, eventFil
 		s.KindControllerManager.Add(&controllers.ControllerConfig{
 			Source: "Resources",
 			Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
-				api.CreateEventType: {eventServer.OnCreate},
-				api.UpdateEventType: {eventServer.OnUpdate},
-				api.DeleteEventType: {eventServer.OnDelete},
+				api.CreateEventType: {eventServer.OnCreate, s.OperationController.OnDispatch},
+				api.UpdateEventType: {eventServer.OnUpdate, s.OperationController.OnDispatch},
+				api.DeleteEventType: {eventServer.OnDelete, s.OperationController.OnDispatch},
 			},
 		})
 	}
 
 	s.StatusController.Add(map[api.StatusEventType][]controllers.StatusHandlerFunc{
-		api.StatusUpdateEventType: {eventServer.OnStatusUpdate},
-		api.StatusDeleteEventType: {eventServer.OnStatusUpdate},
+		api.StatusUpdateEventType: {eventServer.OnStatusUpdate, s.PlacementController.OnStatusUpdate, s.OperationController.OnStatusUpdate},
+		api.StatusDeleteEventType: {eventServer.OnStatusUpdate, s.OperationController.OnStatusDelete},
 	})
 
 	return s
 }
 
+// ConnectedConsumers returns the function that lists the consumers whose agents are connected to the event server,
+// it is nil if the agents are not connected to the event server directly.
+func ConnectedConsumers(eventServer EventServer) func() []string {
+	if lister, ok := eventServer.(ConnectedConsumerLister); ok {
+		return lister.ConnectedConsumers
+	}
+	return nil
+}
+
+// StatusResync returns the function that resyncs the status of the resources from their agents, it is nil if the
+// event server cannot resync the status.
+func StatusResync(eventServer EventServer) func(ctx context.Context, resources api.ResourceList) error {
+	if resyncer, ok := eventServer.(StatusResyncer); ok {
+		return resyncer.ResyncStatus
+	}
+	return nil
+}
+
 type ControllersServer struct {
 	KindControllerManager *controllers.KindControllerManager
 	StatusController      *controllers.StatusController
+	PlacementController   *controllers.PlacementController
+	OperationController   *controllers.OperationController
+	// ConsumerLivenessController is optional, the consumer liveness is not tracked if it is nil.
+	ConsumerLivenessController *controllers.ConsumerLivenessController
+	// StaleStatusController is optional, the stale status is not detected if it is nil.
+	StaleStatusController *controllers.StaleStatusController
+	// EventGCController is optional, the event tables are not garbage collected if it is nil.
+	EventGCController *controllers.EventGCController
 
 	DB db.SessionFactory
 }
@@ -72,6 +143,39 @@ func (s ControllersServer) Start(ctx context.Context) {
 	logger.Info("Status controller listening for status events")
 	go env().Database.SessionFactory.NewListener(ctx, "status_events", s.StatusController.AddStatusEvent)
 
+	logger.Info("Placement controller handling placements")
+	go s.PlacementController.Run(ctx)
+	logger.Info("Placement controller listening for placements and consumers")
+	go env().Database.SessionFactory.NewListener(ctx, "placements", s.PlacementController.AddPlacement)
+	go env().Database.SessionFactory.NewListener(ctx, "consumers", s.PlacementController.AddConsumer)
+
+	logger.Info("Operation controller handling operations")
+	go s.OperationController.Run(ctx)
+	logger.Info("Operation controller listening for operations")
+	go env().Database.SessionFactory.NewListener(ctx, "operations", s.OperationController.AddOperation)
+
+	if s.ConsumerLivenessController != nil {
+		logger.Info("Consumer liveness controller tracking consumers")
+		go s.ConsumerLivenessController.Run(ctx)
+	}
+
+	if s.StaleStatusController != nil {
+		logger.Info("Stale status controller detecting stale resources")
+		go s.StaleStatusController.Run(ctx)
+	}
+
+	if s.EventGCController != nil {
+		logger.Info("Event gc controller purging event tables")
+		go s.EventGCController.Run(ctx)
+	}
+
+	logger.Info("Quota usage metrics refreshing")
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, svcErr := env().Services.Quotas().Usage(ctx); svcErr != nil {
+			logger.Error(svcErr, "Failed to refresh the quota usage")
+		}
+	}, quotaUsageRefreshPeriod)
+
 	// block until the context is done
 	<-ctx.Done()
 }
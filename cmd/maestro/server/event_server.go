@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/common"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
@@ -44,6 +45,18 @@ type EventServer interface {
 	PredicateEvent(ctx context.Context, eventID string) (bool, error)
 }
 
+// ConnectedConsumerLister is implemented by the event servers that the agents connect to directly, e.g. the gRPC
+// broker, it returns the consumers whose agents are connected to the current instance.
+type ConnectedConsumerLister interface {
+	ConnectedConsumers() []string
+}
+
+// StatusResyncer is implemented by the event servers that can resync the status of the resources from their
+// agents, only the resources whose consumers are handled by the current instance are resynced.
+type StatusResyncer interface {
+	ResyncStatus(ctx context.Context, resources api.ResourceList) error
+}
+
 var _ EventServer = &MessageQueueEventServer{}
 
 // MessageQueueEventServer represents a event server responsible for publish resource spec events
@@ -129,6 +142,21 @@ func (s *MessageQueueEventServer) OnDelete(ctx context.Context, resourceID strin
 	return s.sourceClient.OnDelete(ctx, resourceID)
 }
 
+// ResyncStatus requests the agents of the consumers that are owned by the current instance to resend the status
+// of their resources.
+func (s *MessageQueueEventServer) ResyncStatus(ctx context.Context, resources api.ResourceList) error {
+	consumers := sets.New[string]()
+	for _, resource := range resources {
+		if s.statusDispatcher.Dispatch(resource.ConsumerName) {
+			consumers.Insert(resource.ConsumerName)
+		}
+	}
+	if consumers.Len() == 0 {
+		return nil
+	}
+	return s.sourceClient.Resync(ctx, sets.List(consumers))
+}
+
 // On StatusUpdate will be called on each new status event inserted into db.
 // It does two things:
 // 1. build the resource status and broadcast it to subscribers
@@ -260,6 +288,8 @@ func HandleStatusUpdate(ctx context.Context, resource *api.Resource, resourceSer
 		if updated {
 			_, sErr := statusEventService.Create(ctx, &api.StatusEvent{
 				ResourceID:      resource.ID,
+				ResourceSource:  resource.Source,
+				ResourceType:    resource.Type,
 				StatusEventType: api.StatusUpdateEventType,
 			})
 			if sErr != nil {
@@ -286,38 +316,22 @@ func broadcastStatusEvent(ctx context.Context,
 
 	logger := klog.FromContext(ctx).WithValues("resourceID", resourceID, "instanceID", instanceID, "eventID", eventID)
 
-	var resource *api.Resource
-	// check if the status event is delete event
-	if statusEvent.StatusEventType == api.StatusDeleteEventType {
-		// build resource with resource id and delete status
-		resource = &api.Resource{
-			Meta: api.Meta{
-				ID: resourceID,
-			},
-			Source:  statusEvent.ResourceSource,
-			Type:    statusEvent.ResourceType,
-			Payload: statusEvent.Payload,
-			Status:  statusEvent.Status,
-		}
-	} else {
-		resource, sErr = resourceService.Get(ctx, resourceID)
-		if sErr != nil {
-			if sErr.Is404() {
-				logger.Info("skipping resource as it is not found")
-				return nil
-			}
-
-			return fmt.Errorf("failed to get resource %s: %s", resourceID, sErr.Error())
-		}
+	resource, err := statusEventResource(ctx, resourceService, statusEvent)
+	if err != nil {
+		return err
+	}
+	if resource == nil {
+		logger.Info("skipping resource as it is not found")
+		return nil
 	}
 
 	// broadcast the resource status to subscribers
 	logger.Info("Broadcast the resource status",
 		"source", resource.Source, "statusEventType", statusEvent.StatusEventType)
-	eventBroadcaster.Broadcast(resource)
+	eventBroadcaster.BroadcastStatusEvent(resource, statusEvent.Sequence)
 
 	// add the event instance record
-	_, err := eventInstanceDao.Create(ctx, &api.EventInstance{
+	_, err = eventInstanceDao.Create(ctx, &api.EventInstance{
 		EventID:    eventID,
 		InstanceID: instanceID,
 	})
@@ -347,3 +361,31 @@ func broadcastStatusEvent(ctx context.Context,
 
 	return err
 }
+
+// statusEventResource returns the resource whose status is broadcast for a status event, nil is returned if the
+// resource of a status update event is not found.
+func statusEventResource(ctx context.Context, resourceService services.ResourceService, statusEvent *api.StatusEvent) (*api.Resource, error) {
+	// check if the status event is delete event
+	if statusEvent.StatusEventType == api.StatusDeleteEventType {
+		// build resource with resource id and delete status
+		return &api.Resource{
+			Meta: api.Meta{
+				ID: statusEvent.ResourceID,
+			},
+			Source:  statusEvent.ResourceSource,
+			Type:    statusEvent.ResourceType,
+			Payload: statusEvent.Payload,
+			Status:  statusEvent.Status,
+		}, nil
+	}
+
+	resource, sErr := resourceService.Get(ctx, statusEvent.ResourceID)
+	if sErr != nil {
+		if sErr.Is404() {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get resource %s: %s", statusEvent.ResourceID, sErr.Error())
+	}
+	return resource, nil
+}
@@ -15,8 +15,9 @@ import (
 	"google.golang.org/grpc/keepalive"
 	kubeerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
-	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/server"
@@ -33,20 +34,26 @@ const source = "maestro"
 
 var _ EventServer = &GRPCBroker{}
 
+// GRPCBrokerService serves the resources of a resource type to the agents with the CloudEvents data type of
+// the resource type.
 type GRPCBrokerService struct {
+	resourceType       api.ResourceType
 	resourceService    services.ResourceService
 	statusEventService services.StatusEventService
 }
 
-func NewGRPCBrokerService(resourceService services.ResourceService,
+func NewGRPCBrokerService(resourceType api.ResourceType,
+	resourceService services.ResourceService,
 	statusEventService services.StatusEventService) *GRPCBrokerService {
 	return &GRPCBrokerService{
+		resourceType:       resourceType,
 		resourceService:    resourceService,
 		statusEventService: statusEventService,
 	}
 }
 
-// List the cloudEvent from the service
+// List the cloudEvent from the service, the resources are scoped to the sources of the tenant of ctx by the
+// resource service.
 func (s *GRPCBrokerService) List(ctx context.Context, listOpts types.ListOptions) ([]*ce.Event, error) {
 	resources, err := s.resourceService.List(ctx, listOpts)
 	if err != nil {
@@ -55,6 +62,9 @@ func (s *GRPCBrokerService) List(ctx context.Context, listOpts types.ListOptions
 
 	evts := []*ce.Event{}
 	for _, res := range resources {
+		if res.GetResourceType() != s.resourceType {
+			continue
+		}
 		evt, err := EncodeResourceSpec(res, types.ResyncResponseAction)
 		if err != nil {
 			return nil, kubeerrors.NewInternalError(err)
@@ -177,8 +187,15 @@ func NewGRPCBroker(ctx context.Context, eventBroadcaster *event.EventBroadcaster
 		HeartbeatCheckInterval: config.HeartbeatCheckInterval,
 	})
 	pbv1.RegisterCloudEventServiceServer(grpcServer, eventServer)
-	svc := NewGRPCBrokerService(resourceService, statusEventService)
-	eventServer.RegisterService(context.Background(), workpayload.ManifestBundleEventDataType, svc)
+	// serve the resources of each resource type with its data type
+	for _, resourceType := range api.ResourceTypes() {
+		codec, err := api.ResourceTypeCodecFor(resourceType)
+		if err != nil {
+			check(ctx, err, "Failed to register gRPC broker service")
+		}
+		svc := NewGRPCBrokerService(resourceType, resourceService, statusEventService)
+		eventServer.RegisterService(context.Background(), codec.EventDataType(), svc)
+	}
 
 	return &GRPCBroker{
 		instanceID:         env().Config.MessageBroker.ClientID,
@@ -213,6 +230,32 @@ func (bkr *GRPCBroker) Start(ctx context.Context) {
 	bkr.grpcServer.GracefulStop()
 }
 
+// ConnectedConsumers returns the consumers whose agents subscribe to the gRPC broker.
+func (s *GRPCBroker) ConnectedConsumers() []string {
+	return sets.List(s.eventServer.Subscribers())
+}
+
+// ResyncStatus resends the spec of the resources whose agents subscribe to the gRPC broker, so that an agent
+// that missed an update applies the current version and reports its status.
+func (s *GRPCBroker) ResyncStatus(ctx context.Context, resources api.ResourceList) error {
+	subscribers := s.eventServer.Subscribers()
+	errs := []error{}
+	for _, resource := range resources {
+		if !subscribers.Has(resource.ConsumerName) {
+			continue
+		}
+		evt, err := EncodeResourceSpec(resource, types.UpdateRequestAction)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := s.eventServer.HandleEvent(ctx, evt); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
 // OnCreate is called by the controller when a resource is created on the maestro server.
 func (s *GRPCBroker) OnCreate(ctx context.Context, resourceID string) error {
 	evt, err := s.Get(ctx, resourceID, types.CreateRequestAction)
@@ -312,10 +355,14 @@ func DecodeResourceStatus(evt *ce.Event) (*api.Resource, error) {
 	return codec.Decode(evt)
 }
 
-// EncodeResourceSpec translates a resource spec JSON map into a CloudEvent.
+// EncodeResourceSpec translates a resource spec JSON map into a CloudEvent with the data type of the resource type.
 func EncodeResourceSpec(resource *api.Resource, action types.EventAction) (*ce.Event, error) {
+	typeCodec, err := api.ResourceTypeCodecFor(resource.Type)
+	if err != nil {
+		return nil, err
+	}
 	eventType := types.CloudEventsType{
-		CloudEventsDataType: workpayload.ManifestBundleEventDataType,
+		CloudEventsDataType: typeCodec.EventDataType(),
 		SubResource:         types.SubResourceSpec,
 		Action:              action,
 	}
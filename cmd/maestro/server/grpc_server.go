@@ -4,9 +4,12 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
@@ -16,9 +19,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"k8s.io/klog/v2"
-	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
 	grpcprotocol "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protocol"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/payload"
@@ -26,9 +29,12 @@ import (
 	sdkgologging "open-cluster-management.io/sdk-go/pkg/logging"
 
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/client/cloudevents"
 	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 	"github.com/openshift-online/maestro/pkg/config"
+	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/event"
 	"github.com/openshift-online/maestro/pkg/services"
 )
@@ -39,6 +45,8 @@ type GRPCServer struct {
 	grpcServer             *grpc.Server
 	eventBroadcaster       *event.EventBroadcaster
 	resourceService        services.ResourceService
+	operationService       services.OperationService
+	statusEventService     services.StatusEventService
 	disableAuthorizer      bool
 	grpcAuthorizer         grpcauthorizer.GRPCAuthorizer
 	bindAddress            string
@@ -50,6 +58,8 @@ type GRPCServer struct {
 func NewGRPCServer(
 	ctx context.Context,
 	resourceService services.ResourceService,
+	operationService services.OperationService,
+	statusEventService services.StatusEventService,
 	eventBroadcaster *event.EventBroadcaster,
 	config config.GRPCServerConfig, grpcAuthorizer grpcauthorizer.GRPCAuthorizer) *GRPCServer {
 	logger := klog.FromContext(ctx)
@@ -96,8 +106,8 @@ func NewGRPCServer(
 
 		// add metrics and auth interceptors
 		grpcServerOptions = append(grpcServerOptions,
-			grpc.ChainUnaryInterceptor(newMetricsUnaryInterceptor(), newAuthUnaryInterceptor(config.GRPCAuthNType, grpcAuthorizer)),
-			grpc.ChainStreamInterceptor(newMetricsStreamInterceptor(), newAuthStreamInterceptor(config.GRPCAuthNType, grpcAuthorizer)))
+			grpc.ChainUnaryInterceptor(newMetricsUnaryInterceptor(), newAuthUnaryInterceptor(config.GRPCAuthNType, grpcAuthorizer, env().Config.Tenancy.Tenancy)),
+			grpc.ChainStreamInterceptor(newMetricsStreamInterceptor(), newAuthStreamInterceptor(config.GRPCAuthNType, grpcAuthorizer, env().Config.Tenancy.Tenancy)))
 
 		if config.GRPCAuthNType == "mtls" {
 			if len(config.ClientCAFile) == 0 {
@@ -140,6 +150,8 @@ func NewGRPCServer(
 		grpcServer:             grpc.NewServer(grpcServerOptions...),
 		eventBroadcaster:       eventBroadcaster,
 		resourceService:        resourceService,
+		operationService:       operationService,
+		statusEventService:     statusEventService,
 		disableAuthorizer:      disableTLS,
 		grpcAuthorizer:         grpcAuthorizer,
 		bindAddress:            env().Config.HTTPServer.Hostname + ":" + config.ServerBindPort,
@@ -180,9 +192,8 @@ func (svr *GRPCServer) Publish(ctx context.Context, pubReq *pbv1.PublishRequest)
 
 	if !svr.disableAuthorizer {
 		// check if the event is from the authorized source
-		user := ctx.Value(contextUserKey).(string)
-		groups := ctx.Value(contextGroupsKey).([]string)
-		allowed, err := svr.grpcAuthorizer.AccessReview(ctx, "pub", "source", evt.Source(), user, groups)
+		user, groups := auth.IdentityFromContext(ctx)
+		allowed, err := svr.grpcAuthorizer.AccessReview(ctx, grpcauthorizer.PubAction, grpcauthorizer.SourceResourceType, evt.Source(), user, groups)
 		if err != nil {
 			return nil, fmt.Errorf("failed to authorize the request: %v", err)
 		}
@@ -191,6 +202,11 @@ func (svr *GRPCServer) Publish(ctx context.Context, pubReq *pbv1.PublishRequest)
 		}
 	}
 
+	// check if the source belongs to the tenant of the client
+	if !auth.SourceAllowed(ctx, evt.Source()) {
+		return nil, fmt.Errorf("the source %s is not allowed for the tenant", evt.Source())
+	}
+
 	eventType, err := types.ParseCloudEventsType(evt.Type())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse cloud event type %s, %v", evt.Type(), err)
@@ -216,51 +232,205 @@ func (svr *GRPCServer) Publish(ctx context.Context, pubReq *pbv1.PublishRequest)
 		return &emptypb.Empty{}, nil
 	}
 
+	applyOptions, err := applyOptionsFromEvent(evt)
+	if err != nil {
+		return nil, err
+	}
+	// the apply options are the options of the request, they are not a part of the resource payload
+	evt.SetExtension(api.ExtensionFieldManager, nil)
+	evt.SetExtension(api.ExtensionForceApply, nil)
+
 	res, err := decodeResourceSpec(evt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode cloudevent: %v", err)
 	}
 
+	if applyOptions.FieldManager == "" && res.GetResourceType() == api.ManifestBundleResourceType {
+		// the clients that cannot set the extensions, e.g. the ManifestWork clients, set the apply options by
+		// the annotations of the manifest bundle
+		if applyOptions, err = api.ApplyOptionsFromManifestBundle(res.Payload); err != nil {
+			return nil, fmt.Errorf("failed to get apply options: %v", err)
+		}
+	}
+
+	dryRun, err := dryRunFromEvent(evt)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		if err := svr.dryRun(ctx, eventType.Action, res, applyOptions); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	var specEventType api.EventType
 	switch eventType.Action {
 	case types.CreateRequestAction:
-		_, err := svr.resourceService.Create(ctx, res)
+		if res.ManifestManagers, err = api.ManifestManagersOf(res.Payload, applyOptions.FieldManager); err != nil {
+			return nil, fmt.Errorf("failed to create resource: %v", err)
+		}
+		created, err := svr.resourceService.Create(ctx, res)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create resource: %v", err)
 		}
+		res, specEventType = created, api.CreateEventType
 	case types.UpdateRequestAction:
-		found, err := svr.resourceService.Get(ctx, res.ID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get resource: %v", err)
+		if applyOptions.FieldManager != "" {
+			applied, err := svr.applyResource(ctx, res, applyOptions)
+			if err != nil {
+				return nil, err
+			}
+			res, specEventType = applied, api.UpdateEventType
+			break
 		}
-
-		if res.Version == 0 {
-			// the resource version is not guaranteed to be increased by source client,
-			// using the latest resource version.
-			res.Version = found.Version
+		if err := svr.useLatestVersion(ctx, res); err != nil {
+			return nil, err
 		}
-		if _, err = svr.resourceService.Update(ctx, res); err != nil {
+		updated, err := svr.resourceService.Update(ctx, res)
+		if err != nil {
 			return nil, fmt.Errorf("failed to update resource: %v", err)
 		}
+		res, specEventType = updated, api.UpdateEventType
 	case types.DeleteRequestAction:
 		err := svr.resourceService.MarkAsDeleting(ctx, res.ID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to delete resource: %v", err)
 		}
+		specEventType = api.DeleteEventType
 	default:
 		return nil, fmt.Errorf("unsupported action %s", eventType.Action)
 	}
 
+	if err := svr.trackResource(ctx, specEventType, res); err != nil {
+		return nil, err
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
+// trackResource starts an operation that tracks the event of the resource until the agent applies or
+// removes the resource, the id of the operation is sent back to the source client in the response header.
+func (svr *GRPCServer) trackResource(ctx context.Context, eventType api.EventType, res *api.Resource) error {
+	operation, serviceErr := svr.operationService.TrackResource(ctx, eventType, res)
+	if serviceErr != nil {
+		return fmt.Errorf("failed to track resource: %v", serviceErr)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(api.OperationIDHeader, operation.ID)); err != nil {
+		return fmt.Errorf("failed to send operation id: %v", err)
+	}
+	return nil
+}
+
+// applyResource applies the manifests of the resource bundle on behalf of the field manager of the options, the
+// version is not checked if it is not specified by the source client.
+func (svr *GRPCServer) applyResource(ctx context.Context, res *api.Resource, options api.ApplyOptions) (*api.Resource, error) {
+	applied, err := api.DecodeManifestBundle(res.Payload)
+	if err != nil || applied == nil {
+		return nil, fmt.Errorf("failed to decode manifest bundle: %v", err)
+	}
+	updated, serviceErr := svr.resourceService.Apply(ctx, res.ID, res.Version, applied, options)
+	if serviceErr != nil {
+		return nil, fmt.Errorf("failed to apply resource: %v", serviceErr)
+	}
+	return updated, nil
+}
+
+// useLatestVersion sets the resource version to the latest version of the resource if it is not
+// specified by the source client.
+func (svr *GRPCServer) useLatestVersion(ctx context.Context, res *api.Resource) error {
+	found, err := svr.resourceService.Get(ctx, res.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %v", err)
+	}
+
+	if res.Version == 0 {
+		// the resource version is not guaranteed to be increased by source client,
+		// using the latest resource version.
+		res.Version = found.Version
+	}
+	return nil
+}
+
+// dryRun validates the create or update request of the resource without applying it, the resulting
+// resource bundle diff is sent back to the source client in the response header.
+func (svr *GRPCServer) dryRun(ctx context.Context, action types.EventAction, res *api.Resource, options api.ApplyOptions) error {
+	var diff *api.ResourceBundleDiff
+	var serviceErr *errors.ServiceError
+	switch action {
+	case types.CreateRequestAction:
+		diff, serviceErr = svr.resourceService.DryRunCreate(ctx, res)
+	case types.UpdateRequestAction:
+		if options.FieldManager != "" {
+			applied, err := api.DecodeManifestBundle(res.Payload)
+			if err != nil || applied == nil {
+				return fmt.Errorf("failed to decode manifest bundle: %v", err)
+			}
+			diff, serviceErr = svr.resourceService.DryRunApply(ctx, res.ID, res.Version, applied, options)
+			break
+		}
+		if err := svr.useLatestVersion(ctx, res); err != nil {
+			return err
+		}
+		diff, serviceErr = svr.resourceService.DryRunUpdate(ctx, res)
+	default:
+		return fmt.Errorf("unsupported dry-run action %s", action)
+	}
+	if serviceErr != nil {
+		return fmt.Errorf("failed to dry-run %s: %v", action, serviceErr)
+	}
+
+	diffJSON, err := json.Marshal(presenters.PresentResourceBundleDiff(diff))
+	if err != nil {
+		return fmt.Errorf("failed to marshal resource bundle diff: %v", err)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(api.DryRunDiffHeader, string(diffJSON))); err != nil {
+		return fmt.Errorf("failed to send resource bundle diff: %v", err)
+	}
+	return nil
+}
+
+// dryRunFromEvent returns the value of the dry-run extension of the CloudEvent, false if it is not set.
+func dryRunFromEvent(evt *ce.Event) (bool, error) {
+	value, ok := evt.Extensions()[api.ExtensionDryRun]
+	if !ok {
+		return false, nil
+	}
+	dryRun, err := cetypes.ToBool(value)
+	if err != nil {
+		return false, fmt.Errorf("failed to get %s extension: %v", api.ExtensionDryRun, err)
+	}
+	return dryRun, nil
+}
+
+// applyOptionsFromEvent returns the apply options in the field manager and force apply extensions of the
+// CloudEvent, the field manager is empty if it is not set.
+func applyOptionsFromEvent(evt *ce.Event) (api.ApplyOptions, error) {
+	options := api.ApplyOptions{}
+	if value, ok := evt.Extensions()[api.ExtensionFieldManager]; ok {
+		fieldManager, err := cetypes.ToString(value)
+		if err != nil {
+			return api.ApplyOptions{}, fmt.Errorf("failed to get %s extension: %v", api.ExtensionFieldManager, err)
+		}
+		options.FieldManager = fieldManager
+	}
+	if value, ok := evt.Extensions()[api.ExtensionForceApply]; ok {
+		force, err := cetypes.ToBool(value)
+		if err != nil {
+			return api.ApplyOptions{}, fmt.Errorf("failed to get %s extension: %v", api.ExtensionForceApply, err)
+		}
+		options.Force = force
+	}
+	return options, nil
+}
+
 // Subscribe implements the Subscribe method of the CloudEventServiceServer interface
 func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv1.CloudEventService_SubscribeServer) error {
 	if !svr.disableAuthorizer {
 		// check if the client is authorized to subscribe the event from the source
 		ctx := subServer.Context()
-		user := ctx.Value(contextUserKey).(string)
-		groups := ctx.Value(contextGroupsKey).([]string)
-		allowed, err := svr.grpcAuthorizer.AccessReview(ctx, "sub", "source", subReq.Source, user, groups)
+		user, groups := auth.IdentityFromContext(ctx)
+		allowed, err := svr.grpcAuthorizer.AccessReview(ctx, grpcauthorizer.SubAction, grpcauthorizer.SourceResourceType, subReq.Source, user, groups)
 		if err != nil {
 			return fmt.Errorf("failed to authorize the request: %v", err)
 		}
@@ -269,6 +439,17 @@ func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv
 		}
 	}
 
+	// check if the source belongs to the tenant of the client
+	if !auth.SourceAllowed(subServer.Context(), subReq.Source) {
+		return fmt.Errorf("the source %s is not allowed for the tenant", subReq.Source)
+	}
+
+	// the sequence of the last status event the subscriber received, the status events after it are replayed
+	lastSequence, resume, err := statusSequenceFromContext(subServer.Context())
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(subServer.Context())
 	defer cancel()
 
@@ -315,11 +496,14 @@ func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv
 		}
 	}()
 
-	clientID := svr.eventBroadcaster.Register(ctx, subReq.Source, func(res *api.Resource) error {
+	sendStatus := func(res *api.Resource, sequence int64) error {
 		evt, err := encodeResourceStatus(res)
 		if err != nil {
 			return fmt.Errorf("failed to encode cloudevent: %v", err)
 		}
+		if sequence > 0 {
+			evt.SetExtension(api.ExtensionStatusSequence, strconv.FormatInt(sequence, 10))
+		}
 
 		broadcastLogger := sdkgologging.SetLogTracingByCloudEvent(logger, evt)
 		if broadcastLogger.V(4).Enabled() {
@@ -346,8 +530,26 @@ func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv
 		}
 
 		return nil
+	}
+
+	// register before the replay, so that no status event is missed in between, the status events that are
+	// already replayed are skipped
+	var replayedSequence atomic.Int64
+	clientID := svr.eventBroadcaster.Register(ctx, subReq.Source, func(res *api.Resource, sequence int64) error {
+		if sequence > 0 && sequence <= replayedSequence.Load() {
+			return nil
+		}
+		return sendStatus(res, sequence)
 	})
 
+	if resume {
+		if err := svr.replayStatusEvents(ctx, subReq.Source, lastSequence, &replayedSequence, sendStatus); err != nil {
+			logger.Error(err, "failed to replay status events, unregister subscriber", "subscriber", clientID)
+			svr.eventBroadcaster.Unregister(ctx, clientID)
+			return err
+		}
+	}
+
 	if !svr.heartbeatDisable {
 		go func() {
 			ticker := time.NewTicker(svr.heartbeatCheckInterval)
@@ -385,8 +587,83 @@ func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv
 	}
 }
 
-// decodeResourceSpec translates a CloudEvent into a resource containing the spec JSON map.
+// replayStatusEvents sends the resource status of the status events from the source that are after the given
+// sequence. The status events of a resource are replayed once with its current status, and the replayed sequence
+// is set to the sequence of the last status event.
+func (svr *GRPCServer) replayStatusEvents(ctx context.Context, source string, sequence int64,
+	replayedSequence *atomic.Int64, sendStatus func(res *api.Resource, sequence int64) error) error {
+	statusEvents, sErr := svr.statusEventService.FindBySourceAfterSequence(ctx, source, sequence)
+	if sErr != nil {
+		return fmt.Errorf("failed to find status events: %s", sErr)
+	}
+	if len(statusEvents) == 0 {
+		return nil
+	}
+	replayedSequence.Store(statusEvents[len(statusEvents)-1].Sequence)
+
+	// only replay the last status event of each resource
+	latest := map[string]int64{}
+	for _, statusEvent := range statusEvents {
+		latest[statusEvent.ResourceID] = statusEvent.Sequence
+	}
+
+	klog.FromContext(ctx).Info("replay status events to the subscriber",
+		"source", source, "sequence", sequence, "statusEvents", len(statusEvents), "resources", len(latest))
+	for _, statusEvent := range statusEvents {
+		if latest[statusEvent.ResourceID] != statusEvent.Sequence {
+			continue
+		}
+
+		res, err := statusEventResource(ctx, svr.resourceService, statusEvent)
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			// the resource is deleted after the status update, it is skipped
+			continue
+		}
+
+		if err := sendStatus(res, statusEvent.Sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statusSequenceFromContext returns the sequence of the last status event a subscriber received from the
+// metadata of the subscription, false is returned if it is not set and the subscriber doesn't resume. A
+// subscriber resumes from 0 to replay all the retained status events of its source.
+func statusSequenceFromContext(ctx context.Context) (int64, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+
+	values := md.Get(api.StatusSequenceHeader)
+	if len(values) == 0 || values[0] == "" {
+		return 0, false, nil
+	}
+
+	sequence, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || sequence < 0 {
+		return 0, false, fmt.Errorf("invalid %s metadata %q", api.StatusSequenceHeader, values[0])
+	}
+	return sequence, true, nil
+}
+
+// decodeResourceSpec translates a CloudEvent into a resource containing the spec JSON map, the resource type is
+// decided by the data type of the CloudEvent.
 func decodeResourceSpec(evt *ce.Event) (*api.Resource, error) {
+	eventType, err := types.ParseCloudEventsType(evt.Type())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cloud event type %s, %v", evt.Type(), err)
+	}
+	resourceType, err := api.ResourceTypeOf(eventType.CloudEventsDataType)
+	if err != nil {
+		return nil, err
+	}
+
 	evtExtensions := evt.Context.GetExtensions()
 
 	clusterName, err := cetypes.ToString(evtExtensions[types.ExtensionClusterName])
@@ -407,6 +684,7 @@ func decodeResourceSpec(evt *ce.Event) (*api.Resource, error) {
 	resource := &api.Resource{
 		Source:       evt.Source(),
 		ConsumerName: clusterName,
+		Type:         resourceType,
 		Version:      resourceVersion,
 		Meta: api.Meta{
 			ID: resourceID,
@@ -430,39 +708,13 @@ func decodeResourceSpec(evt *ce.Event) (*api.Resource, error) {
 	return resource, nil
 }
 
-// encodeResourceStatus translates a resource status JSON map into a CloudEvent.
+// encodeResourceStatus translates a resource status JSON map into a CloudEvent with the codec of the resource type.
 func encodeResourceStatus(resource *api.Resource) (*ce.Event, error) {
-	statusEvt, err := api.JSONMAPToCloudEvent(resource.Status)
+	codec, err := api.ResourceTypeCodecFor(resource.Type)
 	if err != nil {
 		return nil, err
 	}
-
-	// manifest bundle status from the resource status
-	manifestBundleStatus := &workpayload.ManifestBundleStatus{}
-	if err := statusEvt.DataAs(manifestBundleStatus); err != nil {
-		return nil, err
-	}
-
-	// fill the resource status with resource payload
-	if len(resource.Payload) > 0 {
-		specEvt, err := api.JSONMAPToCloudEvent(resource.Payload)
-		if err != nil {
-			return nil, err
-		}
-
-		// set work spec back from spec event
-		manifestBundle := &workpayload.ManifestBundle{}
-		if err := specEvt.DataAs(manifestBundle); err != nil {
-			return nil, err
-		}
-		manifestBundleStatus.ManifestBundle = manifestBundle
-	}
-
-	if err := statusEvt.SetData(ce.ApplicationJSON, manifestBundleStatus); err != nil {
-		return nil, err
-	}
-
-	return statusEvt, nil
+	return codec.EncodeStatus(resource)
 }
 
 // respondResyncStatusRequest responds to the status resync request by comparing the status hash of the resources
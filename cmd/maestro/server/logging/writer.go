@@ -29,6 +29,13 @@ func (writer *loggingWriter) WriteHeader(status int) {
 	writer.ResponseWriter.WriteHeader(status)
 }
 
+// Flush flushes the wrapped response writer, it is used to stream the watch events.
+func (writer *loggingWriter) Flush() {
+	if flusher, ok := writer.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
 func (writer *loggingWriter) log(logLevel int, logMsg string, err error) {
 	switch err {
 	case nil:
@@ -189,3 +189,10 @@ func (w *metricsResponseWrapper) WriteHeader(code int) {
 	w.code = code
 	w.wrapped.WriteHeader(code)
 }
+
+// Flush flushes the wrapped response writer, it is used to stream the watch events.
+func (w *metricsResponseWrapper) Flush() {
+	if flusher, ok := w.wrapped.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
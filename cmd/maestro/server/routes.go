@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	gorillahandlers "github.com/gorilla/handlers"
@@ -9,12 +10,15 @@ import (
 
 	"github.com/openshift-online/maestro/cmd/maestro/server/logging"
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/event"
 	"github.com/openshift-online/maestro/pkg/handlers"
 	"github.com/openshift-online/maestro/pkg/logger"
 )
 
-func (s *apiServer) routes(ctx context.Context) *mux.Router {
+func (s *apiServer) routes(ctx context.Context, eventBroadcaster *event.EventBroadcaster, watchDone <-chan struct{}) *mux.Router {
 	services := &env().Services
 
 	openAPIDefinitions, err := s.loadOpenAPISpec("openapi.yaml")
@@ -22,10 +26,17 @@ func (s *apiServer) routes(ctx context.Context) *mux.Router {
 		check(ctx, err, "Can't load OpenAPI specification")
 	}
 
-	resourceBundleHandler := handlers.NewResourceBundleHandler(services.Resources(), services.Generic())
-	consumerHandler := handlers.NewConsumerHandler(services.Consumers(), services.Resources(), services.Generic())
+	resourceBundleHandler := handlers.NewResourceBundleHandler(services.Resources(), services.Operations(), services.Generic(),
+		eventBroadcaster, watchDone, env().Config.Operation.MaxResources)
+	consumerHandler := handlers.NewConsumerHandler(services.Consumers(), services.Resources(), services.Operations(), services.Generic())
+	consumerSetHandler := handlers.NewConsumerSetHandler(services.ConsumerSets(), services.Generic())
+	placementHandler := handlers.NewPlacementHandler(services.Placements(), services.Generic())
+	operationHandler := handlers.NewOperationHandler(services.Operations(), services.Generic())
+	quotaHandler := handlers.NewQuotaHandler(services.Quotas())
 	errorsHandler := handlers.NewErrorsHandler()
 
+	authnMiddleware, authzMiddleware := s.authMiddlewares(ctx)
+
 	// mainRouter is top level "/"
 	mainRouter := mux.NewRouter()
 	mainRouter.NotFoundHandler = http.HandlerFunc(api.SendNotFound)
@@ -56,18 +67,59 @@ func (s *apiServer) routes(ctx context.Context) *mux.Router {
 
 	// /api/maestro/v1/resource-bundles
 	apiV1ResourceBundleRouter := apiV1Router.PathPrefix("/resource-bundles").Subrouter()
+	apiV1ResourceBundleRouter.Use(authnMiddleware, authzMiddleware(grpcauthorizer.ResourceBundleResourceType))
 	apiV1ResourceBundleRouter.HandleFunc("", resourceBundleHandler.List).Methods(http.MethodGet)
+	apiV1ResourceBundleRouter.HandleFunc("/summary", resourceBundleHandler.Summary).Methods(http.MethodGet)
 	apiV1ResourceBundleRouter.HandleFunc("/{id}", resourceBundleHandler.Get).Methods(http.MethodGet)
+	apiV1ResourceBundleRouter.HandleFunc("", resourceBundleHandler.Create).Methods(http.MethodPost)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}", resourceBundleHandler.Patch).Methods(http.MethodPatch)
+	apiV1ResourceBundleRouter.HandleFunc("", resourceBundleHandler.DeleteCollection).Methods(http.MethodDelete)
 	apiV1ResourceBundleRouter.HandleFunc("/{id}", resourceBundleHandler.Delete).Methods(http.MethodDelete)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}/revisions", resourceBundleHandler.ListRevisions).Methods(http.MethodGet)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}/revisions/{version}", resourceBundleHandler.GetRevision).Methods(http.MethodGet)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}/rollback", resourceBundleHandler.Rollback).Methods(http.MethodPost)
 
 	//  /api/maestro/v1/consumers
 	apiV1ConsumersRouter := apiV1Router.PathPrefix("/consumers").Subrouter()
+	apiV1ConsumersRouter.Use(authnMiddleware, authzMiddleware(grpcauthorizer.ConsumerResourceType))
 	apiV1ConsumersRouter.HandleFunc("", consumerHandler.List).Methods(http.MethodGet)
 	apiV1ConsumersRouter.HandleFunc("/{id}", consumerHandler.Get).Methods(http.MethodGet)
 	apiV1ConsumersRouter.HandleFunc("", consumerHandler.Create).Methods(http.MethodPost)
 	apiV1ConsumersRouter.HandleFunc("/{id}", consumerHandler.Patch).Methods(http.MethodPatch)
 	apiV1ConsumersRouter.HandleFunc("/{id}", consumerHandler.Delete).Methods(http.MethodDelete)
 
+	//  /api/maestro/v1/consumer-sets
+	apiV1ConsumerSetsRouter := apiV1Router.PathPrefix("/consumer-sets").Subrouter()
+	apiV1ConsumerSetsRouter.Use(authnMiddleware, authzMiddleware(grpcauthorizer.ConsumerSetResourceType))
+	apiV1ConsumerSetsRouter.HandleFunc("", consumerSetHandler.List).Methods(http.MethodGet)
+	apiV1ConsumerSetsRouter.HandleFunc("/{id}", consumerSetHandler.Get).Methods(http.MethodGet)
+	apiV1ConsumerSetsRouter.HandleFunc("", consumerSetHandler.Create).Methods(http.MethodPost)
+	apiV1ConsumerSetsRouter.HandleFunc("/{id}", consumerSetHandler.Patch).Methods(http.MethodPatch)
+	apiV1ConsumerSetsRouter.HandleFunc("/{id}", consumerSetHandler.Delete).Methods(http.MethodDelete)
+
+	//  /api/maestro/v1/placements
+	apiV1PlacementsRouter := apiV1Router.PathPrefix("/placements").Subrouter()
+	apiV1PlacementsRouter.Use(authnMiddleware, authzMiddleware(grpcauthorizer.PlacementResourceType))
+	apiV1PlacementsRouter.HandleFunc("", placementHandler.List).Methods(http.MethodGet)
+	apiV1PlacementsRouter.HandleFunc("/{id}", placementHandler.Get).Methods(http.MethodGet)
+	apiV1PlacementsRouter.HandleFunc("", placementHandler.Create).Methods(http.MethodPost)
+	apiV1PlacementsRouter.HandleFunc("/{id}", placementHandler.Patch).Methods(http.MethodPatch)
+	apiV1PlacementsRouter.HandleFunc("/{id}", placementHandler.Delete).Methods(http.MethodDelete)
+	apiV1PlacementsRouter.HandleFunc("/{id}/pause", placementHandler.Pause).Methods(http.MethodPost)
+	apiV1PlacementsRouter.HandleFunc("/{id}/resume", placementHandler.Resume).Methods(http.MethodPost)
+	apiV1PlacementsRouter.HandleFunc("/{id}/abort", placementHandler.Abort).Methods(http.MethodPost)
+
+	//  /api/maestro/v1/operations
+	apiV1OperationsRouter := apiV1Router.PathPrefix("/operations").Subrouter()
+	apiV1OperationsRouter.Use(authnMiddleware, authzMiddleware(grpcauthorizer.OperationResourceType))
+	apiV1OperationsRouter.HandleFunc("", operationHandler.List).Methods(http.MethodGet)
+	apiV1OperationsRouter.HandleFunc("/{id}", operationHandler.Get).Methods(http.MethodGet)
+
+	//  /api/maestro/v1/quotas
+	apiV1QuotasRouter := apiV1Router.PathPrefix("/quotas").Subrouter()
+	apiV1QuotasRouter.Use(authnMiddleware, authzMiddleware(grpcauthorizer.QuotaResourceType))
+	apiV1QuotasRouter.HandleFunc("", quotaHandler.Get).Methods(http.MethodGet)
+
 	return mainRouter
 }
 
@@ -76,9 +128,40 @@ func registerApiMiddleware(router *mux.Router) {
 
 	router.Use(
 		func(next http.Handler) http.Handler {
-			return db.TransactionMiddleware(next, env().Database.SessionFactory)
+			withTransaction := db.TransactionMiddleware(next, env().Database.SessionFactory)
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// a watch streams until the client disconnects, it must not hold a transaction open
+				if handlers.IsWatchRequest(r) {
+					next.ServeHTTP(w, r)
+					return
+				}
+				withTransaction.ServeHTTP(w, r)
+			})
 		},
 	)
 
 	router.Use(gorillahandlers.CompressHandler)
 }
+
+// authMiddlewares returns the authentication middleware and the per resource type authorization
+// middleware of the REST API based on the configured authentication type.
+func (s *apiServer) authMiddlewares(ctx context.Context) (mux.MiddlewareFunc, func(resourceType string) mux.MiddlewareFunc) {
+	config := env().Config.HTTPServer
+	authorizer := env().Clients.GRPCAuthorizer
+
+	var jwtAuthenticator *auth.JWTAuthenticator
+	switch config.HTTPAuthNType {
+	case "jwt":
+		var err error
+		jwtAuthenticator, err = auth.NewJWTAuthenticator(ctx, config.JWKCertURL, config.JWKCertFile, config.JWTIssuer, config.JWTAudiences)
+		check(ctx, err, "Can't create JWT authenticator")
+	case "token", "mtls", "mock":
+	default:
+		check(ctx, fmt.Errorf("unsupported authentication type %s", config.HTTPAuthNType), "Can't create REST API authentication")
+	}
+
+	authzMiddleware := func(resourceType string) mux.MiddlewareFunc {
+		return newAuthzMiddleware(resourceType, authorizer)
+	}
+	return newAuthnMiddleware(config.HTTPAuthNType, jwtAuthenticator, authorizer, env().Config.Tenancy.Tenancy), authzMiddleware
+}
@@ -77,7 +77,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _openapiYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x6d\x8f\xdb\xb8\x11\xfe\xbe\xbf\x62\x80\xb6\xf0\xe5\xe0\xb7\xf4\xae\x40\x2b\x24\x07\x24\x77\x97\x22\x87\x5c\x92\xee\x26\x6d\x81\xa2\xf0\xd2\xe4\xc8\xe2\xad\x44\x2a\x24\xb5\xbb\x4e\xdb\xff\x5e\x90\xd4\xbb\x65\xad\xec\xdb\x8d\xdd\xad\xf7\x4b\x2c\x6a\x38\x9c\x21\x9f\x79\x34\x43\x32\x32\x45\x41\x52\x1e\xc0\x37\xd3\xf9\x74\x7e\xc6\x45\x28\x83\x33\x00\xc3\x4d\x8c\x01\x24\x04\xb5\x51\x12\x2e\x50\x5d\x73\x8a\xf0\xe2\xfd\xeb\x33\x00\x86\x9a\x2a\x9e\x1a\x2e\xc5\x36\x91\x6b\x54\xda\xbd\x9e\x4f\xe7\xd3\xa7\x67\x1a\x95\x6d\xb1\x9a\x27\x90\xa9\x38\x80\xc8\x98\x34\x98\xcd\x62\x49\x49\x1c\x49\x6d\x82\x3f\xce\xe7\xf3\x33\x80\x96\x76\x9a\x29\x85\xc2\x00\x93\x09\xe1\xa2\xd9\x5d\x07\xb3\x19\x49\xf9\xd4\xba\xa0\x23\x1e\x9a\x29\x95\xc9\xa6\x8a\x9f\x09\x17\xf0\x55\xaa\x24\xcb\xa8\x6d\x79\x02\xde\x9a\x6e\x65\xda\x90\x15\xde\xa5\xf2\xc2\x90\x15\x17\xab\x42\x51\x4a\x4c\xe4\x7c\xb3\x1a\x66\xf9\x84\xcc\xae\x9f\xce\x14\x6a\x99\x29\x8a\x93\x65\x26\x58\x8c\x4e\x06\x60\x85\xc6\xff\x00\xd0\x59\x92\x10\xb5\x0e\xe0\x1c\x4d\xa6\x84\x06\x02\x31\xd7\x06\x64\x08\x45\x5f\xc8\xfb\x16\x3d\x90\x66\x8a\x9b\x75\xa1\xc1\x3a\xf1\x12\x89\x42\x15\xc0\x3f\xfe\x99\x37\x2a\xd4\xa9\x14\xba\x18\xd0\xfe\x8d\x7e\x3f\x9f\x8f\xaa\xc7\x96\x43\x2f\xe0\xa7\x8b\x77\x6f\x81\x28\x45\xd6\x1d\x83\x83\x5c\xfe\x82\xd4\xe8\x5a\x77\x2a\x85\x41\x61\xea\x1a\x01\x48\x9a\xc6\x9c\x12\xab\x73\xf6\x8b\x96\xa2\xf9\x16\x40\xd3\x08\x13\xd2\x6e\x05\xf8\xad\xc2\x30\x80\xd1\x6f\x66\x54\x26\xa9\x14\x28\x8c\x9e\x79\x59\x3d\x3b\xcf\x4d\x79\xe9\x2c\x79\xc3\xb5\x19\x55\x4e\x7d\x3b\x7f\xda\xe3\x54\x66\x22\x30\xf2\x0a\x05\x70\x0d\x5c\x5c\x93\x98\xb3\x43\xb8\xf0\xa3\x52\x52\x35\xac\xfe\x66\xbb\xd5\x1f\x05\xc9\x4c\x24\x15\xff\x8c\x0c\x8c\x84\x14\x55\x28\x55\x02\x32\x45\xe5\xcc\x3a\x06\x0f\xfe\xd0\x07\xa6\x8f\x02\x6f\x53\xa4\x06\x19\xa0\xed\x07\x92\xba\x30\x3e\xfc\xdc\xa7\x44\x91\x04\x4d\xce\x44\xe0\x82\xa7\xab\x73\x25\x37\x4b\xc9\x0a\x47\x43\x85\x35\xff\xbc\x83\x30\x12\x45\xa3\xc1\xe2\x52\x31\x54\x2f\xd7\x83\xe5\x43\x8e\x31\xd3\x95\x38\x17\x01\x44\x48\x98\x23\x3e\xff\x27\x48\x82\x01\xfc\x7d\xf2\xae\x80\xd6\xe4\xf5\x0f\x67\xdb\x27\xdb\xac\x53\x0c\x40\x1b\xc5\xc5\x6a\x00\xd9\xcd\xfe\xc5\xd9\x7f\xb6\x33\xde\x9f\xd1\x00\xd9\x20\x9a\xe5\x1a\xca\x18\x7d\x18\xaa\x3b\x6f\x8d\x18\xca\x4c\xb0\xc6\xb8\x5f\x14\x9f\x4d\x7a\x3b\x51\xdb\x11\x78\xf0\xed\x76\x0f\xde\xca\x0d\xc4\xde\x70\x13\x81\x4e\x91\xf2\x90\x23\x03\xce\x00\x6f\xb9\x3e\xcc\xb7\xf2\xff\x97\xa6\x39\x7b\x40\xa6\xb3\x13\x17\xa3\xc1\x0d\x0e\xfb\xc1\x35\x6f\xd2\xd8\xaf\x27\xb0\x1e\x0c\xb6\x09\xcc\xdb\xc6\x40\x67\x94\xa2\xd6\x61\x16\xc7\xeb\x3a\x9c\x7b\x20\xf0\x57\x4b\x1b\x6e\x3e\x3c\x04\xf4\xf1\x60\xe0\xc4\x80\x27\x06\xfc\xe2\x0c\xe8\x42\xc9\x16\x76\xdd\xf1\x7c\x38\x4f\xf6\x66\xc4\x76\x92\x46\xa5\xd0\x59\x52\xea\x19\x56\x8a\x96\x9d\xbe\x68\x0d\x5a\x8c\x7a\xc8\xe2\xf3\xfb\xdc\x86\x53\xd9\x79\x14\xcc\xf4\x68\xf2\x99\x1d\x0b\xcf\x1d\x4b\xcf\x9d\x8b\xcf\xdd\xcb\xcf\x1d\x0b\xd0\x54\xea\x4d\xa2\xf9\x5e\x21\x71\xd9\x93\xc0\x9b\x32\xda\x77\xa3\x98\x4f\x19\x6a\xf3\x52\xb2\x9a\x5c\x03\x13\x45\xfc\x02\x23\x86\x94\x22\xb6\x1f\x57\xc8\x02\x30\x2a\xab\xc8\xbd\x03\x1c\xfd\xd0\xe8\x06\xc6\x10\x3e\x19\xf5\x92\x64\x0f\xb9\xf8\x39\x3b\x08\xa4\xdb\xb6\x9f\x92\xcb\x13\x85\xef\xe3\xc1\x9f\x7a\xd0\x5d\x84\x2b\x89\x15\x12\xb6\xfe\x5f\x49\x24\x5f\x08\xc8\xb6\x7d\x7d\x80\xda\x90\xb5\x49\xa5\x89\xb0\x4d\x73\x87\x71\x69\x6b\x52\x38\x68\xcb\xae\xcc\xcb\x1e\x7e\xaf\xae\xc4\xc3\x81\x37\xe9\x3a\xa9\xef\xc4\x1f\xc7\x58\x9c\x96\xe8\x7c\x3c\x55\xe9\x91\x7c\x36\x53\x62\x68\xb4\xc1\x09\x1f\x53\xe6\x92\x38\xf1\x40\x19\x9c\xd7\xcf\xaa\x75\x3d\xb2\x4c\xee\xbd\x9d\x95\x73\xef\x46\x7f\x56\x37\x84\xe7\xb2\xdc\xdb\xce\xbd\xbc\x43\x13\xde\x29\xd7\xfb\xc2\x56\x9f\xb8\xfa\x28\x9c\x78\x84\x09\xeb\xc6\x37\xc6\x11\x8f\x4d\x52\x8f\x22\x41\xbd\xfb\xc0\x65\xbf\x8f\xcd\x8e\x27\x2d\xd5\xee\xc1\xe9\x88\xe5\xc4\x8c\xf7\xeb\xc1\x23\x60\xc6\x3d\xcf\x56\x8e\x84\x61\xf6\x3c\x52\xa9\xde\xd8\x6e\x05\xef\x5c\x58\xfd\x05\xb1\xe4\xc4\x93\x6b\xf5\x67\xc9\x91\x31\xe9\x59\xcd\x6e\x0c\x60\xe9\xc4\xf2\x46\xff\xf0\x4a\xaa\x84\x98\x00\x7e\xfa\xdb\x87\xb3\xc2\xc1\x5c\xe9\x3b\x77\x08\x72\x8e\x21\x2a\x14\x14\x9b\xda\xfd\x09\x49\xde\x94\x2a\x0b\x75\xc3\xeb\x3c\xc7\x59\x7d\x9e\x36\x8e\xb7\xed\xdf\x15\x17\x77\x0b\x45\x76\x82\xfa\x84\xde\xf0\x6a\xa3\x77\xa0\x6d\x83\x06\x4e\xc9\x0a\x37\x85\xb8\x30\xb8\xaa\x21\x49\xf3\xcf\x03\xa4\x8c\x34\x24\xbe\x4b\xac\xac\x2c\x6a\x5f\x14\x6b\x69\xed\xd1\xda\x54\x7b\xb4\x83\xd7\x1e\xdd\x28\xb5\x67\x6e\x30\xf1\x61\xeb\x40\x58\xe8\x25\x71\xfc\x2e\xec\x47\x60\x01\xde\x16\x04\xaa\x0b\x0f\x1d\x13\xdd\x3d\xd5\x36\xd2\x18\x36\x43\xa6\x73\xba\xad\xff\x64\x23\xe6\xb6\x88\x96\xcc\xba\x68\xc2\xac\xa3\x83\x73\xbd\x8e\x91\x1d\xdc\xaf\x9f\xc1\xed\xe4\xb3\x9b\xf9\x2e\xc3\xdc\x51\x63\xa3\xbd\x43\x74\x30\xa1\x34\x6f\x72\x1d\x68\x7d\xdd\x45\x97\x21\x8b\x56\xb0\xf0\x62\x70\x8f\xe2\x4a\x77\x87\x6c\x3b\xc2\xc0\x6f\x7a\x22\x5b\x10\x33\x48\x37\x40\x98\x53\x9f\xad\x7f\x27\x86\x27\xf5\x33\xf7\xbc\x2a\xbe\x1f\x65\x79\x2e\x77\x3f\xca\x12\x34\x84\x11\x43\xba\x54\xb5\xd6\x0b\x20\x21\x82\x87\xa8\xcd\xaf\xc1\xe2\x16\xd5\xde\xa9\x85\xf4\x1f\xdf\x5d\x8c\x59\x50\x29\x42\xbe\x7a\x00\x9b\xb4\x21\x26\xeb\xd4\x5b\x13\xdd\xbc\xdd\xfd\x58\x98\xa1\xeb\x62\x67\x51\x50\x74\xfa\xb8\x27\x3d\x6c\x75\x79\x9b\xd3\x5d\x24\xd1\x03\xff\x98\x2c\x31\x1e\xba\xe6\xce\x29\xc6\xb8\x85\x21\x89\xdf\x6f\x19\xbf\x77\xbc\x6d\xcc\xd1\xd3\xa5\x3f\x46\xb7\xf3\xc7\x1e\x2a\xeb\x17\x42\xf6\x5a\xc5\xe6\x4d\x92\x9d\x97\xae\x27\x00\x37\x31\xbb\x45\x7c\x97\xad\xbf\xae\x6d\xce\x1d\xf3\xbb\x4d\x00\x6d\xf1\xf9\x6e\xe0\xb4\x96\xab\x9d\xc2\x57\xe9\x87\xbf\xef\x59\x56\xab\x5c\x04\x90\x12\x13\xe5\x8f\x8d\x42\xe5\x43\x84\xb6\xac\x72\xff\xe9\x85\x4a\xc5\xda\xf9\x5f\x7d\x67\xb9\x5d\x72\x6c\xc0\xa7\x9e\xa6\x7a\x1b\x6a\x49\xa2\xb5\xe2\x53\x86\x6a\xdd\x65\xc6\x7b\xb2\x42\x10\x59\xb2\x44\x55\xd9\xe2\x6f\x60\xdd\x44\x28\x1a\x0d\x78\x4b\x11\x99\xae\xd5\x85\x76\x94\x7a\x02\xda\x6d\x68\xfb\x33\xcd\x30\x24\x59\x6c\x02\x78\x5a\x36\x25\x5c\xf0\x24\x4b\xaa\xa6\x6a\x1e\x42\x12\x6b\xaf\xbf\x9e\x66\x7b\x2f\x6b\x43\xf7\x7a\xf9\x33\xb9\xb5\xea\x37\x1c\xd5\xb6\x52\x57\xee\xe2\xd9\x9e\x1e\xe4\xff\x79\xac\xe1\xc3\xbc\xcf\x07\x77\x01\xa6\xe5\x85\x6b\xdb\xe2\x47\x97\x92\x96\x77\xff\x9e\x94\x36\x5c\xe4\x4b\xa3\xdd\xa9\xaf\x57\x0c\x54\x71\x83\x8a\x93\xa9\x03\x9d\x5e\x0b\x43\x6e\xed\x1c\x98\x88\xeb\x0a\xcc\xc0\xab\xea\x5e\xf3\x84\xc7\x44\xd9\xd9\x31\xad\x2e\x08\x8b\x9b\x08\x15\x2e\x80\xc6\x24\xd3\x68\x5b\x89\x80\x8b\xbf\xbc\x71\x5f\x5e\x4c\x50\x98\x71\xa9\x28\xd3\xc5\x09\xb4\x75\x55\x17\x2a\x6c\x5d\x0d\xc4\x18\xc5\x97\x99\x41\x0d\x33\xa0\x32\xce\x12\xd1\x94\x22\x94\xca\x4c\x98\x29\x94\xea\x5e\x49\x05\x78\x4b\x92\x34\xc6\x31\x70\x01\xee\x76\x50\xbe\x86\x8a\xe3\x35\x5a\x52\xac\xf7\xd5\x7e\x27\x83\x40\xa6\x51\x59\xe5\x95\x8b\x86\x28\xb7\x2f\xe0\x04\x2e\x93\xf5\x65\x70\x56\xbe\xbc\xbc\xbc\xd4\x9f\xe2\x9a\x17\xbe\x33\xc4\xfc\x0a\x61\x94\xac\x7f\x37\xaa\x8b\x56\xfd\x3e\x6c\x4e\x3a\x50\x22\x80\xc4\x5a\xc2\x12\xfd\xde\x02\x32\x90\x36\xb0\x62\x77\xe4\x52\x5c\xf9\x9c\xee\xe1\xa4\xce\x96\x25\x0c\xb4\x27\x3c\x74\xc7\xd5\x97\xa1\x94\xcf\x97\x44\x5d\x8e\xb7\xfa\x54\xef\xbb\xf0\x5c\x39\xbd\xc2\x35\x3c\x87\x51\x28\xe5\x08\x88\x60\x9d\x32\xd7\x24\xce\xd0\x4a\x2d\x89\xda\x32\x0b\xaf\xfd\xf2\xd5\x91\x25\x46\xc6\x92\xf4\x35\x67\xc8\xc6\x20\x15\x70\x2f\xe3\xb5\x71\x0d\x98\xa4\x66\x3d\xb6\x6d\xd5\x46\xd9\xc6\x5a\x9a\x88\x18\xd7\x62\x17\x04\x22\xa2\x21\x45\x95\x70\x6d\xeb\x03\x3b\x41\x1a\x11\x6e\x78\x1c\xc3\xb2\x5a\x67\x1f\xdd\xc8\xa6\x43\xb9\x34\xbf\x71\xd6\x0c\xd1\xbc\xf1\x01\x62\xd4\xaf\xee\x72\x7d\xef\x51\x5a\x28\x1e\x16\xa8\xcb\xcc\xec\x1c\xac\xad\x30\xdd\x11\xc0\xe5\xaa\xba\xd7\x1e\xb7\x45\xa0\x0d\x08\x45\xa2\x69\x37\xfa\xde\xa9\xfd\xc6\x84\x05\x11\x6c\x01\x21\x57\xda\xc0\x70\x23\xc6\xbe\xc7\xdb\x5e\x9b\xee\x2b\x22\x84\x04\xbc\x4d\x63\x4e\xb9\xf1\x2e\x78\x02\x73\x88\x2f\xc8\x65\x30\xd0\xfd\x45\xc9\x26\xce\x7d\xdb\xfd\xc0\x3c\x73\xf6\x68\x77\x6a\x92\x24\x64\xa2\xd1\xfa\x6f\x39\xaf\xb8\xe0\xed\x47\xb3\xab\xb4\xc4\x8d\x40\x05\x78\xe5\x5f\xcb\xd0\x12\xd1\x44\x1b\x95\x51\x93\x29\xab\x51\xb8\xc4\xc9\x65\x9e\xda\xae\x06\x3c\x2b\xdf\x7e\x37\x7d\xe6\xd4\x7e\x07\x42\x1a\xb7\x3d\x54\x29\x7c\xa6\x4d\x21\xf4\x35\x24\x48\x84\x76\xa8\x70\xf2\x4e\x21\x94\x6a\xca\x3e\x3f\x7a\x20\x07\x1e\xd5\x84\x46\x70\x51\x63\x45\x6b\xfb\x0a\x0d\x70\x36\x76\x9b\x94\x63\x48\x63\x22\xbe\xe2\xcc\xd9\x78\xc5\x05\x7b\xe2\x7e\x79\xf2\x84\xaf\xca\xe1\xf4\x93\x06\xba\xca\xdf\x92\x26\x4e\x61\x93\xda\x27\x93\x0a\x3a\xbe\xfb\x73\xce\xc6\x6e\x40\x3b\xde\x94\x33\xff\xaf\x1d\x70\x9c\x13\xf5\xd7\xcd\x5e\x68\x68\xf4\xc6\xbd\x79\xde\xb8\xb3\x50\x0d\xde\x0b\x98\xff\x06\x00\x00\xff\xff\x71\x4d\x52\xfa\xb0\x3f\x00\x00")
+var _openapiYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x7b\x8f\xe3\xb8\x91\xff\xdf\x9f\x82\xc1\x1d\xd0\x09\xe0\x7e\x6c\x32\x77\xb8\x33\xb0\x01\x66\x33\xb3\xc1\x24\x3b\x99\xbd\xee\xd9\xec\x01\x87\x43\x2f\x5b\x2a\xdb\xcc\x48\xa4\x97\xa4\xba\xc7\x49\xee\xbb\x1f\x8a\x2f\xbd\x28\x59\x72\xbb\xdb\x9e\x5e\x61\xf7\x8f\x69\x99\x22\xab\x8a\x55\xbf\xaa\x62\x91\x94\xd8\x00\xa7\x1b\xb6\x20\xbf\xbb\xb8\xba\xb8\x9a\x31\xbe\x14\x8b\x19\x21\x9a\xe9\x0c\x16\x24\xa7\xa0\xb4\x14\xe4\x06\xe4\x3d\x4b\x80\xbc\xfe\xfe\xdd\x8c\x90\x14\x54\x22\xd9\x46\x33\xc1\xbb\x9a\xdc\x83\x54\xe6\xe7\xab\x8b\xab\x8b\xaf\x66\x0a\x24\x3e\xc1\x9e\xcf\x49\x21\xb3\x05\x59\x6b\xbd\x59\x5c\x5e\x66\x22\xa1\xd9\x5a\x28\xbd\xf8\x8f\xab\xab\xab\x19\x21\x8d\xde\x93\x42\x4a\xe0\x9a\xa4\x22\xa7\x8c\xd7\x5f\x57\x8b\xcb\x4b\xba\x61\x17\xc8\x82\x5a\xb3\xa5\xbe\x48\x44\xde\xee\xe2\x3d\x65\x9c\xfc\x7a\x23\x45\x5a\x24\xf8\xe4\x37\xc4\x52\x13\xef\x4c\x69\xba\x82\x5d\x5d\xde\x68\xba\x62\x7c\xe5\x3b\xda\x50\xbd\x36\xbc\x21\x39\x97\x4e\x20\x97\xf7\x5f\x5d\x4a\x50\xa2\x90\x09\x9c\xdf\x15\x3c\xcd\xc0\xb4\x21\x64\x05\xda\xfe\x83\x10\x55\xe4\x39\x95\xdb\x05\xb9\x06\x5d\x48\xae\x08\x25\x19\x53\x9a\x88\x25\xf1\xef\x12\xf7\xae\x7b\xa3\x46\xc7\x3f\xcf\xdd\x53\x32\xa0\x83\x0b\xf2\x23\xd3\x6b\xf2\x40\x75\xb2\x9e\x13\xbd\x06\xa2\x34\xd5\x85\x22\xc9\x9a\xf2\x15\x28\x1c\x14\x9f\x36\xdf\x23\x7a\x4d\x75\x18\x27\xc7\xd7\xed\xdb\x40\x65\xb2\x26\x54\x62\x47\x12\x68\x0e\x29\xa1\xca\xa8\x0a\xc8\xf3\x1b\x9c\xb5\xb7\xf7\xc0\xb5\x22\x8c\x2b\x0d\x34\xbd\x20\x1f\xd7\x40\xf4\x76\x03\x38\x14\xd0\x64\x4d\x00\x1b\x10\xa6\xc8\xfb\x0f\x6f\xde\x7d\xfb\xee\xed\x9b\x30\x8e\x90\xe4\xcd\xdb\xef\xde\x7e\x7c\xfb\x66\x4e\x98\x56\x24\xa5\x9a\x62\xc3\x08\x85\x73\x42\x79\x6a\x1a\xb1\x14\x9b\x50\x64\xbd\xc8\x81\x68\xf1\x09\xf8\x05\x79\x6d\x79\x76\x4f\x15\x59\x4a\x37\xa7\xf8\x3f\xf6\xf7\x1d\x55\xfa\xdc\xd0\x7a\xfe\xee\x0d\x59\x03\x4d\x41\x12\x21\xfd\x58\x45\x0e\x1f\xb1\x27\xb2\xa1\x92\xe6\xa0\x41\xce\x63\x64\x20\x6d\x54\x1b\x79\x58\x89\xa6\x61\x10\xba\xd4\x60\xbb\x33\x24\x99\x36\x0a\x39\x5f\x32\xa9\xb4\x95\x8b\x13\xa7\x58\x12\xea\xe8\x4d\x28\xe7\x42\x93\x42\x01\xf9\xd3\xcd\x87\xbf\x7c\x43\x96\x0c\xb2\x54\x95\x0c\x3d\xe0\x7c\xea\x35\x84\x71\x3c\x45\x7f\xb5\xd6\x87\x62\x76\xfa\x60\x06\x22\x0a\x78\x8a\x64\x7a\x0a\x55\x85\x32\xd3\x8c\x96\x33\x51\x99\x01\x3b\x4d\x6a\x1e\xc6\xd1\x6b\xe0\x84\x92\x6f\x3e\x7c\xf8\xf3\xfb\xd7\xd7\x7f\x76\xd3\xf8\xb0\x16\x0a\xec\x44\xad\x69\x7d\xa6\x6e\x1d\x1e\xb8\x87\x9b\x8c\x6e\x71\xa6\x12\x91\x6f\x32\xd0\x40\x8a\x0d\xd1\xe2\xc2\xf5\xaf\x20\x29\x24\xd3\x5b\x6f\x24\x68\xa7\xdf\x00\x95\x20\x17\xe4\x7f\xfe\xd7\x3d\x94\xa0\x36\x82\x2b\x6f\x53\xf8\xdf\xd9\x6f\xaf\xae\xce\xca\x3f\x1b\xb6\xf2\xda\x08\x91\x50\x29\xe9\x36\x62\x1e\x44\xdc\xfd\x0d\x12\xad\xe6\xc8\x37\x75\x1a\x8d\xed\xac\xa8\x0d\x87\x8a\x3c\x20\xe3\xf6\x09\x53\x44\x41\x69\x17\x84\x24\x82\x6b\xe0\xc1\xb4\xdd\xcc\x6f\x36\x19\x4b\x28\xc2\xc6\xe5\xdf\x94\xe0\xf5\x5f\x09\x51\xc9\x1a\x72\xda\x7c\x4a\xc8\xbf\x4a\x58\x2e\xc8\xd9\xbf\x5c\xa2\x88\x04\xc7\xc1\x2f\x6d\x5b\x75\x79\xed\x28\xff\xc6\x10\xfe\x1d\x53\xfa\xac\xf6\xbe\x86\xcf\xfa\xd2\x10\x7c\x6e\xd9\x18\x3a\x28\x9a\xe6\x02\x59\x67\x7c\x15\x7e\x3c\x7b\x75\xf5\x55\x8f\x54\x0b\x54\x41\xa3\xd4\x0c\x0d\xfd\x9e\x66\x2c\x3d\x86\x50\xde\x4a\x29\x64\x29\x87\xb3\x57\x57\xbf\xeb\xa6\xfa\x07\x4e\x0b\xbd\x16\x92\xfd\x1d\x52\xa2\x05\xd9\x80\x5c\x0a\x99\x13\xb1\x01\x69\xe6\xea\x24\x38\xf8\xaa\x47\x9b\x3f\x56\xf1\xc7\x1b\x97\x03\xf0\xa0\x9f\x28\x26\x9a\x68\x48\x3b\xf0\x8a\x83\x65\xff\xce\x5a\x3f\xe2\xf7\xca\x7a\xd9\xa3\x33\xff\x6f\x7d\xa6\xfc\x03\x87\xcf\x1b\x40\xc6\x08\xe0\x7b\x44\x24\x26\x4e\x38\xbe\xe2\x05\x0f\x11\x60\xe9\x3c\xfa\x72\xd9\xee\x72\x43\x57\x70\x36\xb4\xb1\x62\x7f\x1f\xde\x18\x45\xc0\x78\x31\xa2\x77\xe3\x82\x06\x37\x17\x32\x05\xf9\xcd\x76\x70\x7b\xeb\xbe\xca\xe6\x9c\xe6\xb0\x20\x4b\x96\x69\x13\x8c\xe1\x43\x42\x18\x5f\x90\x9f\x0b\x90\xdb\x59\x74\xea\x7f\x5f\x46\x3c\xc4\x43\x3a\xf0\x44\xa4\x90\x92\x3a\x2e\x7e\xcb\xb2\x1d\x9e\xda\x86\x32\x77\x42\xd7\xe3\x19\x9e\x9a\x3f\x2d\x5d\xe8\xaa\x2b\x23\x3a\x33\x11\xd2\xba\x68\x70\x31\x8d\x6b\xeb\x4c\x8e\x65\xd6\xac\x68\xa9\x0e\x06\x68\x0c\x5b\xe4\x6e\xeb\x46\xc3\x18\xd4\xbb\x3c\x42\x24\xfc\x5c\x30\x09\xe9\x82\x2c\x69\xa6\x60\xd6\xad\x92\x11\x94\x2e\x65\x09\x59\x7a\x03\x19\x24\x5a\xec\x2b\xd2\x3f\x17\x77\x20\x39\x68\x50\xe7\x4a\x6f\x33\xb0\x51\x07\x51\xae\xd7\xae\x28\x71\x4e\xe0\x62\x75\x41\x72\xd0\x14\x23\x80\x0b\x24\xe8\x6b\xbe\x62\xfc\xf3\xdc\x36\xfc\xd5\xd7\x2e\x30\x36\x22\xab\x0c\xa9\x8a\xcd\x46\x48\x34\x66\x33\x92\x42\x91\xd7\xfb\x99\xd7\xff\x54\x1b\x9a\x00\xf9\x35\x52\x91\x08\xae\x8a\x1c\xa4\xe1\xff\x37\xf3\xf0\xf7\x2d\xfe\x6d\xc2\x42\x3b\xf8\xdc\xc4\x4a\x95\x41\xf1\xe5\xaf\xe7\xe4\xeb\xaf\x4d\xa3\x5f\x7d\xed\xa0\x5f\x48\x75\x41\xde\x69\x8c\x4d\xb8\xd0\x15\xd2\xee\xb6\x76\xc6\x0f\x3c\x61\xa6\xcf\xa1\x13\xf5\x23\x06\x1f\x0a\xf4\xa8\xe0\x7d\x77\x78\xbe\x37\x47\x77\x42\x64\x40\xab\xee\x22\x85\x25\x2d\x32\x5d\xef\xc0\xf3\x5a\x89\xa4\x87\x72\x8c\x5e\x8e\xa5\x9e\xb7\x8c\x2a\x4d\x24\x24\xc0\xee\x21\xad\xc6\x65\xf3\x8a\xdb\xf3\x41\xbe\x0d\x6c\x99\xde\x9b\xbb\xe8\x7c\x35\x02\xec\xa1\x7c\xd4\x4c\xec\x63\x2c\x2e\x2e\x23\x75\x74\x67\xf8\x47\x63\x26\xab\x3c\x76\x05\xf3\x0d\x1d\xa8\x8c\x19\xb4\xc1\x49\xc5\xd8\xa0\x99\x70\xc0\xe4\x80\xb2\x4c\x19\x0b\x21\xaf\xbe\xba\x22\x7f\x14\x1c\x08\xb3\x7d\xa5\x90\x01\xf2\x50\xbe\x6a\xcc\x73\x53\xc8\x15\xa4\x07\x33\x06\x44\x28\x9b\x76\x85\x96\x56\x67\xfe\xfb\xfc\x83\x0f\xc9\xce\xdf\xbd\x19\xd3\xed\x06\xd7\x14\x9a\x59\xf6\x1f\x24\x50\x0d\x84\x12\x0e\x0f\x4d\x01\x8f\x4b\x3e\x7e\x2e\x40\xe9\x6f\x44\xba\x5d\xc4\x67\xfc\xba\xde\xb9\xc9\x8a\x22\xd2\xd2\xb2\x80\x59\x4f\xc8\xd2\x1f\xb0\xb4\xc5\xb0\x2b\x58\xa9\xbb\xc8\xb3\xde\x5c\xaa\x27\xea\xb7\x72\xac\x86\x5b\x76\xf6\x2a\x1d\xe0\xff\x0e\xf0\xcf\x43\x58\x7d\xce\xd2\x21\xd4\xba\xce\x2e\xc3\xdc\xbf\x7b\x73\x76\x8c\xd0\x2e\x2e\xad\x5d\x89\x26\x5a\x56\x4e\x39\x5b\x82\xd2\x2e\xd2\x78\x10\x45\x96\x92\x3b\x20\x89\x15\xdc\x9c\x48\xb3\x5c\x83\x30\x86\xa0\x9e\xca\xed\x75\xc1\x4f\x26\xa5\x7c\xc3\x96\xcb\x0a\xb7\xaf\xfa\xb8\xfd\x2b\x26\x7c\x66\x92\x6c\x2c\xae\x4e\x27\x18\x9f\x72\xd7\xe3\xe5\xae\x57\xff\xd9\xcd\x41\x13\x1b\x69\x26\x81\xa6\x5b\x02\x9f\x99\xd2\xea\x14\xc8\xef\xcd\x3e\x5f\x73\x52\x74\x25\xa0\xd6\xc0\x71\x69\x38\x12\x8c\x1d\x9d\xb3\x32\x17\x5b\x0c\xcd\xd9\x2c\x32\x9d\x0d\x6d\x6e\x22\xf8\xf7\x94\xd3\x15\xb8\x51\x4d\x00\x01\x2d\x4f\xfc\xc6\x3c\xde\x99\x9c\x51\x97\x95\xcd\x22\xf3\x50\x59\xfc\x7e\x4f\xe5\x27\x85\xb1\xa0\xdc\x36\xbb\xab\xf4\x06\xaa\x96\xe7\x29\x17\xdc\xf0\x95\xc9\x01\xa4\x5f\x40\xe7\xa5\x29\xd5\x97\xbe\x5d\x7a\x87\x84\xa7\x44\xf0\x04\x48\x35\x05\x51\x84\x26\x9f\xb8\x78\xc8\x20\x5d\x41\x2d\x76\x32\xf1\x5c\x96\xb9\xf0\x2c\x1f\x17\x68\xc4\x3c\xf3\x6f\xbb\x95\xf3\x63\x75\x5c\x5c\x0b\x4f\x12\xd8\xd4\x5d\xf5\xb3\xe9\x5e\x70\xdf\x43\x7d\x49\x65\x19\x9c\x29\x92\x33\xa5\xd0\x92\x84\x3c\x2d\x6c\x9e\x3c\xca\x31\x3c\xca\xb8\x05\xc1\x60\xd9\x31\x80\x39\x3a\x3b\x25\x60\x2e\x1a\xf9\x65\x0d\xed\x62\x69\x65\x47\xea\xd0\x05\x8c\x84\xdc\x6c\x20\x61\x4b\x56\xc7\xbe\x44\x32\x0d\x92\xd1\x66\xc6\xe8\x25\x84\x71\x85\x11\xa1\x5b\x38\xb1\xef\xe2\x92\x8a\xda\x72\x4d\x3f\xe3\x42\x42\xb5\xee\x44\x7c\xc7\xf1\xfe\x4c\x62\x3b\xeb\x16\x5f\x23\x79\xdb\x55\x45\xbd\xf4\x5e\x64\x67\x35\x15\xc9\xe6\x45\x7e\x07\x32\x52\xf1\xc1\x90\xcf\x56\x21\x13\xc1\x53\x86\x8a\x6e\x2a\xa2\x30\x27\x2b\x29\x8a\x8d\x5d\xf5\xf1\xf0\x3e\x27\xee\x65\x21\x49\x46\xef\x20\x1b\x03\xe3\xed\x09\xf7\x59\x6f\x7d\x76\x7d\xda\x6b\xc6\xbf\xbd\xab\xfe\xd0\x95\x61\xf7\xcd\x3d\x21\x7f\xc4\x8e\xa2\x45\x53\xe5\x16\x22\x99\x24\x3f\x79\x1e\x7f\x9a\xfb\x27\xb6\xe9\x4f\x73\x5f\x05\xbd\xa7\x59\x61\xaa\xb6\xb4\xd6\xbd\x5f\x96\x23\x3f\x19\x91\xd8\xf7\xc9\x27\xd8\x7a\xc5\x32\x8f\x31\xba\x5e\xb1\x7b\xe0\x7e\xed\xd3\xb5\x2e\xc5\x32\xeb\x37\xae\x56\x72\xef\xff\x03\x5e\xb4\x8a\x5b\xe7\xc1\x23\xb7\x7e\x68\x2d\x8b\xe0\xff\xe7\xb5\xe9\x6c\xac\x63\xb5\xba\xea\x9b\xb5\x66\x3f\x03\xa7\xec\x63\x5d\x64\x41\xa6\xa6\x3b\xb4\x44\xa3\x0d\x5d\x73\x38\x0f\xc3\xe0\x92\xcd\x4f\x5e\x73\x7e\x42\xa1\x3b\x39\x8f\x97\x6e\x3c\xec\xd8\xe1\xb9\xc7\x1b\x5a\xd8\x06\x60\xa8\x3e\x06\x2c\xd7\xf3\xde\x1b\x8b\x2b\x43\xc3\x95\x29\xf5\x9d\x52\xdf\x76\xea\x3b\x2e\x50\x39\x09\x8d\xd9\xe9\x70\xff\xc1\xd2\xff\xeb\xf6\xb6\x7f\x04\xdd\x5e\xaf\x46\xac\x67\xe9\x18\x27\x39\x1a\x74\x9a\xeb\x08\x4b\x51\xf0\xb4\x36\xee\x11\xb1\x64\xb2\xc4\xa3\x5b\xe2\xab\xab\x57\xdd\x1c\xfc\x45\xb4\x34\xd6\x14\x40\x94\x8b\x97\x53\xc2\xd2\x2f\x65\x45\xea\x34\x51\xa5\x2b\xd3\x89\xbd\x5c\xb6\xbb\x64\xe9\xd9\xd0\xa6\xcd\x0d\x05\x4f\x51\x46\xc2\x65\xa8\x16\xe2\xfd\xb0\x49\x6d\x1d\xa9\xa1\x42\xb3\xc8\xe4\x54\x22\x72\xfb\x9a\x6a\xbf\xe7\xb6\x66\x56\x57\xcf\x6c\x28\x5d\xd6\x11\x5c\x74\xe8\x4b\x76\x58\x84\x33\x3e\x01\x77\x23\x70\x72\x07\x6b\x9a\x2d\xc3\x40\xae\xb1\xe9\x8f\xe4\xb6\x43\xbf\x0b\x73\x11\xf9\x49\x3c\x70\xd5\x18\x0f\x0b\x7d\xa6\x7f\x65\xf7\x5f\x96\xbf\xd4\xd6\xc4\x90\x0e\xac\x94\x7b\x5a\x28\xdf\xe6\x02\x69\x93\x98\x71\xe4\xe2\x1e\x8b\x1d\x7e\x4b\x45\x8b\x1b\xa1\xd7\x20\xeb\xb4\xd8\xf2\xff\x27\xd8\xe8\x0b\xf2\x3a\x0c\xe3\xdf\x34\x0b\x72\x08\x94\xe2\x81\xdb\xfc\x8c\xf2\x48\x27\x66\x41\x8f\xf9\xe2\x78\xea\xf3\x0e\x2f\x3c\x5c\x17\x43\x37\xbb\xcc\x58\xa2\xcb\x5d\x8d\x05\xcf\x40\x29\xb2\x14\x88\x09\xb6\x16\x33\x27\x0f\x6b\x86\xfb\x5d\xe9\x27\x97\x41\xe3\xc0\x52\xad\xd9\xc6\xf3\xe0\x49\xb3\x25\x55\x5f\xcf\x45\x12\x8d\x9b\xa2\x99\x29\xf1\x84\x41\x50\x50\x5b\xc6\x57\x17\xe3\x5c\xe3\xae\x7a\xa3\x55\xad\x94\xc8\x2f\xa1\xee\xf8\x3d\x1a\xd5\xb5\x9d\x8c\xb3\x7d\xbd\x7f\x23\xd3\xbd\x6e\x30\x5e\x38\x81\xa8\x22\x49\x40\xa9\x65\x91\x65\xdb\x0b\xf2\x63\xab\xda\x16\xdd\x20\xe4\xf6\x7f\xd4\x06\xf0\x1d\xa2\x6a\x51\xd2\x2e\x98\xe1\x3b\xa1\xaa\xe7\x77\x3c\xff\x62\x2b\xa4\x53\xe2\x34\x25\x4e\x23\x13\xa7\x97\x14\xae\xf5\xd6\x3f\x7f\xdf\x8f\x5c\x15\x17\x82\x9e\x15\xe1\x29\xa3\x1a\x54\x37\x54\xdd\x01\xd6\x49\xec\x82\x6d\x1a\xd6\xec\x9c\x4b\xae\x8d\xe5\x9d\x95\xf5\xb2\xc1\x89\xc6\xfc\xf0\x29\x88\x71\x5c\xd4\x6b\x10\x1a\x25\xd1\x10\xd1\xd1\x39\x29\x43\xd6\xc5\xd0\xd0\x76\x44\x14\xfc\xe8\x12\xed\x90\x97\x30\x26\x1a\x52\xd0\xed\x0a\x89\x1f\xb1\x02\xd0\x83\x0a\x4d\xd3\x71\x26\x50\x73\xfa\x47\x71\xc1\x93\xff\x9b\xfc\xdf\x2f\xd9\xff\xed\x59\xad\x8d\x63\xc7\xf1\x38\x29\x11\x70\x31\x14\x29\x59\x3a\x78\x45\xf5\x52\xc2\x3d\xc3\xdd\xbf\xaa\x7b\x6d\xb5\x5a\xc9\x0c\xcd\xa3\x1b\x84\x67\x11\x49\x57\x72\xa4\xd7\xe1\x75\x8c\x18\x24\x24\x78\x7a\x23\x75\x7b\x66\x34\xcb\xa1\x96\xcc\xaa\x72\xcb\xfd\x3c\x3c\x44\x75\x5a\xb2\x95\x22\x7e\xca\xc0\xe5\xb8\x61\x10\x97\x12\x37\x28\x73\x69\xb8\xdf\x73\xec\x99\x30\x11\x08\x1e\x21\xc1\x9d\xff\x52\xe4\xe6\x55\x0e\x0f\x38\x92\x16\xe6\x2f\x91\xa5\xa0\xf4\xc5\xe3\x7d\xc8\x23\xce\x05\x06\x82\x8f\xa1\x8d\xde\xc1\xd9\xfc\xea\xda\x91\x52\x3f\xf2\x37\x01\xf6\x04\xd8\xcf\x0c\xd8\x27\x13\xae\x3c\x17\x40\x5f\xfe\xc3\x65\x65\x03\xca\x60\x0e\x65\x63\x18\x8d\x0b\x91\xae\xa3\x27\xc5\xb4\x66\x5c\x1c\x88\x0a\x25\xb2\x3a\x15\x27\x80\x69\x53\xec\x3c\xc5\xce\x4f\x19\x3b\x3b\x03\x68\x60\xb0\x33\x83\x09\x88\x8f\x06\xc4\x83\x9a\xba\x69\x1a\x01\xdc\x22\xcb\xee\x68\xf2\x69\xd1\x7d\x16\xec\x5a\x64\x19\xc1\x36\x11\x98\xb6\x87\x77\x51\x69\x44\xa1\x82\xf2\xcc\x22\x33\x52\x89\xb0\xaf\xe1\xdc\x55\xcc\x46\x84\xd2\x58\x4d\xa8\xc5\xd2\x36\xb6\x6f\x8d\x5d\x56\x12\x4c\x10\xed\xd8\xc3\x90\x2e\x54\xde\xb0\xa8\x85\xe7\xda\xbc\x4e\xc7\x83\xf1\x03\xd7\x9d\xaa\x35\x2f\x2d\x88\x0c\x42\xd5\xe2\xe4\xca\x4e\xd7\x4e\x6a\x8f\xad\x3c\x5d\xd7\x25\x6a\x98\xc6\x62\x24\x4e\xc8\xd1\x57\x9e\x9e\x11\x00\xea\xd2\x9d\x1c\xf8\xe4\xc0\x9f\xd2\x81\xd7\x6d\x4e\xc8\x00\x8d\x91\xbc\x0a\x51\xf5\xf4\x5c\x7b\x6f\x51\xe8\xe3\x80\xd2\xce\x29\x30\x31\x2e\x3e\x41\x68\xc4\x8a\x0c\xc2\x6e\x93\xbd\xa3\x73\x53\x06\x18\x8b\xa1\x81\x48\x3c\x79\xf4\xbb\xc4\x55\x77\x72\xe8\xd7\xf1\xfc\x21\xff\x65\x79\x6e\x6c\x9c\x43\x7e\xe4\xea\x96\x1f\xd5\x5f\x77\x75\x8c\x49\xf8\x83\xa3\x61\x5a\xc6\x3a\x89\x65\xac\x17\x93\x71\x8c\xbc\x6c\x69\xe4\x75\x4b\x7b\x5c\xb8\x34\xfa\xca\xa5\xf1\x97\x2e\x8d\xdc\x25\xb9\xfb\x56\x0c\x0f\x10\xe3\x50\x69\x57\x9a\xe0\x4d\xfe\x54\xf6\xa3\x79\x7a\xce\x7a\x71\xb5\x07\x8f\xda\x37\x60\x3c\x9b\x15\x34\x69\x9f\x02\xee\x29\xe0\xde\x27\xe0\xee\x09\x46\xbd\x8a\xbd\xdc\xab\x19\x1a\x30\x77\x1c\x96\x3a\xe3\xc8\x41\x07\x6e\x7c\xeb\x67\x38\x69\x13\xf4\xe1\xc8\x47\x6c\x3c\x1d\x13\x7e\x9c\x00\x7e\xf4\x27\xec\x41\x3b\xdb\xd9\xf9\x17\x02\x26\xa7\x1a\xfa\xf6\x1f\x49\xe1\x4f\x14\xc1\xf9\x03\x06\xc9\x89\x46\x72\x07\x39\x53\xe0\x3b\x8b\x9e\x1e\x38\xc6\xb4\x7b\x82\xa6\x58\x6f\x8a\xf5\x1e\x13\xeb\xbd\x00\xac\x7e\x91\x01\x6b\xf7\x1e\x76\x3f\x27\x47\x66\x61\xd7\x6e\xef\x06\x99\x5d\xb5\x51\x7b\xdd\x97\x3b\x76\x67\x67\xca\x9c\xe4\xc3\xef\x04\xf0\xd6\xea\xbe\x72\xe7\x21\x13\xaa\x12\x9a\x42\xf4\xdc\x43\x38\x43\xd8\xa0\x80\x98\x93\x0d\x7e\x23\xb8\xbd\x29\x15\xcb\x01\xb5\x6b\xbd\x2a\x47\xb5\xe6\xb5\x4e\x30\x4c\x4c\xa1\x71\xc3\x57\xe3\x1e\xaf\x30\x90\x58\x9a\x93\x91\x2d\xc2\x58\xed\x2a\xb0\x74\x8c\x1f\x2e\x97\x6d\xaa\xcd\xec\xf5\x22\x4e\x1a\xe1\x79\xec\x7a\xa0\xc1\x97\x8e\xb8\x09\x1c\x22\x58\x72\x07\x4b\x3c\xd2\x59\x7d\x36\xeb\x57\xb0\xae\xfb\x81\x3b\x6e\x08\xde\xeb\x96\x33\x27\x8e\x93\xbe\xed\xac\xf7\xf0\x42\xc0\x2c\xaf\x70\xd1\x60\x63\xf2\xf7\x93\xbf\xff\x45\xfa\xfb\x3d\x8f\x10\x44\x10\xea\x18\x2c\xb4\x81\x7c\xcf\xda\xe2\x26\xa3\x09\xe4\x38\xcc\x98\xe2\x62\xf9\xd6\x18\xef\xf3\xe8\xea\x62\x18\xf6\x98\xe5\xc5\xef\x3d\x11\x53\x7d\x71\xaa\x2f\x4e\xf5\xc5\x13\xab\x2f\x06\x88\x18\x07\x4c\xbb\x96\xa7\x82\xd1\x9f\xca\xba\x54\x20\xe8\xac\x17\x5c\x4f\xb3\xc4\xd8\x22\x7e\xaa\x31\x4e\x35\xc6\x03\xd7\x18\x83\x8e\xbd\xdc\x22\x63\x13\xeb\x4e\xa3\xca\x18\xa8\x1a\x76\xaf\x5f\x68\xfe\x0c\x75\xc6\x52\x27\x8e\x5c\x68\x0c\x84\x4c\x28\x72\x02\x28\xd2\x9f\xcd\x96\x0a\xfa\x72\xd2\xd9\x2f\xa2\xd4\x58\x4a\x7e\x1c\x28\x0c\x2d\x35\x6e\x4e\x36\xa6\x3b\x48\xb1\x31\xf4\x76\x32\xd5\xc6\x40\xd1\x14\xf6\x4d\x61\xdf\x63\xc2\xbe\x97\x00\xd8\xbd\xc1\xeb\xc7\x6a\x74\xd7\x7d\x63\xd7\x29\xf0\xb1\x67\xfd\x31\x70\x77\x64\x1e\x76\x15\x20\xf7\xf4\x41\x31\xac\x7e\x35\x04\xab\x77\x15\x6b\x26\xc8\x99\x20\x67\x5f\xc8\xd9\xb3\xe4\xd1\x34\x81\x63\xf1\x50\xae\x09\x2e\x66\x03\xd7\x0e\x77\xd5\x3c\x4c\x86\x7a\xb9\xa1\x85\x82\x45\xf7\x02\xe3\xf7\xf8\xbb\x29\xd5\xe3\x79\x33\x51\x68\x77\x88\xfa\x70\xd0\x70\x35\xd4\x15\x84\xaf\xe5\xf8\x98\xce\x53\xb4\x59\x53\x05\xc7\x98\xa0\xd1\x41\x9d\x3f\x60\x8e\x54\x3b\x8f\xc6\x38\xd9\x48\xb1\x92\xa0\xd4\x14\xd8\x4d\x81\xdd\x97\x1d\xd8\x7d\xe1\x01\xd1\x93\xa1\xac\xfd\x72\x76\xdf\x8d\x19\xa6\x81\x41\x37\x83\xc8\xe9\x04\xb7\x4f\x03\xb7\x56\xba\xc7\xa0\x7e\x42\xda\x09\x69\x27\xa4\x7d\x6a\xa4\xa5\x77\x42\xea\x1e\xa0\x7d\x8d\xbf\x4f\xf1\xec\xd3\xc4\xb3\x95\x4f\xf8\x96\xdb\xc5\xcd\x8c\x4c\x90\x3b\x41\xee\x04\xb9\x2f\x03\x72\xfd\x36\xd0\x73\x05\xe3\x76\x4e\xfa\x17\xf1\xdb\x30\xea\x49\x81\xb6\xf3\x6a\x16\x05\x47\xdd\x3f\xe9\xf7\xa5\xdf\xc0\xb4\x83\x72\xda\x41\x39\xed\xa0\x3c\xb5\x1d\x94\x55\x9c\x18\x07\x50\xbb\x0a\xef\xe1\x44\x8a\x82\x93\xa9\xb9\x57\xd0\xe8\xac\x17\x69\x4f\x73\x27\x65\x84\xfc\xa9\xa8\x3e\x15\xd5\xf7\x29\xaa\xf7\x94\xa3\xbd\x96\x21\x24\xbc\xdc\xed\x94\x11\xe0\x3b\x0e\x5b\xbd\xc1\xe6\xb8\xbb\x5b\x14\x3c\xc7\xbe\xca\x9a\x7e\x9c\xc8\x1d\x2e\x4d\x44\x9c\xb2\xde\x53\xcc\x7a\x6b\x8a\xfa\x72\x12\xdf\x2f\x63\x7f\x65\x55\xf8\xe3\xf0\x61\xe8\x16\xcb\xe4\xb4\x23\xbe\xc3\x5e\xea\x82\x58\x7b\x2a\x5b\x2d\x2b\x4c\x4e\x71\xe1\x14\x17\x3e\x26\x2e\xfc\x45\x02\x78\x58\xb9\xac\xf2\x77\x64\x36\x86\xde\x95\x32\x1e\xce\x63\x88\xf7\x6a\x20\xe2\x4d\x1b\x16\xbf\xc4\x0d\x8b\x2f\xd4\x6c\x5b\xd7\x34\x9c\x80\xd9\x96\x0b\x71\x8b\xd9\xc0\x05\xbb\x78\xcd\x21\x28\x98\xea\x4e\xff\xda\x05\x87\xf2\xad\xc7\x63\xc2\x88\x6a\x43\x18\xf6\x98\xa5\x86\x70\x67\xce\x54\x68\x98\x0a\x0d\x53\xa1\xe1\x19\x0b\x0d\xdd\xd8\x35\x64\xfd\xaa\x7a\x97\xda\xd3\xaf\x5e\x05\x94\x38\xf6\xd2\x55\x20\x64\x82\xaa\xa3\x43\xd5\xae\x00\xaa\x54\xd0\x97\x13\x3d\x9d\x08\xe0\x96\x80\xb2\x98\x0d\x04\x9e\x78\xc0\xf4\x73\x21\x34\x55\xdd\x58\xe3\x83\x25\x5c\xfc\xb7\x6d\xcd\x55\x8e\xf8\x67\xa1\xe8\x0a\xfc\x4d\x85\xcd\x1b\x0c\x9f\x14\x8d\x3e\xb6\x89\xe1\x45\x7e\x07\x32\xf2\xf1\x68\x73\x49\x25\xd0\x64\x5d\xc6\xbb\xc8\x80\x6d\x73\x8c\x59\xfc\x2f\x94\xe2\x0f\xaa\xe6\xfa\xa6\x68\x6b\x8a\xb6\x86\x45\x5b\xe5\x2f\x8b\x59\x69\x5e\x37\xd8\xc8\xdb\x8f\xb3\x2f\xd7\xbb\xbd\x07\x74\xad\xf5\xc6\x3d\x30\x7a\x08\x0b\x72\x67\x9a\xb9\x87\xf6\x8f\x6f\x85\xcc\xa9\x5e\x90\x3f\xfd\xf8\x71\xe6\xa9\x74\x9d\x7e\x30\x19\xca\x35\x2c\x41\x02\x4f\xc2\x0a\x8b\xed\xdd\xa6\x2f\xee\xd1\x46\xe2\x0c\x6b\x56\x35\xe7\xfa\x47\x15\xed\x4b\x4a\x4b\xc6\x57\xe1\xf1\x27\xc6\x77\x37\x5a\xa3\x80\xfa\x1a\x61\x12\x33\x92\xb6\x41\x03\xe3\x96\x98\x76\x23\xc6\x35\xac\x2a\xf7\x1c\x62\x80\xba\xbb\x95\x16\x9a\x66\xbb\x9b\xf9\xf0\x75\x27\x6d\x0d\xad\xfd\x7d\x79\xe5\x2f\xfe\xf7\x61\x43\x7f\x2e\xc0\xc1\x87\x16\xa1\x5b\x83\x9a\x26\x01\x0e\x1f\xfe\xcf\xa8\xd2\xe1\x52\x5e\xc2\x34\xe4\x73\xc2\xcc\x89\x08\x5c\xc4\x7a\x58\x63\x07\x6b\x90\x60\xae\xf7\xcd\xf1\x3e\x5a\x6c\x53\x75\xe2\x24\xe0\xb1\xe9\xd9\x9d\xa5\x30\xbb\x71\xf0\xeb\x9c\x5b\xf3\x93\x8b\x99\xcb\x94\x61\xd6\x28\x77\x84\x0e\xcf\xcd\xe4\x54\xfe\xc4\x69\xa8\xfc\x89\xf2\xae\xfc\x69\x04\x5b\xf9\xbb\xa4\xce\x78\x4e\xdf\x2f\xcd\xb2\x0f\xcb\x7e\xb7\xe9\x8d\xae\xa1\xf5\x1e\x38\xce\x63\xba\x15\xd7\x2e\x9c\xc7\xb4\x36\x87\x9d\xb3\x28\x81\xb6\xb0\xa2\xa3\x69\xc0\xd0\x5b\x96\xee\x78\xc1\xb0\x5e\x35\x8b\x11\xec\x57\x97\x04\x46\xf1\x6c\x24\x1f\x23\xcc\xac\x7d\xd4\x9e\x47\x9a\x0e\x86\xf0\xfa\xd7\x4f\xf7\x60\xf0\x10\xf3\x6b\x6e\x86\x8e\xb0\xda\x9a\x34\x1f\x7f\xdc\x0e\x7e\xc3\x72\x37\xa8\x69\xd8\x3d\xbc\x5b\x23\xa2\xa8\x81\x21\x15\x4b\x7d\x34\x17\x7a\xb3\x57\x84\x47\x02\x3c\x44\x05\xb3\x33\x04\x52\xb2\x14\x25\x6a\x11\x7f\x13\x43\x8c\x88\x26\xc4\x91\xd0\xeb\x6d\xcf\x5b\xbb\x48\x6f\x00\x5e\x80\x3c\xd7\xa5\xe7\xc9\xa0\x5b\xb2\xa6\xbc\x33\x68\xb5\x68\xc7\x91\x2d\x05\x78\x81\xb8\x14\x4a\x11\x9a\x65\xcd\xf6\x8d\xf1\x7c\xa0\x69\xb2\x1c\xb8\x07\xb9\xad\x8f\xc3\xa4\xc9\x7d\x88\x90\x44\x69\xaa\x8b\x2a\x62\x3a\x19\xde\x52\x3d\x90\xf5\xa5\xf3\xd1\x58\x43\x3d\xd7\x2c\xaf\x12\xe3\x2a\xab\x87\xe9\xcc\xac\x13\x1f\xaa\x33\xff\xf9\xed\x58\x57\x0d\x2b\x23\xe5\x67\xbb\x1f\x81\x20\x1d\x5d\x5b\xa6\x6e\x85\xd5\xfa\xd9\x80\x37\x3c\x31\xb7\xee\x73\xe1\x87\xa7\x29\x8c\x90\x53\x4e\x57\x20\xa3\x43\xb4\xde\x8a\xd8\xaf\xd9\xc3\x4a\x7c\x2f\xd6\x74\xc5\x03\x6f\x7e\x0a\xfd\x13\x6c\xad\x33\xa6\x1b\xf6\x57\x6b\x23\x97\xe8\x67\x2f\x11\x97\xd4\x86\x26\x60\xfe\x55\x1b\x8b\xa6\x29\x43\x91\xd1\xec\xfb\x28\x08\xf6\x28\x86\x55\xf9\x1d\x3c\xd5\x91\xfc\xb9\xdd\xd5\x73\xa0\x50\x00\x52\x13\x19\xe1\x47\x17\x14\x00\xaf\xa2\x8b\xc5\x0c\x45\x8a\x0d\xd1\x62\x4e\x28\x79\xc0\xad\x17\xfe\xf8\x17\x2b\xbf\x72\xe0\x26\xad\x31\x9e\x02\x9e\xaa\x5a\x47\x74\xa9\x41\x86\x31\x67\xbd\xda\x39\x4a\x91\xfb\x26\xa1\x3e\x93\x31\x3f\x5d\xdd\x51\xb2\x98\x75\x28\x44\x7c\xaa\x22\xf3\x13\xf7\x2c\x31\xbc\x89\x2a\x44\xb0\x8b\xc5\x6c\x97\x30\x3a\xa5\xd6\xe8\xb2\x13\x63\x7a\x09\x88\xe1\xcb\xfe\x74\xd4\x25\x7e\xed\x3e\xd3\xbd\x87\x4d\x1d\x22\x42\x0a\xe6\x35\x30\x34\x89\xcc\x72\xd7\x3c\x1f\xd8\x81\x4e\x6e\x2a\xe6\xa6\xe2\xca\xf4\x92\x72\x8a\x38\x87\x31\xf0\xba\x16\x59\x86\x5f\x52\x7f\x62\xfc\x8a\x78\x77\xf7\xae\xf7\x16\xe1\xd3\xfb\x5a\x98\xa3\xb5\x04\xa9\x22\x5a\xf4\x24\xd3\xae\x87\x08\x57\x6f\xd8\x72\x39\x92\x95\x0e\xa3\x8e\x9a\x9d\x1b\x78\x37\xdb\xd6\x73\x45\x3a\x6c\x7f\xc7\xa6\x26\x9f\x1f\xd7\xa0\xd7\xce\xd7\xb9\x9d\x97\xe4\x41\x14\x59\xea\x7a\x34\x3f\x28\x2d\x24\xa4\x81\x70\x17\xf4\xcf\x9a\xb6\x7f\x3b\x98\x88\xa6\xcd\x0d\x7f\xb3\x66\xdf\xe3\x07\x54\xed\xa6\x4d\x23\x88\x98\x40\x9f\x01\xbc\x77\x3d\xa3\x22\x58\xb5\xaf\x3e\x19\xa9\x1a\x96\x9f\x36\x8d\x2d\x30\xae\xcd\xe1\x07\x6e\xf2\xb2\xd7\x69\x0a\xe9\x9c\x5c\x43\x2e\xee\xf1\x1f\xef\x45\x6a\xb7\xdc\x08\x49\x7e\xe0\x4e\x54\xa1\x0f\xba\x61\xb1\xa0\x6d\xff\x15\xc6\x10\x03\x0f\x6a\xb9\xb3\x51\xed\x43\x91\x1d\x22\x6c\x49\x02\x73\x71\xb3\x53\x24\x07\xb9\x02\xbb\x43\xb9\x5c\xa5\x73\x6a\xec\x75\x01\x97\xd6\x51\xbb\xd1\x46\x85\x32\xdf\xcc\x82\x39\x11\x3c\xdb\xba\x93\x06\x92\xe4\x5e\x84\x41\x7f\xcc\xc8\x7e\x8b\x5a\x14\xc4\xf7\x8c\x0b\x3a\x31\x3d\xae\x29\x31\x39\x76\xca\x12\xff\xcf\xe8\x1d\x64\x2a\xde\xbc\x35\xe2\xd0\xc4\xa5\x67\xbc\xae\xe8\xa2\xe7\x95\xfe\x08\xa3\x3b\x49\x7f\x44\x97\x89\xe0\xdc\x14\x29\xe3\x3d\x36\x61\x04\xff\xc3\xd5\x90\x5b\xcc\x40\x1e\x4d\x84\x57\xa3\xce\x78\x60\x4c\x44\xb0\x87\xfe\x44\x9d\x7d\x57\x64\xd0\xd1\xbc\x1f\x1c\x3d\x87\x67\x35\x7e\x1f\x91\xc6\xb4\xb5\xb8\x83\xe7\xdd\xda\xdb\x9a\xae\x70\x37\x47\x74\x2e\xf6\x32\xea\x8e\x29\x89\x4f\x48\xdb\x9c\x1f\xbf\xb8\x19\x41\xf8\xae\x08\xe2\xc0\x09\x41\x97\xb1\xee\xd5\x59\x58\x00\x56\x90\x41\xa2\x85\x8c\xf5\xd9\xd2\x81\x88\x73\x30\xfa\x43\x7c\x2f\x44\xdc\xbb\xd0\xc7\x0f\xe0\x60\x72\x6e\xd7\x0e\x72\x54\xd4\xef\xcc\x13\x53\x9e\x31\x7f\xbf\xfd\xbc\xc1\xab\x04\x2b\x7b\x1e\xa7\xfc\xa7\x2b\xff\x71\x01\xaf\xbd\xbf\xe6\x56\x69\x49\x35\xac\xb6\xc3\x83\xab\x60\x92\x98\x3c\x88\x42\xdf\xb8\x1e\xce\x22\xbd\x9b\x4b\xd3\x06\xea\x5a\x2c\x7c\xfa\xde\xdd\x11\xc9\xf8\x6a\x6e\x2f\xe5\x4c\xe7\xf6\x32\x23\x0c\x0d\x24\xf9\x83\xbf\x7a\xa7\xd6\x13\xde\xc0\xf3\x81\x67\xdb\xc6\x49\xa3\xf8\xe2\xdd\x20\x56\x6f\xcc\xaa\xdf\x59\x1d\x92\x5e\x52\xca\x18\x98\x6a\xf0\xf8\x1c\xab\x5b\xbd\x40\x12\x95\x4f\x9f\xf2\x3e\x4e\x75\x63\x90\x11\x25\x21\x0a\x17\xf1\xe9\xe8\x9c\xb7\x46\x97\x9d\x30\xd1\x4b\x40\x0c\x22\xf6\xa7\x23\x48\xe8\xa6\x66\x2a\x03\xa7\x3c\x05\x55\xcf\xd2\xbb\xa6\x3c\xe2\x05\xca\x2d\x4f\x5e\x1f\x70\xf9\x97\x6a\x0b\xf0\xcd\xe3\xda\x56\x51\x42\x7f\x66\x17\x1b\xd7\x8f\x1c\xb8\xeb\xbb\xb5\xa1\x7e\x18\xba\x71\x8e\xf4\xd0\xe3\xb9\x2f\x18\xdf\x43\xbd\xc8\xe1\xe9\x70\x5c\x56\xea\x99\xce\xc6\xc2\x70\x66\x87\xd7\x10\xba\xe8\x3d\x65\x19\xbd\xcb\x60\x77\xd3\x25\x65\xd9\xa3\x59\x75\x02\xeb\x60\xd9\x0e\x81\xb9\xdf\x1d\x78\x1e\x10\xde\x71\x67\x46\x0a\x2b\x49\xd3\x0a\xc2\x8b\x3b\x05\xf2\x1e\xd2\xee\x4c\x79\x00\x65\x2d\x11\x96\x15\x0d\xeb\x24\xb0\x22\x4c\x57\x2b\x09\xab\x56\x51\xd8\x5f\x5c\xdc\x0a\xa4\x3a\x5c\x5b\x5f\x10\xd5\x22\x0c\x33\x19\x82\x91\x56\xed\xb6\xbe\x1c\xbf\x4b\xec\xc7\x0d\xaf\x2b\x4d\xb3\x6c\xc8\x4a\x4b\xdf\xfa\x52\x75\x04\x2e\xc2\x20\x26\xd8\x62\xbc\x51\x3f\x0f\xbf\xa6\x40\xd3\x8c\xf1\x92\x15\x7b\xc3\xe8\x2d\xce\x64\x21\x41\xb5\x69\x1a\x8c\x46\x2d\xf9\xb5\x64\x54\x02\xc4\xc3\x5a\x28\x70\x1a\xda\xd6\x2d\x77\xa7\x6c\x85\x4d\xf3\x6d\xee\x2d\x49\x45\x79\x2b\x6a\x4d\x0a\x74\x45\x19\xb7\xdf\xe9\xc6\xe2\xb9\x29\x52\x15\x79\x45\xf9\x72\x50\xb8\xd7\x71\x31\xeb\xa1\xba\xcb\xcb\x8c\x04\x53\xd3\x2c\xfc\x15\x19\x27\x22\x9d\xd7\x59\x46\x7e\x8d\x0c\xb9\xaf\x62\xff\xc6\xe5\xe7\xb6\xee\x5f\xca\x8d\x6a\xc3\xe4\xbc\x0c\xb0\xee\xfd\x85\x8c\xae\xe8\x16\x9a\x32\x4e\x1e\xe8\xbd\x05\xc3\x3b\x84\xe2\xdb\xda\x3e\xa5\xf2\x51\x9b\xd6\x51\x08\x51\x12\xc7\xb8\xa9\x16\xde\x9b\x05\x34\x5a\x23\xd1\xcd\x53\x2d\x73\xbd\x81\x7d\xa2\xb0\x67\x4d\x0e\x73\x40\xdf\xa6\x62\x6d\x9b\x66\x11\x35\x8c\xce\x8e\x63\x12\xa5\x79\xe9\xb9\x4a\xa1\x1a\x51\x22\xb4\xb1\xa4\xe7\xa8\xdc\x89\x27\x74\xa7\x9b\x0c\x8f\xcd\x2c\x2a\xca\x5b\xcd\x2d\x2a\x8f\x5f\x52\x76\x51\x61\xab\xc5\xe7\x23\x32\x8c\x88\x59\xc5\x49\xee\xe4\xad\x31\xcb\xbd\x16\xd0\x22\xaa\xc2\xc4\xce\x68\x39\x9a\x92\x8e\xe2\x69\x7f\x30\xad\x99\x5e\xd5\xe4\xbd\xc3\xbc\x75\x0e\xf3\x91\x83\xb6\xfc\x6f\x0b\x84\xfa\x88\x79\x8a\xd0\xd5\xc7\x8e\x87\x66\x6c\x5c\xd0\x1a\x0e\x79\xed\x61\xce\x87\x70\x53\xcd\x40\xa2\x43\xf9\x5b\x42\xf8\x67\x6b\x0f\x90\x5d\x94\x79\x63\xf2\x54\xaf\xfd\x73\xf7\x77\xbd\x04\xab\xe6\xee\x8e\xbc\xfa\xe3\xb9\xbb\x27\xa6\xfe\xb4\x31\x8c\x90\xd1\x2e\x2b\xad\x34\x95\x2b\x38\xcc\xde\x50\x6b\xa5\xf6\x9f\x7e\x9e\x30\xf0\x73\xe7\x3b\x30\x0f\xe1\x73\x02\x17\xab\x8b\xba\xea\xd6\x8e\xac\xd8\x83\x96\xfb\x12\x63\xdf\x26\x89\x64\x1a\x24\xa3\x36\x13\xb1\xde\xd3\x47\xaf\x4d\x05\x14\xcb\x3a\xc5\x95\x01\xe0\xfe\x50\xdb\x66\x4d\x4f\x2e\x2f\x92\x6c\xb5\x02\x09\x69\x7d\x58\x17\x55\x30\xbe\xca\x5a\x44\x56\x46\xf1\xbf\xc4\x32\xb6\x6e\x83\x8c\xd0\xe6\x73\x35\x47\x60\x63\x44\xf3\x8c\xae\x90\xe8\xbc\x50\xda\x24\x92\x5b\x4c\x2a\xfd\x05\xdd\x9d\x32\x4b\x99\x32\x65\xc9\x43\x45\x03\x11\xd2\x43\x4a\x67\xa5\x2a\x96\x75\x62\x50\xe5\x4a\x2a\x7c\x15\xd4\x30\x53\xe9\xf7\xb1\xcb\xa9\xd7\x05\xe7\x66\x29\xf5\x06\xbf\x0b\x09\x29\x6a\xb7\x24\xdf\x1a\x20\xab\xbc\xdc\x3a\xd2\x31\x6a\x92\x76\x3b\x84\xd8\x14\x84\x4b\xd4\x9f\x63\x5c\xab\xd4\xb8\xba\x10\x86\xad\xf4\x1d\xc9\xf1\x3a\xe5\x7c\xca\xe5\x19\xc7\xd9\x63\xbb\x0b\xee\xeb\x25\x45\xa4\x81\xa9\xfa\xae\x28\x73\x9a\xd1\xf7\x13\x21\xb5\xeb\xdc\xa6\x53\xb0\xa6\xe2\xe1\xb6\x57\x73\xb2\xd3\x7f\x87\x05\xf8\x52\xc8\x04\xcf\x0c\x2f\xdd\x59\xa4\xab\x59\xb7\x08\x72\xfa\xf9\xb6\x19\xa3\xdd\x6e\x40\xde\x7a\x2f\xb4\x98\x35\x45\xd3\xb6\x14\x3f\xa9\x8c\xeb\x7f\x7f\xb5\xbb\xeb\x76\x1d\x73\x7c\xc7\x61\xe1\xd2\x10\xdb\x18\xe6\x71\x5d\x6f\xe8\x36\x13\x34\xbd\xbd\xdb\x6a\x50\xfb\x77\x15\x99\xc9\x9c\x7e\x66\x79\x91\x13\xc5\xfe\x1e\x4e\x54\x98\x6d\x2b\xc0\xf1\xcc\x53\x4a\xdc\xd0\xf8\x1b\x6d\x42\x8c\xe1\xa9\x3c\x0a\xfb\x4e\x43\xde\xa3\x45\xb1\xb9\x6e\xae\x25\x74\x18\x69\x8b\x6c\x7c\xcf\x93\x1b\x82\x13\x61\xf3\xed\xc6\xf1\x8e\xc6\x44\x1c\x56\x7c\xdd\xe8\x3b\x8f\x9a\x06\x29\x78\x0a\xfe\xfa\x1e\xc1\x4d\xd4\x8c\x16\x92\x88\x82\x7b\x38\x2e\x05\x3a\x52\x98\x83\xf6\x46\xfd\x5c\xb5\xf5\x5d\x78\x51\x03\x88\xb3\x56\xc2\xb8\x5f\x0e\xda\x37\x60\x5d\x99\xca\x11\x2d\x19\xcf\x33\x9e\x67\xda\x46\xe9\x37\xf6\x13\x07\x7b\xe8\xf6\x27\xd8\xee\x9c\x8d\x88\x4a\x79\xe1\xce\x2b\xca\xec\x55\xdb\x2e\x31\xdd\xd3\xac\x08\xda\xbf\x92\xa2\xd8\xcc\x09\xe4\x1b\xbd\x25\x2c\x0e\xc8\x7e\xe5\x37\xd4\x58\x4c\x3f\xb3\xce\xc0\xe7\x49\xcd\x82\xf1\x24\x2b\x52\x7f\x17\xf1\x0e\x03\x19\x9f\x27\x1f\x84\xca\x32\x52\xf2\x19\xee\xd2\xce\x00\x93\xa1\x1c\xe5\xa2\xf2\x7d\x12\xf4\x43\xd3\xe8\x47\x1e\x40\xe5\x98\xb5\x81\x83\x11\x39\x64\xcd\x60\x10\xed\x88\x9a\x7c\xf5\x5c\xb4\x77\xa8\x22\x17\xfa\x56\xc2\xc6\xec\xc6\x78\x2e\x52\x6c\xc5\xc7\xd5\xe8\x1e\xa8\x22\x1c\x8f\x28\x12\x4f\x86\x3f\x95\x5d\x66\x4e\x51\x10\x1b\x0b\x60\x43\xfc\x89\xc1\x9f\xdb\xbb\xdd\x50\x67\x30\x67\x67\xab\x16\x16\xf5\xa1\x77\x27\x52\x9f\xcd\x3a\xfd\xc1\x41\xdc\xc6\x8e\x81\xeb\x3f\x7f\xcb\x32\x0d\xb2\x47\xf6\x35\x45\x78\x8d\x72\x2b\x12\x5d\xe0\xb2\xc3\xd2\xbc\x1a\xd3\x88\x79\x3b\x1a\xb3\x5b\x06\x40\x99\x10\xdb\x04\xda\x39\x80\xb6\x35\x30\xbf\xa7\x9e\x49\x53\xa2\xab\x80\x87\x82\x3e\x15\xe8\x8a\x8b\x1b\xb3\x16\x51\x66\x47\x9a\xf3\x52\x4d\xf2\x5b\xa1\x84\x39\x4f\x3d\x60\xa2\x3a\xdd\x65\x6f\x36\xd2\x25\x29\xca\xb7\xee\xb5\xbc\x5f\x19\xa2\x5c\xef\x4b\x72\xad\x56\xf5\x7c\xe4\x76\x6d\x58\xdd\x49\x6f\x4d\x71\x1c\xd9\xb6\x33\xbf\xce\xee\xf7\x0f\x75\x31\xd5\x4f\x6d\x9f\xa5\x99\x2d\x8f\x37\xae\x2e\x71\x5d\x12\x52\x5a\x78\x22\xb8\xdd\x5f\x7b\x28\xd6\xca\x0e\x3d\x7b\x0e\x77\xfb\x98\xfb\x7f\xde\xae\xa5\xb7\x71\x1b\x08\xdf\xf7\x57\xf0\x52\x78\x77\x21\xbb\xe9\xd5\x48\x16\x58\x74\x37\x40\x80\x6e\xfa\x08\x8a\x1e\x2d\xca\xa6\x12\xc2\x12\xe9\x25\xa9\x4d\xfc\xef\x8b\x19\xbe\x25\x59\x7e\x34\xcd\x29\x0e\x4d\x72\x38\xe4\xcc\x48\xf3\xe0\xe7\x8b\x98\xfb\xd5\x53\x1a\x67\xcc\x07\x59\xea\xc4\x7c\x1c\x3c\xdd\x43\x71\x8c\x83\x8c\xe7\x27\xe4\xa9\x61\x8e\x5c\x25\xb7\x4e\xb3\x89\xfc\x92\x2a\x56\x4b\xc5\xde\x6c\x4d\x96\xdc\x70\x39\x21\x72\xf4\x26\x3b\xe4\xa8\x4d\xef\x90\x5f\xd2\x1b\xed\x90\x5f\xd3\xd8\x0e\x1d\x52\x9d\x89\x27\xd1\xa5\x6e\x0c\x8c\xa2\xa3\x89\xc3\x01\xbf\x19\x4f\x77\xa2\x20\xf7\xd2\xc0\x9f\xaf\x88\xc4\x0b\xb2\xf7\x45\x32\x7d\x2f\x0d\x36\x8c\x59\x43\x28\xd1\x81\x1f\xf9\x0d\x66\xc8\x87\x99\x9c\xb6\xa2\x7b\x84\xf6\x15\xa7\x0e\xb4\x6d\xfb\x05\x46\x22\x4e\x18\x48\x16\xde\x72\x58\xcf\xe0\x4e\x60\x51\x36\xd2\xc3\x4f\xd6\x1d\x83\xaf\x1c\x5f\xd0\x98\x32\x36\x6d\x34\x46\xf6\xce\xd3\x8b\x1d\xe7\x64\xcb\xe2\xba\xe7\xe1\x10\x7c\x96\x76\x60\x5a\xce\x3c\xf8\x4b\x2a\x71\x40\x48\xa1\xdf\xc0\x96\xba\x64\xd2\x67\xfb\xf2\x5f\x90\xcf\xc1\x67\x81\x13\x57\xbc\x4e\x83\xd1\xc3\xb2\x82\x93\x68\xab\x8e\x15\xe4\x96\x36\x9a\x81\x18\xfd\x2d\xb6\x42\x3e\x8b\x89\xed\x83\x49\x93\x7f\x03\xac\x47\xc4\xdb\xb3\xbd\x63\x56\x09\x9e\xdc\xcb\x98\x04\xe3\x62\x09\x17\xbb\x9e\xde\x8d\xad\x26\x24\x95\x14\x5b\x4b\xb5\xe9\xaf\x23\xcd\x88\xf7\xa1\xc4\x06\xdc\x3a\x4f\x28\x5f\x46\xee\x1e\x1d\x5b\x8b\xeb\xed\x0f\xa6\xaf\x54\xfe\x26\xea\xf9\xcb\x4c\x1d\x9e\x8d\xda\xff\xd5\x85\x44\x97\x5d\xa6\x6d\x73\x4d\xb0\xca\xef\x1d\x53\xfb\xb1\x65\x26\x99\xd7\x7f\x00\xa7\x4a\x33\xe3\xa3\x68\xf6\x36\x28\xd7\x04\x61\xf3\xec\x83\xca\x81\x54\x6d\x78\xed\xd2\xc4\xa4\x62\xe6\x19\xf0\x18\x4e\xb8\x28\xea\x47\xbb\xa9\xc1\x1f\x15\x0c\x0c\x89\x87\xce\x2a\x82\x9d\xd9\xc1\xce\x69\x70\x3c\xf1\x05\xcc\xde\x5e\x9c\xdc\x92\x7e\x21\xa2\x2b\x4b\x5b\x92\x1a\x84\x73\xb0\xc5\xb1\x15\xc1\x3f\xbe\x59\xec\x8f\x7c\x17\xd3\x6f\xce\xdc\xcb\x7e\xbc\x34\x43\x18\x71\x8e\x00\xaa\xa5\xee\x83\x8c\x70\x84\x1e\xe9\x35\x83\x63\x61\x1f\xcc\x9a\x24\xe5\xa1\x36\x50\xa2\x27\xf7\x25\xd3\xdf\x51\xfe\x65\xe2\x6d\x38\xc6\x65\x0c\xe6\x5e\x26\x3d\x3d\x7e\xe9\xd6\x15\xfb\xc9\x67\xc1\x94\x7e\xe2\x3b\xbf\x31\x3e\x32\x11\x59\x0d\x5e\x12\xf4\x45\xb8\x15\x09\x25\xa4\x81\x50\x36\x35\x94\xf0\x69\xc3\x28\x2a\x3d\x84\x3c\x40\x62\x40\x88\xec\xef\x54\xd5\x0d\x5f\x9b\xc9\xed\xb9\x58\x6c\x52\x60\x3b\xbb\x6b\x09\xc6\xda\xe4\xa6\xfd\x41\x1f\xf3\xb8\x03\x98\x2a\x44\x1a\xb1\x50\x71\x69\x03\x7b\x81\xe4\xa9\x4e\x20\x60\x81\x0a\x49\xea\x22\x8f\x1b\x88\x8c\xad\x5f\x42\x53\xcb\x05\x64\x21\x62\xd3\x18\x97\x69\xb5\xa5\xe5\x32\x21\x3d\xc9\xe5\x37\x97\xe4\xe8\x33\xaa\x21\x24\x65\x15\xfe\x42\x0e\xae\xae\x86\x3c\x5c\x4d\xf0\xd0\xc7\x03\xb4\x7c\xf8\xd6\x53\x78\x49\x20\x6a\x1c\x38\x8d\x45\x05\x0c\x90\x7f\xd5\x9e\x50\xb2\x03\x7b\x2e\x3b\x6d\x4f\xee\x30\x62\x20\x37\xda\xd6\x5e\x83\xe7\x62\xd5\x05\x0f\x35\x3c\x02\x09\x8f\xce\x1b\x7f\x14\x52\x25\x96\x77\x80\x01\xe8\x5f\xc4\x74\xb7\x73\x71\x29\x14\x7f\x6e\xfe\x9b\x55\xc8\xeb\x4c\xdc\xd1\x63\xdb\x81\x0d\x1b\x9b\xe4\xb0\xb5\x78\x70\xf2\x0c\xea\x3e\x28\x4b\x59\xa0\xf9\xd4\x7b\x61\xe8\x0b\x08\x0e\x62\xf8\x8c\xee\x8e\xe6\x2d\x6f\xa8\xf2\x45\x0c\xe9\x10\x46\x56\xcf\x80\xb9\xb8\x22\xeb\x06\x8b\xaf\x21\x99\x26\xc8\xc3\x9f\xbf\xe1\xdd\x2c\x7c\x39\x2b\xc2\x44\x9d\xf6\xc1\xf1\x2c\x50\x00\xd0\xb5\x84\x1a\xa3\x78\xd5\x81\xed\xfd\x99\xac\x65\xd3\xb5\x22\xef\x45\xd7\x98\x4a\x5a\x90\x30\xdd\xad\x54\x84\xbd\x50\x48\x8b\x17\xe0\xd0\xe2\xa9\x39\xc1\x57\x9c\xfd\x60\x21\x40\xe4\xc6\x3a\x0c\x34\x4a\x3a\xcd\x54\x06\x22\xa5\x0d\x55\x26\x58\xb5\xb2\xdd\x97\xcb\x77\xe1\xcb\xb2\x2c\xf5\xf7\x26\xfc\xeb\x07\x93\x86\x6f\x19\x99\xb5\xfb\x9f\xa2\x67\x5b\x96\x65\x1c\x37\x56\x0b\xb4\xa6\x00\xaa\xa4\xf3\x78\x31\xc8\x78\x93\x5d\xa7\x58\x5c\xc0\xa4\xee\xaa\x20\x06\x20\xfb\x15\x83\x72\xb6\x6a\x4f\xca\x5a\xca\x9b\x8a\xaa\xb2\x38\xc8\x53\x3a\x76\x85\x43\xf5\x62\xcb\xf6\xe4\x86\xcc\x6a\x29\x67\xa8\x18\x63\x7d\xd0\x81\x80\x5e\x15\x55\xb3\x74\xf2\x48\xe9\xce\xdd\xb9\x49\x24\x4b\xcc\xf0\xe6\xc1\x0f\x8e\x58\x0a\x52\xf9\xec\x8e\x9d\x8d\x6b\x9b\xf3\x41\xa5\x8d\xcf\x8c\xc1\x59\x86\x0b\x1e\x70\x20\x08\x59\xb5\x63\xaa\xe5\xda\xc3\x8e\x68\x06\x1e\x55\xd3\x90\x2a\x9e\xb3\xb7\x25\x8b\x53\xf5\xd6\x59\x82\x5c\x45\x5d\xe3\xff\xa0\xa3\x38\x33\x3c\x8d\x5f\x5b\x4b\xfd\xc4\xa7\x29\x6a\xd5\x99\xb3\x95\x55\xd6\xe9\xf1\x9c\x2b\xc0\xe1\x54\x13\x34\x56\xaf\x68\x27\xa8\x22\xd5\xeb\x71\xe9\xfb\x5d\x5d\x46\x93\xac\xa8\xd8\xac\x48\xcd\x95\x36\x2e\x97\x7f\xca\x22\x0a\x3b\xe2\x7e\x72\x4d\xaf\xa5\x11\x42\x12\xf6\x02\x08\xe0\xdc\x81\xd8\xc2\x81\x39\x89\xf7\xc6\xe5\x64\x41\xc7\x17\x3e\xdd\x7b\x43\xc5\xb6\xd7\x11\xf3\xce\xbd\x88\xc3\x1b\x63\xdb\xd2\xb9\x66\xf0\xac\x01\x9b\xe7\x7f\xe6\xc6\xae\xc0\xe5\xd2\xfa\x8a\x4a\xc8\xad\xfd\x5a\xd6\x44\x77\xd5\x3c\xa4\x12\x20\x76\x8c\xef\xa5\x18\xec\xd0\x60\xda\xc9\x75\xf8\xf6\xd3\xe2\x1a\xa7\xfd\x04\xf7\x83\xb0\x3e\x28\x4e\x78\xad\x8d\xef\xf4\x91\xb4\x8c\x0a\x9b\x50\xc0\xfe\xfe\x7a\x85\x9b\x26\x8c\xf9\x6a\x2d\xf1\xd2\x9a\x65\x80\x6d\x7f\x48\xac\x22\xac\xfd\x91\x19\xc2\x37\x05\x62\x41\x17\x70\xb3\x4f\xbc\xe7\x1b\x5c\x23\x14\x31\x7c\xc0\x4f\x2e\xc4\xfc\x3e\x90\xd3\x1f\xa2\x74\x80\xa8\xf8\xcf\x72\xdd\x02\xec\x7d\x66\x7a\x35\x99\xcf\xa3\xe8\xd8\xe1\x37\x7c\x53\x20\x41\xa0\xb7\xe0\x1b\xfb\x17\x08\x16\xce\x50\x7f\xcc\x47\xb1\x70\x45\xe3\x26\x71\x83\x53\xe2\x47\x04\xe6\x89\xd1\x4d\x08\x23\x84\xe2\xab\xbb\x2f\xcb\x23\x72\x90\x57\xa4\xf6\x0a\xf8\x8c\xa2\xeb\xad\xce\x1c\xe3\x4e\x18\xee\xec\x3e\x64\x00\x9d\x58\x83\x7f\x46\x14\x62\xf0\x60\xf7\x30\x7d\xcf\x29\x2e\x7a\x54\x12\x2f\x18\x94\xfd\xc8\x4f\x9c\x2c\x4e\xd9\x8a\x7f\x07\x00\x14\x91\xfb\xfc\x1e\x17\x01\x00")
 
 func openapiYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -92,7 +92,7 @@ func openapiYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "openapi.yaml", size: 16304, mode: os.FileMode(493), modTime: time.Unix(1765460770, 0)}
+	info := bindataFileInfo{name: "openapi.yaml", size: 71454, mode: os.FileMode(493), modTime: time.Unix(1792319636, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,31 @@
+package drift
+
+import (
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	workv1 "open-cluster-management.io/api/work/v1"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/agent/codec"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+// Codec is the manifest bundle codec of the drift controller, it marks the status events that it encodes as
+// drift reports, so that the server merges them into the status reported by the work agent.
+type Codec struct {
+	*codec.ManifestBundleCodec
+}
+
+func NewCodec() *Codec {
+	return &Codec{ManifestBundleCodec: codec.NewManifestBundleCodec()}
+}
+
+func (c *Codec) Encode(source string, eventType types.CloudEventsType, work *workv1.ManifestWork) (*cloudevents.Event, error) {
+	evt, err := c.ManifestBundleCodec.Encode(source, eventType, work)
+	if err != nil {
+		return nil, err
+	}
+	if eventType.SubResource == types.SubResourceStatus {
+		evt.SetExtension(api.ExtensionDriftReport, true)
+	}
+	return evt, nil
+}
@@ -0,0 +1,250 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+	workv1client "open-cluster-management.io/api/client/work/clientset/versioned/typed/work/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+// maxReportedPaths is the max number of the drifted field paths in the message of a Drifted condition.
+const maxReportedPaths = 10
+
+// Controller periodically compares the live objects of the manifest works applied by the work agent with their
+// manifests, e.g. to find the objects edited by kubectl, and reports the drift as the Drifted conditions of the
+// manifest works. The drifted objects are re-applied if the drift policy of their manifest work is Reapply.
+//
+// The manifest works are read from and the Drifted conditions are reported to the server by a separate cloudevents
+// client of the agent, its status updates are drift reports that only carry the Drifted conditions.
+type Controller struct {
+	works    workv1client.ManifestWorkInterface
+	client   dynamic.Interface
+	mapper   meta.RESTMapper
+	policy   api.DriftPolicy
+	interval time.Duration
+}
+
+func NewController(works workv1client.ManifestWorkInterface, client dynamic.Interface, mapper meta.RESTMapper,
+	policy api.DriftPolicy, interval time.Duration) *Controller {
+	return &Controller{
+		works:    works,
+		client:   client,
+		mapper:   mapper,
+		policy:   policy,
+		interval: interval,
+	}
+}
+
+func (c *Controller) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting drift controller", "policy", c.policy, "interval", c.interval)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.sync(ctx); err != nil {
+			logger.Error(err, "Failed to sync the drift of manifest works")
+		}
+	}, c.interval)
+
+	logger.Info("Shutting down drift controller")
+}
+
+func (c *Controller) sync(ctx context.Context) error {
+	works, err := c.works.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for i := range works.Items {
+		work := &works.Items[i]
+		if !work.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := c.syncWork(ctx, work); err != nil {
+			errs = append(errs, fmt.Errorf("failed to sync the drift of manifest work %s: %v", work.Name, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// syncWork detects the drift of the live objects of a manifest work and reports the Drifted conditions if they
+// are changed.
+func (c *Controller) syncWork(ctx context.Context, work *workv1.ManifestWork) error {
+	policy := c.policyOf(ctx, work)
+
+	status := work.Status.DeepCopy()
+	manifests := []workv1.ManifestCondition{}
+	drifted := []string{}
+	reapplied := false
+	for i, manifest := range work.Spec.Workload.Manifests {
+		desired := &unstructured.Unstructured{}
+		if err := desired.UnmarshalJSON(manifest.Raw); err != nil {
+			return fmt.Errorf("failed to decode manifest %d: %v", i, err)
+		}
+
+		resourceMeta, paths, err := c.detect(ctx, i, desired, policy)
+		if err != nil {
+			return err
+		}
+		if resourceMeta == nil {
+			// the object is not applied yet or is deleted, the work agent reports it
+			continue
+		}
+
+		condition := metav1.Condition{
+			Type:               api.DriftedCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             api.NoDriftReason,
+			Message:            "The live object matches the manifest",
+			ObservedGeneration: work.Generation,
+		}
+		if len(paths) != 0 {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = api.DriftDetectedReason
+			condition.Message = fmt.Sprintf("The fields of the live object diverge from the manifest: %s", formatPaths(paths))
+			if policy == api.DriftPolicyReapply {
+				condition.Reason = api.DriftReappliedReason
+				condition.Message = fmt.Sprintf("The diverged fields of the live object are re-applied: %s", formatPaths(paths))
+				reapplied = true
+			}
+			drifted = append(drifted, fmt.Sprintf("%s %s", resourceMeta.Kind, objectKey(desired)))
+		}
+
+		manifestCondition := findManifest(status.ResourceStatus.Manifests, *resourceMeta)
+		meta.SetStatusCondition(&manifestCondition.Conditions, condition)
+		manifests = append(manifests, manifestCondition)
+	}
+
+	condition := metav1.Condition{
+		Type:               api.DriftedCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             api.NoDriftReason,
+		Message:            "The live objects match the manifests",
+		ObservedGeneration: work.Generation,
+	}
+	if len(drifted) != 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = api.DriftDetectedReason
+		if reapplied {
+			condition.Reason = api.DriftReappliedReason
+		}
+		condition.Message = fmt.Sprintf("The live objects diverge from the manifests: %s", formatPaths(drifted))
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	status.ResourceStatus.Manifests = manifests
+
+	if equality.Semantic.DeepEqual(*status, work.Status) {
+		return nil
+	}
+
+	if len(drifted) != 0 {
+		klog.FromContext(ctx).Info("Manifest work is drifted", "manifestWork", work.Name, "policy", policy, "objects", drifted)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		return err
+	}
+	_, err = c.works.Patch(ctx, work.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	return err
+}
+
+// detect returns the resource meta of a manifest and the paths of its drifted fields, the drifted fields are
+// re-applied if the policy is Reapply. A nil resource meta is returned if the live object does not exist.
+func (c *Controller) detect(ctx context.Context, ordinal int, desired *unstructured.Unstructured,
+	policy api.DriftPolicy) (*workv1.ManifestResourceMeta, []string, error) {
+	gvk := desired.GroupVersionKind()
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find the resource of %s: %v", gvk, err)
+	}
+
+	var client dynamic.ResourceInterface = c.client.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		client = c.client.Resource(mapping.Resource).Namespace(desired.GetNamespace())
+	}
+
+	live, err := client.Get(ctx, desired.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resourceMeta := &workv1.ManifestResourceMeta{
+		Ordinal:   int32(ordinal),
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Resource:  mapping.Resource.Resource,
+		Name:      desired.GetName(),
+		Namespace: desired.GetNamespace(),
+	}
+
+	paths := Diff(desired.Object, live.Object)
+	if len(paths) == 0 || policy != api.DriftPolicyReapply {
+		return resourceMeta, paths, nil
+	}
+
+	Merge(live.Object, desired.Object)
+	if _, err := client.Update(ctx, live, metav1.UpdateOptions{}); err != nil {
+		return nil, nil, fmt.Errorf("failed to re-apply %s %s: %v", gvk.Kind, objectKey(desired), err)
+	}
+	return resourceMeta, paths, nil
+}
+
+// policyOf returns the drift policy of a manifest work, the policy of the controller is overridden by the drift
+// policy annotation of the manifest work.
+func (c *Controller) policyOf(ctx context.Context, work *workv1.ManifestWork) api.DriftPolicy {
+	annotation, ok := work.Annotations[api.DriftPolicyAnnotation]
+	if !ok {
+		return c.policy
+	}
+	policy, err := api.ParseDriftPolicy(annotation)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "Ignoring the drift policy of manifest work", "manifestWork", work.Name)
+		return c.policy
+	}
+	return policy
+}
+
+// findManifest returns the reported condition of a manifest, a new condition is returned if it is not reported.
+func findManifest(manifests []workv1.ManifestCondition, resourceMeta workv1.ManifestResourceMeta) workv1.ManifestCondition {
+	for _, manifest := range manifests {
+		if manifest.ResourceMeta == resourceMeta {
+			return manifest
+		}
+	}
+	return workv1.ManifestCondition{ResourceMeta: resourceMeta}
+}
+
+func formatPaths(paths []string) string {
+	if len(paths) > maxReportedPaths {
+		return fmt.Sprintf("%s and %d more", strings.Join(paths[:maxReportedPaths], ", "), len(paths)-maxReportedPaths)
+	}
+	return strings.Join(paths, ", ")
+}
+
+func objectKey(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
@@ -0,0 +1,187 @@
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	workfake "open-cluster-management.io/api/client/work/clientset/versioned/fake"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+func TestControllerSync(t *testing.T) {
+	cases := []struct {
+		name              string
+		policy            api.DriftPolicy
+		annotations       map[string]string
+		liveData          string
+		expectedStatus    metav1.ConditionStatus
+		expectedReason    string
+		expectedLiveValue string
+	}{
+		{
+			name:              "no drift",
+			policy:            api.DriftPolicyAlert,
+			liveData:          "v1",
+			expectedStatus:    metav1.ConditionFalse,
+			expectedReason:    api.NoDriftReason,
+			expectedLiveValue: "v1",
+		},
+		{
+			name:              "drift is reported",
+			policy:            api.DriftPolicyAlert,
+			liveData:          "edited",
+			expectedStatus:    metav1.ConditionTrue,
+			expectedReason:    api.DriftDetectedReason,
+			expectedLiveValue: "edited",
+		},
+		{
+			name:              "drift is re-applied",
+			policy:            api.DriftPolicyReapply,
+			liveData:          "edited",
+			expectedStatus:    metav1.ConditionTrue,
+			expectedReason:    api.DriftReappliedReason,
+			expectedLiveValue: "v1",
+		},
+		{
+			name:              "drift policy is overridden by the manifest work",
+			policy:            api.DriftPolicyAlert,
+			annotations:       map[string]string{api.DriftPolicyAnnotation: string(api.DriftPolicyReapply)},
+			liveData:          "edited",
+			expectedStatus:    metav1.ConditionTrue,
+			expectedReason:    api.DriftReappliedReason,
+			expectedLiveValue: "v1",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			work := newManifestWork(t, c.annotations, newConfigMap("v1"))
+			workClient := workfake.NewSimpleClientset(work)
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				&unstructured.Unstructured{Object: newConfigMap(c.liveData)})
+
+			controller := NewController(workClient.WorkV1().ManifestWorks("cluster1"), dynamicClient, newRESTMapper(),
+				c.policy, time.Minute)
+			if err := controller.sync(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			synced, err := workClient.WorkV1().ManifestWorks("cluster1").Get(ctx, work.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			condition := meta.FindStatusCondition(synced.Status.Conditions, api.DriftedCondition)
+			if condition == nil || condition.Status != c.expectedStatus || condition.Reason != c.expectedReason {
+				t.Errorf("expected drifted condition %s/%s but got: %v", c.expectedStatus, c.expectedReason, condition)
+			}
+			if len(synced.Status.ResourceStatus.Manifests) != 1 {
+				t.Fatalf("expected drifted condition of 1 manifest but got: %v", synced.Status.ResourceStatus.Manifests)
+			}
+			manifest := synced.Status.ResourceStatus.Manifests[0]
+			if manifest.ResourceMeta.Resource != "configmaps" || manifest.ResourceMeta.Name != "nginx" {
+				t.Errorf("unexpected manifest resource meta: %v", manifest.ResourceMeta)
+			}
+			if !meta.IsStatusConditionPresentAndEqual(manifest.Conditions, api.DriftedCondition, c.expectedStatus) {
+				t.Errorf("expected drifted condition %s of the manifest but got: %v", c.expectedStatus, manifest.Conditions)
+			}
+
+			live, err := dynamicClient.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).
+				Namespace("default").Get(ctx, "nginx", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			value, _, _ := unstructured.NestedString(live.Object, "data", "version")
+			if value != c.expectedLiveValue {
+				t.Errorf("expected live value %s but got: %s", c.expectedLiveValue, value)
+			}
+
+			// the unchanged drift is not reported again
+			workClient.ClearActions()
+			if err := controller.sync(ctx); err != nil {
+				t.Fatal(err)
+			}
+			for _, action := range workClient.Actions() {
+				if action.GetVerb() == "patch" && c.expectedReason != api.DriftReappliedReason {
+					t.Errorf("expected no status patch but got: %v", action)
+				}
+			}
+		})
+	}
+}
+
+func TestControllerSkipsMissingObjects(t *testing.T) {
+	ctx := context.Background()
+
+	work := newManifestWork(t, nil, newConfigMap("v1"))
+	workClient := workfake.NewSimpleClientset(work)
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	controller := NewController(workClient.WorkV1().ManifestWorks("cluster1"), dynamicClient, newRESTMapper(),
+		api.DriftPolicyReapply, time.Minute)
+	if err := controller.sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	synced, err := workClient.WorkV1().ManifestWorks("cluster1").Get(ctx, work.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !meta.IsStatusConditionFalse(synced.Status.Conditions, api.DriftedCondition) {
+		t.Errorf("expected not drifted condition but got: %v", synced.Status.Conditions)
+	}
+	if len(synced.Status.ResourceStatus.Manifests) != 0 {
+		t.Errorf("expected no manifest conditions but got: %v", synced.Status.ResourceStatus.Manifests)
+	}
+}
+
+func newManifestWork(t *testing.T, annotations map[string]string, manifests ...map[string]interface{}) *workv1.ManifestWork {
+	work := &workv1.ManifestWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "work1",
+			Namespace:   "cluster1",
+			Generation:  1,
+			Annotations: annotations,
+		},
+	}
+	for _, manifest := range manifests {
+		raw, err := json.Marshal(manifest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		work.Spec.Workload.Manifests = append(work.Spec.Workload.Manifests,
+			workv1.Manifest{RawExtension: runtime.RawExtension{Raw: raw}})
+	}
+	return work
+}
+
+func newConfigMap(version string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+		},
+		"data": map[string]interface{}{
+			"version": version,
+		},
+	}
+}
+
+func newRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	return mapper
+}
@@ -0,0 +1,136 @@
+package drift
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// metadataFields are the metadata fields of a manifest that are owned by the manifest, the other metadata fields
+// are managed by the API server or by the controllers of the cluster.
+var metadataFields = map[string]bool{"labels": true, "annotations": true}
+
+// Diff returns the paths of the fields of a manifest whose values in the live object diverge from the manifest.
+// Only the fields set in the manifest are compared, so the fields defaulted by the API server or set by the
+// other controllers are not drift. The status and the server managed metadata are ignored.
+func Diff(desired, live map[string]interface{}) []string {
+	paths := []string{}
+	for _, key := range sortedKeys(desired) {
+		switch key {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			desiredMeta, _ := desired[key].(map[string]interface{})
+			liveMeta, _ := live[key].(map[string]interface{})
+			for _, field := range sortedKeys(desiredMeta) {
+				if metadataFields[field] {
+					paths = diff(paths, "metadata."+field, desiredMeta[field], liveMeta[field])
+				}
+			}
+		default:
+			paths = diff(paths, key, desired[key], live[key])
+		}
+	}
+	return paths
+}
+
+func diff(paths []string, path string, desired, live interface{}) []string {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		liveValue, ok := live.(map[string]interface{})
+		if !ok {
+			return append(paths, path)
+		}
+		for _, key := range sortedKeys(desiredValue) {
+			paths = diff(paths, path+"."+key, desiredValue[key], liveValue[key])
+		}
+		return paths
+	case []interface{}:
+		liveValue, ok := live.([]interface{})
+		if !ok || len(liveValue) != len(desiredValue) {
+			return append(paths, path)
+		}
+		for i := range desiredValue {
+			paths = diff(paths, fmt.Sprintf("%s[%d]", path, i), desiredValue[i], liveValue[i])
+		}
+		return paths
+	}
+
+	if !equalValue(desired, live) {
+		return append(paths, path)
+	}
+	return paths
+}
+
+// Merge sets the fields of a manifest to the live object, the other fields of the live object are kept.
+func Merge(live, desired map[string]interface{}) {
+	for key, value := range desired {
+		switch key {
+		case "status":
+			continue
+		case "metadata":
+			desiredMeta, _ := value.(map[string]interface{})
+			liveMeta, ok := live[key].(map[string]interface{})
+			if !ok {
+				liveMeta = map[string]interface{}{}
+				live[key] = liveMeta
+			}
+			for field := range metadataFields {
+				if desiredField, ok := desiredMeta[field]; ok {
+					liveMeta[field] = merge(liveMeta[field], desiredField)
+				}
+			}
+		default:
+			live[key] = merge(live[key], value)
+		}
+	}
+}
+
+func merge(live, desired interface{}) interface{} {
+	desiredValue, ok := desired.(map[string]interface{})
+	if !ok {
+		return desired
+	}
+	liveValue, ok := live.(map[string]interface{})
+	if !ok {
+		return desired
+	}
+	for key, value := range desiredValue {
+		liveValue[key] = merge(liveValue[key], value)
+	}
+	return liveValue
+}
+
+// equalValue compares two scalar values, the numbers are compared by their values since a manifest and a live
+// object may be decoded with different numeric types.
+func equalValue(desired, live interface{}) bool {
+	desiredNumber, desiredIsNumber := toFloat(desired)
+	liveNumber, liveIsNumber := toFloat(live)
+	if desiredIsNumber && liveIsNumber {
+		return desiredNumber == liveNumber
+	}
+	return reflect.DeepEqual(desired, live)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,143 @@
+package drift
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	desired := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "nginx"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "nginx", "image": "nginx:1.25"},
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		live          map[string]interface{}
+		expectedPaths []string
+	}{
+		{
+			name: "defaulted fields and server managed metadata are not drift",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "nginx",
+					"namespace":       "default",
+					"resourceVersion": "100",
+					"labels":          map[string]interface{}{"app": "nginx", "team": "web"},
+				},
+				"spec": map[string]interface{}{
+					"replicas":             float64(1),
+					"revisionHistoryLimit": int64(10),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "nginx", "image": "nginx:1.25", "imagePullPolicy": "IfNotPresent"},
+							},
+						},
+					},
+				},
+				"status": map[string]interface{}{"replicas": int64(1)},
+			},
+			expectedPaths: []string{},
+		},
+		{
+			name: "edited fields are drift",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":      "nginx",
+					"namespace": "default",
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "nginx", "image": "nginx:latest"},
+							},
+						},
+					},
+				},
+			},
+			expectedPaths: []string{"metadata.labels", "spec.replicas", "spec.template.spec.containers[0].image"},
+		},
+		{
+			name: "added list items are drift",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "nginx"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "nginx", "image": "nginx:1.25"},
+								map[string]interface{}{"name": "sidecar", "image": "busybox"},
+							},
+						},
+					},
+				},
+			},
+			expectedPaths: []string{"spec.template.spec.containers"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			paths := Diff(desired, c.live)
+			if !reflect.DeepEqual(paths, c.expectedPaths) {
+				t.Errorf("expected paths %v but got: %v", c.expectedPaths, paths)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "nginx",
+			"labels": map[string]interface{}{"app": "nginx"},
+		},
+		"data": map[string]interface{}{"key": "value"},
+	}
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "nginx",
+			"resourceVersion": "100",
+			"labels":          map[string]interface{}{"team": "web"},
+		},
+		"data": map[string]interface{}{"key": "edited", "extra": "kept"},
+	}
+
+	Merge(live, desired)
+
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "nginx",
+			"resourceVersion": "100",
+			"labels":          map[string]interface{}{"team": "web", "app": "nginx"},
+		},
+		"data": map[string]interface{}{"key": "value", "extra": "kept"},
+	}
+	if !reflect.DeepEqual(live, expected) {
+		t.Errorf("expected %v but got: %v", expected, live)
+	}
+	if paths := Diff(desired, live); len(paths) != 0 {
+		t.Errorf("expected no drift after merge but got: %v", paths)
+	}
+}
@@ -0,0 +1,95 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	workinformers "open-cluster-management.io/api/client/work/informers/externalversions"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/options"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/store"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/builder"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+// Options are the options of the drift controller of the agent.
+type Options struct {
+	// Policy is the drift policy of the manifest works, the drift is not detected if it is empty.
+	Policy string
+	// Interval is the interval to compare the live objects with the manifests.
+	Interval time.Duration
+}
+
+func NewOptions() *Options {
+	return &Options{
+		Interval: time.Minute,
+	}
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Policy, "drift-policy", o.Policy,
+		"Policy to handle the live objects that diverge from the manifests, Alert or Reapply. The drift is not detected if it is empty.")
+	fs.DurationVar(&o.Interval, "drift-check-interval", o.Interval,
+		"Interval to compare the live objects with the manifests.")
+}
+
+// Start starts the drift controller of an agent if the drift policy is set. The controller receives the manifest
+// works from the message broker that the work agent is connected to with its own cloudevents client.
+func (o *Options) Start(ctx context.Context, kubeConfig *rest.Config,
+	brokerType, brokerConfig, clusterName, clientID string) error {
+	if o.Policy == "" {
+		return nil
+	}
+
+	policy, err := api.ParseDriftPolicy(o.Policy)
+	if err != nil {
+		return err
+	}
+	if o.Interval <= 0 {
+		return fmt.Errorf("the drift check interval must be positive")
+	}
+
+	_, config, err := builder.NewConfigLoader(brokerType, brokerConfig).LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load the message broker config: %v", err)
+	}
+
+	if clientID == "" {
+		clientID = fmt.Sprintf("%s-work-agent", clusterName)
+	}
+	watcherStore := store.NewAgentInformerWatcherStore()
+	clientOptions := options.NewGenericClientOptions(config, NewCodec(), fmt.Sprintf("%s-drift", clientID)).
+		WithClusterName(clusterName).
+		WithClientWatcherStore(watcherStore)
+	clientHolder, err := work.NewAgentClientHolder(ctx, clientOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create the manifest work client: %v", err)
+	}
+
+	// the informer consumes the watch events of the watcher store
+	informerFactory := workinformers.NewSharedInformerFactoryWithOptions(clientHolder.WorkInterface(),
+		10*time.Minute, workinformers.WithNamespace(clusterName))
+	informerFactory.Work().V1().ManifestWorks().Informer()
+	informerFactory.Start(ctx.Done())
+
+	dynamicClient, err := dynamic.NewForConfig(kubeConfig)
+	if err != nil {
+		return err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(kubeConfig)
+	if err != nil {
+		return err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	go NewController(clientHolder.ManifestWorks(clusterName), dynamicClient, mapper, policy, o.Interval).Run(ctx)
+	return nil
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	"github.com/openshift-online/maestro/pkg/db"
@@ -16,6 +18,14 @@ type Consumer struct {
 	// Cannot be updated.
 	Name   string
 	Labels *db.StringMap
+
+	// Connected is true if the agent of the consumer is connected, it is set when the agent sends a status
+	// or subscribes to the gRPC broker, and unset when the agent is disconnected from the gRPC broker.
+	Connected bool
+	// LastSeen is the last time that the agent of the consumer is seen by the maestro server.
+	LastSeen *time.Time
+	// SilencedAt is the time that the consumer is reported as silent, it is reset once the agent is seen again.
+	SilencedAt *time.Time
 }
 
 type ConsumerList []*Consumer
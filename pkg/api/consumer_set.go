@@ -0,0 +1,96 @@
+package api
+
+import (
+	"fmt"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// ConsumerSet is a named group of consumers, the members of a set are either listed statically or
+// selected by a label selector over the consumer labels.
+type ConsumerSet struct {
+	Meta
+	// Name must be unique and not null.
+	// The format of the name should be follow the RFC 1123 (same as the k8s namespace).
+	// Cannot be updated.
+	Name string
+	// Members are the names of the consumers of a static set.
+	Members db.StringSlice
+	// ConsumerSelector is the JSON representation of a metav1.LabelSelector over the consumer labels.
+	ConsumerSelector datatypes.JSONMap
+}
+
+type ConsumerSetList []*ConsumerSet
+
+// ConsumerSetStatus is the status of a consumer set aggregated from the resource bundles of its members.
+type ConsumerSetStatus struct {
+	// Members is the number of consumers in the set.
+	Members int32
+	// ResourceBundles is the number of resource bundles targeted at the consumers in the set.
+	ResourceBundles int32
+	// Applied is the number of resource bundles that are applied on their consumers.
+	Applied int32
+	// Available is the number of resource bundles that are available on their consumers.
+	Available int32
+	// Degraded is the number of resource bundles that failed to be applied or are degraded on their consumers.
+	Degraded int32
+}
+
+func (s *ConsumerSet) BeforeCreate(tx *gorm.DB) error {
+	// generate a new ID if it doesn't exist
+	if s.ID == "" {
+		s.ID = NewID()
+	}
+	return nil
+}
+
+// Selector converts the consumer selector of the set to a labels.Selector, a set without a consumer
+// selector does not select any consumer.
+func (s *ConsumerSet) Selector() (labels.Selector, error) {
+	if len(s.ConsumerSelector) == 0 {
+		return labels.Nothing(), nil
+	}
+
+	labelSelector := &metav1.LabelSelector{}
+	if err := convertJSON(s.ConsumerSelector, labelSelector); err != nil {
+		return nil, fmt.Errorf("failed to decode consumer selector: %v", err)
+	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
+// Select returns the consumers in the set.
+func (s *ConsumerSet) Select(consumers ConsumerList) (ConsumerList, error) {
+	members := ConsumerList{}
+	if len(s.Members) > 0 {
+		names := map[string]bool{}
+		for _, name := range s.Members {
+			names[name] = true
+		}
+		for _, consumer := range consumers {
+			if names[consumer.Name] {
+				members = append(members, consumer)
+			}
+		}
+		return members, nil
+	}
+
+	selector, err := s.Selector()
+	if err != nil {
+		return nil, err
+	}
+	for _, consumer := range consumers {
+		consumerLabels := labels.Set{}
+		if consumer.Labels != nil {
+			consumerLabels = labels.Set(*consumer.Labels)
+		}
+		if selector.Matches(consumerLabels) {
+			members = append(members, consumer)
+		}
+	}
+	return members, nil
+}
@@ -0,0 +1,62 @@
+package api
+
+import "time"
+
+// The tables of the events that are garbage collected.
+const (
+	EventsTable         = "events"
+	StatusEventsTable   = "status_events"
+	EventInstancesTable = "event_instances"
+	// ResourceTombstonesTable is the table of the deletions of the resources that a watch resumes from.
+	ResourceTombstonesTable = "resource_tombstones"
+	// OperationsTable is the table of the operations, only the completed operations are garbage collected.
+	OperationsTable = "operations"
+	// OperationResourcesTable is the table of the resources of the operations, they are purged with their operations.
+	OperationResourcesTable = "operation_resources"
+	// ResourceRevisionsTable is the table of the revisions of the resources that a resource is rolled back to.
+	ResourceRevisionsTable = "resource_revisions"
+)
+
+// EventRetention is the retention of the rows of an event table, a limit is not enforced if it is 0.
+type EventRetention struct {
+	// MaxAge is the maximum age of the rows, the older rows are purged whether they are handled or not.
+	MaxAge time.Duration
+	// MaxCount is the maximum number of the rows, the oldest rows exceeding it are purged.
+	MaxCount int64
+}
+
+// EventGCPolicy is the policy of the garbage collection of the event tables.
+type EventGCPolicy struct {
+	Events       EventRetention
+	StatusEvents EventRetention
+	// ResourceTombstones is the retention of the deletions of the resources, a watch cannot resume from a
+	// resource version before the purged deletions.
+	ResourceTombstones EventRetention
+	// Operations is the retention of the completed operations, the age of an operation is measured from its
+	// completion. The running operations are not purged.
+	Operations EventRetention
+	// ResourceRevisions is the retention of the revisions of the resources, only its max count is enforced and it is
+	// the maximum number of the revisions of each resource. The current revision of a resource is not purged.
+	ResourceRevisions EventRetention
+	// DeadInstanceGracePeriod is the time after which a maestro instance that is not ready and stops sending
+	// heartbeats is dead, the event instances of the dead instances are purged. They are not purged if it is 0.
+	DeadInstanceGracePeriod time.Duration
+}
+
+// EventGCResult is the result of the garbage collection of an event table.
+type EventGCResult struct {
+	Table string `json:"table"`
+	// Rows is the number of the rows of the table before the garbage collection.
+	Rows int64 `json:"rows"`
+	// Expired is the number of the purged rows that are older than the max age.
+	Expired int64 `json:"expired"`
+	// Exceeded is the number of the purged rows that exceed the max count.
+	Exceeded int64 `json:"exceeded"`
+	// Orphaned is the number of the purged rows of the dead maestro instances.
+	Orphaned int64 `json:"orphaned"`
+}
+
+// Purged returns the number of the purged rows of the table.
+func (r EventGCResult) Purged() int64 {
+	return r.Expired + r.Exceeded + r.Orphaned
+}
@@ -27,6 +27,8 @@ type Consumer struct {
 	Labels    *map[string]string `json:"labels,omitempty"`
 	CreatedAt *time.Time         `json:"created_at,omitempty"`
 	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	Connected *bool              `json:"connected,omitempty"`
+	LastSeen  *time.Time         `json:"last_seen,omitempty"`
 }
 
 // NewConsumer instantiates a new Consumer object
@@ -270,6 +272,70 @@ func (o *Consumer) SetUpdatedAt(v time.Time) {
 	o.UpdatedAt = &v
 }
 
+// GetConnected returns the Connected field value if set, zero value otherwise.
+func (o *Consumer) GetConnected() bool {
+	if o == nil || IsNil(o.Connected) {
+		var ret bool
+		return ret
+	}
+	return *o.Connected
+}
+
+// GetConnectedOk returns a tuple with the Connected field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Consumer) GetConnectedOk() (*bool, bool) {
+	if o == nil || IsNil(o.Connected) {
+		return nil, false
+	}
+	return o.Connected, true
+}
+
+// HasConnected returns a boolean if a field has been set.
+func (o *Consumer) HasConnected() bool {
+	if o != nil && !IsNil(o.Connected) {
+		return true
+	}
+
+	return false
+}
+
+// SetConnected gets a reference to the given bool and assigns it to the Connected field.
+func (o *Consumer) SetConnected(v bool) {
+	o.Connected = &v
+}
+
+// GetLastSeen returns the LastSeen field value if set, zero value otherwise.
+func (o *Consumer) GetLastSeen() time.Time {
+	if o == nil || IsNil(o.LastSeen) {
+		var ret time.Time
+		return ret
+	}
+	return *o.LastSeen
+}
+
+// GetLastSeenOk returns a tuple with the LastSeen field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Consumer) GetLastSeenOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.LastSeen) {
+		return nil, false
+	}
+	return o.LastSeen, true
+}
+
+// HasLastSeen returns a boolean if a field has been set.
+func (o *Consumer) HasLastSeen() bool {
+	if o != nil && !IsNil(o.LastSeen) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastSeen gets a reference to the given time.Time and assigns it to the LastSeen field.
+func (o *Consumer) SetLastSeen(v time.Time) {
+	o.LastSeen = &v
+}
+
 func (o Consumer) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -301,6 +367,12 @@ func (o Consumer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.UpdatedAt) {
 		toSerialize["updated_at"] = o.UpdatedAt
 	}
+	if !IsNil(o.Connected) {
+		toSerialize["connected"] = o.Connected
+	}
+	if !IsNil(o.LastSeen) {
+		toSerialize["last_seen"] = o.LastSeen
+	}
 	return toSerialize, nil
 }
 
@@ -21,11 +21,12 @@ var _ MappedNullable = &ConsumerList{}
 
 // ConsumerList struct for ConsumerList
 type ConsumerList struct {
-	Kind  string     `json:"kind"`
-	Page  int32      `json:"page"`
-	Size  int32      `json:"size"`
-	Total int32      `json:"total"`
-	Items []Consumer `json:"items"`
+	Kind     string     `json:"kind"`
+	Page     int32      `json:"page"`
+	Size     int32      `json:"size"`
+	Total    int32      `json:"total"`
+	Continue *string    `json:"continue,omitempty"`
+	Items    []Consumer `json:"items"`
 }
 
 type _ConsumerList ConsumerList
@@ -148,6 +149,38 @@ func (o *ConsumerList) SetTotal(v int32) {
 	o.Total = v
 }
 
+// GetContinue returns the Continue field value if set, zero value otherwise.
+func (o *ConsumerList) GetContinue() string {
+	if o == nil || IsNil(o.Continue) {
+		var ret string
+		return ret
+	}
+	return *o.Continue
+}
+
+// GetContinueOk returns a tuple with the Continue field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ConsumerList) GetContinueOk() (*string, bool) {
+	if o == nil || IsNil(o.Continue) {
+		return nil, false
+	}
+	return o.Continue, true
+}
+
+// HasContinue returns a boolean if a field has been set.
+func (o *ConsumerList) HasContinue() bool {
+	if o != nil && !IsNil(o.Continue) {
+		return true
+	}
+
+	return false
+}
+
+// SetContinue gets a reference to the given string and assigns it to the Continue field.
+func (o *ConsumerList) SetContinue(v string) {
+	o.Continue = &v
+}
+
 // GetItems returns the Items field value
 func (o *ConsumerList) GetItems() []Consumer {
 	if o == nil {
@@ -186,6 +219,9 @@ func (o ConsumerList) ToMap() (map[string]interface{}, error) {
 	toSerialize["page"] = o.Page
 	toSerialize["size"] = o.Size
 	toSerialize["total"] = o.Total
+	if !IsNil(o.Continue) {
+		toSerialize["continue"] = o.Continue
+	}
 	toSerialize["items"] = o.Items
 	return toSerialize, nil
 }
@@ -20,20 +20,24 @@ var _ MappedNullable = &ResourceBundle{}
 
 // ResourceBundle struct for ResourceBundle
 type ResourceBundle struct {
-	Id              *string                  `json:"id,omitempty"`
-	Kind            *string                  `json:"kind,omitempty"`
-	Href            *string                  `json:"href,omitempty"`
-	Name            *string                  `json:"name,omitempty"`
-	ConsumerName    *string                  `json:"consumer_name,omitempty"`
-	Version         *int32                   `json:"version,omitempty"`
-	CreatedAt       *time.Time               `json:"created_at,omitempty"`
-	UpdatedAt       *time.Time               `json:"updated_at,omitempty"`
-	DeletedAt       *time.Time               `json:"deleted_at,omitempty"`
-	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
-	Manifests       []map[string]interface{} `json:"manifests,omitempty"`
-	DeleteOption    map[string]interface{}   `json:"delete_option,omitempty"`
-	ManifestConfigs []map[string]interface{} `json:"manifest_configs,omitempty"`
-	Status          map[string]interface{}   `json:"status,omitempty"`
+	Id               *string                  `json:"id,omitempty"`
+	Kind             *string                  `json:"kind,omitempty"`
+	Href             *string                  `json:"href,omitempty"`
+	Name             *string                  `json:"name,omitempty"`
+	ConsumerName     *string                  `json:"consumer_name,omitempty"`
+	Source           *string                  `json:"source,omitempty"`
+	PlacementId      *string                  `json:"placement_id,omitempty"`
+	Version          *int32                   `json:"version,omitempty"`
+	ResourceVersion  *string                  `json:"resource_version,omitempty"`
+	CreatedAt        *time.Time               `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time               `json:"updated_at,omitempty"`
+	DeletedAt        *time.Time               `json:"deleted_at,omitempty"`
+	Metadata         map[string]interface{}   `json:"metadata,omitempty"`
+	Manifests        []map[string]interface{} `json:"manifests,omitempty"`
+	DeleteOption     map[string]interface{}   `json:"delete_option,omitempty"`
+	ManifestConfigs  []map[string]interface{} `json:"manifest_configs,omitempty"`
+	ManifestManagers *map[string]string       `json:"manifest_managers,omitempty"`
+	Status           map[string]interface{}   `json:"status,omitempty"`
 }
 
 // NewResourceBundle instantiates a new ResourceBundle object
@@ -213,6 +217,70 @@ func (o *ResourceBundle) SetConsumerName(v string) {
 	o.ConsumerName = &v
 }
 
+// GetSource returns the Source field value if set, zero value otherwise.
+func (o *ResourceBundle) GetSource() string {
+	if o == nil || IsNil(o.Source) {
+		var ret string
+		return ret
+	}
+	return *o.Source
+}
+
+// GetSourceOk returns a tuple with the Source field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ResourceBundle) GetSourceOk() (*string, bool) {
+	if o == nil || IsNil(o.Source) {
+		return nil, false
+	}
+	return o.Source, true
+}
+
+// HasSource returns a boolean if a field has been set.
+func (o *ResourceBundle) HasSource() bool {
+	if o != nil && !IsNil(o.Source) {
+		return true
+	}
+
+	return false
+}
+
+// SetSource gets a reference to the given string and assigns it to the Source field.
+func (o *ResourceBundle) SetSource(v string) {
+	o.Source = &v
+}
+
+// GetPlacementId returns the PlacementId field value if set, zero value otherwise.
+func (o *ResourceBundle) GetPlacementId() string {
+	if o == nil || IsNil(o.PlacementId) {
+		var ret string
+		return ret
+	}
+	return *o.PlacementId
+}
+
+// GetPlacementIdOk returns a tuple with the PlacementId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ResourceBundle) GetPlacementIdOk() (*string, bool) {
+	if o == nil || IsNil(o.PlacementId) {
+		return nil, false
+	}
+	return o.PlacementId, true
+}
+
+// HasPlacementId returns a boolean if a field has been set.
+func (o *ResourceBundle) HasPlacementId() bool {
+	if o != nil && !IsNil(o.PlacementId) {
+		return true
+	}
+
+	return false
+}
+
+// SetPlacementId gets a reference to the given string and assigns it to the PlacementId field.
+func (o *ResourceBundle) SetPlacementId(v string) {
+	o.PlacementId = &v
+}
+
 // GetVersion returns the Version field value if set, zero value otherwise.
 func (o *ResourceBundle) GetVersion() int32 {
 	if o == nil || IsNil(o.Version) {
@@ -245,6 +313,38 @@ func (o *ResourceBundle) SetVersion(v int32) {
 	o.Version = &v
 }
 
+// GetResourceVersion returns the ResourceVersion field value if set, zero value otherwise.
+func (o *ResourceBundle) GetResourceVersion() string {
+	if o == nil || IsNil(o.ResourceVersion) {
+		var ret string
+		return ret
+	}
+	return *o.ResourceVersion
+}
+
+// GetResourceVersionOk returns a tuple with the ResourceVersion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ResourceBundle) GetResourceVersionOk() (*string, bool) {
+	if o == nil || IsNil(o.ResourceVersion) {
+		return nil, false
+	}
+	return o.ResourceVersion, true
+}
+
+// HasResourceVersion returns a boolean if a field has been set.
+func (o *ResourceBundle) HasResourceVersion() bool {
+	if o != nil && !IsNil(o.ResourceVersion) {
+		return true
+	}
+
+	return false
+}
+
+// SetResourceVersion gets a reference to the given string and assigns it to the ResourceVersion field.
+func (o *ResourceBundle) SetResourceVersion(v string) {
+	o.ResourceVersion = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value if set, zero value otherwise.
 func (o *ResourceBundle) GetCreatedAt() time.Time {
 	if o == nil || IsNil(o.CreatedAt) {
@@ -469,6 +569,38 @@ func (o *ResourceBundle) SetManifestConfigs(v []map[string]interface{}) {
 	o.ManifestConfigs = v
 }
 
+// GetManifestManagers returns the ManifestManagers field value if set, zero value otherwise.
+func (o *ResourceBundle) GetManifestManagers() map[string]string {
+	if o == nil || IsNil(o.ManifestManagers) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.ManifestManagers
+}
+
+// GetManifestManagersOk returns a tuple with the ManifestManagers field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ResourceBundle) GetManifestManagersOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.ManifestManagers) {
+		return nil, false
+	}
+	return o.ManifestManagers, true
+}
+
+// HasManifestManagers returns a boolean if a field has been set.
+func (o *ResourceBundle) HasManifestManagers() bool {
+	if o != nil && !IsNil(o.ManifestManagers) {
+		return true
+	}
+
+	return false
+}
+
+// SetManifestManagers gets a reference to the given map[string]string and assigns it to the ManifestManagers field.
+func (o *ResourceBundle) SetManifestManagers(v map[string]string) {
+	o.ManifestManagers = &v
+}
+
 // GetStatus returns the Status field value if set, zero value otherwise.
 func (o *ResourceBundle) GetStatus() map[string]interface{} {
 	if o == nil || IsNil(o.Status) {
@@ -526,9 +658,18 @@ func (o ResourceBundle) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ConsumerName) {
 		toSerialize["consumer_name"] = o.ConsumerName
 	}
+	if !IsNil(o.Source) {
+		toSerialize["source"] = o.Source
+	}
+	if !IsNil(o.PlacementId) {
+		toSerialize["placement_id"] = o.PlacementId
+	}
 	if !IsNil(o.Version) {
 		toSerialize["version"] = o.Version
 	}
+	if !IsNil(o.ResourceVersion) {
+		toSerialize["resource_version"] = o.ResourceVersion
+	}
 	if !IsNil(o.CreatedAt) {
 		toSerialize["created_at"] = o.CreatedAt
 	}
@@ -550,6 +691,9 @@ func (o ResourceBundle) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ManifestConfigs) {
 		toSerialize["manifest_configs"] = o.ManifestConfigs
 	}
+	if !IsNil(o.ManifestManagers) {
+		toSerialize["manifest_managers"] = o.ManifestManagers
+	}
 	if !IsNil(o.Status) {
 		toSerialize["status"] = o.Status
 	}
@@ -21,11 +21,13 @@ var _ MappedNullable = &ResourceBundleList{}
 
 // ResourceBundleList struct for ResourceBundleList
 type ResourceBundleList struct {
-	Kind  string           `json:"kind"`
-	Page  int32            `json:"page"`
-	Size  int32            `json:"size"`
-	Total int32            `json:"total"`
-	Items []ResourceBundle `json:"items"`
+	Kind            string           `json:"kind"`
+	Page            int32            `json:"page"`
+	Size            int32            `json:"size"`
+	Total           int32            `json:"total"`
+	Continue        *string          `json:"continue,omitempty"`
+	ResourceVersion *string          `json:"resource_version,omitempty"`
+	Items           []ResourceBundle `json:"items"`
 }
 
 type _ResourceBundleList ResourceBundleList
@@ -148,6 +150,70 @@ func (o *ResourceBundleList) SetTotal(v int32) {
 	o.Total = v
 }
 
+// GetContinue returns the Continue field value if set, zero value otherwise.
+func (o *ResourceBundleList) GetContinue() string {
+	if o == nil || IsNil(o.Continue) {
+		var ret string
+		return ret
+	}
+	return *o.Continue
+}
+
+// GetContinueOk returns a tuple with the Continue field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ResourceBundleList) GetContinueOk() (*string, bool) {
+	if o == nil || IsNil(o.Continue) {
+		return nil, false
+	}
+	return o.Continue, true
+}
+
+// HasContinue returns a boolean if a field has been set.
+func (o *ResourceBundleList) HasContinue() bool {
+	if o != nil && !IsNil(o.Continue) {
+		return true
+	}
+
+	return false
+}
+
+// SetContinue gets a reference to the given string and assigns it to the Continue field.
+func (o *ResourceBundleList) SetContinue(v string) {
+	o.Continue = &v
+}
+
+// GetResourceVersion returns the ResourceVersion field value if set, zero value otherwise.
+func (o *ResourceBundleList) GetResourceVersion() string {
+	if o == nil || IsNil(o.ResourceVersion) {
+		var ret string
+		return ret
+	}
+	return *o.ResourceVersion
+}
+
+// GetResourceVersionOk returns a tuple with the ResourceVersion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ResourceBundleList) GetResourceVersionOk() (*string, bool) {
+	if o == nil || IsNil(o.ResourceVersion) {
+		return nil, false
+	}
+	return o.ResourceVersion, true
+}
+
+// HasResourceVersion returns a boolean if a field has been set.
+func (o *ResourceBundleList) HasResourceVersion() bool {
+	if o != nil && !IsNil(o.ResourceVersion) {
+		return true
+	}
+
+	return false
+}
+
+// SetResourceVersion gets a reference to the given string and assigns it to the ResourceVersion field.
+func (o *ResourceBundleList) SetResourceVersion(v string) {
+	o.ResourceVersion = &v
+}
+
 // GetItems returns the Items field value
 func (o *ResourceBundleList) GetItems() []ResourceBundle {
 	if o == nil {
@@ -186,6 +252,12 @@ func (o ResourceBundleList) ToMap() (map[string]interface{}, error) {
 	toSerialize["page"] = o.Page
 	toSerialize["size"] = o.Size
 	toSerialize["total"] = o.Total
+	if !IsNil(o.Continue) {
+		toSerialize["continue"] = o.Continue
+	}
+	if !IsNil(o.ResourceVersion) {
+		toSerialize["resource_version"] = o.ResourceVersion
+	}
 	toSerialize["items"] = o.Items
 	return toSerialize, nil
 }
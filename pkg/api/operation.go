@@ -0,0 +1,123 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// OperationIDHeader is the HTTP and gRPC response header that carries the id of the operation
+// that tracks a create, update or delete request of a resource bundle.
+const OperationIDHeader = "maestro-operation-id"
+
+// Operation tracks a long-running request, e.g. the deletion of many resources, until the
+// consumers acknowledge it. The progress of the operation is the number of its resources
+// that are completed.
+type Operation struct {
+	Meta
+	Type OperationType
+	// TargetID is the id of the object the operation is performed on, e.g. the consumer id of a
+	// consumer deletion. It is empty if the operation is performed on a set of resources.
+	TargetID string
+	// Search is the search criteria that selected the resources of the operation.
+	Search string
+	// Sources are the sources whose tenants can access the operation, they are the source of the resource of
+	// an operation of a single resource, or the sources of the tenant that started the operation. The operations
+	// without sources are only accessible to the admins.
+	Sources db.StringSlice
+	// EventID is the id of the event that triggered the operation of a single resource, the
+	// event is dispatched to the consumer of the resource by the event controller.
+	EventID string
+	// ResourceVersion is the version of the resource that the consumer must apply to complete
+	// the operation of a single resource.
+	ResourceVersion int32
+	// DispatchedAt is the time the event of the operation is dispatched to the consumer.
+	DispatchedAt *time.Time
+	Phase        OperationPhase
+	// Total is the number of resources of the operation.
+	Total int32
+	// Completed is the number of resources of the operation that are completed.
+	Completed int32
+	// Message is a human readable message about the operation, e.g. why it failed.
+	Message     string
+	CompletedAt *time.Time
+}
+
+type OperationList []*Operation
+type OperationIndex map[string]*Operation
+
+func (l OperationList) Index() OperationIndex {
+	index := OperationIndex{}
+	for _, o := range l {
+		index[o.ID] = o
+	}
+	return index
+}
+
+func (o *Operation) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == "" {
+		o.ID = NewID()
+	}
+	if o.Phase == "" {
+		o.Phase = OperationRunning
+	}
+	return nil
+}
+
+// Done returns true if the operation is succeeded or failed.
+func (o *Operation) Done() bool {
+	return o.Phase == OperationSucceeded || o.Phase == OperationFailed
+}
+
+type OperationType string
+
+const (
+	// DeleteConsumerOperationType deletes all resources of a consumer and then the consumer.
+	DeleteConsumerOperationType OperationType = "DeleteConsumer"
+	// DeleteResourceBundlesOperationType deletes the resources that match a search.
+	DeleteResourceBundlesOperationType OperationType = "DeleteResourceBundles"
+	// CreateResourceBundleOperationType creates a resource, it is completed once the consumer
+	// reports the status of the resource.
+	CreateResourceBundleOperationType OperationType = "CreateResourceBundle"
+	// UpdateResourceBundleOperationType updates a resource, it is completed once the consumer
+	// reports the status of the updated version of the resource.
+	UpdateResourceBundleOperationType OperationType = "UpdateResourceBundle"
+	// DeleteResourceBundleOperationType deletes a resource, it is completed once the consumer
+	// acknowledges the deletion and the resource is removed.
+	DeleteResourceBundleOperationType OperationType = "DeleteResourceBundle"
+)
+
+// ResourceOperationType returns the type of the operation that tracks the given event of a resource.
+func ResourceOperationType(eventType EventType) OperationType {
+	switch eventType {
+	case CreateEventType:
+		return CreateResourceBundleOperationType
+	case UpdateEventType:
+		return UpdateResourceBundleOperationType
+	default:
+		return DeleteResourceBundleOperationType
+	}
+}
+
+type OperationPhase string
+
+const (
+	// OperationRunning means the operation is waiting for its resources to be completed.
+	OperationRunning OperationPhase = "Running"
+	// OperationSucceeded means all resources of the operation are completed.
+	OperationSucceeded OperationPhase = "Succeeded"
+	// OperationFailed means the operation cannot be completed.
+	OperationFailed OperationPhase = "Failed"
+)
+
+// OperationResource is a resource of an operation, a resource of a deletion is completed once the
+// consumer acknowledges the deletion and the resource is removed.
+type OperationResource struct {
+	OperationID string
+	ResourceID  string
+	CompletedAt *time.Time
+}
+
+type OperationResourceList []*OperationResource
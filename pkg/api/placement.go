@@ -0,0 +1,180 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Placement pairs a resource bundle template with a label selector over the consumer labels,
+// a resource is created from the template for every consumer that matches the selector.
+type Placement struct {
+	Meta
+	// Name must be unique and not null.
+	// The format of the name should be follow the RFC 1123 (same as the k8s namespace).
+	// Cannot be updated.
+	Name    string
+	Source  string
+	Version int32
+	// ConsumerSelector is the JSON representation of a metav1.LabelSelector over the consumer labels.
+	ConsumerSelector datatypes.JSONMap
+	// Payload is the resource bundle template with CloudEvent format, the resources of the placement
+	// get the template with their own resource id.
+	Payload datatypes.JSONMap
+	// RolloutStrategy is the JSON representation of the PlacementRolloutStrategy.
+	RolloutStrategy datatypes.JSONMap
+	// RolloutPhase is the phase of the rollout of the current placement version.
+	RolloutPhase RolloutPhase
+	// Status is the JSON representation of the PlacementStatus.
+	Status datatypes.JSONMap
+}
+
+type PlacementList []*Placement
+
+type RolloutStrategyType string
+
+const (
+	// AllRolloutStrategyType updates the resources of all consumers at once.
+	AllRolloutStrategyType RolloutStrategyType = "All"
+	// ProgressiveRolloutStrategyType updates the resources in waves of BatchSize consumers, a wave
+	// starts once the resources of the previous waves are applied and available on their consumers.
+	ProgressiveRolloutStrategyType RolloutStrategyType = "Progressive"
+)
+
+// PlacementRolloutStrategy defines how the resources of a placement are created and updated.
+type PlacementRolloutStrategy struct {
+	Type RolloutStrategyType `json:"type"`
+	// BatchSize is the number of consumers in a wave of a progressive rollout.
+	BatchSize int32 `json:"batchSize,omitempty"`
+}
+
+type RolloutPhase string
+
+const (
+	// RolloutProgressing means the resources are being created or updated with the current placement version.
+	RolloutProgressing RolloutPhase = "Progressing"
+	// RolloutPaused means no resource is created or updated until the rollout is resumed, a progressive
+	// rollout is paused automatically when a resource fails on its consumer.
+	RolloutPaused RolloutPhase = "Paused"
+	// RolloutAborted means the rollout of the current placement version is stopped, the resources that
+	// are not updated keep the previous template until the placement is updated again.
+	RolloutAborted RolloutPhase = "Aborted"
+	// RolloutCompleted means all resources are updated, applied and available on their consumers.
+	RolloutCompleted RolloutPhase = "Completed"
+)
+
+// PlacementStatus is the rollout status of a placement aggregated from its resources.
+type PlacementStatus struct {
+	// Desired is the number of consumers that match the consumer selector.
+	Desired int32 `json:"desired"`
+	// Current is the number of resources of the placement, including the resources under deletion.
+	Current int32 `json:"current"`
+	// Updated is the number of resources that have the manifests of the current placement template.
+	Updated int32 `json:"updated"`
+	// Applied is the number of updated resources that are applied on their consumers.
+	Applied int32 `json:"applied"`
+	// Available is the number of updated resources that are available on their consumers.
+	Available int32 `json:"available"`
+	// Failed is the number of updated resources that failed to be applied or are degraded on their consumers.
+	Failed int32 `json:"failed"`
+	// ObservedVersion is the placement version that the status is aggregated for.
+	ObservedVersion int32 `json:"observedVersion"`
+	// ProgressedAt is the last time the rollout made progress, i.e. a resource is updated or becomes available.
+	ProgressedAt *time.Time `json:"progressedAt,omitempty"`
+	// Stalled is true if the updated resources are not available and the rollout makes no progress within the
+	// progress deadline, it is reset once the rollout makes progress again.
+	Stalled bool `json:"stalled,omitempty"`
+	// PausedFailures are the consumers whose failed resources paused the rollout. The failures are acknowledged
+	// once the rollout is resumed, the rollout is only paused again if the resources of other consumers fail.
+	PausedFailures []string `json:"pausedFailures,omitempty"`
+	// Message is a human readable message about the rollout, e.g. why the rollout is paused.
+	Message string `json:"message,omitempty"`
+}
+
+func (p *Placement) BeforeCreate(tx *gorm.DB) error {
+	// generate a new ID if it doesn't exist
+	if p.ID == "" {
+		p.ID = NewID()
+	}
+	// start the placement version from 1
+	if p.Version == 0 {
+		p.Version = 1
+	}
+	if p.RolloutPhase == "" {
+		p.RolloutPhase = RolloutProgressing
+	}
+	return nil
+}
+
+// Selector converts the consumer selector of the placement to a labels.Selector, a placement
+// without a consumer selector does not select any consumer.
+func (p *Placement) Selector() (labels.Selector, error) {
+	if len(p.ConsumerSelector) == 0 {
+		return labels.Nothing(), nil
+	}
+
+	labelSelector := &metav1.LabelSelector{}
+	if err := convertJSON(p.ConsumerSelector, labelSelector); err != nil {
+		return nil, fmt.Errorf("failed to decode consumer selector: %v", err)
+	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
+// Rollout decodes the rollout strategy of the placement, a placement without a rollout strategy
+// updates the resources of all consumers at once.
+func (p *Placement) Rollout() (*PlacementRolloutStrategy, error) {
+	strategy := &PlacementRolloutStrategy{Type: AllRolloutStrategyType}
+	if len(p.RolloutStrategy) == 0 {
+		return strategy, nil
+	}
+
+	if err := convertJSON(p.RolloutStrategy, strategy); err != nil {
+		return nil, fmt.Errorf("failed to decode rollout strategy: %v", err)
+	}
+	return strategy, nil
+}
+
+// EncodeRolloutStrategy converts the rollout strategy to its JSONMap representation.
+func EncodeRolloutStrategy(strategy *PlacementRolloutStrategy) (datatypes.JSONMap, error) {
+	jsonMap := datatypes.JSONMap{}
+	if err := convertJSON(strategy, &jsonMap); err != nil {
+		return nil, err
+	}
+	return jsonMap, nil
+}
+
+// DecodePlacementStatus converts the JSONMap representation of the placement status to PlacementStatus.
+func DecodePlacementStatus(status datatypes.JSONMap) (*PlacementStatus, error) {
+	placementStatus := &PlacementStatus{}
+	if len(status) == 0 {
+		return placementStatus, nil
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, placementStatus); err != nil {
+		return nil, fmt.Errorf("failed to decode placement status: %v", err)
+	}
+	return placementStatus, nil
+}
+
+// EncodePlacementStatus converts the placement status to its JSONMap representation.
+func EncodePlacementStatus(status *PlacementStatus) (datatypes.JSONMap, error) {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonMap := datatypes.JSONMap{}
+	if err := json.Unmarshal(data, &jsonMap); err != nil {
+		return nil, err
+	}
+	return jsonMap, nil
+}
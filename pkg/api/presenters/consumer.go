@@ -27,5 +27,7 @@ func PresentConsumer(consumer *api.Consumer) openapi.Consumer {
 		Labels:    consumer.Labels.ToMap(),
 		CreatedAt: openapi.PtrTime(consumer.CreatedAt),
 		UpdatedAt: openapi.PtrTime(consumer.UpdatedAt),
+		Connected: openapi.PtrBool(consumer.Connected),
+		LastSeen:  consumer.LastSeen,
 	}
 }
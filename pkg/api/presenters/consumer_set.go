@@ -0,0 +1,46 @@
+package presenters
+
+import (
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/util"
+)
+
+// ConvertConsumerSet converts a consumer set from the openapi representation to the API consumer set.
+func ConvertConsumerSet(consumerSet openapi.ConsumerSet) *api.ConsumerSet {
+	return &api.ConsumerSet{
+		Meta: api.Meta{
+			ID: util.NilToEmptyString(consumerSet.Id),
+		},
+		Name:             util.NilToEmptyString(consumerSet.Name),
+		Members:          consumerSet.Members,
+		ConsumerSelector: consumerSet.ConsumerSelector,
+	}
+}
+
+// PresentConsumerSet converts a consumer set from the API to the openapi representation, the status is
+// aggregated from the resource bundles of the consumers in the set.
+func PresentConsumerSet(consumerSet *api.ConsumerSet, status *api.ConsumerSetStatus) openapi.ConsumerSet {
+	if status == nil {
+		status = &api.ConsumerSetStatus{}
+	}
+
+	reference := PresentReference(consumerSet.ID, consumerSet)
+	return openapi.ConsumerSet{
+		Id:               reference.Id,
+		Kind:             reference.Kind,
+		Href:             reference.Href,
+		Name:             openapi.PtrString(consumerSet.Name),
+		Members:          consumerSet.Members,
+		ConsumerSelector: consumerSet.ConsumerSelector,
+		CreatedAt:        openapi.PtrTime(consumerSet.CreatedAt),
+		UpdatedAt:        openapi.PtrTime(consumerSet.UpdatedAt),
+		Status: &openapi.ConsumerSetStatus{
+			Members:         openapi.PtrInt32(status.Members),
+			ResourceBundles: openapi.PtrInt32(status.ResourceBundles),
+			Applied:         openapi.PtrInt32(status.Applied),
+			Available:       openapi.PtrInt32(status.Available),
+			Degraded:        openapi.PtrInt32(status.Degraded),
+		},
+	}
+}
@@ -17,6 +17,22 @@ func ObjectKind(i interface{}) *string {
 		result = "ResourceBundle"
 	case api.ResourceList, *api.ResourceList, []api.Resource, []*api.Resource:
 		result = "ResourceBundleList"
+	case api.ResourceRevision, *api.ResourceRevision:
+		result = "ResourceBundleRevision"
+	case api.ResourceRevisionList, *api.ResourceRevisionList, []api.ResourceRevision, []*api.ResourceRevision:
+		result = "ResourceBundleRevisionList"
+	case api.ConsumerSet, *api.ConsumerSet:
+		result = "ConsumerSet"
+	case api.ConsumerSetList, *api.ConsumerSetList, []api.ConsumerSet, []*api.ConsumerSet:
+		result = "ConsumerSetList"
+	case api.Placement, *api.Placement:
+		result = "Placement"
+	case api.PlacementList, *api.PlacementList, []api.Placement, []*api.Placement:
+		result = "PlacementList"
+	case api.Operation, *api.Operation:
+		result = "Operation"
+	case api.OperationList, *api.OperationList, []api.Operation, []*api.Operation:
+		result = "OperationList"
 	case errors.ServiceError, *errors.ServiceError:
 		result = "Error"
 	}
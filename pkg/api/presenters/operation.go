@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+func PresentOperation(operation *api.Operation) openapi.Operation {
+	reference := PresentReference(operation.ID, operation)
+	o := openapi.Operation{
+		Id:        reference.Id,
+		Kind:      reference.Kind,
+		Href:      reference.Href,
+		Type:      openapi.PtrString(string(operation.Type)),
+		Phase:     openapi.PtrString(string(operation.Phase)),
+		Total:     openapi.PtrInt32(operation.Total),
+		Completed: openapi.PtrInt32(operation.Completed),
+		CreatedAt: openapi.PtrTime(operation.CreatedAt),
+		UpdatedAt: openapi.PtrTime(operation.UpdatedAt),
+	}
+	if operation.TargetID != "" {
+		o.TargetId = openapi.PtrString(operation.TargetID)
+	}
+	if operation.Search != "" {
+		o.Search = openapi.PtrString(operation.Search)
+	}
+	if operation.EventID != "" {
+		o.EventId = openapi.PtrString(operation.EventID)
+	}
+	if operation.ResourceVersion != 0 {
+		o.ResourceVersion = openapi.PtrInt32(operation.ResourceVersion)
+	}
+	if operation.DispatchedAt != nil {
+		o.DispatchedAt = openapi.PtrTime(*operation.DispatchedAt)
+	}
+	if operation.Message != "" {
+		o.Message = openapi.PtrString(operation.Message)
+	}
+	if operation.CompletedAt != nil {
+		o.CompletedAt = openapi.PtrTime(*operation.CompletedAt)
+	}
+	return o
+}
@@ -18,10 +18,16 @@ func ObjectPath(id string, obj interface{}) *string {
 
 func path(i interface{}) string {
 	switch i.(type) {
-	case api.Resource, *api.Resource:
+	case api.Resource, *api.Resource, api.ResourceRevision, *api.ResourceRevision:
 		return "resource-bundles"
 	case api.Consumer, *api.Consumer:
 		return "consumers"
+	case api.ConsumerSet, *api.ConsumerSet:
+		return "consumer-sets"
+	case api.Placement, *api.Placement:
+		return "placements"
+	case api.Operation, *api.Operation:
+		return "operations"
 	case errors.ServiceError, *errors.ServiceError:
 		return "errors"
 	default:
@@ -0,0 +1,165 @@
+package presenters
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// SlicePathFilter converts the items of a list to maps that only have the given fields, like SliceFilter. The
+// fields are json paths, e.g. status.conditions, and the paths continue into the free-form maps of the items and
+// into each element of their arrays. <field>.* means all of a field. A field that doesn't exist in the type of
+// the items causes a validation error, a path that doesn't exist in an item is omitted.
+func SlicePathFilter(fields []string, model interface{}) (*ProjectionList, *errors.ServiceError) {
+	if model == nil {
+		return nil, errors.Validation("Empty model")
+	}
+
+	reflectValue := reflect.Indirect(reflect.ValueOf(model))
+	items := reflectValue.FieldByName("Items")
+	if err := validatePaths(items.Type().Elem(), fields); err != nil {
+		return nil, err
+	}
+
+	result := &ProjectionList{
+		Kind:  reflectValue.FieldByName("Kind").String(),
+		Page:  int32(reflectValue.FieldByName("Page").Int()),
+		Size:  int32(reflectValue.FieldByName("Size").Int()),
+		Total: int32(reflectValue.FieldByName("Total").Int()),
+		Items: []map[string]interface{}{},
+	}
+	if continueToken := reflectValue.FieldByName("Continue"); continueToken.IsValid() && !continueToken.IsNil() {
+		result.Continue = continueToken.Elem().String()
+	}
+	if resourceVersion := reflectValue.FieldByName("ResourceVersion"); resourceVersion.IsValid() && !resourceVersion.IsNil() {
+		result.ResourceVersion = resourceVersion.Elem().String()
+	}
+
+	for i := 0; i < items.Len(); i++ {
+		projected, err := projectItem(items.Index(i).Interface(), fields)
+		if err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, projected)
+	}
+	return result, nil
+}
+
+// PathFilter converts an object to a map that only has the given fields, the fields are json paths as the fields
+// of SlicePathFilter.
+func PathFilter(fields []string, item interface{}) (map[string]interface{}, *errors.ServiceError) {
+	if item == nil {
+		return nil, errors.Validation("Empty model")
+	}
+
+	if err := validatePaths(reflect.TypeOf(item), fields); err != nil {
+		return nil, err
+	}
+	return projectItem(item, fields)
+}
+
+// validatePaths checks that the first field of every path is a json field of the type.
+func validatePaths(t reflect.Type, paths []string) *errors.ServiceError {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	known := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		known[strings.Split(tag, ",")[0]] = true
+	}
+
+	unknown := []string{}
+	for _, path := range paths {
+		if !known[strings.Split(path, ".")[0]] {
+			unknown = append(unknown, path)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	message := fmt.Sprintf("The following field(s) doesn't exist in `%s`: %s", t.Name(), strings.Join(unknown, ", "))
+	return errors.Validation("%s", message)
+}
+
+// projectItem converts an item to its json representation and keeps the paths of it.
+func projectItem(item interface{}, paths []string) (map[string]interface{}, *errors.ServiceError) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to project fields: %s", err)
+	}
+	value := map[string]interface{}{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, errors.GeneralError("Unable to project fields: %s", err)
+	}
+
+	splitPaths := make([][]string, 0, len(paths))
+	for _, path := range paths {
+		splitPaths = append(splitPaths, strings.Split(path, "."))
+	}
+	projected, _ := projectPaths(value, splitPaths).(map[string]interface{})
+	if projected == nil {
+		projected = map[string]interface{}{}
+	}
+	return projected, nil
+}
+
+// projectPaths keeps the paths of a json value, the paths of an array are kept in each of its elements. nil is
+// returned if the value has none of the paths.
+func projectPaths(value interface{}, paths [][]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		whole := map[string]bool{}
+		nested := map[string][][]string{}
+		for _, path := range paths {
+			if path[0] == "*" {
+				return v
+			}
+			if len(path) == 1 || path[1] == "*" {
+				whole[path[0]] = true
+				continue
+			}
+			nested[path[0]] = append(nested[path[0]], path[1:])
+		}
+
+		result := map[string]interface{}{}
+		for key, child := range v {
+			if whole[key] {
+				result[key] = child
+				continue
+			}
+			if childPaths, ok := nested[key]; ok {
+				if projected := projectPaths(child, childPaths); projected != nil {
+					result[key] = projected
+				}
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case []interface{}:
+		result := []interface{}{}
+		for _, element := range v {
+			if projected := projectPaths(element, paths); projected != nil {
+				result = append(result, projected)
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	default:
+		return nil
+	}
+}
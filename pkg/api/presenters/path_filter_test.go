@@ -0,0 +1,127 @@
+package presenters
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+func TestSlicePathFilter(t *testing.T) {
+	list := openapi.ResourceBundleList{
+		Kind:            "ResourceBundleList",
+		Page:            1,
+		Size:            1,
+		Total:           2,
+		Continue:        openapi.PtrString("token"),
+		ResourceVersion: openapi.PtrString("42"),
+		Items: []openapi.ResourceBundle{
+			{
+				Id:           openapi.PtrString("id1"),
+				Name:         openapi.PtrString("nginx"),
+				ConsumerName: openapi.PtrString("cluster1"),
+				Manifests: []map[string]interface{}{
+					{"kind": "Deployment", "metadata": map[string]interface{}{"name": "nginx"}},
+					{"kind": "Service"},
+				},
+				Status: map[string]interface{}{
+					"conditions":     []interface{}{map[string]interface{}{"type": "Applied", "status": "True"}},
+					"resourceStatus": []interface{}{},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		fields        []string
+		expectedItems []map[string]interface{}
+		expectedErr   bool
+	}{
+		{
+			name:   "top level fields",
+			fields: []string{"id", "name"},
+			expectedItems: []map[string]interface{}{
+				{"id": "id1", "name": "nginx"},
+			},
+		},
+		{
+			name:   "nested fields",
+			fields: []string{"id", "status.conditions"},
+			expectedItems: []map[string]interface{}{
+				{"id": "id1", "status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Applied", "status": "True"}},
+				}},
+			},
+		},
+		{
+			name:   "nested fields of arrays",
+			fields: []string{"id", "manifests.metadata.name"},
+			expectedItems: []map[string]interface{}{
+				{"id": "id1", "manifests": []interface{}{
+					map[string]interface{}{"metadata": map[string]interface{}{"name": "nginx"}},
+				}},
+			},
+		},
+		{
+			name:   "all fields of a structure",
+			fields: []string{"id", "status.*"},
+			expectedItems: []map[string]interface{}{
+				{"id": "id1", "status": map[string]interface{}{
+					"conditions":     []interface{}{map[string]interface{}{"type": "Applied", "status": "True"}},
+					"resourceStatus": []interface{}{},
+				}},
+			},
+		},
+		{
+			name:   "missing nested fields",
+			fields: []string{"id", "status.unknown"},
+			expectedItems: []map[string]interface{}{
+				{"id": "id1"},
+			},
+		},
+		{
+			name:        "unknown fields",
+			fields:      []string{"id", "unknown"},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			projected, err := SlicePathFilter(c.fields, list)
+			if c.expectedErr {
+				Expect(err).NotTo(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(projected.Kind).To(Equal("ResourceBundleList"))
+			Expect(projected.Total).To(Equal(int32(2)))
+			Expect(projected.Continue).To(Equal("token"))
+			Expect(projected.ResourceVersion).To(Equal("42"))
+			Expect(projected.Items).To(Equal(c.expectedItems))
+		})
+	}
+}
+
+func TestResourceBundleColumns(t *testing.T) {
+	RegisterTestingT(t)
+
+	columns, err := ResourceBundleColumns([]string{"id", "name", "status.conditions"})
+	Expect(err).To(BeNil())
+	Expect(columns).To(Equal([]string{"id", "name", "stale_since", "status", "version"}))
+
+	columns, err = ResourceBundleColumns([]string{"id", "manifests.metadata.name", "metadata"})
+	Expect(err).To(BeNil())
+	Expect(columns).To(Equal([]string{"deleted_at", "id", "payload"}))
+
+	columns, err = ResourceBundleColumns([]string{"id", "manifest_managers"})
+	Expect(err).To(BeNil())
+	Expect(columns).To(Equal([]string{"id", "manifest_managers"}))
+
+	_, err = ResourceBundleColumns([]string{"id", "unknown"})
+	Expect(err).NotTo(BeNil())
+}
@@ -0,0 +1,109 @@
+package presenters
+
+import (
+	"gorm.io/datatypes"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/util"
+)
+
+// ConvertPlacement converts a placement from the openapi representation to the API placement.
+// A new placement id is generated, since it is also used as the resource id of the manifest bundle template.
+func ConvertPlacement(placement openapi.Placement) (*api.Placement, error) {
+	id := api.NewID()
+	source := util.NilToEmptyString(placement.Source)
+	payload, err := api.NewManifestBundle(source, id, &api.ManifestBundleWrapper{
+		Meta:            placement.Metadata,
+		Manifests:       placement.Manifests,
+		ManifestConfigs: placement.ManifestConfigs,
+		DeleteOption:    placement.DeleteOption,
+	})
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := ConvertRolloutStrategy(placement.RolloutStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Placement{
+		Meta: api.Meta{
+			ID: id,
+		},
+		Name:             util.NilToEmptyString(placement.Name),
+		Source:           source,
+		ConsumerSelector: placement.ConsumerSelector,
+		Payload:          payload,
+		RolloutStrategy:  strategy,
+	}, nil
+}
+
+// ConvertRolloutStrategy converts a rollout strategy from the openapi representation to the JSONMap
+// representation of the API placement, nil is returned if the rollout strategy is not set.
+func ConvertRolloutStrategy(strategy *openapi.PlacementRolloutStrategy) (datatypes.JSONMap, error) {
+	if strategy == nil {
+		return nil, nil
+	}
+
+	return api.EncodeRolloutStrategy(&api.PlacementRolloutStrategy{
+		Type:      api.RolloutStrategyType(util.NilToEmptyString(strategy.Type)),
+		BatchSize: util.NilToEmptyInt32(strategy.BatchSize),
+	})
+}
+
+// PresentPlacement converts a placement from the API to the openapi representation.
+func PresentPlacement(placement *api.Placement) (*openapi.Placement, error) {
+	manifestWrapper, err := api.DecodeManifestBundle(placement.Payload)
+	if err != nil {
+		return nil, err
+	}
+	status, err := api.DecodePlacementStatus(placement.Status)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := placement.Rollout()
+	if err != nil {
+		return nil, err
+	}
+
+	reference := PresentReference(placement.ID, placement)
+	p := &openapi.Placement{
+		Id:               reference.Id,
+		Kind:             reference.Kind,
+		Href:             reference.Href,
+		Name:             openapi.PtrString(placement.Name),
+		Source:           openapi.PtrString(placement.Source),
+		Version:          openapi.PtrInt32(placement.Version),
+		CreatedAt:        openapi.PtrTime(placement.CreatedAt),
+		UpdatedAt:        openapi.PtrTime(placement.UpdatedAt),
+		ConsumerSelector: placement.ConsumerSelector,
+		RolloutStrategy: &openapi.PlacementRolloutStrategy{
+			Type:      openapi.PtrString(string(strategy.Type)),
+			BatchSize: openapi.PtrInt32(strategy.BatchSize),
+		},
+		RolloutPhase: openapi.PtrString(string(placement.RolloutPhase)),
+		Status: &openapi.PlacementStatus{
+			Desired:         openapi.PtrInt32(status.Desired),
+			Current:         openapi.PtrInt32(status.Current),
+			Updated:         openapi.PtrInt32(status.Updated),
+			Applied:         openapi.PtrInt32(status.Applied),
+			Available:       openapi.PtrInt32(status.Available),
+			Failed:          openapi.PtrInt32(status.Failed),
+			ObservedVersion: openapi.PtrInt32(status.ObservedVersion),
+			ProgressedAt:    status.ProgressedAt,
+			Stalled:         openapi.PtrBool(status.Stalled),
+			PausedFailures:  status.PausedFailures,
+			Message:         openapi.PtrString(status.Message),
+		},
+	}
+
+	if manifestWrapper != nil {
+		p.Metadata = manifestWrapper.Meta
+		p.Manifests = manifestWrapper.Manifests
+		p.ManifestConfigs = manifestWrapper.ManifestConfigs
+		p.DeleteOption = manifestWrapper.DeleteOption
+	}
+
+	return p, nil
+}
@@ -0,0 +1,38 @@
+package presenters
+
+import (
+	"sort"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+// PresentQuotaUsage converts the quota usage from the API to the openapi representation, the consumers and the
+// sources are sorted by their names.
+func PresentQuotaUsage(usage *api.QuotaUsage) *openapi.QuotaUsage {
+	return &openapi.QuotaUsage{
+		Kind: openapi.PtrString("QuotaUsage"),
+		Quota: &openapi.ResourceQuota{
+			MaxResourceBundlesPerConsumer: openapi.PtrInt64(usage.Quota.MaxResourcesPerConsumer),
+			MaxResourceBundlesPerSource:   openapi.PtrInt64(usage.Quota.MaxResourcesPerSource),
+			MaxManifestsPerResourceBundle: openapi.PtrInt64(usage.Quota.MaxManifestsPerResource),
+			MaxPayloadBytes:               openapi.PtrInt64(usage.Quota.MaxPayloadBytes),
+		},
+		Consumers: presentQuotaUsageItems(usage.Consumers),
+		Sources:   presentQuotaUsageItems(usage.Sources),
+	}
+}
+
+func presentQuotaUsageItems(counts map[string]int64) []openapi.QuotaUsageItem {
+	items := make([]openapi.QuotaUsageItem, 0, len(counts))
+	for name, count := range counts {
+		items = append(items, openapi.QuotaUsageItem{
+			Name:            openapi.PtrString(name),
+			ResourceBundles: openapi.PtrInt64(count),
+		})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return *items[i].Name < *items[j].Name
+	})
+	return items
+}
@@ -1,10 +1,92 @@
 package presenters
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/util"
 )
 
+// ConvertResourceBundle converts a resource bundle from the openapi representation to the API resource.
+// A new resource id is generated, since it is also used as the resource id of the manifest bundle.
+func ConvertResourceBundle(rb openapi.ResourceBundle) (*api.Resource, error) {
+	id := api.NewID()
+	source := util.NilToEmptyString(rb.Source)
+	payload, err := api.NewManifestBundle(source, id, &api.ManifestBundleWrapper{
+		Meta:            rb.Metadata,
+		Manifests:       rb.Manifests,
+		ManifestConfigs: rb.ManifestConfigs,
+		DeleteOption:    rb.DeleteOption,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Resource{
+		Meta: api.Meta{
+			ID: id,
+		},
+		Name:         util.NilToEmptyString(rb.Name),
+		ConsumerName: util.NilToEmptyString(rb.ConsumerName),
+		Source:       source,
+		Payload:      payload,
+	}, nil
+}
+
+// resourceBundleColumns are the columns of the resources table that each field of a resource bundle is presented
+// from.
+var resourceBundleColumns = map[string][]string{
+	"id":                {"id"},
+	"kind":              {"id"},
+	"href":              {"id"},
+	"name":              {"name"},
+	"consumer_name":     {"consumer_name"},
+	"source":            {"source"},
+	"placement_id":      {"placement_id"},
+	"version":           {"version"},
+	"resource_version":  {"resource_version"},
+	"created_at":        {"created_at"},
+	"updated_at":        {"updated_at"},
+	"deleted_at":        {"deleted_at"},
+	"metadata":          {"payload", "deleted_at"},
+	"manifests":         {"payload"},
+	"delete_option":     {"payload"},
+	"manifest_configs":  {"payload"},
+	"manifest_managers": {"manifest_managers"},
+	"status":            {"status", "stale_since", "version"},
+}
+
+// ResourceBundleColumns returns the columns of the resources table that the fields of the resource bundles are
+// presented from, so a list only loads the columns of its fields. The fields can be nested paths, e.g.
+// status.conditions, which are presented from the column of their first field.
+func ResourceBundleColumns(fields []string) ([]string, *errors.ServiceError) {
+	columns := sets.New[string]()
+	unknown := []string{}
+	for _, field := range fields {
+		fieldColumns, ok := resourceBundleColumns[strings.Split(field, ".")[0]]
+		if !ok {
+			unknown = append(unknown, field)
+			continue
+		}
+		columns.Insert(fieldColumns...)
+	}
+	if len(unknown) != 0 {
+		sort.Strings(unknown)
+		return nil, errors.Validation("The following field(s) doesn't exist in `ResourceBundle`: %s",
+			strings.Join(unknown, ", "))
+	}
+	return sets.List(columns), nil
+}
+
 // PresentResourceBundle converts a resource from the API to the openapi representation.
 func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, error) {
 	manifestWrapper, err := api.DecodeManifestBundle(resource.Payload)
@@ -15,6 +97,9 @@ func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, err
 	if err != nil {
 		return nil, err
 	}
+	if resource.StaleSince != nil {
+		status = withStaleCondition(status, resource)
+	}
 
 	reference := PresentReference(resource.ID, resource)
 	rb := &openapi.ResourceBundle{
@@ -23,6 +108,7 @@ func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, err
 		Href:         reference.Href,
 		Name:         openapi.PtrString(resource.Name),
 		ConsumerName: openapi.PtrString(resource.ConsumerName),
+		Source:       openapi.PtrString(resource.Source),
 		Version:      openapi.PtrInt32(resource.Version),
 		CreatedAt:    openapi.PtrTime(resource.CreatedAt),
 		UpdatedAt:    openapi.PtrTime(resource.UpdatedAt),
@@ -36,6 +122,25 @@ func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, err
 		rb.DeleteOption = manifestWrapper.DeleteOption
 	}
 
+	// the resource version is assigned when the change of the resource commits
+	if resource.ResourceVersion != 0 {
+		rb.ResourceVersion = openapi.PtrString(strconv.FormatInt(resource.ResourceVersion, 10))
+	}
+
+	// set the manifest managers if the manifests are applied by field managers
+	if len(resource.ManifestManagers) != 0 {
+		managers := make(map[string]string, len(resource.ManifestManagers))
+		for key, manager := range resource.ManifestManagers {
+			managers[key], _ = manager.(string)
+		}
+		rb.ManifestManagers = &managers
+	}
+
+	// set the placementId field if the resource is created for a placement
+	if resource.PlacementID != "" {
+		rb.PlacementId = openapi.PtrString(resource.PlacementID)
+	}
+
 	// set the deletedAt field if the resource has been marked as deleted
 	if !resource.DeletedAt.Time.IsZero() {
 		rb.DeletedAt = openapi.PtrTime(resource.DeletedAt.Time)
@@ -43,3 +148,71 @@ func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, err
 
 	return rb, nil
 }
+
+// withStaleCondition adds the Stale condition to the status of a resource bundle whose status is stale.
+func withStaleCondition(status map[string]interface{}, resource *api.Resource) map[string]interface{} {
+	if status == nil {
+		status = map[string]interface{}{}
+	}
+	conditions, _ := status["conditions"].([]interface{})
+	status["conditions"] = append(conditions, map[string]interface{}{
+		"type":               api.StaleCondition,
+		"status":             string(metav1.ConditionTrue),
+		"reason":             "StatusNotReported",
+		"message":            fmt.Sprintf("The status of version %d is not reported", resource.Version),
+		"lastTransitionTime": resource.StaleSince.UTC().Format(time.RFC3339),
+	})
+	return status
+}
+
+// PresentResourceBundleDiff converts a resource bundle diff from the API to the openapi representation.
+func PresentResourceBundleDiff(diff *api.ResourceBundleDiff) *openapi.ResourceBundleDiff {
+	manifests := make([]openapi.ManifestDiff, 0, len(diff.Manifests))
+	for _, manifest := range diff.Manifests {
+		manifests = append(manifests, openapi.ManifestDiff{
+			Change:     openapi.PtrString(string(manifest.Change)),
+			ApiVersion: openapi.PtrString(manifest.APIVersion),
+			Kind:       openapi.PtrString(manifest.Kind),
+			Namespace:  openapi.PtrString(manifest.Namespace),
+			Name:       openapi.PtrString(manifest.Name),
+			Patch:      manifest.Patch,
+		})
+	}
+
+	return &openapi.ResourceBundleDiff{
+		ResourceId:             openapi.PtrString(diff.ResourceID),
+		Version:                openapi.PtrInt32(diff.Version),
+		Changed:                openapi.PtrBool(diff.Changed()),
+		MetadataChanged:        openapi.PtrBool(diff.MetadataChanged),
+		ManifestConfigsChanged: openapi.PtrBool(diff.ManifestConfigsChanged),
+		DeleteOptionChanged:    openapi.PtrBool(diff.DeleteOptionChanged),
+		Manifests:              manifests,
+	}
+}
+
+// PresentResourceBundleRevision converts a resource revision from the API to the openapi representation,
+// the revision is addressed by the version of the resource bundle.
+func PresentResourceBundleRevision(revision *api.ResourceRevision) (*openapi.ResourceBundleRevision, error) {
+	manifestWrapper, err := api.DecodeManifestBundle(revision.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	rbr := &openapi.ResourceBundleRevision{
+		Id:         openapi.PtrString(revision.ID),
+		Kind:       ObjectKind(revision),
+		Href:       ObjectPath(fmt.Sprintf("%s/revisions/%d", revision.ResourceID, revision.Version), revision),
+		ResourceId: openapi.PtrString(revision.ResourceID),
+		Version:    openapi.PtrInt32(revision.Version),
+		CreatedAt:  openapi.PtrTime(revision.CreatedAt),
+	}
+
+	if manifestWrapper != nil {
+		rbr.Metadata = manifestWrapper.Meta
+		rbr.Manifests = manifestWrapper.Manifests
+		rbr.ManifestConfigs = manifestWrapper.ManifestConfigs
+		rbr.DeleteOption = manifestWrapper.DeleteOption
+	}
+
+	return rbr, nil
+}
@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/util"
+)
+
+// ConvertResourceBundleFilter converts a resource bundle filter from the openapi representation to the API
+// resource filter.
+func ConvertResourceBundleFilter(filter openapi.ResourceBundleFilter) *api.ResourceFilter {
+	labels := []api.LabelRequirement{}
+	for _, label := range filter.Labels {
+		labels = append(labels, api.LabelRequirement{
+			Key:      label.Key,
+			Operator: api.LabelOperator(label.Operator),
+			Values:   label.Values,
+		})
+	}
+
+	conditions := []api.ConditionRequirement{}
+	for _, condition := range filter.Conditions {
+		conditions = append(conditions, api.ConditionRequirement{
+			Type:   condition.Type,
+			Status: metav1.ConditionStatus(condition.Status),
+		})
+	}
+
+	return &api.ResourceFilter{
+		Source:        util.NilToEmptyString(filter.Source),
+		ConsumerNames: filter.ConsumerNames,
+		Names:         filter.Names,
+		Labels:        labels,
+		Conditions:    conditions,
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+		UpdatedAfter:  filter.UpdatedAfter,
+		UpdatedBefore: filter.UpdatedBefore,
+	}
+}
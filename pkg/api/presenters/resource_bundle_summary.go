@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"sort"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+// PresentResourceBundleSummary converts the resource bundle summary from the API to the openapi representation,
+// the items are sorted by their keys.
+func PresentResourceBundleSummary(summary *api.ResourceBundleSummary) *openapi.ResourceBundleSummary {
+	items := make([]openapi.ResourceBundleSummaryItem, 0, len(summary.Items))
+	for _, item := range summary.Items {
+		presented := presentResourceBundleSummaryItem(item)
+		presented.Key = openapi.PtrString(item.Key)
+		items = append(items, presented)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return *items[i].Key < *items[j].Key
+	})
+
+	total := presentResourceBundleSummaryItem(summary.Total())
+	presented := &openapi.ResourceBundleSummary{
+		Kind:    openapi.PtrString("ResourceBundleSummary"),
+		GroupBy: openapi.PtrString(string(summary.GroupBy)),
+		Total:   &total,
+		Items:   items,
+	}
+	if summary.Label != "" {
+		presented.Label = openapi.PtrString(summary.Label)
+	}
+	return presented
+}
+
+func presentResourceBundleSummaryItem(item api.ResourceBundleSummaryItem) openapi.ResourceBundleSummaryItem {
+	return openapi.ResourceBundleSummaryItem{
+		Total:       openapi.PtrInt64(item.Total),
+		Applied:     openapi.PtrInt64(item.Applied),
+		Available:   openapi.PtrInt64(item.Available),
+		Degraded:    openapi.PtrInt64(item.Degraded),
+		Deleting:    openapi.PtrInt64(item.Deleting),
+		NotReported: openapi.PtrInt64(item.NotReported),
+	}
+}
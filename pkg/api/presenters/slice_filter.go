@@ -11,11 +11,13 @@ import (
 )
 
 type ProjectionList struct {
-	Kind  string                   `json:"kind"`
-	Page  int32                    `json:"page"`
-	Size  int32                    `json:"size"`
-	Total int32                    `json:"total"`
-	Items []map[string]interface{} `json:"items"`
+	Kind            string                   `json:"kind"`
+	Page            int32                    `json:"page"`
+	Size            int32                    `json:"size"`
+	Total           int32                    `json:"total"`
+	Continue        string                   `json:"continue,omitempty"`
+	ResourceVersion string                   `json:"resource_version,omitempty"`
+	Items           []map[string]interface{} `json:"items"`
 }
 
 /*
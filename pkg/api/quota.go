@@ -0,0 +1,21 @@
+package api
+
+// ResourceQuota is the quotas of the resource bundles, a quota is not enforced if it is 0.
+type ResourceQuota struct {
+	// MaxResourcesPerConsumer is the maximum number of the resource bundles of a consumer.
+	MaxResourcesPerConsumer int64
+	// MaxResourcesPerSource is the maximum number of the resource bundles of a source.
+	MaxResourcesPerSource int64
+	// MaxManifestsPerResource is the maximum number of the manifests of a resource bundle.
+	MaxManifestsPerResource int64
+	// MaxPayloadBytes is the maximum size of the JSON encoded payload of a resource bundle.
+	MaxPayloadBytes int64
+}
+
+// QuotaUsage is the number of the resource bundles of each consumer and each source against the quotas, the
+// resource bundles under deletion are not counted.
+type QuotaUsage struct {
+	Quota     ResourceQuota
+	Consumers map[string]int64
+	Sources   map[string]int64
+}
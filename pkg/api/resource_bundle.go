@@ -1,12 +1,16 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	cloudeventstypes "github.com/cloudevents/sdk-go/v2/types"
 	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	workv1 "open-cluster-management.io/api/work/v1"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 )
@@ -99,9 +103,136 @@ func DecodeManifestBundle(manifestBundle datatypes.JSONMap) (*ManifestBundleWrap
 	}, nil
 }
 
+// NewManifestBundle converts the metadata, manifests, manifest configs and delete option of the
+// openapi input into a CloudEvent JSONMap representation of a resource manifest bundle.
+func NewManifestBundle(source, resourceID string, manifestBundleWrapper *ManifestBundleWrapper) (datatypes.JSONMap, error) {
+	evt := types.NewEventBuilder(source, types.CloudEventsType{
+		CloudEventsDataType: workpayload.ManifestBundleEventDataType,
+		SubResource:         types.SubResourceSpec,
+		Action:              types.CreateRequestAction,
+	}).WithResourceID(resourceID).NewEvent()
+
+	if err := setManifestBundle(&evt, &workpayload.ManifestBundle{}, manifestBundleWrapper); err != nil {
+		return nil, err
+	}
+
+	return CloudEventToJSONMap(&evt)
+}
+
+// PatchManifestBundle applies the non-nil metadata, manifests, manifest configs and delete option of
+// the openapi input to the given CloudEvent JSONMap representation of a resource manifest bundle.
+// The other fields of the manifest bundle and the CloudEvent attributes are kept unchanged.
+func PatchManifestBundle(manifestBundle datatypes.JSONMap, manifestBundleWrapper *ManifestBundleWrapper) (datatypes.JSONMap, error) {
+	evt, err := JSONMAPToCloudEvent(manifestBundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert resource manifest bundle to cloudevent: %v", err)
+	}
+
+	eventPayload := &workpayload.ManifestBundle{}
+	if err := evt.DataAs(eventPayload); err != nil {
+		return nil, fmt.Errorf("failed to decode cloudevent payload: %v", err)
+	}
+
+	if err := setManifestBundle(evt, eventPayload, manifestBundleWrapper); err != nil {
+		return nil, err
+	}
+
+	return CloudEventToJSONMap(evt)
+}
+
+// setManifestBundle sets the non-nil fields of the manifest bundle wrapper to the manifest bundle,
+// and then sets the manifest bundle as the CloudEvent data.
+func setManifestBundle(evt *cloudevents.Event, eventPayload *workpayload.ManifestBundle, manifestBundleWrapper *ManifestBundleWrapper) error {
+	if manifestBundleWrapper.Meta != nil {
+		// an empty metadata removes the metadata extension
+		var meta interface{}
+		if len(manifestBundleWrapper.Meta) != 0 {
+			metaJson, err := json.Marshal(manifestBundleWrapper.Meta)
+			if err != nil {
+				return fmt.Errorf("failed to marshal metadata: %v", err)
+			}
+			meta = string(metaJson)
+		}
+		evt.SetExtension(types.ExtensionWorkMeta, meta)
+	}
+
+	if manifestBundleWrapper.Manifests != nil {
+		manifests := make([]workv1.Manifest, 0, len(manifestBundleWrapper.Manifests))
+		for _, manifest := range manifestBundleWrapper.Manifests {
+			raw, err := json.Marshal(manifest)
+			if err != nil {
+				return fmt.Errorf("failed to marshal manifest: %v", err)
+			}
+			manifests = append(manifests, workv1.Manifest{RawExtension: runtime.RawExtension{Raw: raw}})
+		}
+		eventPayload.Manifests = manifests
+	}
+
+	if manifestBundleWrapper.ManifestConfigs != nil {
+		manifestConfigs := []workv1.ManifestConfigOption{}
+		if err := convertJSON(manifestBundleWrapper.ManifestConfigs, &manifestConfigs); err != nil {
+			return fmt.Errorf("failed to convert manifest configs: %v", err)
+		}
+		eventPayload.ManifestConfigs = manifestConfigs
+	}
+
+	if manifestBundleWrapper.DeleteOption != nil {
+		eventPayload.DeleteOption = nil
+		if len(manifestBundleWrapper.DeleteOption) != 0 {
+			deleteOption := &workv1.DeleteOption{}
+			if err := convertJSON(manifestBundleWrapper.DeleteOption, deleteOption); err != nil {
+				return fmt.Errorf("failed to convert delete option: %v", err)
+			}
+			eventPayload.DeleteOption = deleteOption
+		}
+	}
+
+	if err := evt.SetData(cloudevents.ApplicationJSON, eventPayload); err != nil {
+		return fmt.Errorf("failed to set cloudevent data: %v", err)
+	}
+
+	return nil
+}
+
+// convertJSON converts the given object into the out object through its JSON representation,
+// unknown fields are rejected.
+func convertJSON(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(out)
+}
+
 // DecodeBundleStatus converts a CloudEvent JSONMap representation of a resource bundle status
 // into resource bundle status (map[string]interface{}) in openapi output.
 func DecodeBundleStatus(status datatypes.JSONMap) (map[string]interface{}, error) {
+	resourceBundleStatus, err := DecodeResourceBundleStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	if resourceBundleStatus == nil {
+		return nil, nil
+	}
+
+	resourceBundleStatusJSON, err := json.Marshal(resourceBundleStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource status: %v", err)
+	}
+	resourceBundleStatusMap := make(map[string]interface{})
+	if err := json.Unmarshal(resourceBundleStatusJSON, &resourceBundleStatusMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resource status: %v", err)
+	}
+
+	return resourceBundleStatusMap, nil
+}
+
+// DecodeResourceBundleStatus converts a CloudEvent JSONMap representation of a resource bundle status
+// into ResourceBundleStatus, nil is returned if the resource has no status yet.
+func DecodeResourceBundleStatus(status datatypes.JSONMap) (*ResourceBundleStatus, error) {
 	if len(status) == 0 {
 		return nil, nil
 	}
@@ -130,16 +261,38 @@ func DecodeBundleStatus(status datatypes.JSONMap) (map[string]interface{}, error
 	if err := evt.DataAs(resourceBundleStatus.ManifestBundleStatus); err != nil {
 		return nil, fmt.Errorf("failed to decode cloudevent payload: %v", err)
 	}
-	resourceBundleStatusJSON, err := json.Marshal(resourceBundleStatus)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal resource status: %v", err)
+
+	return resourceBundleStatus, nil
+}
+
+// StaleCondition is the condition of a resource bundle whose status of the current version is not reported longer
+// than the stale status threshold.
+const StaleCondition = "Stale"
+
+// ResourceBundleRollout is the rollout state of a resource bundle on its consumer.
+type ResourceBundleRollout struct {
+	Applied   bool
+	Available bool
+	// Failed is true if the resource bundle failed to apply or is degraded.
+	Failed bool
+}
+
+// ResourceBundleRolloutOf returns the rollout state of the current version of a resource bundle, a resource
+// bundle whose status is missing or reported for a previous version is neither applied nor failed.
+func ResourceBundleRolloutOf(resource *Resource) ResourceBundleRollout {
+	status, err := DecodeResourceBundleStatus(resource.Status)
+	if err != nil || status == nil || status.ManifestBundleStatus == nil {
+		return ResourceBundleRollout{}
 	}
-	resourceBundleStatusMap := make(map[string]interface{})
-	if err := json.Unmarshal(resourceBundleStatusJSON, &resourceBundleStatusMap); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal resource status: %v", err)
+	if status.ObservedVersion != resource.Version {
+		return ResourceBundleRollout{}
+	}
+	return ResourceBundleRollout{
+		Applied:   meta.IsStatusConditionTrue(status.Conditions, workv1.WorkApplied),
+		Available: meta.IsStatusConditionTrue(status.Conditions, workv1.WorkAvailable),
+		Failed: meta.IsStatusConditionFalse(status.Conditions, workv1.WorkApplied) ||
+			meta.IsStatusConditionTrue(status.Conditions, workv1.WorkDegraded),
 	}
-
-	return resourceBundleStatusMap, nil
 }
 
 // JSONMAPToCloudEvent converts a JSONMap (resource manifest or status) to a CloudEvent
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	"gorm.io/datatypes"
+)
+
+const (
+	// ExtensionFieldManager is the CloudEvent extension of a create or update request that applies the manifest
+	// bundle on behalf of a field manager.
+	ExtensionFieldManager = "fieldmanager"
+	// ExtensionForceApply is the CloudEvent extension of an update request that takes the ownership of the
+	// manifests that are owned by other field managers instead of failing with a conflict.
+	ExtensionForceApply = "forceapply"
+
+	// FieldManagerAnnotation is the annotation of a manifest bundle that sets the field manager of a request
+	// without the field manager extension, e.g. the requests of the ManifestWork clients.
+	FieldManagerAnnotation = "maestro.open-cluster-management.io/field-manager"
+	// ForceApplyAnnotation is the annotation of a manifest bundle that sets the force of a request without the
+	// force apply extension.
+	ForceApplyAnnotation = "maestro.open-cluster-management.io/force-apply"
+)
+
+// ApplyOptions are the options to apply a manifest bundle on behalf of a field manager.
+type ApplyOptions struct {
+	// FieldManager is the name of the field manager that owns the applied manifests.
+	FieldManager string
+	// Force takes the ownership of the applied manifests that are owned by other field managers.
+	Force bool
+}
+
+// ManifestConflict is a manifest that a field manager changes while it is owned by another field manager.
+type ManifestConflict struct {
+	// Key is the apiVersion/kind/namespace/name of the manifest.
+	Key     string
+	Manager string
+}
+
+func (c ManifestConflict) String() string {
+	return fmt.Sprintf("%s (owned by %s)", c.Key, c.Manager)
+}
+
+// ApplyOptionsFromManifestBundle returns the apply options that are set by the annotations in the metadata of a
+// manifest bundle, the field manager is empty if the manifest bundle is not applied by a field manager.
+func ApplyOptionsFromManifestBundle(manifestBundle datatypes.JSONMap) (ApplyOptions, error) {
+	wrapper, err := DecodeManifestBundle(manifestBundle)
+	if err != nil || wrapper == nil {
+		return ApplyOptions{}, err
+	}
+
+	annotations, _ := wrapper.Meta["annotations"].(map[string]interface{})
+	options := ApplyOptions{}
+	options.FieldManager, _ = annotations[FieldManagerAnnotation].(string)
+	if force, ok := annotations[ForceApplyAnnotation].(string); ok {
+		if options.Force, err = strconv.ParseBool(force); err != nil {
+			return ApplyOptions{}, fmt.Errorf("invalid %s annotation %q", ForceApplyAnnotation, force)
+		}
+	}
+	return options, nil
+}
+
+// ManifestManagersOf returns the manifest managers of a manifest bundle whose manifests are all owned by the
+// field manager, nil is returned if the field manager is empty.
+func ManifestManagersOf(manifestBundle datatypes.JSONMap, fieldManager string) (datatypes.JSONMap, error) {
+	if fieldManager == "" {
+		return nil, nil
+	}
+	wrapper, err := DecodeManifestBundle(manifestBundle)
+	if err != nil || wrapper == nil {
+		return nil, err
+	}
+
+	managers := datatypes.JSONMap{}
+	for _, manifest := range wrapper.Manifests {
+		managers[manifestKey(manifest)] = fieldManager
+	}
+	return emptyToNil(managers), nil
+}
+
+// PruneManifestManagers removes the owners of the manifests that are not in the manifest bundle, e.g. after the
+// manifests are replaced by an update that is not applied by a field manager.
+func PruneManifestManagers(managers, manifestBundle datatypes.JSONMap) (datatypes.JSONMap, error) {
+	if len(managers) == 0 {
+		return nil, nil
+	}
+	wrapper, err := DecodeManifestBundle(manifestBundle)
+	if err != nil || wrapper == nil {
+		return nil, err
+	}
+
+	pruned := datatypes.JSONMap{}
+	for _, manifest := range wrapper.Manifests {
+		key := manifestKey(manifest)
+		if manager, ok := managers[key]; ok {
+			pruned[key] = manager
+		}
+	}
+	return emptyToNil(pruned), nil
+}
+
+// ApplyManifestBundle applies the manifests of a field manager to the CloudEvent JSONMap representation of a
+// manifest bundle, and returns the applied manifest bundle and its manifest managers. The manifests are matched
+// by their apiVersion, kind, namespace and name:
+//   - an applied manifest replaces the stored one and is owned by the field manager, an applied manifest that is
+//     equal to the stored one keeps its owner.
+//   - the manifests of the field manager that are not applied are removed.
+//   - the manifests of the other field managers that are not applied are kept.
+//
+// A changed manifest that is owned by another field manager is a conflict, the conflicts are returned and the
+// manifest bundle is not applied, unless the options force the field manager to take their ownership. The
+// manifests are not changed if the applied manifests are nil. The non-nil metadata, manifest configs and delete
+// option of the applied manifest bundle replace the stored ones, they are not owned by a field manager.
+func ApplyManifestBundle(manifestBundle, managers datatypes.JSONMap, applied *ManifestBundleWrapper,
+	options ApplyOptions) (datatypes.JSONMap, datatypes.JSONMap, []ManifestConflict, error) {
+	if options.FieldManager == "" {
+		return nil, nil, nil, fmt.Errorf("the field manager is required")
+	}
+
+	stored, err := DecodeManifestBundle(manifestBundle)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode stored manifest bundle: %v", err)
+	}
+	if stored == nil {
+		stored = &ManifestBundleWrapper{}
+	}
+
+	appliedManagers := datatypes.JSONMap{}
+	for key, manager := range managers {
+		appliedManagers[key] = manager
+	}
+
+	result := &ManifestBundleWrapper{
+		Meta:            applied.Meta,
+		ManifestConfigs: applied.ManifestConfigs,
+		DeleteOption:    applied.DeleteOption,
+	}
+	conflicts := []ManifestConflict{}
+	if applied.Manifests != nil {
+		appliedKeys := []string{}
+		appliedManifests := map[string]map[string]interface{}{}
+		for _, manifest := range applied.Manifests {
+			key := manifestKey(manifest)
+			if _, ok := appliedManifests[key]; !ok {
+				appliedKeys = append(appliedKeys, key)
+			}
+			appliedManifests[key] = manifest
+		}
+
+		manifests := []map[string]interface{}{}
+		storedKeys := map[string]bool{}
+		for _, manifest := range stored.Manifests {
+			key := manifestKey(manifest)
+			storedKeys[key] = true
+			owner, _ := managers[key].(string)
+
+			appliedManifest, ok := appliedManifests[key]
+			switch {
+			case !ok && owner == options.FieldManager:
+				// the field manager doesn't apply its manifest anymore
+				delete(appliedManagers, key)
+			case !ok:
+				manifests = append(manifests, manifest)
+			case equalJSONObjects(manifest, appliedManifest):
+				if owner == "" {
+					appliedManagers[key] = options.FieldManager
+				}
+				manifests = append(manifests, manifest)
+			case owner != "" && owner != options.FieldManager && !options.Force:
+				conflicts = append(conflicts, ManifestConflict{Key: key, Manager: owner})
+				manifests = append(manifests, manifest)
+			default:
+				appliedManagers[key] = options.FieldManager
+				manifests = append(manifests, appliedManifest)
+			}
+		}
+		for _, key := range appliedKeys {
+			if !storedKeys[key] {
+				appliedManagers[key] = options.FieldManager
+				manifests = append(manifests, appliedManifests[key])
+			}
+		}
+		result.Manifests = manifests
+	}
+	if len(conflicts) != 0 {
+		return nil, nil, conflicts, nil
+	}
+
+	payload, err := PatchManifestBundle(manifestBundle, result)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return payload, emptyToNil(appliedManagers), nil, nil
+}
+
+func emptyToNil(managers datatypes.JSONMap) datatypes.JSONMap {
+	if len(managers) == 0 {
+		return nil
+	}
+	return managers
+}
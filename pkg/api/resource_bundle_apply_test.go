@@ -0,0 +1,201 @@
+package api
+
+import (
+	"testing"
+
+	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+func TestApplyManifestBundle(t *testing.T) {
+	const (
+		configMap      = "{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"b\"}}"
+		configMapV2    = "{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"c\"}}"
+		secret         = "{\"apiVersion\":\"v1\",\"kind\":\"Secret\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}"
+		service        = "{\"apiVersion\":\"v1\",\"kind\":\"Service\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}"
+		serviceV2      = "{\"apiVersion\":\"v1\",\"kind\":\"Service\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\",\"labels\":{\"a\":\"b\"}}}"
+		deployment     = "{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}"
+		configMapKey   = "v1/ConfigMap/default/nginx"
+		secretKey      = "v1/Secret/default/nginx"
+		serviceKey     = "v1/Service/default/nginx"
+		deploymentKey  = "apps/v1/Deployment/default/nginx"
+		managerA       = "controller-a"
+		managerB       = "controller-b"
+		unknownManager = ""
+	)
+
+	newBundle := func(manifests ...string) datatypes.JSONMap {
+		manifestBundle, err := NewManifestBundle("maestro", "test-id", &ManifestBundleWrapper{
+			Meta:      map[string]interface{}{"name": "nginx"},
+			Manifests: newJSONMAPList(t, manifests...),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return manifestBundle
+	}
+
+	// the config map and the secret are owned by controller a, the service by controller b
+	stored := newBundle(configMap, secret, service)
+	managers := datatypes.JSONMap{configMapKey: managerA, secretKey: managerA, serviceKey: managerB}
+
+	cases := []struct {
+		name              string
+		managers          datatypes.JSONMap
+		applied           *ManifestBundleWrapper
+		options           ApplyOptions
+		expectedManifests []string
+		expectedManagers  datatypes.JSONMap
+		expectedConflicts []ManifestConflict
+		expectedErr       bool
+	}{
+		{
+			name:     "update, add and remove the owned manifests",
+			managers: managers,
+			applied: &ManifestBundleWrapper{
+				Manifests: newJSONMAPList(t, configMapV2, deployment),
+			},
+			options:           ApplyOptions{FieldManager: managerA},
+			expectedManifests: []string{configMapV2, service, deployment},
+			expectedManagers:  datatypes.JSONMap{configMapKey: managerA, serviceKey: managerB, deploymentKey: managerA},
+		},
+		{
+			name:     "the unchanged manifests of other managers are not conflicts",
+			managers: managers,
+			applied: &ManifestBundleWrapper{
+				Manifests: newJSONMAPList(t, configMap, secret, service),
+			},
+			options:           ApplyOptions{FieldManager: managerA},
+			expectedManifests: []string{configMap, secret, service},
+			expectedManagers:  managers,
+		},
+		{
+			name:     "the changed manifests of other managers are conflicts",
+			managers: managers,
+			applied: &ManifestBundleWrapper{
+				Manifests: newJSONMAPList(t, configMapV2, serviceV2),
+			},
+			options:           ApplyOptions{FieldManager: managerA},
+			expectedConflicts: []ManifestConflict{{Key: serviceKey, Manager: managerB}},
+		},
+		{
+			name:     "force takes the ownership of the conflicts",
+			managers: managers,
+			applied: &ManifestBundleWrapper{
+				Manifests: newJSONMAPList(t, configMapV2, secret, serviceV2),
+			},
+			options:           ApplyOptions{FieldManager: managerA, Force: true},
+			expectedManifests: []string{configMapV2, secret, serviceV2},
+			expectedManagers:  datatypes.JSONMap{configMapKey: managerA, secretKey: managerA, serviceKey: managerA},
+		},
+		{
+			name: "the manifests without owners are owned by the applying manager",
+			applied: &ManifestBundleWrapper{
+				Manifests: newJSONMAPList(t, configMapV2, secret),
+			},
+			options:           ApplyOptions{FieldManager: managerB},
+			expectedManifests: []string{configMapV2, secret, service},
+			expectedManagers:  datatypes.JSONMap{configMapKey: managerB, secretKey: managerB},
+		},
+		{
+			name:              "nil manifests are not changed",
+			managers:          managers,
+			applied:           &ManifestBundleWrapper{Meta: map[string]interface{}{"name": "nginx"}},
+			options:           ApplyOptions{FieldManager: managerB},
+			expectedManifests: []string{configMap, secret, service},
+			expectedManagers:  managers,
+		},
+		{
+			name:        "the field manager is required",
+			managers:    managers,
+			applied:     &ManifestBundleWrapper{},
+			options:     ApplyOptions{FieldManager: unknownManager},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			payload, appliedManagers, conflicts, err := ApplyManifestBundle(stored, c.managers, c.applied, c.options)
+			if c.expectedErr {
+				if err == nil {
+					t.Errorf("expected error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if len(c.expectedConflicts) != 0 {
+				if !equality.Semantic.DeepEqual(c.expectedConflicts, conflicts) {
+					t.Errorf("expected conflicts %v, but got %v", c.expectedConflicts, conflicts)
+				}
+				if payload != nil {
+					t.Errorf("expected the manifest bundle is not applied, but got %v", payload)
+				}
+				return
+			}
+			if len(conflicts) != 0 {
+				t.Errorf("unexpected conflicts %v", conflicts)
+			}
+
+			wrapper, err := DecodeManifestBundle(payload)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !equality.Semantic.DeepEqual(newJSONMAPList(t, c.expectedManifests...), wrapper.Manifests) {
+				t.Errorf("expected manifests %v, but got %v", c.expectedManifests, wrapper.Manifests)
+			}
+			if !equality.Semantic.DeepEqual(c.expectedManagers, appliedManagers) {
+				t.Errorf("expected managers %v, but got %v", c.expectedManagers, appliedManagers)
+			}
+		})
+	}
+}
+
+func TestManifestManagers(t *testing.T) {
+	manifestBundle, err := NewManifestBundle("maestro", "test-id", &ManifestBundleWrapper{
+		Meta: map[string]interface{}{
+			"name": "nginx",
+			"annotations": map[string]interface{}{
+				FieldManagerAnnotation: "controller-a",
+				ForceApplyAnnotation:   "true",
+			},
+		},
+		Manifests: newJSONMAPList(t,
+			"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options, err := ApplyOptionsFromManifestBundle(manifestBundle)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if options != (ApplyOptions{FieldManager: "controller-a", Force: true}) {
+		t.Errorf("unexpected apply options %v", options)
+	}
+
+	managers, err := ManifestManagersOf(manifestBundle, options.FieldManager)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := datatypes.JSONMap{"v1/ConfigMap/default/nginx": "controller-a"}
+	if !equality.Semantic.DeepEqual(expected, managers) {
+		t.Errorf("expected managers %v, but got %v", expected, managers)
+	}
+
+	// the owners of the removed manifests are pruned
+	pruned, err := PruneManifestManagers(datatypes.JSONMap{
+		"v1/ConfigMap/default/nginx": "controller-a",
+		"v1/Secret/default/nginx":    "controller-b",
+	}, manifestBundle)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !equality.Semantic.DeepEqual(expected, pruned) {
+		t.Errorf("expected managers %v, but got %v", expected, pruned)
+	}
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ExtensionDryRun is the CloudEvent extension that asks the server to only validate a create or
+	// update request of a manifest bundle and to return the resulting diff instead of applying it.
+	ExtensionDryRun = "dryrun"
+
+	// DryRunDiffHeader is the gRPC response header that carries the JSON encoded resource bundle
+	// diff of a dry-run publish request.
+	DryRunDiffHeader = "maestro-dryrun-diff-bin"
+)
+
+// ManifestChangeType describes how a manifest changes between two manifest bundles
+type ManifestChangeType string
+
+const (
+	ManifestAdded     ManifestChangeType = "Added"
+	ManifestRemoved   ManifestChangeType = "Removed"
+	ManifestModified  ManifestChangeType = "Modified"
+	ManifestUnchanged ManifestChangeType = "Unchanged"
+)
+
+// ManifestDiff is the change of a single manifest, the manifests of two manifest bundles are
+// matched by their apiVersion, kind, namespace and name.
+type ManifestDiff struct {
+	Change     ManifestChangeType
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	// Patch is the JSON merge patch (RFC 7386) that turns the stored manifest into the proposed
+	// one, it is only set for modified manifests.
+	Patch map[string]interface{}
+}
+
+// ResourceBundleDiff is the difference between the stored manifest bundle of a resource and a
+// proposed one.
+type ResourceBundleDiff struct {
+	ResourceID             string
+	Version                int32
+	Manifests              []ManifestDiff
+	MetadataChanged        bool
+	ManifestConfigsChanged bool
+	DeleteOptionChanged    bool
+}
+
+// Changed returns true if applying the proposed manifest bundle would change the stored one.
+func (d *ResourceBundleDiff) Changed() bool {
+	if d.MetadataChanged || d.ManifestConfigsChanged || d.DeleteOptionChanged {
+		return true
+	}
+	for _, manifest := range d.Manifests {
+		if manifest.Change != ManifestUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffManifestBundles compares the CloudEvent JSONMap representations of the stored and proposed
+// manifest bundles. An empty stored manifest bundle is treated as a bundle without manifests, so
+// every proposed manifest is reported as added.
+// The manifests are returned in the order of the proposed manifest bundle, followed by the
+// removed manifests in the order of the stored one.
+func DiffManifestBundles(stored, proposed datatypes.JSONMap) (*ResourceBundleDiff, error) {
+	storedWrapper, err := DecodeManifestBundle(stored)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode stored manifest bundle: %v", err)
+	}
+	if storedWrapper == nil {
+		storedWrapper = &ManifestBundleWrapper{}
+	}
+
+	proposedWrapper, err := DecodeManifestBundle(proposed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode proposed manifest bundle: %v", err)
+	}
+	if proposedWrapper == nil {
+		proposedWrapper = &ManifestBundleWrapper{}
+	}
+
+	diff := &ResourceBundleDiff{
+		Manifests:              []ManifestDiff{},
+		MetadataChanged:        !equalJSONObjects(storedWrapper.Meta, proposedWrapper.Meta),
+		ManifestConfigsChanged: !equalJSONObjects(storedWrapper.ManifestConfigs, proposedWrapper.ManifestConfigs),
+		DeleteOptionChanged:    !equalJSONObjects(storedWrapper.DeleteOption, proposedWrapper.DeleteOption),
+	}
+
+	storedManifests := map[string]map[string]interface{}{}
+	for _, manifest := range storedWrapper.Manifests {
+		storedManifests[manifestKey(manifest)] = manifest
+	}
+
+	proposedKeys := map[string]bool{}
+	for _, manifest := range proposedWrapper.Manifests {
+		key := manifestKey(manifest)
+		proposedKeys[key] = true
+
+		manifestDiff := newManifestDiff(manifest)
+		storedManifest, found := storedManifests[key]
+		switch {
+		case !found:
+			manifestDiff.Change = ManifestAdded
+		case equalJSONObjects(storedManifest, manifest):
+			manifestDiff.Change = ManifestUnchanged
+		default:
+			patch, err := createMergePatch(storedManifest, manifest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create patch for manifest %s: %v", key, err)
+			}
+			manifestDiff.Change = ManifestModified
+			manifestDiff.Patch = patch
+		}
+		diff.Manifests = append(diff.Manifests, manifestDiff)
+	}
+
+	for _, manifest := range storedWrapper.Manifests {
+		if proposedKeys[manifestKey(manifest)] {
+			continue
+		}
+		manifestDiff := newManifestDiff(manifest)
+		manifestDiff.Change = ManifestRemoved
+		diff.Manifests = append(diff.Manifests, manifestDiff)
+	}
+
+	return diff, nil
+}
+
+func newManifestDiff(manifest map[string]interface{}) ManifestDiff {
+	obj := unstructured.Unstructured{Object: manifest}
+	return ManifestDiff{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+func manifestKey(manifest map[string]interface{}) string {
+	obj := unstructured.Unstructured{Object: manifest}
+	return fmt.Sprintf("%s/%s/%s/%s", obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
+// equalJSONObjects compares two objects through their JSON representation, so nil and empty
+// objects are treated as equal and numbers are compared regardless of their go types.
+func equalJSONObjects(a, b interface{}) bool {
+	return reflect.DeepEqual(normalizeJSON(a), normalizeJSON(b))
+}
+
+func normalizeJSON(obj interface{}) interface{} {
+	if obj == nil || reflect.ValueOf(obj).Len() == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return obj
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return obj
+	}
+	return normalized
+}
+
+func createMergePatch(original, modified map[string]interface{}) (map[string]interface{}, error) {
+	originalJSON, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	modifiedJSON, err := json.Marshal(modified)
+	if err != nil {
+		return nil, err
+	}
+
+	patchJSON, err := jsonpatch.CreateMergePatch(originalJSON, modifiedJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := map[string]interface{}{}
+	if err := json.Unmarshal(patchJSON, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
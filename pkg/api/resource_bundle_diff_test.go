@@ -0,0 +1,137 @@
+package api
+
+import (
+	"testing"
+
+	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+func TestDiffManifestBundles(t *testing.T) {
+	newBundle := func(wrapper *ManifestBundleWrapper) datatypes.JSONMap {
+		manifestBundle, err := NewManifestBundle("maestro", "test-id", wrapper)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return manifestBundle
+	}
+
+	stored := newBundle(&ManifestBundleWrapper{
+		Meta: map[string]interface{}{"name": "nginx"},
+		Manifests: newJSONMAPList(t, []string{
+			"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"b\"}}",
+			"{\"apiVersion\":\"v1\",\"kind\":\"Secret\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+			"{\"apiVersion\":\"v1\",\"kind\":\"Service\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+		}...),
+		DeleteOption: map[string]interface{}{"propagationPolicy": "Foreground"},
+	})
+
+	cases := []struct {
+		name              string
+		stored            datatypes.JSONMap
+		proposed          datatypes.JSONMap
+		expectedManifests []ManifestDiff
+		expectedMetadata  bool
+		expectedDelete    bool
+		expectedChanged   bool
+	}{
+		{
+			name:   "unchanged",
+			stored: stored,
+			proposed: newBundle(&ManifestBundleWrapper{
+				Meta: map[string]interface{}{"name": "nginx"},
+				Manifests: newJSONMAPList(t, []string{
+					"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"b\"}}",
+					"{\"apiVersion\":\"v1\",\"kind\":\"Secret\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+					"{\"apiVersion\":\"v1\",\"kind\":\"Service\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+				}...),
+				DeleteOption: map[string]interface{}{"propagationPolicy": "Foreground"},
+			}),
+			expectedManifests: []ManifestDiff{
+				{Change: ManifestUnchanged, APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "nginx"},
+				{Change: ManifestUnchanged, APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "nginx"},
+				{Change: ManifestUnchanged, APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "nginx"},
+			},
+		},
+		{
+			name:   "added, modified and removed",
+			stored: stored,
+			proposed: newBundle(&ManifestBundleWrapper{
+				Meta: map[string]interface{}{"name": "nginx", "labels": map[string]interface{}{"app": "nginx"}},
+				Manifests: newJSONMAPList(t, []string{
+					"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"c\"}}",
+					"{\"apiVersion\":\"v1\",\"kind\":\"Service\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+					"{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+				}...),
+				DeleteOption: map[string]interface{}{"propagationPolicy": "Foreground"},
+			}),
+			expectedManifests: []ManifestDiff{
+				{
+					Change: ManifestModified, APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "nginx",
+					Patch: map[string]interface{}{"data": map[string]interface{}{"a": "c"}},
+				},
+				{Change: ManifestUnchanged, APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "nginx"},
+				{Change: ManifestAdded, APIVersion: "apps/v1", Kind: "Deployment", Namespace: "default", Name: "nginx"},
+				{Change: ManifestRemoved, APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "nginx"},
+			},
+			expectedMetadata: true,
+			expectedChanged:  true,
+		},
+		{
+			name:   "delete option removed",
+			stored: stored,
+			proposed: newBundle(&ManifestBundleWrapper{
+				Meta: map[string]interface{}{"name": "nginx"},
+				Manifests: newJSONMAPList(t, []string{
+					"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"b\"}}",
+					"{\"apiVersion\":\"v1\",\"kind\":\"Secret\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+					"{\"apiVersion\":\"v1\",\"kind\":\"Service\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+				}...),
+			}),
+			expectedManifests: []ManifestDiff{
+				{Change: ManifestUnchanged, APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "nginx"},
+				{Change: ManifestUnchanged, APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "nginx"},
+				{Change: ManifestUnchanged, APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "nginx"},
+			},
+			expectedDelete:  true,
+			expectedChanged: true,
+		},
+		{
+			name:   "no stored manifest bundle",
+			stored: nil,
+			proposed: newBundle(&ManifestBundleWrapper{
+				Manifests: newJSONMAPList(t, []string{
+					"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+				}...),
+			}),
+			expectedManifests: []ManifestDiff{
+				{Change: ManifestAdded, APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "nginx"},
+			},
+			expectedChanged: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diff, err := DiffManifestBundles(c.stored, c.proposed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equality.Semantic.DeepEqual(c.expectedManifests, diff.Manifests) {
+				t.Errorf("expected manifests %#v but got: %#v", c.expectedManifests, diff.Manifests)
+			}
+			if diff.MetadataChanged != c.expectedMetadata {
+				t.Errorf("expected metadata changed %v but got: %v", c.expectedMetadata, diff.MetadataChanged)
+			}
+			if diff.DeleteOptionChanged != c.expectedDelete {
+				t.Errorf("expected delete option changed %v but got: %v", c.expectedDelete, diff.DeleteOptionChanged)
+			}
+			if diff.ManifestConfigsChanged {
+				t.Errorf("expected manifest configs unchanged")
+			}
+			if diff.Changed() != c.expectedChanged {
+				t.Errorf("expected changed %v but got: %v", c.expectedChanged, diff.Changed())
+			}
+		})
+	}
+}
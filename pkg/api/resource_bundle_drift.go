@@ -0,0 +1,148 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cloudeventstypes "github.com/cloudevents/sdk-go/v2/types"
+	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+)
+
+// DriftedCondition is the condition reported by the agent when the live objects of a resource bundle diverge
+// from its manifests, it is reported for the resource bundle and for each of its drifted manifests.
+const DriftedCondition = "Drifted"
+
+const (
+	// DriftDetectedReason is the reason of the Drifted condition when the drift is only reported.
+	DriftDetectedReason = "LiveObjectModified"
+	// DriftReappliedReason is the reason of the Drifted condition when the drifted objects are re-applied.
+	DriftReappliedReason = "Reapplied"
+	// NoDriftReason is the reason of the Drifted condition when the live objects match the manifests.
+	NoDriftReason = "LiveObjectsMatch"
+)
+
+// ExtensionDriftReport is the CloudEvent extension of the status update events that only carry the drift
+// conditions of a resource bundle. These events are merged into the stored status instead of replacing it.
+const ExtensionDriftReport = "driftreport"
+
+// DriftPolicy decides how the agent handles the drift of a resource bundle.
+type DriftPolicy string
+
+const (
+	// DriftPolicyAlert only reports the drift with the Drifted condition.
+	DriftPolicyAlert DriftPolicy = "Alert"
+	// DriftPolicyReapply re-applies the manifests to the drifted objects and reports the drift.
+	DriftPolicyReapply DriftPolicy = "Reapply"
+)
+
+// DriftPolicyAnnotation is the annotation of a resource bundle that overrides the drift policy of the agent.
+const DriftPolicyAnnotation = "maestro.open-cluster-management.io/drift-policy"
+
+// ParseDriftPolicy parses a drift policy, it returns an error if the policy is unknown.
+func ParseDriftPolicy(policy string) (DriftPolicy, error) {
+	switch DriftPolicy(policy) {
+	case DriftPolicyAlert, DriftPolicyReapply:
+		return DriftPolicy(policy), nil
+	}
+	return "", fmt.Errorf("unknown drift policy %q, it must be one of %s or %s",
+		policy, DriftPolicyAlert, DriftPolicyReapply)
+}
+
+// IsDriftReport returns true if a status of a resource bundle is a drift report.
+func IsDriftReport(status datatypes.JSONMap) bool {
+	if len(status) == 0 {
+		return false
+	}
+	evt, err := JSONMAPToCloudEvent(status)
+	if err != nil {
+		return false
+	}
+	report, err := cloudeventstypes.ToBool(evt.Extensions()[ExtensionDriftReport])
+	return err == nil && report
+}
+
+// MergeDriftReport merges the drift conditions of a drift report into a status of a resource bundle, the
+// other conditions and the status feedback of the status are kept.
+func MergeDriftReport(status, report datatypes.JSONMap) (datatypes.JSONMap, error) {
+	return mergeDriftConditions(status, report, true)
+}
+
+// KeepDriftConditions keeps the drift conditions of the stored status of a resource bundle in a new status
+// that does not report them, the work agent reports the status without the drift conditions.
+func KeepDriftConditions(status, stored datatypes.JSONMap) (datatypes.JSONMap, error) {
+	return mergeDriftConditions(status, stored, false)
+}
+
+// DriftedSinceOf returns the time since the live objects of a resource bundle diverge from its manifests,
+// nil is returned if the resource bundle is not drifted.
+func DriftedSinceOf(status datatypes.JSONMap) *time.Time {
+	bundleStatus, err := DecodeResourceBundleStatus(status)
+	if err != nil || bundleStatus == nil || bundleStatus.ManifestBundleStatus == nil {
+		return nil
+	}
+	drifted := meta.FindStatusCondition(bundleStatus.Conditions, DriftedCondition)
+	if drifted == nil || drifted.Status != metav1.ConditionTrue {
+		return nil
+	}
+	driftedSince := drifted.LastTransitionTime.Time
+	return &driftedSince
+}
+
+func mergeDriftConditions(status, from datatypes.JSONMap, override bool) (datatypes.JSONMap, error) {
+	if len(status) == 0 || len(from) == 0 {
+		return status, nil
+	}
+
+	fromStatus, err := DecodeResourceBundleStatus(from)
+	if err != nil {
+		return nil, err
+	}
+
+	evt, err := JSONMAPToCloudEvent(status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert resource bundle status to cloudevent: %v", err)
+	}
+	bundleStatus := &workpayload.ManifestBundleStatus{}
+	if err := evt.DataAs(bundleStatus); err != nil {
+		return nil, fmt.Errorf("failed to decode cloudevent payload: %v", err)
+	}
+
+	changed := setDriftCondition(&bundleStatus.Conditions, fromStatus.Conditions, override)
+	for i := range bundleStatus.ResourceStatus {
+		for _, manifest := range fromStatus.ResourceStatus {
+			if sameManifest(bundleStatus.ResourceStatus[i].ResourceMeta, manifest.ResourceMeta) {
+				changed = setDriftCondition(&bundleStatus.ResourceStatus[i].Conditions, manifest.Conditions, override) || changed
+			}
+		}
+	}
+	if !changed {
+		return status, nil
+	}
+
+	if err := evt.SetData(cloudevents.ApplicationJSON, bundleStatus); err != nil {
+		return nil, fmt.Errorf("failed to encode cloudevent payload: %v", err)
+	}
+	return CloudEventToJSONMap(evt)
+}
+
+// setDriftCondition sets the Drifted condition of the given conditions to conditions, the existing Drifted
+// condition is only replaced when override is true.
+func setDriftCondition(conditions *[]metav1.Condition, from []metav1.Condition, override bool) bool {
+	drifted := meta.FindStatusCondition(from, DriftedCondition)
+	if drifted == nil {
+		return false
+	}
+	if !override && meta.FindStatusCondition(*conditions, DriftedCondition) != nil {
+		return false
+	}
+	return meta.SetStatusCondition(conditions, *drifted)
+}
+
+func sameManifest(a, b workv1.ManifestResourceMeta) bool {
+	return a.Group == b.Group && a.Kind == b.Kind && a.Namespace == b.Namespace && a.Name == b.Name
+}
@@ -0,0 +1,50 @@
+package api
+
+// ResourceBundleSummaryGroupBy is the way the resource bundles are grouped in a summary.
+type ResourceBundleSummaryGroupBy string
+
+const (
+	ResourceBundleSummaryByConsumer ResourceBundleSummaryGroupBy = "consumer"
+	ResourceBundleSummaryBySource   ResourceBundleSummaryGroupBy = "source"
+	ResourceBundleSummaryByLabel    ResourceBundleSummaryGroupBy = "label"
+)
+
+// ResourceBundleSummaryItem is the number of the resource bundles of a group in each state. The applied,
+// available and degraded states are only counted for the current version of a resource bundle, a resource
+// bundle whose agent has never reported a status is counted as not reported.
+type ResourceBundleSummaryItem struct {
+	Key         string
+	Total       int64
+	Applied     int64
+	Available   int64
+	Degraded    int64
+	Deleting    int64
+	NotReported int64
+}
+
+// Add adds the counts of another item to the item.
+func (i *ResourceBundleSummaryItem) Add(other ResourceBundleSummaryItem) {
+	i.Total += other.Total
+	i.Applied += other.Applied
+	i.Available += other.Available
+	i.Degraded += other.Degraded
+	i.Deleting += other.Deleting
+	i.NotReported += other.NotReported
+}
+
+// ResourceBundleSummary is the number of the resource bundles in each state grouped by consumer, source or the
+// value of a label, the resource bundles without the label are grouped under an empty key.
+type ResourceBundleSummary struct {
+	GroupBy ResourceBundleSummaryGroupBy
+	Label   string
+	Items   []ResourceBundleSummaryItem
+}
+
+// Total returns the sum of the items of the summary.
+func (s *ResourceBundleSummary) Total() ResourceBundleSummaryItem {
+	total := ResourceBundleSummaryItem{}
+	for _, item := range s.Items {
+		total.Add(item)
+	}
+	return total
+}
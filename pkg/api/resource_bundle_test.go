@@ -71,6 +71,82 @@ func TestDecodeManifestBundle(t *testing.T) {
 	}
 }
 
+func TestNewAndPatchManifestBundle(t *testing.T) {
+	manifestBundleWrapper := &ManifestBundleWrapper{
+		Meta: map[string]interface{}{"name": "nginx"},
+		Manifests: newJSONMAPList(t, []string{
+			"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+		}...),
+		ManifestConfigs: newJSONMAPList(t, []string{
+			"{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"\",\"resource\":\"configmaps\",\"namespace\":\"default\"}}",
+		}...),
+		DeleteOption: map[string]interface{}{"propagationPolicy": "Orphan"},
+	}
+
+	manifestBundle, err := NewManifestBundle("maestro", "test-id", manifestBundleWrapper)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := DecodeManifestBundle(manifestBundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equality.Semantic.DeepEqual(manifestBundleWrapper.Meta, got.Meta) {
+		t.Errorf("expected metaData %#v but got: %#v", manifestBundleWrapper.Meta, got.Meta)
+	}
+	if !equality.Semantic.DeepEqual(manifestBundleWrapper.Manifests, got.Manifests) {
+		t.Errorf("expected manifests %#v but got: %#v", manifestBundleWrapper.Manifests, got.Manifests)
+	}
+	if !equality.Semantic.DeepEqual(manifestBundleWrapper.ManifestConfigs, got.ManifestConfigs) {
+		t.Errorf("expected manifestConfigs %#v but got: %#v", manifestBundleWrapper.ManifestConfigs, got.ManifestConfigs)
+	}
+	if !equality.Semantic.DeepEqual(manifestBundleWrapper.DeleteOption, got.DeleteOption) {
+		t.Errorf("expected deleteOption %#v but got: %#v", manifestBundleWrapper.DeleteOption, got.DeleteOption)
+	}
+
+	// patching with nothing keeps the manifest bundle unchanged
+	unchanged, err := PatchManifestBundle(manifestBundle, &ManifestBundleWrapper{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equality.Semantic.DeepEqual(manifestBundle, unchanged) {
+		t.Errorf("expected manifest bundle %#v but got: %#v", manifestBundle, unchanged)
+	}
+
+	// patching the manifests keeps the other fields
+	manifests := newJSONMAPList(t, []string{
+		"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"b\"}}",
+	}...)
+	patched, err := PatchManifestBundle(manifestBundle, &ManifestBundleWrapper{Manifests: manifests})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if patched["id"] != manifestBundle["id"] {
+		t.Errorf("expected event id %v but got: %v", manifestBundle["id"], patched["id"])
+	}
+	got, err = DecodeManifestBundle(patched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equality.Semantic.DeepEqual(manifests, got.Manifests) {
+		t.Errorf("expected manifests %#v but got: %#v", manifests, got.Manifests)
+	}
+	if !equality.Semantic.DeepEqual(manifestBundleWrapper.Meta, got.Meta) {
+		t.Errorf("expected metaData %#v but got: %#v", manifestBundleWrapper.Meta, got.Meta)
+	}
+	if !equality.Semantic.DeepEqual(manifestBundleWrapper.DeleteOption, got.DeleteOption) {
+		t.Errorf("expected deleteOption %#v but got: %#v", manifestBundleWrapper.DeleteOption, got.DeleteOption)
+	}
+
+	// unknown fields in the manifest configs are rejected
+	if _, err := PatchManifestBundle(manifestBundle, &ManifestBundleWrapper{
+		ManifestConfigs: []map[string]interface{}{{"unknown": "field"}},
+	}); err == nil {
+		t.Errorf("expected error for unknown manifest config field")
+	}
+}
+
 func TestDecodeBundleStatus(t *testing.T) {
 	cases := []struct {
 		name             string
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// LabelOperator is the operator of a label requirement, the operators are the same as the operators of the
+// label selectors of kubernetes.
+type LabelOperator string
+
+const (
+	LabelOpIn           LabelOperator = "In"
+	LabelOpNotIn        LabelOperator = "NotIn"
+	LabelOpExists       LabelOperator = "Exists"
+	LabelOpDoesNotExist LabelOperator = "DoesNotExist"
+)
+
+// LabelRequirement is a requirement of the labels in the metadata of a resource bundle.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	// Values must be set for In and NotIn, and must be empty for Exists and DoesNotExist.
+	Values []string
+}
+
+// ConditionRequirement is a requirement of a condition in the status of a resource bundle.
+type ConditionRequirement struct {
+	Type   string
+	Status metav1.ConditionStatus
+}
+
+// ResourceFilter is a structured filter of the resources. The server compiles it to a parameterized query, so
+// it never carries SQL. A resource matches the filter if it meets all of the requirements that are set.
+type ResourceFilter struct {
+	Source        string
+	ConsumerNames []string
+	Names         []string
+	Labels        []LabelRequirement
+	Conditions    []ConditionRequirement
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+}
+
+// Validate returns an error if a requirement of the filter is invalid.
+func (f *ResourceFilter) Validate() error {
+	for _, label := range f.Labels {
+		if label.Key == "" {
+			return fmt.Errorf("the key of a label requirement is required")
+		}
+		switch label.Operator {
+		case LabelOpIn, LabelOpNotIn:
+			if len(label.Values) == 0 {
+				return fmt.Errorf("the values of label %q are required for operator %s", label.Key, label.Operator)
+			}
+		case LabelOpExists, LabelOpDoesNotExist:
+			if len(label.Values) != 0 {
+				return fmt.Errorf("the values of label %q must be empty for operator %s", label.Key, label.Operator)
+			}
+		default:
+			return fmt.Errorf("unsupported operator %q of label %q", label.Operator, label.Key)
+		}
+	}
+
+	for _, condition := range f.Conditions {
+		if condition.Type == "" {
+			return fmt.Errorf("the type of a condition requirement is required")
+		}
+		switch condition.Status {
+		case metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionUnknown:
+		default:
+			return fmt.Errorf("unsupported status %q of condition %q", condition.Status, condition.Type)
+		}
+	}
+
+	return nil
+}
+
+// Match returns true if a resource matches the filter, it evaluates the filter in the same way as its query.
+func (f *ResourceFilter) Match(resource *Resource) bool {
+	if f.Source != "" && resource.Source != f.Source {
+		return false
+	}
+	if len(f.ConsumerNames) != 0 && !sets.New(f.ConsumerNames...).Has(resource.ConsumerName) {
+		return false
+	}
+	if len(f.Names) != 0 && !sets.New(f.Names...).Has(resource.Name) {
+		return false
+	}
+	if !inTimeRange(resource.CreatedAt, f.CreatedAfter, f.CreatedBefore) ||
+		!inTimeRange(resource.UpdatedAt, f.UpdatedAfter, f.UpdatedBefore) {
+		return false
+	}
+
+	labels := resourceLabels(resource)
+	for _, label := range f.Labels {
+		value, exists := labels[label.Key]
+		switch label.Operator {
+		case LabelOpIn:
+			if !exists || !sets.New(label.Values...).Has(value) {
+				return false
+			}
+		case LabelOpNotIn:
+			if exists && sets.New(label.Values...).Has(value) {
+				return false
+			}
+		case LabelOpExists:
+			if !exists {
+				return false
+			}
+		case LabelOpDoesNotExist:
+			if exists {
+				return false
+			}
+		}
+	}
+
+	if len(f.Conditions) == 0 {
+		return true
+	}
+	status, err := DecodeResourceBundleStatus(resource.Status)
+	if err != nil || status == nil || status.ManifestBundleStatus == nil {
+		return false
+	}
+	for _, required := range f.Conditions {
+		condition := meta.FindStatusCondition(status.Conditions, required.Type)
+		if condition == nil || condition.Status != required.Status {
+			return false
+		}
+	}
+	return true
+}
+
+// inTimeRange returns true if a time is not before after and is before before, the nil bounds are not checked.
+func inTimeRange(t time.Time, after, before *time.Time) bool {
+	if after != nil && t.Before(*after) {
+		return false
+	}
+	if before != nil && !t.Before(*before) {
+		return false
+	}
+	return true
+}
+
+// resourceLabels returns the labels in the metadata of the payload of a resource.
+func resourceLabels(resource *Resource) map[string]string {
+	labels := map[string]string{}
+	metadata, ok := resource.Payload["metadata"].(map[string]interface{})
+	if !ok {
+		return labels
+	}
+	values, ok := metadata["labels"].(map[string]interface{})
+	if !ok {
+		return labels
+	}
+	for key, value := range values {
+		labels[key] = fmt.Sprintf("%v", value)
+	}
+	return labels
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/datatypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResourceFilterValidate(t *testing.T) {
+	cases := []struct {
+		name             string
+		filter           *ResourceFilter
+		expectedErrorMsg string
+	}{
+		{
+			name:   "empty",
+			filter: &ResourceFilter{},
+		},
+		{
+			name: "valid",
+			filter: &ResourceFilter{
+				Labels: []LabelRequirement{
+					{Key: "app", Operator: LabelOpIn, Values: []string{"nginx"}},
+					{Key: "env", Operator: LabelOpDoesNotExist},
+				},
+				Conditions: []ConditionRequirement{{Type: "Applied", Status: metav1.ConditionTrue}},
+			},
+		},
+		{
+			name:             "label without key",
+			filter:           &ResourceFilter{Labels: []LabelRequirement{{Operator: LabelOpExists}}},
+			expectedErrorMsg: "the key of a label requirement is required",
+		},
+		{
+			name:             "in without values",
+			filter:           &ResourceFilter{Labels: []LabelRequirement{{Key: "app", Operator: LabelOpIn}}},
+			expectedErrorMsg: "the values of label \"app\" are required for operator In",
+		},
+		{
+			name: "exists with values",
+			filter: &ResourceFilter{
+				Labels: []LabelRequirement{{Key: "app", Operator: LabelOpExists, Values: []string{"nginx"}}},
+			},
+			expectedErrorMsg: "the values of label \"app\" must be empty for operator Exists",
+		},
+		{
+			name:             "unsupported operator",
+			filter:           &ResourceFilter{Labels: []LabelRequirement{{Key: "app", Operator: "Gt"}}},
+			expectedErrorMsg: "unsupported operator \"Gt\" of label \"app\"",
+		},
+		{
+			name:             "condition without type",
+			filter:           &ResourceFilter{Conditions: []ConditionRequirement{{Status: metav1.ConditionTrue}}},
+			expectedErrorMsg: "the type of a condition requirement is required",
+		},
+		{
+			name:             "unsupported condition status",
+			filter:           &ResourceFilter{Conditions: []ConditionRequirement{{Type: "Applied", Status: "Yes"}}},
+			expectedErrorMsg: "unsupported status \"Yes\" of condition \"Applied\"",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.filter.Validate()
+			if c.expectedErrorMsg == "" {
+				if err != nil {
+					t.Errorf("unexpected error %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != c.expectedErrorMsg {
+				t.Errorf("expected error %q, but got %v", c.expectedErrorMsg, err)
+			}
+		})
+	}
+}
+
+func TestResourceFilterMatch(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	resource := &Resource{
+		Meta:         Meta{CreatedAt: now, UpdatedAt: now},
+		Source:       "maestro",
+		ConsumerName: "cluster1",
+		Name:         "nginx",
+		Payload: datatypes.JSONMap{
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{"app": "nginx"},
+			},
+		},
+		Status: newJSONMap(t, "{\"id\":\"dfaa4da7-915a-4060-962e-4c741c979989\",\"data\":{\"conditions\":[{\"type\":\"Applied\",\"reason\":\"AppliedManifestWorkComplete\",\"status\":\"True\",\"message\":\"Apply manifest work complete\",\"lastTransitionTime\":\"2024-05-21T08:56:35Z\"}]},\"time\":\"2024-05-21T08:58:31.813194788Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request\",\"source\":\"cluster1-work-agent\",\"resourceid\":\"68ebf474-6709-48bb-b760-386181268064\",\"sequenceid\":\"1792842398301163520\",\"clustername\":\"cluster1\",\"specversion\":\"1.0\",\"originalsource\":\"maestro\",\"datacontenttype\":\"application/json\",\"resourceversion\":\"1\"}"),
+	}
+
+	cases := []struct {
+		name     string
+		filter   *ResourceFilter
+		expected bool
+	}{
+		{
+			name:     "empty",
+			filter:   &ResourceFilter{},
+			expected: true,
+		},
+		{
+			name:     "source and consumer",
+			filter:   &ResourceFilter{Source: "maestro", ConsumerNames: []string{"cluster1", "cluster2"}},
+			expected: true,
+		},
+		{
+			name:     "another consumer",
+			filter:   &ResourceFilter{ConsumerNames: []string{"cluster2"}},
+			expected: false,
+		},
+		{
+			name:     "another name",
+			filter:   &ResourceFilter{Names: []string{"web"}},
+			expected: false,
+		},
+		{
+			name: "label in",
+			filter: &ResourceFilter{Labels: []LabelRequirement{
+				{Key: "app", Operator: LabelOpIn, Values: []string{"web", "nginx"}},
+			}},
+			expected: true,
+		},
+		{
+			name: "label not in",
+			filter: &ResourceFilter{Labels: []LabelRequirement{
+				{Key: "app", Operator: LabelOpNotIn, Values: []string{"nginx"}},
+			}},
+			expected: false,
+		},
+		{
+			name: "missing label not in",
+			filter: &ResourceFilter{Labels: []LabelRequirement{
+				{Key: "env", Operator: LabelOpNotIn, Values: []string{"prod"}},
+			}},
+			expected: true,
+		},
+		{
+			name:     "label exists",
+			filter:   &ResourceFilter{Labels: []LabelRequirement{{Key: "app", Operator: LabelOpExists}}},
+			expected: true,
+		},
+		{
+			name:     "label does not exist",
+			filter:   &ResourceFilter{Labels: []LabelRequirement{{Key: "env", Operator: LabelOpDoesNotExist}}},
+			expected: true,
+		},
+		{
+			name:     "existing label does not exist",
+			filter:   &ResourceFilter{Labels: []LabelRequirement{{Key: "app", Operator: LabelOpDoesNotExist}}},
+			expected: false,
+		},
+		{
+			name: "condition",
+			filter: &ResourceFilter{Conditions: []ConditionRequirement{
+				{Type: "Applied", Status: metav1.ConditionTrue},
+			}},
+			expected: true,
+		},
+		{
+			name: "another condition status",
+			filter: &ResourceFilter{Conditions: []ConditionRequirement{
+				{Type: "Applied", Status: metav1.ConditionFalse},
+			}},
+			expected: false,
+		},
+		{
+			name: "missing condition",
+			filter: &ResourceFilter{Conditions: []ConditionRequirement{
+				{Type: "Available", Status: metav1.ConditionTrue},
+			}},
+			expected: false,
+		},
+		{
+			name:     "created in range",
+			filter:   &ResourceFilter{CreatedAfter: &before, CreatedBefore: timePtr(now.Add(time.Hour))},
+			expected: true,
+		},
+		{
+			name:     "updated before",
+			filter:   &ResourceFilter{UpdatedBefore: &now},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if matched := c.filter.Match(resource); matched != c.expected {
+				t.Errorf("expected %v, but got %v", c.expected, matched)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ResourceRevision is the resource payload of a resource version, a revision is recorded every time
+// the resource spec is changed.
+type ResourceRevision struct {
+	Meta
+	ResourceID string
+	Version    int32
+	Payload    datatypes.JSONMap
+}
+
+type ResourceRevisionList []*ResourceRevision
+
+func (r *ResourceRevision) BeforeCreate(tx *gorm.DB) error {
+	r.ID = NewID()
+	return nil
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ResourceTombstone records the deletion of a resource, so that a watch that resumes from a resource version
+// before the deletion sees it. The tombstones are recorded by the database and purged by the garbage collection
+// of the event tables.
+type ResourceTombstone struct {
+	// ID is the resource version of the deletion.
+	ID           int64
+	ResourceID   string
+	Version      int32
+	Source       string
+	ConsumerName string
+	Name         string
+	Payload      datatypes.JSONMap
+	CreatedAt    time.Time
+}
+
+type ResourceTombstoneList []*ResourceTombstone
+
+// ResourceChange is a change of a resource since a resource version, the resource of a deletion is the deleted
+// resource of its tombstone.
+type ResourceChange struct {
+	Resource *Resource
+	Deleted  bool
+}
+
+// ToResource returns the deleted resource of the tombstone.
+func (t *ResourceTombstone) ToResource() *Resource {
+	return &Resource{
+		Meta: Meta{
+			ID:        t.ResourceID,
+			DeletedAt: gorm.DeletedAt{Time: t.CreatedAt, Valid: true},
+		},
+		Version:         t.Version,
+		Source:          t.Source,
+		ConsumerName:    t.ConsumerName,
+		Name:            t.Name,
+		Payload:         t.Payload,
+		ResourceVersion: t.ID,
+	}
+}
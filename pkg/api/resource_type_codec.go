@@ -0,0 +1,302 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"gorm.io/datatypes"
+	workv1 "open-cluster-management.io/api/work/v1"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+// ResourceTypeCodec encodes, decodes, validates and hashes the resources of a resource type. The resources of a
+// type are transported between the sources, the server and the agents with the CloudEvents of its data type.
+type ResourceTypeCodec interface {
+	// EventDataType returns the CloudEvents data type that the resources of the type are transported with.
+	EventDataType() cetypes.CloudEventsDataType
+	// Validate validates the payload of a resource.
+	Validate(payload datatypes.JSONMap) error
+	// EncodeSpec converts a resource to the CloudEvent of its spec that is sent to the agent, the event type
+	// and the extensions of the resource are set by the caller.
+	EncodeSpec(res *Resource) (*cloudevents.Event, error)
+	// DecodeStatus converts the CloudEvent of a resource status that is received from the agent to the
+	// resource status.
+	DecodeStatus(evt *cloudevents.Event) (datatypes.JSONMap, error)
+	// EncodeStatus converts the status of a resource to the CloudEvent that is sent to the source.
+	EncodeStatus(res *Resource) (*cloudevents.Event, error)
+	// StatusHash returns the hash of a resource status, it must be the same hash as the agent calculates, so
+	// that the unchanged status is not resent on a status resync.
+	StatusHash(status datatypes.JSONMap) (string, error)
+}
+
+// resourceTypeRegistry is a registry of the resource types by their names and CloudEvents data types.
+type resourceTypeRegistry struct {
+	mu        sync.RWMutex
+	codecs    map[ResourceType]ResourceTypeCodec
+	dataTypes map[cetypes.CloudEventsDataType]ResourceType
+}
+
+func newResourceTypeRegistry() *resourceTypeRegistry {
+	registry := &resourceTypeRegistry{
+		codecs:    map[ResourceType]ResourceTypeCodec{},
+		dataTypes: map[cetypes.CloudEventsDataType]ResourceType{},
+	}
+	// the manifest bundles are always supported
+	if err := registry.register(ManifestBundleResourceType, &manifestBundleCodec{}); err != nil {
+		panic(err)
+	}
+	return registry
+}
+
+// resourceTypes is the registry of the resource types that the server supports.
+var resourceTypes = newResourceTypeRegistry()
+
+// RegisterResourceType registers the codec of a resource type, so that the server transports the resources of
+// the type. Neither the resource type nor its CloudEvents data type may be registered already. The resource types
+// must be registered before the server is started.
+func RegisterResourceType(resourceType ResourceType, codec ResourceTypeCodec) error {
+	return resourceTypes.register(resourceType, codec)
+}
+
+// ResourceTypeCodecFor returns the codec of a resource type, the resources without a type are manifest bundles.
+func ResourceTypeCodecFor(resourceType ResourceType) (ResourceTypeCodec, error) {
+	return resourceTypes.codec(resourceType)
+}
+
+// ResourceTypeOf returns the resource type whose resources are transported with the CloudEvents data type.
+func ResourceTypeOf(dataType cetypes.CloudEventsDataType) (ResourceType, error) {
+	return resourceTypes.resourceType(dataType)
+}
+
+// ResourceTypes returns the registered resource types sorted by their names.
+func ResourceTypes() []ResourceType {
+	return resourceTypes.list()
+}
+
+func (r *resourceTypeRegistry) register(resourceType ResourceType, codec ResourceTypeCodec) error {
+	if resourceType == "" {
+		return fmt.Errorf("the resource type is empty")
+	}
+	if codec == nil {
+		return fmt.Errorf("the codec of the resource type %s is nil", resourceType)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.codecs[resourceType]; exists {
+		return fmt.Errorf("the resource type %s is already registered", resourceType)
+	}
+	dataType := codec.EventDataType()
+	if registered, exists := r.dataTypes[dataType]; exists {
+		return fmt.Errorf("the data type %s is already registered by the resource type %s", dataType, registered)
+	}
+
+	r.codecs[resourceType] = codec
+	r.dataTypes[dataType] = resourceType
+	return nil
+}
+
+func (r *resourceTypeRegistry) codec(resourceType ResourceType) (ResourceTypeCodec, error) {
+	if resourceType == "" {
+		resourceType = ManifestBundleResourceType
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codec, ok := r.codecs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported resource type %s", resourceType)
+	}
+	return codec, nil
+}
+
+func (r *resourceTypeRegistry) resourceType(dataType cetypes.CloudEventsDataType) (ResourceType, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resourceType, ok := r.dataTypes[dataType]
+	if !ok {
+		return "", fmt.Errorf("unsupported cloudevents data type %s", dataType)
+	}
+	return resourceType, nil
+}
+
+func (r *resourceTypeRegistry) list() []ResourceType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]ResourceType, 0, len(r.codecs))
+	for resourceType := range r.codecs {
+		types = append(types, resourceType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// genericCodec is the codec of a resource type whose payload and status are the CloudEvents of the resource
+// spec and status in JSON representation, they are transported without conversion.
+type genericCodec struct {
+	dataType cetypes.CloudEventsDataType
+	validate func(payload datatypes.JSONMap) error
+}
+
+var _ ResourceTypeCodec = &genericCodec{}
+
+// NewGenericResourceTypeCodec returns the codec of a resource type whose resources are transported with the
+// CloudEvents of the data type as they are, e.g. a single object or a custom payload schema. The payload is
+// validated with the given function, a nil function only requires the payload to be a CloudEvent. The status
+// that the agent sends for a deleted resource must have the "Deleted" condition in its conditions.
+func NewGenericResourceTypeCodec(dataType cetypes.CloudEventsDataType, validate func(payload datatypes.JSONMap) error) ResourceTypeCodec {
+	return &genericCodec{
+		dataType: dataType,
+		validate: validate,
+	}
+}
+
+func (c *genericCodec) EventDataType() cetypes.CloudEventsDataType {
+	return c.dataType
+}
+
+func (c *genericCodec) Validate(payload datatypes.JSONMap) error {
+	if _, err := JSONMAPToCloudEvent(payload); err != nil {
+		return fmt.Errorf("failed to decode the payload: %v", err)
+	}
+	if c.validate == nil {
+		return nil
+	}
+	return c.validate(payload)
+}
+
+func (c *genericCodec) EncodeSpec(res *Resource) (*cloudevents.Event, error) {
+	return JSONMAPToCloudEvent(res.Payload)
+}
+
+func (c *genericCodec) DecodeStatus(evt *cloudevents.Event) (datatypes.JSONMap, error) {
+	return CloudEventToJSONMap(evt)
+}
+
+func (c *genericCodec) EncodeStatus(res *Resource) (*cloudevents.Event, error) {
+	return JSONMAPToCloudEvent(res.Status)
+}
+
+func (c *genericCodec) StatusHash(status datatypes.JSONMap) (string, error) {
+	if len(status) == 0 {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(""))), nil
+	}
+	evt, err := JSONMAPToCloudEvent(status)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert resource status to cloud event, %v", err)
+	}
+
+	// retrieve the status hash from status CloudEvent extension;
+	// if not found, calculate the status hash from the status data
+	if statusHashVal, ok := evt.Extensions()[cetypes.ExtensionStatusHash]; ok {
+		return fmt.Sprintf("%v", statusHashVal), nil
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(evt.Data())), nil
+}
+
+// manifestBundleCodec is the codec of the manifest bundles, they are applied as ManifestWorks by the agent.
+type manifestBundleCodec struct {
+	genericCodec
+}
+
+var _ ResourceTypeCodec = &manifestBundleCodec{}
+
+func (c *manifestBundleCodec) EventDataType() cetypes.CloudEventsDataType {
+	return workpayload.ManifestBundleEventDataType
+}
+
+// Validate only requires the payload to be a manifest bundle, the manifests of the bundle are validated by the
+// resource service.
+func (c *manifestBundleCodec) Validate(payload datatypes.JSONMap) error {
+	manifestBundle, err := DecodeManifestBundle(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode manifest bundle: %v", err)
+	}
+	if manifestBundle == nil {
+		return fmt.Errorf("manifest bundle is empty")
+	}
+	return nil
+}
+
+// EncodeStatus fills the manifest bundle of the resource payload into the manifest bundle status, so that the
+// source can rebuild the ManifestWork from the status.
+func (c *manifestBundleCodec) EncodeStatus(res *Resource) (*cloudevents.Event, error) {
+	statusEvt, err := JSONMAPToCloudEvent(res.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	// manifest bundle status from the resource status
+	manifestBundleStatus := &workpayload.ManifestBundleStatus{}
+	if err := statusEvt.DataAs(manifestBundleStatus); err != nil {
+		return nil, err
+	}
+
+	// fill the resource status with resource payload
+	if len(res.Payload) > 0 {
+		specEvt, err := JSONMAPToCloudEvent(res.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		// set work spec back from spec event
+		manifestBundle := &workpayload.ManifestBundle{}
+		if err := specEvt.DataAs(manifestBundle); err != nil {
+			return nil, err
+		}
+		manifestBundleStatus.ManifestBundle = manifestBundle
+	}
+
+	if err := statusEvt.SetData(cloudevents.ApplicationJSON, manifestBundleStatus); err != nil {
+		return nil, err
+	}
+
+	return statusEvt, nil
+}
+
+// StatusHash calculates the hash based on the manifestwork status to ensure consistency with the agent's status
+// calculation.
+func (c *manifestBundleCodec) StatusHash(status datatypes.JSONMap) (string, error) {
+	if len(status) == 0 {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(""))), nil
+	}
+	evt, err := JSONMAPToCloudEvent(status)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert resource status to cloud event, %v", err)
+	}
+
+	// retrieve stash hash from status CloudEvent extension;
+	// if not found, calculate the status hash by itself
+	evtExtensions := evt.Context.GetExtensions()
+	statusHashVal, ok := evtExtensions[cetypes.ExtensionStatusHash]
+	if ok {
+		return fmt.Sprintf("%v", statusHashVal), nil
+	}
+
+	// calculate the status hash by itself
+	eventPayload := &workpayload.ManifestBundleStatus{}
+	if err := evt.DataAs(eventPayload); err != nil {
+		return "", fmt.Errorf("failed to decode cloudevent data as manifest bundle status: %v", err)
+	}
+	workStatus := workv1.ManifestWorkStatus{
+		Conditions: eventPayload.Conditions,
+		ResourceStatus: workv1.ManifestResourceStatus{
+			Manifests: eventPayload.ResourceStatus,
+		},
+	}
+	workStatusBytes, err := json.Marshal(workStatus)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal work status, %v", err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(workStatusBytes)), nil
+}
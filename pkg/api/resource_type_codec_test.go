@@ -0,0 +1,152 @@
+package api
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"gorm.io/datatypes"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+var configMapDataType = cetypes.CloudEventsDataType{
+	Group:    "maestro.io",
+	Version:  "v1",
+	Resource: "configmaps",
+}
+
+func TestResourceTypeRegistry(t *testing.T) {
+	registry := newResourceTypeRegistry()
+
+	// the resources without a type are manifest bundles
+	codec, err := registry.codec("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if codec.EventDataType() != workpayload.ManifestBundleEventDataType {
+		t.Errorf("expected the manifest bundle data type but got: %s", codec.EventDataType())
+	}
+
+	if err := registry.register("ConfigMap", NewGenericResourceTypeCodec(configMapDataType, nil)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resourceType, err := registry.resourceType(configMapDataType)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resourceType != "ConfigMap" {
+		t.Errorf("expected the resource type ConfigMap but got: %s", resourceType)
+	}
+	if types := registry.list(); len(types) != 2 || types[0] != "ConfigMap" || types[1] != ManifestBundleResourceType {
+		t.Errorf("unexpected resource types: %v", types)
+	}
+
+	cases := []struct {
+		name             string
+		resourceType     ResourceType
+		codec            ResourceTypeCodec
+		expectedErrorMsg string
+	}{
+		{
+			name:             "empty resource type",
+			resourceType:     "",
+			codec:            NewGenericResourceTypeCodec(configMapDataType, nil),
+			expectedErrorMsg: "the resource type is empty",
+		},
+		{
+			name:             "nil codec",
+			resourceType:     "Secret",
+			expectedErrorMsg: "the codec of the resource type Secret is nil",
+		},
+		{
+			name:             "registered resource type",
+			resourceType:     ManifestBundleResourceType,
+			codec:            NewGenericResourceTypeCodec(configMapDataType, nil),
+			expectedErrorMsg: "the resource type ManifestBundle is already registered",
+		},
+		{
+			name:             "registered data type",
+			resourceType:     "Work",
+			codec:            NewGenericResourceTypeCodec(workpayload.ManifestBundleEventDataType, nil),
+			expectedErrorMsg: "is already registered by the resource type ManifestBundle",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := registry.register(c.resourceType, c.codec)
+			if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+				t.Errorf("expected error %q but got: %v", c.expectedErrorMsg, err)
+			}
+		})
+	}
+
+	if _, err := registry.codec("Secret"); err == nil || err.Error() != "unsupported resource type Secret" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	unknown := cetypes.CloudEventsDataType{Group: "maestro.io", Version: "v1", Resource: "secrets"}
+	if _, err := registry.resourceType(unknown); err == nil || !strings.Contains(err.Error(), "unsupported cloudevents data type") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGenericResourceTypeCodec(t *testing.T) {
+	codec := NewGenericResourceTypeCodec(configMapDataType, func(payload datatypes.JSONMap) error {
+		if _, ok := payload["data"]; !ok {
+			return fmt.Errorf("the data is required")
+		}
+		return nil
+	})
+
+	evt := cloudevents.NewEvent()
+	evt.SetID("1")
+	evt.SetSource("maestro")
+	evt.SetType("test")
+	if err := evt.SetData(cloudevents.ApplicationJSON, map[string]string{"key": "value"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	payload, err := CloudEventToJSONMap(&evt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := codec.Validate(payload); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := codec.Validate(datatypes.JSONMap{"specversion": "1.0"}); err == nil || !strings.Contains(err.Error(), "the data is required") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// the payload and status are transported as they are
+	spec, err := codec.EncodeSpec(&Resource{Payload: payload})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(spec.Data()) != string(evt.Data()) {
+		t.Errorf("unexpected spec data: %s", spec.Data())
+	}
+	status, err := codec.DecodeStatus(&evt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hash, err := codec.StatusHash(status)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := fmt.Sprintf("%x", sha256.Sum256(evt.Data())); hash != expected {
+		t.Errorf("expected status hash %s but got: %s", expected, hash)
+	}
+
+	evt.SetExtension(cetypes.ExtensionStatusHash, "agent-hash")
+	status, err = codec.DecodeStatus(&evt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hash, err := codec.StatusHash(status); err != nil || hash != "agent-hash" {
+		t.Errorf("expected the status hash from the agent but got: %s, %v", hash, err)
+	}
+}
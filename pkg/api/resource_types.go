@@ -2,6 +2,7 @@ package api
 
 import (
 	"strconv"
+	"time"
 
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
@@ -9,8 +10,14 @@ import (
 	ktypes "k8s.io/apimachinery/pkg/types"
 )
 
+// ResourceType is the type of a resource, it decides how the resource payload and status are encoded, validated
+// and transported to the agents.
 type ResourceType string
 
+// ManifestBundleResourceType is the type of the resources whose payload is a manifest bundle, it is the type of
+// the resources that are created without a type.
+const ManifestBundleResourceType ResourceType = "ManifestBundle"
+
 type Resource struct {
 	Meta
 	Version      int32
@@ -24,6 +31,24 @@ type Resource struct {
 	// When creating a resource, if its name is not specified, the resource id will be used as its name.
 	// Cannot be updated.
 	Name string
+	// PlacementID is the id of the placement that the resource is created from, it is empty if the
+	// resource is not created from a placement.
+	PlacementID string
+	// SpecUpdatedAt is the time when the current version of the resource is created, the status of the
+	// resource is lagging behind since then until the agent reports the status of the version.
+	SpecUpdatedAt *time.Time
+	// StaleSince is the time when the status of the resource is detected as stale, it is nil if the status is
+	// not stale.
+	StaleSince *time.Time
+	// DriftedSince is the time since the live objects of the resource diverge from its manifests, it is nil if
+	// the agent does not report the resource as drifted.
+	DriftedSince *time.Time
+	// ManifestManagers maps the keys of the manifests of the resource to the field managers that own them, the
+	// manifests that are not applied by a field manager have no owner.
+	ManifestManagers datatypes.JSONMap
+	// ResourceVersion is the global version of the last change of the resource, it increases monotonically
+	// across all of the resources. It is assigned by the database when a change commits, so it is never written.
+	ResourceVersion int64 `gorm:"->"`
 }
 
 type ResourceList []*Resource
@@ -49,9 +74,24 @@ func (d *Resource) BeforeCreate(tx *gorm.DB) error {
 	if d.Version == 0 {
 		d.Version = 1
 	}
+	if d.Type == "" {
+		d.Type = ManifestBundleResourceType
+	}
+	if d.SpecUpdatedAt == nil {
+		now := time.Now()
+		d.SpecUpdatedAt = &now
+	}
 	return nil
 }
 
+// GetResourceType returns the type of the resource, the resources without a type are manifest bundles.
+func (d *Resource) GetResourceType() ResourceType {
+	if d.Type == "" {
+		return ManifestBundleResourceType
+	}
+	return d.Type
+}
+
 func (d *Resource) GetUID() ktypes.UID {
 	return ktypes.UID(d.Meta.ID)
 }
@@ -14,6 +14,15 @@ const (
 	StatusDeleteEventType StatusEventType = "StatusDelete"
 )
 
+const (
+	// StatusSequenceHeader is the gRPC metadata of a subscription that carries the sequence of the last status
+	// event the subscriber received, the server replays the status events after it before the live ones.
+	StatusSequenceHeader = "maestro-status-sequence"
+	// ExtensionStatusSequence is the CloudEvent extension that carries the sequence of the status event that a
+	// resource status is sent for, it is not set on the resource status that is sent for a resync.
+	ExtensionStatusSequence = "statussequence"
+)
+
 type StatusEvent struct {
 	Meta
 	ResourceID      string
@@ -23,6 +32,10 @@ type StatusEvent struct {
 	Status          datatypes.JSONMap
 	StatusEventType StatusEventType // Update|Delete
 	ReconciledDate  *time.Time      `json:"gorm:null"`
+	// Sequence is assigned by the database when the status event is committed, it orders the status events, so
+	// that a subscriber can resume from the last status event it received. The sequence of a status event that is
+	// not committed yet is provisional.
+	Sequence int64 `gorm:"autoIncrement"`
 }
 
 type StatusEventList []*StatusEvent
@@ -0,0 +1,25 @@
+package auth
+
+import "context"
+
+// Context key type defined to avoid collisions in other pkgs using context
+// See https://golang.org/pkg/context/#WithValue
+type contextKey string
+
+const (
+	contextUserKey   contextKey = "user"
+	contextGroupsKey contextKey = "groups"
+)
+
+// NewContextWithIdentity returns a copy of ctx carrying the authenticated user and groups.
+func NewContextWithIdentity(ctx context.Context, user string, groups []string) context.Context {
+	ctx = context.WithValue(ctx, contextUserKey, user)
+	return context.WithValue(ctx, contextGroupsKey, groups)
+}
+
+// IdentityFromContext returns the authenticated user and groups stored in ctx, if any.
+func IdentityFromContext(ctx context.Context) (user string, groups []string) {
+	user, _ = ctx.Value(contextUserKey).(string)
+	groups, _ = ctx.Value(contextGroupsKey).([]string)
+	return user, groups
+}
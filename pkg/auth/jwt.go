@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mendsley/gojwk"
+	"k8s.io/klog/v2"
+)
+
+// minKeysRefreshInterval bounds how often the JWK set is re-fetched when a token
+// references a key id that is not known yet, so a flood of bogus tokens cannot be
+// turned into a flood of requests against the JWKS endpoint.
+const minKeysRefreshInterval = 1 * time.Minute
+
+// supportedSigningMethods are the JWT signing algorithms accepted by the authenticator.
+var supportedSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "PS384", "PS512"}
+
+// JWTAuthenticator validates bearer JWTs against the keys of a JSON Web Key Set, the issuer and
+// the audiences, and extracts the user and groups from their claims.
+type JWTAuthenticator struct {
+	certURL    string
+	certFile   string
+	issuer     string
+	audiences  []string
+	httpClient *http.Client
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	lastRefresh time.Time
+}
+
+// NewJWTAuthenticator creates a JWTAuthenticator that loads the JWK set from the given
+// URL and/or file. Keys from the URL are refreshed when a token signed by an unknown
+// key id is received. The tokens must be issued by the issuer for one of the audiences.
+func NewJWTAuthenticator(ctx context.Context, certURL, certFile, issuer string, audiences []string) (*JWTAuthenticator, error) {
+	if certURL == "" && certFile == "" {
+		return nil, fmt.Errorf("either the JWK cert URL or the JWK cert file must be specified")
+	}
+	if issuer == "" {
+		return nil, fmt.Errorf("the JWT issuer must be specified")
+	}
+	if len(audiences) == 0 {
+		return nil, fmt.Errorf("the JWT audience must be specified")
+	}
+
+	a := &JWTAuthenticator{
+		certURL:    certURL,
+		certFile:   certFile,
+		issuer:     issuer,
+		audiences:  audiences,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		keys:       map[string]crypto.PublicKey{},
+	}
+	if err := a.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Authenticate validates the given token and returns the user and groups associated with it.
+// The token must carry the "iss" claim of the issuer and one of the audiences in its "aud" claim.
+// The user is taken from the "username" claim, falling back to "preferred_username" and "sub";
+// the groups are taken from the "groups" claim, which is required because the groups are part
+// of every access review.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (user string, groups []string, err error) {
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims,
+		func(t *jwt.Token) (interface{}, error) {
+			return a.keyFor(ctx, t)
+		},
+		jwt.WithValidMethods(supportedSigningMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuer(a.issuer),
+		jwt.WithAudience(a.audiences...),
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid token: %v", err)
+	}
+
+	for _, claim := range []string{"username", "preferred_username", "sub"} {
+		if value, ok := claims[claim].(string); ok && value != "" {
+			user = value
+			break
+		}
+	}
+	if user == "" {
+		return "", nil, fmt.Errorf("could not find user in token claims")
+	}
+
+	if values, ok := claims["groups"].([]interface{}); ok {
+		for _, value := range values {
+			if group, ok := value.(string); ok && group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	if len(groups) == 0 {
+		return "", nil, fmt.Errorf("could not find groups in token claims")
+	}
+
+	return user, groups, nil
+}
+
+// keyFor returns the public key used to sign the given token.
+func (a *JWTAuthenticator) keyFor(ctx context.Context, t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		return nil, fmt.Errorf("token has no key id")
+	}
+
+	a.mu.RLock()
+	key, ok := a.keys[kid]
+	stale := time.Since(a.lastRefresh) >= minKeysRefreshInterval
+	a.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	// the key may have been rotated, reload the key set and try again
+	if a.certURL != "" && stale {
+		if err := a.refreshKeys(ctx); err != nil {
+			klog.FromContext(ctx).Error(err, "failed to refresh JWK set")
+		}
+		a.mu.RLock()
+		key, ok = a.keys[kid]
+		a.mu.RUnlock()
+		if ok {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown key id %s", kid)
+}
+
+// refreshKeys (re)loads the JWK set from the configured file and URL.
+func (a *JWTAuthenticator) refreshKeys(ctx context.Context) error {
+	keys := map[string]crypto.PublicKey{}
+
+	if a.certFile != "" {
+		data, err := os.ReadFile(a.certFile)
+		if err != nil {
+			return fmt.Errorf("failed to read JWK cert file %s: %v", a.certFile, err)
+		}
+		if err := parseKeys(data, keys); err != nil {
+			return fmt.Errorf("failed to parse JWK cert file %s: %v", a.certFile, err)
+		}
+	}
+
+	if a.certURL != "" {
+		data, err := a.fetchKeys(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch JWK cert from %s: %v", a.certURL, err)
+		}
+		if err := parseKeys(data, keys); err != nil {
+			return fmt.Errorf("failed to parse JWK cert from %s: %v", a.certURL, err)
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.keys = keys
+	a.lastRefresh = time.Now()
+	return nil
+}
+
+func (a *JWTAuthenticator) fetchKeys(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.certURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parseKeys decodes a JWK set document and adds its public keys, indexed by key id, to keys.
+func parseKeys(data []byte, keys map[string]crypto.PublicKey) error {
+	set := struct {
+		Keys []json.RawMessage `json:"keys"`
+	}{}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return err
+	}
+
+	for _, raw := range set.Keys {
+		jwk, err := gojwk.Unmarshal(raw)
+		if err != nil {
+			return err
+		}
+		if jwk.Kid == "" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.DecodePublicKey()
+		if err != nil {
+			return fmt.Errorf("failed to decode key %s: %v", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return nil
+}
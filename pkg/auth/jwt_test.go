@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/openshift-online/maestro/test/mocks/jwk"
+)
+
+func TestJWTAuthenticator(t *testing.T) {
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certURL, teardown := jwk.NewJWKCertServerMock(t, &signingKey.PublicKey, "test-kid", "RS256")
+	defer func() {
+		_ = teardown()
+	}()
+
+	ctx := context.Background()
+	authenticator, err := NewJWTAuthenticator(ctx, certURL, "", "https://issuer.example.com", []string{"maestro"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+	// claims returns the claims of a valid token with the given extra claims
+	claims := func(extra jwt.MapClaims) jwt.MapClaims {
+		claims := jwt.MapClaims{"iss": "https://issuer.example.com", "aud": "maestro", "groups": []string{"devs"}, "exp": exp}
+		for key, value := range extra {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	cases := []struct {
+		name           string
+		token          string
+		expectedUser   string
+		expectedGroups []string
+		expectedErr    bool
+	}{
+		{
+			name:           "username and groups",
+			token:          sign(signingKey, "test-kid", claims(jwt.MapClaims{"username": "alice", "groups": []string{"admins", "devs"}})),
+			expectedUser:   "alice",
+			expectedGroups: []string{"admins", "devs"},
+		},
+		{
+			name:           "fallback to sub",
+			token:          sign(signingKey, "test-kid", claims(jwt.MapClaims{"sub": "bob"})),
+			expectedUser:   "bob",
+			expectedGroups: []string{"devs"},
+		},
+		{
+			name:           "one of the audiences",
+			token:          sign(signingKey, "test-kid", claims(jwt.MapClaims{"sub": "bob", "aud": []string{"other", "maestro"}})),
+			expectedUser:   "bob",
+			expectedGroups: []string{"devs"},
+		},
+		{
+			name:        "no user",
+			token:       sign(signingKey, "test-kid", claims(nil)),
+			expectedErr: true,
+		},
+		{
+			name:        "no groups",
+			token:       sign(signingKey, "test-kid", claims(jwt.MapClaims{"sub": "bob", "groups": []string{}})),
+			expectedErr: true,
+		},
+		{
+			name:        "wrong issuer",
+			token:       sign(signingKey, "test-kid", claims(jwt.MapClaims{"sub": "bob", "iss": "https://other.example.com"})),
+			expectedErr: true,
+		},
+		{
+			name:        "wrong audience",
+			token:       sign(signingKey, "test-kid", claims(jwt.MapClaims{"sub": "bob", "aud": "other"})),
+			expectedErr: true,
+		},
+		{
+			name:        "expired",
+			token:       sign(signingKey, "test-kid", claims(jwt.MapClaims{"sub": "bob", "exp": time.Now().Add(-time.Hour).Unix()})),
+			expectedErr: true,
+		},
+		{
+			name:        "no expiration",
+			token:       sign(signingKey, "test-kid", jwt.MapClaims{"sub": "bob", "iss": "https://issuer.example.com", "aud": "maestro", "groups": []string{"devs"}}),
+			expectedErr: true,
+		},
+		{
+			name:        "unknown key id",
+			token:       sign(signingKey, "other-kid", claims(jwt.MapClaims{"sub": "bob"})),
+			expectedErr: true,
+		},
+		{
+			name:        "wrong signing key",
+			token:       sign(otherKey, "test-kid", claims(jwt.MapClaims{"sub": "bob"})),
+			expectedErr: true,
+		},
+		{
+			name:        "malformed token",
+			token:       "not-a-token",
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			user, groups, err := authenticator.Authenticate(ctx, c.token)
+			if c.expectedErr {
+				if err == nil {
+					t.Errorf("expected error, got user %q", user)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if user != c.expectedUser {
+				t.Errorf("expected user %q, got %q", c.expectedUser, user)
+			}
+			if !reflect.DeepEqual(groups, c.expectedGroups) {
+				t.Errorf("expected groups %v, got %v", c.expectedGroups, groups)
+			}
+		})
+	}
+}
+
+func TestNewJWTAuthenticatorWithoutKeys(t *testing.T) {
+	if _, err := NewJWTAuthenticator(context.Background(), "", "", "https://issuer.example.com", []string{"maestro"}); err == nil {
+		t.Errorf("expected error when neither the cert URL nor the cert file is specified")
+	}
+}
+
+func TestNewJWTAuthenticatorWithoutIssuerOrAudience(t *testing.T) {
+	if _, err := NewJWTAuthenticator(context.Background(), "https://keys.example.com", "", "", []string{"maestro"}); err == nil {
+		t.Errorf("expected error when the issuer is not specified")
+	}
+	if _, err := NewJWTAuthenticator(context.Background(), "https://keys.example.com", "", "https://issuer.example.com", nil); err == nil {
+		t.Errorf("expected error when the audience is not specified")
+	}
+}
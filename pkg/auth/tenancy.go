@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+)
+
+const contextSourcesKey contextKey = "sources"
+
+// Subjects are the users and groups of the authenticated identities.
+type Subjects struct {
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Matches returns true if the user or one of the groups is in the subjects.
+func (s Subjects) Matches(user string, groups []string) bool {
+	if slices.Contains(s.Users, user) {
+		return true
+	}
+	for _, group := range groups {
+		if slices.Contains(s.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tenant binds the identities of a tenant to the sources whose resource bundles the tenant can access.
+type Tenant struct {
+	Name     string   `json:"name"`
+	Subjects Subjects `json:"subjects"`
+	Sources  []string `json:"sources"`
+}
+
+// Tenancy isolates the resource bundles of the tenants by their sources. An identity is only allowed to access
+// the resource bundles of the sources of its tenants, an identity that is not bound to any tenant cannot access
+// any resource bundle. The admins can access the resource bundles of all sources.
+type Tenancy struct {
+	Admins  Subjects `json:"admins"`
+	Tenants []Tenant `json:"tenants"`
+}
+
+// LoadTenancy loads the tenancy from a YAML or JSON file.
+func LoadTenancy(file string) (*Tenancy, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the tenancy config file %s: %v", file, err)
+	}
+
+	tenancy := &Tenancy{}
+	if err := yaml.UnmarshalStrict(data, tenancy); err != nil {
+		return nil, fmt.Errorf("failed to parse the tenancy config file %s: %v", file, err)
+	}
+	for _, tenant := range tenancy.Tenants {
+		if tenant.Name == "" {
+			return nil, fmt.Errorf("the tenant name is required in the tenancy config file %s", file)
+		}
+		if len(tenant.Sources) == 0 {
+			return nil, fmt.Errorf("the sources of tenant %s are required in the tenancy config file %s", tenant.Name, file)
+		}
+	}
+	return tenancy, nil
+}
+
+// SourcesFor returns the sources that an identity is allowed to access. The scoped is false if the identity can
+// access all sources, which is the case for the admins and when the tenancy is not set.
+func (t *Tenancy) SourcesFor(user string, groups []string) (sources []string, scoped bool) {
+	if t == nil || t.Admins.Matches(user, groups) {
+		return nil, false
+	}
+
+	sources = []string{}
+	for _, tenant := range t.Tenants {
+		if !tenant.Subjects.Matches(user, groups) {
+			continue
+		}
+		for _, source := range tenant.Sources {
+			if !slices.Contains(sources, source) {
+				sources = append(sources, source)
+			}
+		}
+	}
+	return sources, true
+}
+
+// NewContextWithTenancy returns a copy of ctx that is scoped to the sources the identity is allowed to access,
+// ctx is returned if the identity can access all sources.
+func NewContextWithTenancy(ctx context.Context, tenancy *Tenancy, user string, groups []string) context.Context {
+	sources, scoped := tenancy.SourcesFor(user, groups)
+	if !scoped {
+		return ctx
+	}
+	return context.WithValue(ctx, contextSourcesKey, sources)
+}
+
+// SourcesFromContext returns the sources that ctx is scoped to, the scoped is false if ctx can access all sources.
+func SourcesFromContext(ctx context.Context) (sources []string, scoped bool) {
+	sources, scoped = ctx.Value(contextSourcesKey).([]string)
+	return sources, scoped
+}
+
+// SourceAllowed returns true if ctx is allowed to access the resource bundles of the source.
+func SourceAllowed(ctx context.Context, source string) bool {
+	sources, scoped := SourcesFromContext(ctx)
+	return !scoped || slices.Contains(sources, source)
+}
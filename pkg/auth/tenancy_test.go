@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTenancy(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tenancy.yaml")
+	config := `admins:
+  groups:
+  - maestro-admins
+tenants:
+- name: tenant1
+  subjects:
+    users:
+    - user1
+  sources:
+  - source1
+- name: tenant2
+  subjects:
+    groups:
+    - team2
+  sources:
+  - source2
+  - source1
+`
+	if err := os.WriteFile(file, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tenancy, err := LoadTenancy(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name            string
+		user            string
+		groups          []string
+		expectedScoped  bool
+		expectedSources []string
+	}{
+		{name: "admin", user: "admin", groups: []string{"maestro-admins"}, expectedScoped: false},
+		{name: "user", user: "user1", expectedScoped: true, expectedSources: []string{"source1"}},
+		{name: "group", user: "user2", groups: []string{"team2"}, expectedScoped: true, expectedSources: []string{"source2", "source1"}},
+		{name: "user and group", user: "user1", groups: []string{"team2"}, expectedScoped: true, expectedSources: []string{"source1", "source2"}},
+		{name: "no tenant", user: "user3", groups: []string{"team3"}, expectedScoped: true, expectedSources: []string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := NewContextWithTenancy(context.Background(), tenancy, c.user, c.groups)
+			sources, scoped := SourcesFromContext(ctx)
+			if scoped != c.expectedScoped {
+				t.Errorf("expected scoped %v, got %v", c.expectedScoped, scoped)
+			}
+			if scoped && !reflect.DeepEqual(sources, c.expectedSources) {
+				t.Errorf("expected sources %v, got %v", c.expectedSources, sources)
+			}
+			if SourceAllowed(ctx, "source1") != (!c.expectedScoped || len(c.expectedSources) > 0) {
+				t.Errorf("unexpected access to source1")
+			}
+		})
+	}
+
+	// the identities are not isolated without the tenancy
+	var none *Tenancy
+	if _, scoped := SourcesFromContext(NewContextWithTenancy(context.Background(), none, "user3", nil)); scoped {
+		t.Errorf("expected the context is not scoped without the tenancy")
+	}
+}
+
+func TestLoadInvalidTenancy(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tenancy.yaml")
+	if err := os.WriteFile(file, []byte("tenants:\n- name: tenant1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTenancy(file); err == nil {
+		t.Errorf("expected an error for the tenant without sources")
+	}
+}
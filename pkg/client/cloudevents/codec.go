@@ -14,23 +14,42 @@ import (
 	"github.com/openshift-online/maestro/pkg/api"
 )
 
+// Codec encodes and decodes the resources of a CloudEvents data type with the codec of their resource type. The
+// source client of a data type publishes and receives the events of the data type with its codec.
 type Codec struct {
 	sourceID string
+	dataType cetypes.CloudEventsDataType
 }
 
 var _ cegeneric.Codec[*api.Resource] = &Codec{}
 
+// NewCodec returns the codec of the manifest bundles.
 func NewCodec(sourceID string) *Codec {
+	return NewCodecForDataType(sourceID, workpayload.ManifestBundleEventDataType)
+}
+
+// NewCodecForDataType returns the codec of the resources of a CloudEvents data type.
+func NewCodecForDataType(sourceID string, dataType cetypes.CloudEventsDataType) *Codec {
 	return &Codec{
 		sourceID: sourceID,
+		dataType: dataType,
 	}
 }
 
+// EventDataType returns the data type that the source client of the codec subscribes to and publishes with.
 func (codec *Codec) EventDataType() cetypes.CloudEventsDataType {
-	return workpayload.ManifestBundleEventDataType
+	return codec.dataType
 }
 
 func (codec *Codec) Encode(source string, eventType cetypes.CloudEventsType, res *api.Resource) (*cloudevents.Event, error) {
+	typeCodec, err := api.ResourceTypeCodecFor(res.Type)
+	if err != nil {
+		return nil, err
+	}
+	if typeCodec.EventDataType() != eventType.CloudEventsDataType {
+		return nil, fmt.Errorf("unmatched cloudevents data type %s for resource type %s", eventType.CloudEventsDataType, res.GetResourceType())
+	}
+
 	// use resource id as the resource payload metadata name to ensure the payload metadata name is
 	// unique on the agent side
 	if err := resetPayloadMetadataNameWithResID(res); err != nil {
@@ -39,7 +58,7 @@ func (codec *Codec) Encode(source string, eventType cetypes.CloudEventsType, res
 
 	// converts a resource payload to a CloudEvent
 	// If the resource payload has metadata the event will have the metadata extension
-	evt, err := api.JSONMAPToCloudEvent(res.Payload)
+	evt, err := typeCodec.EncodeSpec(res)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert resource payload to cloudevent: %v", err)
 	}
@@ -69,8 +88,13 @@ func (codec *Codec) Decode(evt *cloudevents.Event) (*api.Resource, error) {
 		return nil, fmt.Errorf("failed to parse cloud event type %s, %v", evt.Type(), err)
 	}
 
-	if eventType.CloudEventsDataType != workpayload.ManifestBundleEventDataType {
-		return nil, fmt.Errorf("unsupported cloudevents data type %s", eventType.CloudEventsDataType)
+	resourceType, err := api.ResourceTypeOf(eventType.CloudEventsDataType)
+	if err != nil {
+		return nil, err
+	}
+	typeCodec, err := api.ResourceTypeCodecFor(resourceType)
+	if err != nil {
+		return nil, err
 	}
 
 	evtExtensions := evt.Context.GetExtensions()
@@ -95,7 +119,7 @@ func (codec *Codec) Decode(evt *cloudevents.Event) (*api.Resource, error) {
 		return nil, fmt.Errorf("failed to get originalsource extension: %v", err)
 	}
 
-	status, err := api.CloudEventToJSONMap(evt)
+	status, err := typeCodec.DecodeStatus(evt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert cloudevent to resource status: %v", err)
 	}
@@ -110,6 +134,7 @@ func (codec *Codec) Decode(evt *cloudevents.Event) (*api.Resource, error) {
 		},
 		Version:      resourceVersion,
 		ConsumerName: clusterName,
+		Type:         resourceType,
 		Status:       status,
 	}
 
@@ -176,6 +176,35 @@ func TestEncode(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:      "encode resource of unsupported type",
+			source:    "test-source",
+			eventType: cetypes.CloudEventsType{CloudEventsDataType: workpayload.ManifestBundleEventDataType, SubResource: cetypes.SubResourceSpec, Action: "create"},
+			resource: &api.Resource{
+				Meta: api.Meta{
+					ID: resourceID,
+				},
+				Type:         "Unknown",
+				ConsumerName: consumerName,
+			},
+			expectedErrorMsg: "unsupported resource type Unknown",
+		},
+		{
+			name:   "encode resource with unmatched data type",
+			source: "test-source",
+			eventType: cetypes.CloudEventsType{
+				CloudEventsDataType: cetypes.CloudEventsDataType{Group: "maestro.io", Version: "v1", Resource: "configmaps"},
+				SubResource:         cetypes.SubResourceSpec,
+				Action:              "create",
+			},
+			resource: &api.Resource{
+				Meta: api.Meta{
+					ID: resourceID,
+				},
+				ConsumerName: consumerName,
+			},
+			expectedErrorMsg: "unmatched cloudevents data type maestro.io.v1.configmaps for resource type ManifestBundle",
+		},
 	}
 
 	for _, c := range cases {
@@ -0,0 +1,141 @@
+package grpcsource
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	maestrologger "github.com/openshift-online/maestro/pkg/logger"
+)
+
+// ListChangesTimeout is the maximum time to list the changes of the resource bundles, default is 60s.
+var ListChangesTimeout = 60 * time.Second
+
+// ResourceBundleChange is a change of a resource bundle after a resource version, the resource bundle of a
+// deletion is its last version before it is deleted.
+type ResourceBundleChange struct {
+	ResourceBundle openapi.ResourceBundle
+	Deleted        bool
+}
+
+// ListChanges lists the changes of the resource bundles that are selected by the filter after the given resource
+// version, ordered by their resource versions, and returns the resource version that the changes are complete up
+// to. The changes are replayed by a watch from the resource version, the watch is closed after its bookmark. An
+// expired error is returned if the changes after the resource version are compacted, the resource bundles must be
+// listed again.
+func ListChanges(ctx context.Context, logger logging.Logger, client *openapi.APIClient,
+	filter openapi.ResourceBundleFilter, resourceVersion string) ([]ResourceBundleChange, string, error) {
+	filterJson, err := json.Marshal(filter)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal the filter: %v", err)
+	}
+
+	cfg := client.GetConfig()
+	basePath, err := cfg.ServerURLWithContext(ctx, "DefaultAPIService.ApiMaestroV1ResourceBundlesGet")
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := url.Values{}
+	query.Set("watch", "true")
+	query.Set("filter", string(filterJson))
+	query.Set("resourceVersion", resourceVersion)
+
+	// the watch streams until it is closed, it is closed after the bookmark or when the timeout is reached
+	watchCtx, cancel := context.WithTimeout(ctx, ListChangesTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(watchCtx, http.MethodGet,
+		basePath+"/api/maestro/v1/resource-bundles?"+query.Encode(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if token, ok := ctx.Value(openapi.ContextAccessToken).(string); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for header, value := range cfg.DefaultHeader {
+		req.Header.Set(header, value)
+	}
+	if operationID := maestrologger.GetOperationID(ctx); operationID != "" {
+		req.Header.Set("X-Operation-ID", operationID)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	logger.Debug(ctx, "list changes with filter=%s, resourceVersion=%s", filterJson, resourceVersion)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusGone:
+		return nil, "", apierrors.NewResourceExpired(
+			fmt.Sprintf("the resource version %s is compacted", resourceVersion))
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return nil, "", fmt.Errorf("failed to list changes, status=%d, body=%s", resp.StatusCode, body)
+	}
+
+	changes, bookmark, err := readChanges(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	logger.Debug(ctx, "listed changes size=%d, resourceVersion=%s", len(changes), bookmark)
+	return changes, bookmark, nil
+}
+
+// readChanges reads the Server-Sent Events of a watch until its bookmark, and returns the changes before the
+// bookmark and the resource version of the bookmark.
+func readChanges(body io.Reader) ([]ResourceBundleChange, string, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 1024*1024), 64*1024*1024)
+
+	changes := []ResourceBundleChange{}
+	eventType, data := "", ""
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			eventType = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		case line == "" && eventType != "":
+			switch eventType {
+			case "BOOKMARK":
+				bookmark := openapi.ResourceBundle{}
+				if err := json.Unmarshal([]byte(data), &bookmark); err != nil {
+					return nil, "", fmt.Errorf("failed to unmarshal the bookmark: %v", err)
+				}
+				return changes, bookmark.GetResourceVersion(), nil
+			case "MODIFIED", "DELETED":
+				rb := openapi.ResourceBundle{}
+				if err := json.Unmarshal([]byte(data), &rb); err != nil {
+					return nil, "", fmt.Errorf("failed to unmarshal the resource bundle: %v", err)
+				}
+				changes = append(changes, ResourceBundleChange{ResourceBundle: rb, Deleted: eventType == "DELETED"})
+			}
+			eventType, data = "", ""
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, "", err
+	}
+	return nil, "", fmt.Errorf("the watch is closed before its bookmark")
+}
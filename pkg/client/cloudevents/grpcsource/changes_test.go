@@ -0,0 +1,106 @@
+package grpcsource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/client/cloudevents/grpcsource/mock"
+)
+
+func TestListChanges(t *testing.T) {
+	getter := &mock.ResourceBundlesStore{}
+	maestroServer := mock.NewMaestroMockServer(getter)
+	maestroServer.Start()
+	defer maestroServer.Stop()
+
+	client := mock.NewMaestroAPIClient(maestroServer.URL())
+
+	logger, err := logging.NewStdLoggerBuilder().Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getter.SetChanges(5, 2, []mock.Change{
+		{EventType: "MODIFIED", ResourceBundle: changedResourceBundle("rb1", "3")},
+		{EventType: "MODIFIED", ResourceBundle: changedResourceBundle("rb2", "4")},
+		{EventType: "DELETED", ResourceBundle: changedResourceBundle("rb1", "5")},
+	})
+
+	cases := []struct {
+		name                    string
+		resourceVersion         string
+		expectedIDs             []string
+		expectedEventTypes      []watch.EventType
+		expectedResourceVersion string
+		expectedExpired         bool
+	}{
+		{
+			name:                    "changes after the resource version",
+			resourceVersion:         "3",
+			expectedIDs:             []string{"rb2", "rb1"},
+			expectedEventTypes:      []watch.EventType{watch.Modified, watch.Deleted},
+			expectedResourceVersion: "5",
+		},
+		{
+			name:                    "no changes after the resource version",
+			resourceVersion:         "5",
+			expectedResourceVersion: "5",
+		},
+		{
+			name:            "the resource version is compacted",
+			resourceVersion: "1",
+			expectedExpired: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes, resourceVersion, err := ListChanges(context.Background(), logger, client,
+				openapi.ResourceBundleFilter{}, c.resourceVersion)
+			if c.expectedExpired {
+				if !apierrors.IsResourceExpired(err) {
+					t.Errorf("expected expired error, but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+
+			if resourceVersion != c.expectedResourceVersion {
+				t.Errorf("expected resource version %s, but got %s", c.expectedResourceVersion, resourceVersion)
+			}
+
+			works, err := toChangedWorks(changes)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+			if len(works) != len(c.expectedIDs) {
+				t.Fatalf("expected %d works, but got %d", len(c.expectedIDs), len(works))
+			}
+			for i, work := range works {
+				if work.Name != c.expectedIDs[i] {
+					t.Errorf("expected work %s, but got %s", c.expectedIDs[i], work.Name)
+				}
+				if eventType := watchEventType(work); eventType != c.expectedEventTypes[i] {
+					t.Errorf("expected event type %s of work %s, but got %s", c.expectedEventTypes[i], work.Name, eventType)
+				}
+			}
+		})
+	}
+}
+
+func changedResourceBundle(name, resourceVersion string) openapi.ResourceBundle {
+	var version int32 = 1
+	return openapi.ResourceBundle{
+		Id:              openapi.PtrString(name),
+		Metadata:        map[string]interface{}{"name": name, "namespace": "cluster1"},
+		Version:         &version,
+		ResourceVersion: openapi.PtrString(resourceVersion),
+	}
+}
@@ -16,6 +16,10 @@ import (
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
+// NewMaestroGRPCSourceWorkClient returns a WorkV1Interface that gets and lists the works from the maestro server
+// and watches their status with the gRPC source client. The lists have resource versions, and a watch from the
+// resource version of a list replays the changes after it, so the informers built on the client resume without
+// relisting and without missing the changes between their list and watch.
 func NewMaestroGRPCSourceWorkClient(
 	ctx context.Context,
 	logger logging.Logger,
@@ -49,7 +53,8 @@ func NewMaestroGRPCSourceWorkClient(
 			case <-ctx.Done():
 				return
 			case <-cloudEventsClient.SubscribedChan():
-				// reconnect happened, sync the works for current watchers
+				// reconnect happened, sync the works for current watchers, the changes after the last synced
+				// resource version are replayed, so the works are not relisted
 				if err := watcherStore.Sync(); err != nil {
 					logger.Error(ctx, "failed to sync the works %v", err)
 				}
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -10,8 +11,18 @@ import (
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
+// Change is a change of a resource bundle, a watch from a resource version replays the changes after it.
+type Change struct {
+	EventType      string
+	ResourceBundle openapi.ResourceBundle
+}
+
 type ResourceBundlesStore struct {
 	items []openapi.ResourceBundle
+
+	resourceVersion          int64
+	compactedResourceVersion int64
+	changes                  []Change
 }
 
 func (g *ResourceBundlesStore) Get() []openapi.ResourceBundle {
@@ -22,6 +33,14 @@ func (g *ResourceBundlesStore) Set(items []openapi.ResourceBundle) {
 	g.items = items
 }
 
+// SetChanges sets the latest resource version, the compacted resource version and the changes of the store, the
+// resource version of each change must be set.
+func (g *ResourceBundlesStore) SetChanges(resourceVersion, compactedResourceVersion int64, changes []Change) {
+	g.resourceVersion = resourceVersion
+	g.compactedResourceVersion = compactedResourceVersion
+	g.changes = changes
+}
+
 type MaestroMockServer struct {
 	server *httptest.Server
 }
@@ -32,12 +51,24 @@ func NewMaestroMockServer(store *ResourceBundlesStore) *MaestroMockServer {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
+			if r.URL.Query().Get("watch") == "true" {
+				watch(w, r, store)
+				return
+			}
+
 			list := &openapi.ResourceBundleList{}
 			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 			size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+			if page == 0 {
+				page = 1
+			}
 
+			// the continue token of the mock server is the index of the next item
 			items := store.Get()
 			index := ((page - 1) * size)
+			if next := r.URL.Query().Get("continue"); next != "" {
+				index, _ = strconv.Atoi(next)
+			}
 			for i := 0; i < size; i++ {
 				if index >= len(items) {
 					break
@@ -45,7 +76,13 @@ func NewMaestroMockServer(store *ResourceBundlesStore) *MaestroMockServer {
 				list.Items = append(list.Items, items[index])
 				index = index + 1
 			}
+			if index < len(items) {
+				list.Continue = openapi.PtrString(strconv.Itoa(index))
+			}
 
+			if store.resourceVersion != 0 {
+				list.ResourceVersion = openapi.PtrString(strconv.FormatInt(store.resourceVersion, 10))
+			}
 			list.Page = int32(page)
 			list.Total = int32(len(items))
 			list.Size = int32(len(list.Items))
@@ -62,6 +99,35 @@ func NewMaestroMockServer(store *ResourceBundlesStore) *MaestroMockServer {
 	return mockServer
 }
 
+// watch replays the changes after the resource version of the request, then sends a bookmark and returns.
+func watch(w http.ResponseWriter, r *http.Request, store *ResourceBundlesStore) {
+	resourceVersion, err := strconv.ParseInt(r.URL.Query().Get("resourceVersion"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if resourceVersion < store.compactedResourceVersion {
+		w.WriteHeader(http.StatusGone)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	for _, change := range store.changes {
+		changed, _ := strconv.ParseInt(change.ResourceBundle.GetResourceVersion(), 10, 64)
+		if changed <= resourceVersion {
+			continue
+		}
+		data, _ := json.Marshal(change.ResourceBundle)
+		_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", change.EventType, data)
+	}
+	bookmark := resourceVersion
+	if store.resourceVersion > bookmark {
+		bookmark = store.resourceVersion
+	}
+	_, _ = fmt.Fprintf(w, "event: BOOKMARK\ndata: {\"resource_version\":\"%d\"}\n\n", bookmark)
+}
+
 func (m *MaestroMockServer) URL() string {
 	return m.server.URL
 }
@@ -2,8 +2,8 @@ package grpcsource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"strconv"
 
 	"github.com/openshift-online/ocm-sdk-go/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,12 +19,17 @@ import (
 var MaxListPageSize int32 = 400
 
 // PageList assists client code in breaking large list queries into multiple smaller chunks of PageSize or smaller.
-func PageList(ctx context.Context, logger logging.Logger, client *openapi.APIClient, search string, opts metav1.ListOptions) (*openapi.ResourceBundleList, string, error) {
+// The chunks are continued by the continue tokens of the server, so the items are neither skipped nor repeated when
+// the resource bundles are changed during the list. The returned continue token continues the list from its last
+// item, it is empty if there are no more items. The resource bundles are selected by the filter and the field
+// selector of the options, which the server compiles to a parameterized query. The resource version of the
+// returned list is the resource version of the first page, a watch from it replays the changes after the list.
+func PageList(ctx context.Context, logger logging.Logger, client *openapi.APIClient, filter openapi.ResourceBundleFilter, opts metav1.ListOptions) (*openapi.ResourceBundleList, string, error) {
 	items := []openapi.ResourceBundle{}
 
-	page, err := page(opts)
+	filterJson, err := json.Marshal(filter)
 	if err != nil {
-		return nil, "", err
+		return nil, "", fmt.Errorf("failed to marshal the filter: %v", err)
 	}
 
 	operationID := maestrologger.GetOperationID(ctx)
@@ -34,16 +39,22 @@ func PageList(ctx context.Context, logger logging.Logger, client *openapi.APICli
 		return nil, "", fmt.Errorf("limit cannot be less than 0")
 	}
 
-	var total int32 = 0
-	nextPage := ""
-	pageSize := pageSize(int32(limit))
-	offset := (page - 1) * pageSize
+	resourceVersion := ""
+	next := opts.Continue
 	for {
-		logger.Debug(ctx, "list works with search=%s, page=%d, size=%d", search, page, pageSize)
+		size := pageSize(limit, len(items))
+		logger.Debug(ctx, "list works with filter=%s, continue=%s, size=%d", filterJson, next, size)
 		req := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
-			Search(search).
-			Page(page).
-			Size(pageSize)
+			Filter(string(filterJson)).
+			Size(size)
+
+		if len(next) > 0 {
+			req = req.Continue_(next)
+		}
+
+		if len(opts.FieldSelector) > 0 {
+			req = req.FieldSelector(opts.FieldSelector)
+		}
 
 		if len(operationID) > 0 {
 			req = req.XOperationID(operationID)
@@ -53,61 +64,33 @@ func PageList(ctx context.Context, logger logging.Logger, client *openapi.APICli
 		if err != nil {
 			return nil, "", err
 		}
-		logger.Debug(ctx, "listed works total=%d, page=%d, size=%d", rbs.Total, rbs.Page, rbs.Size)
+		logger.Debug(ctx, "listed works total=%d, size=%d", rbs.Total, rbs.Size)
 
+		if len(resourceVersion) == 0 {
+			resourceVersion = rbs.GetResourceVersion()
+		}
 		items = append(items, rbs.Items...)
-		total = rbs.Size + total
-		page = page + 1
+		next = rbs.GetContinue()
 
-		if rbs.Size < pageSize {
-			// reaches the last page, stop list
+		if len(next) == 0 {
+			// reaches the last item, stop list
 			break
 		}
 
-		if limit == 0 {
-			// no limit, continue to list the rest of items
-			continue
-		}
-
-		if total == int32(limit) {
-			// reaches the limit, stop list
-			if (total + offset) < rbs.Total {
-				// the listed items reach the limit size, but there are still items left
-				nextPage = fmt.Sprintf("%d", page)
-			}
-
+		if limit != 0 && int64(len(items)) >= limit {
+			// reaches the limit, stop list, the rest of items are listed with the continue token
 			break
 		}
 	}
 
-	return &openapi.ResourceBundleList{Items: items}, nextPage, nil
-}
-
-func page(opts metav1.ListOptions) (int32, error) {
-	if len(opts.Continue) == 0 {
-		return 1, nil
-	}
-
-	page, err := strconv.Atoi(opts.Continue)
-	if err != nil {
-		return 0, fmt.Errorf("a page number is required, %v", err)
-	}
-
-	if page < 0 {
-		return 0, fmt.Errorf("an invalid page number %d", page)
-	}
-
-	return int32(page), nil
+	return &openapi.ResourceBundleList{Items: items, ResourceVersion: openapi.PtrString(resourceVersion)}, next, nil
 }
 
-func pageSize(limit int32) int32 {
-	if limit > MaxListPageSize {
-		return MaxListPageSize
-	}
-
-	if limit == 0 {
+// pageSize returns the size of the next page, it is the rest of the limit but not greater than MaxListPageSize.
+func pageSize(limit int64, listed int) int32 {
+	if limit == 0 || limit-int64(listed) > int64(MaxListPageSize) {
 		return MaxListPageSize
 	}
 
-	return limit
+	return int32(limit - int64(listed))
 }
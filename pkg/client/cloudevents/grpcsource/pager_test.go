@@ -69,7 +69,7 @@ func TestPageList(t *testing.T) {
 				Limit: 400,
 			},
 			expectedItemsLen: 400,
-			expectedNext:     "2",
+			expectedNext:     "400",
 		},
 		{
 			name:            "list items (limit < total items)",
@@ -78,24 +78,24 @@ func TestPageList(t *testing.T) {
 				Limit: 40,
 			},
 			expectedItemsLen: 40,
-			expectedNext:     "2",
+			expectedNext:     "40",
 		},
 		{
 			name:            "list items with continue (from last page - 1)",
 			resourceBundles: resourceBundles(429),
 			listOpts: metav1.ListOptions{
 				Limit:    100,
-				Continue: "4",
+				Continue: "300",
 			},
 			expectedItemsLen: 100,
-			expectedNext:     "5",
+			expectedNext:     "400",
 		},
 		{
 			name:            "list items with continue (from page last page)",
 			resourceBundles: resourceBundles(429),
 			listOpts: metav1.ListOptions{
 				Limit:    100,
-				Continue: "5",
+				Continue: "400",
 			},
 			expectedItemsLen: 29,
 			expectedNext:     "",
@@ -105,27 +105,36 @@ func TestPageList(t *testing.T) {
 			resourceBundles: resourceBundles(429),
 			listOpts: metav1.ListOptions{
 				Limit:    100,
-				Continue: "6",
+				Continue: "500",
 			},
 			expectedItemsLen: 0,
 			expectedNext:     "",
 		},
+		{
+			name:            "list items (limit > MaxListPageSize)",
+			resourceBundles: resourceBundles(1229),
+			listOpts: metav1.ListOptions{
+				Limit: 1000,
+			},
+			expectedItemsLen: 1000,
+			expectedNext:     "1000",
+		},
 		{
 			name:            "list items with continue and max limit",
 			resourceBundles: resourceBundles(1229),
 			listOpts: metav1.ListOptions{
 				Limit:    400,
-				Continue: "3",
+				Continue: "800",
 			},
 			expectedItemsLen: 400,
-			expectedNext:     "4",
+			expectedNext:     "1200",
 		},
 		{
 			name:            "list items with continue and max limit",
 			resourceBundles: resourceBundles(1229),
 			listOpts: metav1.ListOptions{
 				Limit:    400,
-				Continue: "4",
+				Continue: "1200",
 			},
 			expectedItemsLen: 29,
 			expectedNext:     "",
@@ -135,7 +144,7 @@ func TestPageList(t *testing.T) {
 			resourceBundles: resourceBundles(1229),
 			listOpts: metav1.ListOptions{
 				Limit:    400,
-				Continue: "5",
+				Continue: "1600",
 			},
 			expectedItemsLen: 0,
 			expectedNext:     "",
@@ -151,7 +160,7 @@ func TestPageList(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			list, next, err := PageList(context.Background(), logger, client, "", c.listOpts)
+			list, next, err := PageList(context.Background(), logger, client, openapi.ResourceBundleFilter{}, c.listOpts)
 			if err != nil {
 				t.Errorf("unexpected error %v", err)
 			}
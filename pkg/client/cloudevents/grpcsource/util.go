@@ -3,7 +3,6 @@ package grpcsource
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,8 +16,6 @@ import (
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
-const jsonbPrefix = `payload->'metadata'->'labels'`
-
 // ToManifestWork converts an openapi.ResourceBundle object to workv1.ManifestWork object
 func ToManifestWork(rb *openapi.ResourceBundle) (*workv1.ManifestWork, error) {
 	work := &workv1.ManifestWork{}
@@ -33,9 +30,14 @@ func ToManifestWork(rb *openapi.ResourceBundle) (*workv1.ManifestWork, error) {
 		return nil, err
 	}
 	work.ObjectMeta = objectMeta
-	// (Deprecated) use the maestro resource version as the work resource version.
+	// use the global resource version of the resource bundle as the work resource version, so that the informers
+	// can resume their watches from it. (Deprecated) the maestro resource version is used for the servers that
+	// don't have global resource versions.
 	// Note: work resource version tracking is deprecated, use work generation instead.
 	work.ObjectMeta.ResourceVersion = fmt.Sprintf("%d", *rb.Version)
+	if rb.ResourceVersion != nil {
+		work.ObjectMeta.ResourceVersion = *rb.ResourceVersion
+	}
 	// use the maestro resource version as the work generation
 	work.ObjectMeta.Generation = int64(*rb.Version)
 
@@ -99,82 +101,51 @@ func ToManifestWork(rb *openapi.ResourceBundle) (*workv1.ManifestWork, error) {
 	return work, nil
 }
 
-func ToLabelSearch(opts metav1.ListOptions) (labels.Selector, string, bool, error) {
+// ToLabelFilter converts the label selector of the list options to the label requirements of a resource bundle
+// filter. The requirements are compiled to a parameterized query by the maestro server.
+func ToLabelFilter(opts metav1.ListOptions) (labels.Selector, []openapi.LabelSelectorRequirement, bool, error) {
 	if len(opts.LabelSelector) == 0 {
-		return labels.Everything(), "", false, nil
+		return labels.Everything(), nil, false, nil
 	}
 
 	labelSelector, err := labels.Parse(opts.LabelSelector)
 	if err != nil {
-		return nil, "", false, fmt.Errorf("invalid labels selector %q: %v", opts.LabelSelector, err)
+		return nil, nil, false, fmt.Errorf("invalid labels selector %q: %v", opts.LabelSelector, err)
 	}
 
 	requirements, selectable := labelSelector.Requirements()
 	if !selectable {
-		return labels.Everything(), "", false, nil
+		return labels.Everything(), nil, false, nil
 	}
 
-	equalsLabels := []string{}
-	notEqualsLabels := []string{}
-
-	existsKeys := []string{}
-	doesNotExistKeys := []string{}
-
-	inLabels := map[string][]string{}
+	labelRequirements := []openapi.LabelSelectorRequirement{}
 
 	// refer to below links to find how to use the label selector in kubernetes
 	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#equality-based-requirement
 	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#set-based-requirement
 	for _, requirement := range requirements {
+		var operator string
 		switch requirement.Operator() {
-		case selection.Equals, selection.DoubleEquals:
-			values := requirement.Values()
-			if len(values) != 1 {
-				return nil, "", false, fmt.Errorf("too many values in equals operation")
-			}
-
-			equalsLabels = append(equalsLabels, fmt.Sprintf(`"%s":"%s"`, requirement.Key(), values.List()[0]))
-		case selection.NotEquals:
-			values := requirement.Values()
-			if len(values) != 1 {
-				return nil, "", false, fmt.Errorf("too many values in not equals operation")
-			}
-
-			notEqualsLabels = append(notEqualsLabels, fmt.Sprintf(`%s->>'%s'<>'%s'`, jsonbPrefix, requirement.Key(), values.List()[0]))
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			operator = "In"
+		case selection.NotEquals, selection.NotIn:
+			operator = "NotIn"
 		case selection.Exists:
-			existsKeys = append(existsKeys, fmt.Sprintf(`%s->>'%s'<>null`, jsonbPrefix, requirement.Key()))
-		case selection.In:
-			vals := []string{}
-			for val := range requirement.Values() {
-				vals = append(vals, fmt.Sprintf("'%s'", val))
-			}
-
-			inLabels[requirement.Key()] = vals
-		case selection.NotIn:
-			for val := range requirement.Values() {
-				notEqualsLabels = append(notEqualsLabels, fmt.Sprintf(`%s->>'%s'<>'%s'`, jsonbPrefix, requirement.Key(), val))
-			}
+			operator = "Exists"
+		case selection.DoesNotExist:
+			operator = "DoesNotExist"
 		default:
-			// only DoesNotExist cannot be supported
-			return nil, "", false, fmt.Errorf("unsupported operator %s", requirement.Operator())
+			return nil, nil, false, fmt.Errorf("unsupported operator %s", requirement.Operator())
 		}
-	}
-
-	labelSearch := []string{}
-	if len(equalsLabels) != 0 {
-		labelSearch = append(labelSearch, fmt.Sprintf(`%s@>'{%s}'`, jsonbPrefix, strings.Join(equalsLabels, ",")))
-	}
 
-	if len(inLabels) != 0 {
-		for key, vals := range inLabels {
-			labelSearch = append(labelSearch, fmt.Sprintf(`%s->>'%s'in(%s)`, jsonbPrefix, key, strings.Join(vals, ",")))
+		labelRequirement := openapi.NewLabelSelectorRequirement(requirement.Key(), operator)
+		if values := requirement.Values(); values.Len() != 0 {
+			labelRequirement.Values = values.List()
 		}
+		labelRequirements = append(labelRequirements, *labelRequirement)
 	}
 
-	labelSearch = append(labelSearch, notEqualsLabels...)
-	labelSearch = append(labelSearch, existsKeys...)
-	labelSearch = append(labelSearch, doesNotExistKeys...)
-	return labelSelector, strings.Join(labelSearch, " and "), true, nil
+	return labelSelector, labelRequirements, true, nil
 }
 
 // ToWorkPatch returns a merge patch between an existing work and a new work.
@@ -226,18 +197,20 @@ func ToWorkPatch(existingWork, newWork *workv1.ManifestWork) ([]byte, error) {
 	return patchBytes, nil
 }
 
-func ToSyncSearch(sourceID string, namespaces []string) string {
-	searchNamespaces := []string{}
+// ToSyncFilter returns a resource bundle filter that selects the resource bundles of a source in the given
+// namespaces (consumer names), all of the consumers are selected if the namespaces contain `metav1.NamespaceAll`.
+func ToSyncFilter(sourceID string, namespaces []string) openapi.ResourceBundleFilter {
+	filter := openapi.ResourceBundleFilter{Source: &sourceID}
 	for _, ns := range namespaces {
 		if ns == metav1.NamespaceAll {
 			// all namespaces
-			return fmt.Sprintf("source='%s'", sourceID)
+			return openapi.ResourceBundleFilter{Source: &sourceID}
 		}
 
-		searchNamespaces = append(searchNamespaces, fmt.Sprintf("consumer_name='%s'", ns))
+		filter.ConsumerNames = append(filter.ConsumerNames, ns)
 	}
 
-	return fmt.Sprintf("source='%s' and (%s)", sourceID, strings.Join(searchNamespaces, " or "))
+	return filter
 }
 
 func marshal(obj map[string]any) ([]byte, error) {
@@ -62,6 +62,39 @@ func TestToManifestWork(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "covert a resource bundle - has a resource version",
+			input: &openapi.ResourceBundle{
+				Metadata: map[string]interface{}{
+					"name":      "test",
+					"namespace": "testns",
+				},
+				Version:         &version,
+				ResourceVersion: openapi.PtrString("42"),
+				Manifests: []map[string]interface{}{
+					{"a": "b"},
+				},
+			},
+			expected: &workv1.ManifestWork{
+				ObjectMeta: v1.ObjectMeta{
+					Name:            "test",
+					Namespace:       "testns",
+					ResourceVersion: "42",
+					Generation:      1,
+				},
+				Spec: workv1.ManifestWorkSpec{
+					Workload: workv1.ManifestsTemplate{
+						Manifests: []workv1.Manifest{
+							{
+								RawExtension: runtime.RawExtension{
+									Raw: workload,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "covert a resource bundle",
 			input: &openapi.ResourceBundle{
@@ -142,72 +175,92 @@ func TestToManifestWork(t *testing.T) {
 	}
 }
 
-func TestToLabelSearch(t *testing.T) {
+func TestToLabelFilter(t *testing.T) {
 	cases := []struct {
-		name                string
-		opts                v1.ListOptions
-		expectedSelectable  bool
-		expectedLabelSearch string
+		name                 string
+		opts                 v1.ListOptions
+		expectedSelectable   bool
+		expectedRequirements []openapi.LabelSelectorRequirement
 	}{
 		{
-			name:                "no label selector",
-			opts:                v1.ListOptions{},
-			expectedSelectable:  false,
-			expectedLabelSearch: "",
+			name:               "no label selector",
+			opts:               v1.ListOptions{},
+			expectedSelectable: false,
 		},
 		{
-			name:                "selector everything",
-			opts:                v1.ListOptions{LabelSelector: labels.Everything().String()},
-			expectedSelectable:  false,
-			expectedLabelSearch: "",
+			name:               "selector everything",
+			opts:               v1.ListOptions{LabelSelector: labels.Everything().String()},
+			expectedSelectable: false,
 		},
 		{
-			name:                "one equals selector",
-			opts:                v1.ListOptions{LabelSelector: "a=b"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'@>'{"a":"b"}'`,
+			name:               "one equals selector",
+			opts:               v1.ListOptions{LabelSelector: "a=b"},
+			expectedSelectable: true,
+			expectedRequirements: []openapi.LabelSelectorRequirement{
+				{Key: "a", Operator: "In", Values: []string{"b"}},
+			},
 		},
 		{
-			name:                "multiple equals selector",
-			opts:                v1.ListOptions{LabelSelector: "a=b,c==d"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'@>'{"a":"b","c":"d"}'`,
+			name:               "multiple equals selector",
+			opts:               v1.ListOptions{LabelSelector: "a=b,c==d"},
+			expectedSelectable: true,
+			expectedRequirements: []openapi.LabelSelectorRequirement{
+				{Key: "a", Operator: "In", Values: []string{"b"}},
+				{Key: "c", Operator: "In", Values: []string{"d"}},
+			},
 		},
 		{
-			name:                "multiple not equals selector",
-			opts:                v1.ListOptions{LabelSelector: "a!=b,c!=d"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'a'<>'b' and payload->'metadata'->'labels'->>'c'<>'d'`,
+			name:               "multiple not equals selector",
+			opts:               v1.ListOptions{LabelSelector: "a!=b,c!=d"},
+			expectedSelectable: true,
+			expectedRequirements: []openapi.LabelSelectorRequirement{
+				{Key: "a", Operator: "NotIn", Values: []string{"b"}},
+				{Key: "c", Operator: "NotIn", Values: []string{"d"}},
+			},
 		},
 		{
-			name:                "exist selector",
-			opts:                v1.ListOptions{LabelSelector: "a"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'a'<>null`,
+			name:               "exist selector",
+			opts:               v1.ListOptions{LabelSelector: "a"},
+			expectedSelectable: true,
+			expectedRequirements: []openapi.LabelSelectorRequirement{
+				{Key: "a", Operator: "Exists"},
+			},
+		},
+		{
+			name:               "does not exist selector",
+			opts:               v1.ListOptions{LabelSelector: "!a"},
+			expectedSelectable: true,
+			expectedRequirements: []openapi.LabelSelectorRequirement{
+				{Key: "a", Operator: "DoesNotExist"},
+			},
 		},
 		{
-			name:                "in selector",
-			opts:                v1.ListOptions{LabelSelector: "env in (a)"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'env'in('a')`,
+			name:               "in selector",
+			opts:               v1.ListOptions{LabelSelector: "env in (a,b)"},
+			expectedSelectable: true,
+			expectedRequirements: []openapi.LabelSelectorRequirement{
+				{Key: "env", Operator: "In", Values: []string{"a", "b"}},
+			},
 		},
 		{
-			name:                "not in selector",
-			opts:                v1.ListOptions{LabelSelector: "env notin (a)"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'env'<>'a'`,
+			name:               "not in selector",
+			opts:               v1.ListOptions{LabelSelector: "env notin (a)"},
+			expectedSelectable: true,
+			expectedRequirements: []openapi.LabelSelectorRequirement{
+				{Key: "env", Operator: "NotIn", Values: []string{"a"}},
+			},
 		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			_, labelSearch, selectable, err := ToLabelSearch(c.opts)
+			_, requirements, selectable, err := ToLabelFilter(c.opts)
 			if c.expectedSelectable != selectable {
 				t.Errorf("expected %v, but got %v", c.expectedSelectable, selectable)
 			}
 
-			if c.expectedLabelSearch != labelSearch {
-				t.Errorf("expected %s, but got %s", c.expectedLabelSearch, labelSearch)
+			if !equality.Semantic.DeepEqual(c.expectedRequirements, requirements) {
+				t.Errorf("expected %v, but got %v", c.expectedRequirements, requirements)
 			}
 
 			if err != nil {
@@ -217,6 +270,42 @@ func TestToLabelSearch(t *testing.T) {
 	}
 }
 
+func TestToSyncFilter(t *testing.T) {
+	cases := []struct {
+		name                  string
+		namespaces            []string
+		expectedConsumerNames []string
+	}{
+		{
+			name:                  "one namespace",
+			namespaces:            []string{"cluster1"},
+			expectedConsumerNames: []string{"cluster1"},
+		},
+		{
+			name:                  "multiple namespaces",
+			namespaces:            []string{"cluster1", "cluster2"},
+			expectedConsumerNames: []string{"cluster1", "cluster2"},
+		},
+		{
+			name:       "all namespaces",
+			namespaces: []string{"cluster1", v1.NamespaceAll},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			filter := ToSyncFilter("source1", c.namespaces)
+			if filter.GetSource() != "source1" {
+				t.Errorf("expected source1, but got %s", filter.GetSource())
+			}
+
+			if !equality.Semantic.DeepEqual(c.expectedConsumerNames, filter.ConsumerNames) {
+				t.Errorf("expected %v, but got %v", c.expectedConsumerNames, filter.ConsumerNames)
+			}
+		})
+	}
+}
+
 func TestToWorkPatch(t *testing.T) {
 	cases := []struct {
 		name                    string
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -41,6 +42,11 @@ type RESTFulAPIWatcherStore struct {
 	watchers  map[string]*workWatcher
 	workQueue cache.Queue
 
+	// resourceVersion is the resource version that the works of the watchers are synced up to, the watchers are
+	// synced with the changes after it when the client reconnects.
+	resourceVersion     string
+	resourceVersionLock sync.Mutex
+
 	logger logging.Logger
 }
 
@@ -72,38 +78,52 @@ func newRESTFulAPIWatcherStore(ctx context.Context,
 }
 
 // GetWatcher returns a watcher to the source work client with a specified namespace (consumer name).
-// Using `metav1.NamespaceAll` to specify all namespaces.
+// Using `metav1.NamespaceAll` to specify all namespaces. If the options have the resource version of a list, the
+// watcher starts from it, the changes of the works after it are replayed, so that an informer doesn't miss the
+// changes between its list and watch. Otherwise, all works are listed and sent to the watcher.
 func (m *RESTFulAPIWatcherStore) GetWatcher(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
 	// Only list works from maestro server with the given namespace when a watcher is required
-	labelSelector, labelSearch, selectable, err := ToLabelSearch(opts)
+	labelSelector, labelRequirements, _, err := ToLabelFilter(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	searches := []string{fmt.Sprintf("source='%s'", m.sourceID)}
-	if namespace != metav1.NamespaceAll {
-		searches = append(searches, fmt.Sprintf("consumer_name='%s'", namespace))
-	}
+	filter := ToSyncFilter(m.sourceID, []string{namespace})
+	filter.Labels = labelRequirements
 
-	if selectable {
-		searches = append(searches, labelSearch)
-	}
-
-	// for watch, we need list all works with the search condition from maestro server
-	rbs, _, err := PageList(ctx, m.logger, m.apiClient, strings.Join(searches, " and "), metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	var works []*workv1.ManifestWork
+	var resourceVersion string
+	if len(opts.ResourceVersion) != 0 && opts.ResourceVersion != "0" {
+		// for watch from a resource version, we need the changes of works after it from maestro server
+		changes, bookmark, err := ListChanges(ctx, m.logger, m.apiClient, filter, opts.ResourceVersion)
+		if err != nil {
+			return nil, err
+		}
+		if works, err = toChangedWorks(changes); err != nil {
+			return nil, err
+		}
+		resourceVersion = bookmark
+	} else {
+		// for watch, we need list all works with the filter from maestro server
+		rbs, _, err := PageList(ctx, m.logger, m.apiClient, filter, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, rb := range rbs.Items {
+			work, err := ToManifestWork(&rb)
+			if err != nil {
+				return nil, err
+			}
+			works = append(works, work)
+		}
+		resourceVersion = rbs.GetResourceVersion()
 	}
 
 	watcher := m.registerWatcher(ctx, namespace, labelSelector)
+	m.lowerResourceVersion(resourceVersion)
 
 	// save the works to a queue
-	for _, rb := range rbs.Items {
-		work, err := ToManifestWork(&rb)
-		if err != nil {
-			return nil, err
-		}
-
+	for _, work := range works {
 		m.logger.Debug(ctx, "enqueue the work %s/%s (source=%s)", work.Namespace, work.Name, m.sourceID)
 		if err := m.workQueue.Add(work); err != nil {
 			return nil, err
@@ -113,14 +133,15 @@ func (m *RESTFulAPIWatcherStore) GetWatcher(ctx context.Context, namespace strin
 	return watcher, nil
 }
 
-// HandleReceivedWork sends the received works to the watch channel
+// HandleReceivedWork sends the received works to the watch channel. The received works don't have the resource
+// versions of maestro server, they are set to the resource version that the works are synced up to, so that an
+// informer that resumes from one of them replays the changes after it.
 func (m *RESTFulAPIWatcherStore) HandleReceivedResource(ctx context.Context, work *workv1.ManifestWork) error {
-	watchType := watch.Modified
-	if meta.IsStatusConditionTrue(work.Status.Conditions, common.ResourceDeleted) {
-		watchType = watch.Deleted
+	if resourceVersion := m.getResourceVersion(); len(resourceVersion) != 0 {
+		work.ResourceVersion = resourceVersion
 	}
 
-	m.sendWatchEvent(watch.Event{Type: watchType, Object: work})
+	m.sendWatchEvent(watch.Event{Type: watchEventType(work), Object: work})
 	return nil
 }
 
@@ -152,28 +173,37 @@ func (m *RESTFulAPIWatcherStore) Get(ctx context.Context, namespace, name string
 }
 
 // List works from maestro server with a specified namespace and list options.
-// Using `metav1.NamespaceAll` to specify all namespace.
+// Using `metav1.NamespaceAll` to specify all namespace. The works are selected by the label selector and the
+// field selector (metadata.name and metadata.namespace) of the options on the maestro server. The resource version
+// of the list is the resource version of its first page, a watch from it doesn't miss the changes after the list.
 func (m *RESTFulAPIWatcherStore) List(ctx context.Context, namespace string, opts metav1.ListOptions) (*store.ResourceList[*workv1.ManifestWork], error) {
 	works := []*workv1.ManifestWork{}
 
-	_, labelSearch, selectable, err := ToLabelSearch(opts)
+	_, labelRequirements, _, err := ToLabelFilter(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	searches := []string{fmt.Sprintf("source='%s'", m.sourceID)}
-	if namespace != metav1.NamespaceAll {
-		searches = append(searches, fmt.Sprintf("consumer_name='%s'", namespace))
-	}
+	filter := ToSyncFilter(m.sourceID, []string{namespace})
+	filter.Labels = labelRequirements
 
-	if selectable {
-		searches = append(searches, labelSearch)
+	// the continue token of a list is prefixed with the resource version of its first page
+	resourceVersion := ""
+	if rv, next, ok := strings.Cut(opts.Continue, ":"); ok {
+		resourceVersion = rv
+		opts.Continue = next
 	}
 
-	rbs, nextPage, err := PageList(ctx, m.logger, m.apiClient, strings.Join(searches, " and "), opts)
+	rbs, nextPage, err := PageList(ctx, m.logger, m.apiClient, filter, opts)
 	if err != nil {
 		return nil, err
 	}
+	if len(resourceVersion) == 0 {
+		resourceVersion = rbs.GetResourceVersion()
+	}
+	if len(nextPage) != 0 {
+		nextPage = resourceVersion + ":" + nextPage
+	}
 
 	for _, rb := range rbs.Items {
 		work, err := ToManifestWork(&rb)
@@ -186,7 +216,7 @@ func (m *RESTFulAPIWatcherStore) List(ctx context.Context, namespace string, opt
 	}
 
 	return &store.ResourceList[*workv1.ManifestWork]{
-		ListMeta: metav1.ListMeta{Continue: nextPage},
+		ListMeta: metav1.ListMeta{ResourceVersion: resourceVersion, Continue: nextPage},
 		Items:    works,
 	}, nil
 }
@@ -215,6 +245,9 @@ func (m *RESTFulAPIWatcherStore) HasInitiated() bool {
 	return true
 }
 
+// Sync sends the works of current watchers that are changed while the client is disconnected to the watchers.
+// The changes after the resource version that the works are synced up to are replayed, including the deletions.
+// All works are listed if the resource version is unknown or compacted.
 func (m *RESTFulAPIWatcherStore) Sync() error {
 	m.RLock()
 	defer m.RUnlock()
@@ -231,30 +264,55 @@ func (m *RESTFulAPIWatcherStore) Sync() error {
 		namespaces = append(namespaces, namespace)
 	}
 
-	search := ToSyncSearch(m.sourceID, namespaces)
+	filter := ToSyncFilter(m.sourceID, namespaces)
 
-	// for sync, we need list all works with the search condition from maestro server
-	rbs, _, err := PageList(m.ctx, m.logger, m.apiClient, search, metav1.ListOptions{})
+	works, resourceVersion, err := m.syncWorks(filter)
 	if err != nil {
 		return err
 	}
 
 	// save the works to a queue
-	for _, rb := range rbs.Items {
-		work, err := ToManifestWork(&rb)
-		if err != nil {
-			return err
-		}
-
+	for _, work := range works {
 		m.logger.Debug(m.ctx, "enqueue the work %s/%s (source=%s)", work.Namespace, work.Name, m.sourceID)
 		if err := m.workQueue.Add(work); err != nil {
 			return err
 		}
 	}
 
+	m.setResourceVersion(resourceVersion)
 	return nil
 }
 
+// syncWorks returns the works that are changed after the resource version that the works are synced up to, or
+// all works if the changes are unavailable, and the resource version that the returned works are synced up to.
+func (m *RESTFulAPIWatcherStore) syncWorks(filter openapi.ResourceBundleFilter) ([]*workv1.ManifestWork, string, error) {
+	if resourceVersion := m.getResourceVersion(); len(resourceVersion) != 0 {
+		changes, bookmark, err := ListChanges(m.ctx, m.logger, m.apiClient, filter, resourceVersion)
+		if err == nil {
+			works, err := toChangedWorks(changes)
+			return works, bookmark, err
+		}
+		m.logger.Warn(m.ctx, "failed to list the changes after %s, list all works (source=%s), %v",
+			resourceVersion, m.sourceID, err)
+	}
+
+	// for sync, we need list all works with the filter from maestro server
+	rbs, _, err := PageList(m.ctx, m.logger, m.apiClient, filter, metav1.ListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	works := []*workv1.ManifestWork{}
+	for _, rb := range rbs.Items {
+		work, err := ToManifestWork(&rb)
+		if err != nil {
+			return nil, "", err
+		}
+		works = append(works, work)
+	}
+	return works, rbs.GetResourceVersion(), nil
+}
+
 // process drains the work queue and send the work to the watch channel.
 func (m *RESTFulAPIWatcherStore) process() {
 	for {
@@ -282,7 +340,35 @@ func (m *RESTFulAPIWatcherStore) process() {
 			return
 		}
 
-		m.sendWatchEvent(watch.Event{Type: watch.Modified, Object: work})
+		m.sendWatchEvent(watch.Event{Type: watchEventType(work), Object: work})
+	}
+}
+
+func (m *RESTFulAPIWatcherStore) getResourceVersion() string {
+	m.resourceVersionLock.Lock()
+	defer m.resourceVersionLock.Unlock()
+
+	return m.resourceVersion
+}
+
+func (m *RESTFulAPIWatcherStore) setResourceVersion(resourceVersion string) {
+	m.resourceVersionLock.Lock()
+	defer m.resourceVersionLock.Unlock()
+
+	m.resourceVersion = resourceVersion
+}
+
+// lowerResourceVersion sets the resource version that the works are synced up to if it is unknown or greater than
+// the given one, since the works of a new watcher are only synced up to the given one.
+func (m *RESTFulAPIWatcherStore) lowerResourceVersion(resourceVersion string) {
+	m.resourceVersionLock.Lock()
+	defer m.resourceVersionLock.Unlock()
+
+	if len(resourceVersion) == 0 {
+		return
+	}
+	if len(m.resourceVersion) == 0 || compareResourceVersion(resourceVersion, m.resourceVersion) < 0 {
+		m.resourceVersion = resourceVersion
 	}
 }
 
@@ -311,3 +397,50 @@ func (m *RESTFulAPIWatcherStore) sendWatchEvent(evt watch.Event) {
 		w.Receive(evt)
 	}
 }
+
+// watchEventType returns the type of the watch event of a work, the work is deleted if its deleted condition is true.
+func watchEventType(work *workv1.ManifestWork) watch.EventType {
+	if meta.IsStatusConditionTrue(work.Status.Conditions, common.ResourceDeleted) {
+		return watch.Deleted
+	}
+	return watch.Modified
+}
+
+// toChangedWorks converts the changes of resource bundles to works, the deleted condition of a deleted work is
+// true.
+func toChangedWorks(changes []ResourceBundleChange) ([]*workv1.ManifestWork, error) {
+	works := []*workv1.ManifestWork{}
+	for _, change := range changes {
+		work, err := ToManifestWork(&change.ResourceBundle)
+		if err != nil {
+			return nil, err
+		}
+		if change.Deleted {
+			meta.SetStatusCondition(&work.Status.Conditions, metav1.Condition{
+				Type:    common.ResourceDeleted,
+				Status:  metav1.ConditionTrue,
+				Reason:  "Deleted",
+				Message: "The work is deleted",
+			})
+		}
+		works = append(works, work)
+	}
+	return works, nil
+}
+
+// compareResourceVersion compares two resource versions of maestro server numerically.
+func compareResourceVersion(a, b string) int {
+	x, errA := strconv.ParseInt(a, 10, 64)
+	y, errB := strconv.ParseInt(b, 10, 64)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
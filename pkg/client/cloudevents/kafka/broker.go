@@ -0,0 +1,32 @@
+package kafka
+
+import (
+	"context"
+)
+
+// Message is a record of a Kafka topic.
+type Message struct {
+	Topic string
+	// Key decides the partition of the record, the records of a key are kept in order.
+	Key       string
+	Value     []byte
+	Headers   map[string]string
+	Partition int
+	Offset    int64
+}
+
+// Broker produces the records to the topics of a Kafka cluster and consumes them with consumer groups.
+type Broker interface {
+	// Produce appends the message to the partition of its topic that is chosen by the message key.
+	Produce(ctx context.Context, msg *Message) error
+
+	// Consume joins the consumer group to consume the topics, the partitions of the topics are assigned to the
+	// members of the group, so that each record is consumed by one member of the group. The handler is called
+	// for one record at a time, and the offset of a record is committed after the handler returns. A group
+	// without committed offsets starts from the latest offsets. This is a blocking call that returns when the
+	// context is canceled or the broker is closed.
+	Consume(ctx context.Context, groupID string, topics []string, handler func(ctx context.Context, msg *Message)) error
+
+	// Close closes the connection to the Kafka cluster.
+	Close() error
+}
@@ -0,0 +1,102 @@
+package kafka
+
+import (
+	"fmt"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const (
+	// ceHeaderPrefix is the prefix of the headers of the CloudEvent attributes and extensions, it follows the
+	// binary content mode of the CloudEvents Kafka protocol binding.
+	ceHeaderPrefix = "ce_"
+	// contentTypeHeader is the header of the CloudEvent data content type.
+	contentTypeHeader = "content-type"
+)
+
+// encode converts a CloudEvent to a Kafka record of the topic with the key.
+func encode(topic, key string, evt cloudevents.Event) (*Message, error) {
+	msg := &Message{
+		Topic:   topic,
+		Key:     key,
+		Value:   evt.Data(),
+		Headers: map[string]string{},
+	}
+
+	version := spec.WithPrefix(ceHeaderPrefix).Version(evt.SpecVersion())
+	for _, attr := range version.Attributes() {
+		value := attr.Get(evt.Context)
+		if value == nil {
+			continue
+		}
+		strValue, err := types.Format(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format attribute %s: %v", attr.Kind().String(), err)
+		}
+		if strValue == "" {
+			continue
+		}
+		if attr.Kind() == spec.DataContentType {
+			msg.Headers[contentTypeHeader] = strValue
+			continue
+		}
+		msg.Headers[attr.PrefixedName()] = strValue
+	}
+
+	for name, value := range evt.Extensions() {
+		strValue, err := types.Format(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format extension %s: %v", name, err)
+		}
+		msg.Headers[ceHeaderPrefix+name] = strValue
+	}
+
+	return msg, nil
+}
+
+// decode converts a Kafka record to a CloudEvent.
+func decode(msg *Message) (cloudevents.Event, error) {
+	specs := spec.WithPrefix(ceHeaderPrefix)
+	specVersion := msg.Headers[specs.PrefixedSpecVersionName()]
+	if specVersion == "" {
+		specVersion = cloudevents.VersionV1
+	}
+	evt := cloudevents.NewEvent(specVersion)
+	version := specs.Version(specVersion)
+
+	attrHeaders := map[string]bool{}
+	for _, attr := range version.Attributes() {
+		attrHeaders[attr.PrefixedName()] = true
+		if attr.Kind() == spec.DataContentType {
+			continue
+		}
+		if value := msg.Headers[attr.PrefixedName()]; value != "" {
+			if err := attr.Set(evt.Context, value); err != nil {
+				return evt, fmt.Errorf("failed to set attribute %s: %v", attr.Kind().String(), err)
+			}
+		}
+	}
+
+	for name, value := range msg.Headers {
+		if attrHeaders[name] || !strings.HasPrefix(name, ceHeaderPrefix) {
+			continue
+		}
+		evt.SetExtension(strings.TrimPrefix(name, ceHeaderPrefix), value)
+	}
+
+	contentType := msg.Headers[contentTypeHeader]
+	if contentType == "" {
+		contentType = cloudevents.ApplicationJSON
+	}
+	if err := evt.SetData(contentType, msg.Value); err != nil {
+		return evt, fmt.Errorf("failed to set event data: %v", err)
+	}
+
+	if err := evt.Validate(); err != nil {
+		return evt, fmt.Errorf("invalid event: %v", err)
+	}
+	return evt, nil
+}
@@ -0,0 +1,155 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"k8s.io/klog/v2"
+)
+
+// franzBroker is a Broker of a Kafka cluster, it is implemented with the franz-go client. The records are
+// produced with one client, and each consumer group is joined with its own client.
+type franzBroker struct {
+	opts     []kgo.Opt
+	producer *kgo.Client
+
+	mu        sync.Mutex
+	closed    bool
+	consumers map[*kgo.Client]struct{}
+}
+
+var _ Broker = &franzBroker{}
+
+// dialFranz connects to the Kafka cluster of the bootstrap servers with the franz-go client. The topics are
+// created on their first use if the cluster allows the auto topic creation.
+func dialFranz(ctx context.Context, kafkaOptions *KafkaOptions) (Broker, error) {
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(kafkaOptions.BootstrapServers...),
+		kgo.AllowAutoTopicCreation(),
+	}
+	if kafkaOptions.TLSConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(kafkaOptions.TLSConfig))
+	}
+
+	producer, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := producer.Ping(ctx); err != nil {
+		producer.Close()
+		return nil, fmt.Errorf("failed to connect to kafka %s: %v", strings.Join(kafkaOptions.BootstrapServers, ","), err)
+	}
+
+	return &franzBroker{
+		opts:      opts,
+		producer:  producer,
+		consumers: map[*kgo.Client]struct{}{},
+	}, nil
+}
+
+func (b *franzBroker) Produce(ctx context.Context, msg *Message) error {
+	record := &kgo.Record{
+		Topic: msg.Topic,
+		Key:   []byte(msg.Key),
+		Value: msg.Value,
+	}
+	for key, value := range msg.Headers {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: key, Value: []byte(value)})
+	}
+	return b.producer.ProduceSync(ctx, record).FirstErr()
+}
+
+// Consume polls the records of the topics with a consumer group client. The offsets of the polled records are
+// committed after the handler returns for all of them, and the partitions are not reassigned in the meantime.
+func (b *franzBroker) Consume(ctx context.Context, groupID string, topics []string,
+	handler func(ctx context.Context, msg *Message)) error {
+	consumer, err := b.newConsumer(groupID, topics)
+	if err != nil {
+		return err
+	}
+	defer b.closeConsumer(consumer)
+
+	logger := klog.FromContext(ctx)
+	for {
+		fetches := consumer.PollFetches(ctx)
+		if fetches.IsClientClosed() || ctx.Err() != nil {
+			return nil
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			logger.Error(err, "failed to fetch the records", "topic", topic, "partition", partition)
+		})
+
+		fetches.EachRecord(func(record *kgo.Record) {
+			handler(ctx, toMessage(record))
+		})
+		if err := consumer.CommitUncommittedOffsets(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error(err, "failed to commit the offsets", "groupID", groupID)
+		}
+		consumer.AllowRebalance()
+	}
+}
+
+func (b *franzBroker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for consumer := range b.consumers {
+		consumer.CloseAllowingRebalance()
+	}
+	b.producer.Close()
+	return nil
+}
+
+func (b *franzBroker) newConsumer(groupID string, topics []string) (*kgo.Client, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, fmt.Errorf("the kafka broker is closed")
+	}
+
+	// a group without committed offsets starts from the latest offsets, the offsets are committed after the
+	// records are handled.
+	opts := append([]kgo.Opt{}, b.opts...)
+	opts = append(opts,
+		kgo.ConsumerGroup(groupID),
+		kgo.ConsumeTopics(topics...),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtEnd()),
+		kgo.DisableAutoCommit(),
+		kgo.BlockRebalanceOnPoll(),
+	)
+	consumer, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	b.consumers[consumer] = struct{}{}
+	return consumer, nil
+}
+
+func (b *franzBroker) closeConsumer(consumer *kgo.Client) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.consumers, consumer)
+	consumer.CloseAllowingRebalance()
+}
+
+func toMessage(record *kgo.Record) *Message {
+	msg := &Message{
+		Topic:     record.Topic,
+		Key:       string(record.Key),
+		Value:     record.Value,
+		Headers:   map[string]string{},
+		Partition: int(record.Partition),
+		Offset:    record.Offset,
+	}
+	for _, header := range record.Headers {
+		msg.Headers[header.Key] = string(header.Value)
+	}
+	return msg
+}
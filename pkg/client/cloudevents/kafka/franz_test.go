@@ -0,0 +1,173 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+const partitions = 4
+
+// startTransport connects the transport to the Kafka cluster and receives its events until the context is
+// canceled.
+func startTransport(ctx context.Context, t *testing.T, transport options.CloudEventTransport) *receivedEvents {
+	if err := transport.Connect(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := transport.Subscribe(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	received := &receivedEvents{}
+	go func() {
+		_ = transport.Receive(ctx, received.handle)
+	}()
+	return received
+}
+
+// commitStartOffsets commits the start offsets of the topics for the consumer groups of the transports, so
+// that the events sent before the groups are joined are consumed.
+func commitStartOffsets(ctx context.Context, t *testing.T, bootstrapServers []string, transports ...options.CloudEventTransport) {
+	client, err := kgo.NewClient(kgo.SeedBrokers(bootstrapServers...))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	admin := kadm.NewClient(client)
+	for _, transport := range transports {
+		kafkaTransport := transport.(*kafkaTransport)
+		offsets := kadm.Offsets{}
+		for _, topic := range kafkaTransport.subscribeTopics {
+			for partition := int32(0); partition < partitions; partition++ {
+				offsets.AddOffset(topic, partition, 0, -1)
+			}
+		}
+		if err := admin.CommitAllOffsets(ctx, kafkaTransport.groupID, offsets); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+// groupMembers returns the number of the members of a stable consumer group, it is -1 if the group is not stable,
+// e.g. its partitions are being assigned.
+func groupMembers(ctx context.Context, t *testing.T, bootstrapServers []string, groupID string) int {
+	client, err := kgo.NewClient(kgo.SeedBrokers(bootstrapServers...))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	groups, err := kadm.NewClient(client).DescribeGroups(ctx, groupID)
+	if err != nil {
+		return -1
+	}
+	group, ok := groups[groupID]
+	if !ok || group.Err != nil || group.State != "Stable" {
+		return -1
+	}
+	return len(group.Members)
+}
+
+func TestTransport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kafkaOptions := &KafkaOptions{TopicPrefix: "maestro"}
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions,
+		specTopic(kafkaOptions, "cluster1"),
+		specTopic(kafkaOptions, "cluster2"),
+		statusTopic(kafkaOptions, "maestro"),
+		sourceBroadcastTopic(kafkaOptions),
+		agentBroadcastTopic(kafkaOptions),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cluster.Close()
+	kafkaOptions.BootstrapServers = cluster.ListenAddrs()
+
+	source := NewSourceOptions(kafkaOptions, "maestro-0", "maestro", true).CloudEventsTransport
+	agent := NewAgentOptions(kafkaOptions, "cluster1", "cluster1-agent").CloudEventsTransport
+	otherAgent := NewAgentOptions(kafkaOptions, "cluster2", "cluster2-agent").CloudEventsTransport
+	commitStartOffsets(ctx, t, kafkaOptions.BootstrapServers, source, agent, otherAgent)
+
+	sourceEvents := startTransport(ctx, t, source)
+	agentEvents := startTransport(ctx, t, agent)
+	otherAgentEvents := startTransport(ctx, t, otherAgent)
+
+	// the spec is sent to the agent of its consumer only
+	spec := newEvent(t, cetypes.SubResourceSpec, "create_request", map[string]any{
+		cetypes.ExtensionClusterName: "cluster1",
+		cetypes.ExtensionResourceID:  "resource1",
+	})
+	if err := source.Send(ctx, spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitFor(t, func() bool { return len(agentEvents.list()) == 1 })
+	received := agentEvents.list()[0]
+	if received.ID() != spec.ID() || received.Type() != spec.Type() || string(received.Data()) != string(spec.Data()) {
+		t.Errorf("unexpected spec event: %v", received)
+	}
+	if resourceID, _ := received.Extensions()[cetypes.ExtensionResourceID].(string); resourceID != "resource1" {
+		t.Errorf("expected the resource id resource1 but got: %v", received.Extensions())
+	}
+
+	// the status resync request of all consumers is sent to all agents
+	resync := newEvent(t, cetypes.SubResourceStatus, cetypes.ResyncRequestAction, map[string]any{
+		cetypes.ExtensionClusterName: cetypes.ClusterAll,
+	})
+	if err := source.Send(ctx, resync); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitFor(t, func() bool { return len(agentEvents.list()) == 2 && len(otherAgentEvents.list()) == 1 })
+
+	// the status is sent to the source in order
+	for i := 0; i < 10; i++ {
+		status := newEvent(t, cetypes.SubResourceStatus, "update_request", map[string]any{
+			cetypes.ExtensionOriginalSource:  "maestro",
+			cetypes.ExtensionResourceVersion: fmt.Sprintf("%d", i),
+		})
+		if err := agent.Send(ctx, status); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	waitFor(t, func() bool { return len(sourceEvents.list()) == 10 })
+	for i, evt := range sourceEvents.list() {
+		if version := evt.Extensions()[cetypes.ExtensionResourceVersion]; version != fmt.Sprintf("%d", i) {
+			t.Errorf("expected the status %d but got: %v", i, version)
+		}
+	}
+
+	// the spec resync request of all sources is sent to the source
+	specResync := newEvent(t, cetypes.SubResourceSpec, cetypes.ResyncRequestAction, map[string]any{
+		cetypes.ExtensionOriginalSource: cetypes.SourceAll,
+	})
+	if err := agent.Send(ctx, specResync); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitFor(t, func() bool { return len(sourceEvents.list()) == 11 })
+
+	if err := source.Close(ctx); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := source.Send(ctx, spec); err == nil || err.Error() != "transport not connected" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFranzDialError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1)
+	defer cancel()
+
+	kafkaOptions := &KafkaOptions{BootstrapServers: []string{"127.0.0.1:1"}, TopicPrefix: "maestro"}
+	if err := NewAgentOptions(kafkaOptions, "cluster1", "cluster1-agent").CloudEventsTransport.Connect(ctx); err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
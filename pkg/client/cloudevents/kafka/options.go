@@ -0,0 +1,180 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"regexp"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"sigs.k8s.io/yaml"
+
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+)
+
+// defaultTopicPrefix is the prefix of the topics if it is not set in the config file.
+const defaultTopicPrefix = "maestro"
+
+// topicNameRegex matches the legal Kafka topic names.
+var topicNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
+// KafkaOptions holds the options that are used to build the Kafka transports.
+//
+// The topics of a Kafka cluster are laid out per source and per consumer:
+//   - <prefix>.consumers.<consumer>.spec: the resource specs that the sources send to the agent of a consumer
+//     and the status resync requests of the consumer.
+//   - <prefix>.sources.<source>.status: the resource status that the agents send to a source and the spec resync
+//     requests of the source, the records are keyed by the consumer name, so that the status of a consumer is
+//     kept in order.
+//   - <prefix>.sourcebroadcast: the status resync requests that the sources send to all agents.
+//   - <prefix>.agentbroadcast: the spec resync requests that the agents send to all sources.
+type KafkaOptions struct {
+	BootstrapServers []string
+	TopicPrefix      string
+	// TLSConfig is the TLS config to connect to the Kafka brokers, the connections are not encrypted if it is nil.
+	TLSConfig *tls.Config
+}
+
+// KafkaConfig holds the information needed to connect to a Kafka cluster.
+type KafkaConfig struct {
+	// BootstrapServers are the addresses of the Kafka brokers, e.g. kafka-0:9092.
+	BootstrapServers []string `json:"bootstrapServers"`
+
+	// (Optional) TopicPrefix is the prefix of the topics, it is "maestro" by default.
+	TopicPrefix string `json:"topicPrefix,omitempty"`
+
+	// (Optional) CAFile is the file of the CA certificates to verify the Kafka brokers, the connections to the
+	// brokers are encrypted with TLS if it is set.
+	CAFile string `json:"caFile,omitempty"`
+
+	// (Optional) ClientCertFile and ClientKeyFile are the files of the client certificate and its key to
+	// authenticate to the Kafka brokers, they require the CAFile.
+	ClientCertFile string `json:"clientCertFile,omitempty"`
+	ClientKeyFile  string `json:"clientKeyFile,omitempty"`
+}
+
+// BuildKafkaOptionsFromFlags builds the Kafka options from a config file path.
+func BuildKafkaOptionsFromFlags(configPath string) (*KafkaOptions, error) {
+	configData, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &KafkaConfig{}
+	if err := yaml.Unmarshal(configData, config); err != nil {
+		return nil, err
+	}
+
+	if len(config.BootstrapServers) == 0 {
+		return nil, fmt.Errorf("the bootstrap servers are required")
+	}
+	if config.TopicPrefix == "" {
+		config.TopicPrefix = defaultTopicPrefix
+	}
+	if !topicNameRegex.MatchString(config.TopicPrefix) {
+		return nil, fmt.Errorf("invalid topic prefix %q", config.TopicPrefix)
+	}
+
+	tlsConfig, err := buildTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KafkaOptions{
+		BootstrapServers: config.BootstrapServers,
+		TopicPrefix:      config.TopicPrefix,
+		TLSConfig:        tlsConfig,
+	}, nil
+}
+
+// buildTLSConfig builds the TLS config of the CA and the client certificate files, it returns nil if the CA
+// file is not set.
+func buildTLSConfig(config *KafkaConfig) (*tls.Config, error) {
+	if config.CAFile == "" {
+		if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+			return nil, fmt.Errorf("the ca file is required by the client certificate")
+		}
+		return nil, nil
+	}
+
+	caData, err := os.ReadFile(config.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caData) {
+		return nil, fmt.Errorf("no ca certificate is found in %s", config.CAFile)
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:    caPool,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertFile == "" || config.ClientKeyFile == "" {
+			return nil, fmt.Errorf("both the client certificate and key files are required")
+		}
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// NewSourceOptions creates the CloudEventsSourceOptions for Kafka. The instances of a source that share the
+// subscription join the same consumer group, so that the status of a consumer is handled by one instance;
+// otherwise each instance joins its own consumer group and receives the status of all consumers.
+func NewSourceOptions(kafkaOptions *KafkaOptions, clientID, sourceID string, shared bool) *options.CloudEventsSourceOptions {
+	groupID := fmt.Sprintf("%s.sources.%s", kafkaOptions.TopicPrefix, sourceID)
+	if !shared {
+		groupID = fmt.Sprintf("%s.%s", groupID, clientID)
+	}
+
+	return &options.CloudEventsSourceOptions{
+		CloudEventsTransport: newTransport(
+			kafkaOptions,
+			groupID,
+			func(evt cloudevents.Event) (string, string, error) {
+				return sourcePubTopic(kafkaOptions, sourceID, evt)
+			},
+			[]string{statusTopic(kafkaOptions, sourceID), agentBroadcastTopic(kafkaOptions)},
+		),
+		SourceID: sourceID,
+	}
+}
+
+// NewAgentOptions creates the CloudEventsAgentOptions for Kafka, the agent of a consumer joins the consumer
+// group of the consumer.
+func NewAgentOptions(kafkaOptions *KafkaOptions, clusterName, agentID string) *options.CloudEventsAgentOptions {
+	return &options.CloudEventsAgentOptions{
+		CloudEventsTransport: newTransport(
+			kafkaOptions,
+			fmt.Sprintf("%s.consumers.%s", kafkaOptions.TopicPrefix, clusterName),
+			func(evt cloudevents.Event) (string, string, error) {
+				return agentPubTopic(kafkaOptions, clusterName, evt)
+			},
+			[]string{specTopic(kafkaOptions, clusterName), sourceBroadcastTopic(kafkaOptions)},
+		),
+		AgentID:     agentID,
+		ClusterName: clusterName,
+	}
+}
+
+func specTopic(o *KafkaOptions, clusterName string) string {
+	return fmt.Sprintf("%s.consumers.%s.spec", o.TopicPrefix, clusterName)
+}
+
+func statusTopic(o *KafkaOptions, sourceID string) string {
+	return fmt.Sprintf("%s.sources.%s.status", o.TopicPrefix, sourceID)
+}
+
+func sourceBroadcastTopic(o *KafkaOptions) string {
+	return fmt.Sprintf("%s.sourcebroadcast", o.TopicPrefix)
+}
+
+func agentBroadcastTopic(o *KafkaOptions) string {
+	return fmt.Sprintf("%s.agentbroadcast", o.TopicPrefix)
+}
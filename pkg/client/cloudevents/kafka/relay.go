@@ -0,0 +1,201 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/klog/v2"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
+	grpcprotocol "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protocol"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+// Relay relays the cloudevents between the gRPC clients of an agent and a Kafka cluster, so that the clients
+// that do not support Kafka, e.g. the clients of the work agent, connect to the Kafka cluster with a local gRPC
+// connection. The events that the clients publish are sent with the Kafka transport of the agent, and the
+// events received from Kafka are sent to the clients that subscribe to their data types. The clients of an
+// agent share the consumer group of the agent through the relay.
+//
+// An event is held until a client of its data type subscribes, so the consumption of Kafka is blocked and the
+// offset of the event is not committed while no client can take it, e.g. before the work agent connects.
+type Relay struct {
+	pbv1.UnimplementedCloudEventServiceServer
+
+	transport options.CloudEventTransport
+
+	mu          sync.RWMutex
+	subscribers map[string]*relaySubscriber
+	// subscribed is closed and replaced when a client subscribes.
+	subscribed chan struct{}
+}
+
+type relaySubscriber struct {
+	dataType cetypes.CloudEventsDataType
+	events   chan *pbv1.CloudEvent
+	done     <-chan struct{}
+}
+
+var _ pbv1.CloudEventServiceServer = &Relay{}
+
+// NewRelay creates a relay for the agent of a consumer.
+func NewRelay(kafkaOptions *KafkaOptions, clusterName, agentID string) *Relay {
+	return &Relay{
+		transport:   NewAgentOptions(kafkaOptions, clusterName, agentID).CloudEventsTransport,
+		subscribers: map[string]*relaySubscriber{},
+		subscribed:  make(chan struct{}),
+	}
+}
+
+// Start connects to the Kafka cluster and serves the gRPC clients on the listener until the context is canceled.
+func (r *Relay) Start(ctx context.Context, listener net.Listener) error {
+	if err := r.transport.Connect(ctx); err != nil {
+		return err
+	}
+	if err := r.transport.Subscribe(ctx); err != nil {
+		_ = r.transport.Close(ctx)
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	server := grpc.NewServer()
+	pbv1.RegisterCloudEventServiceServer(server, r)
+	go func() {
+		logger.Info("serving the kafka relay", "address", listener.Addr().String())
+		if err := server.Serve(listener); err != nil {
+			logger.Error(err, "failed to serve the kafka relay")
+		}
+	}()
+
+	go func() {
+		if err := r.transport.Receive(ctx, r.relay); err != nil {
+			logger.Error(err, "failed to receive the events from kafka")
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		server.Stop()
+		if err := r.transport.Close(context.Background()); err != nil {
+			logger.Error(err, "failed to close the kafka transport")
+		}
+	}()
+	return nil
+}
+
+// Publish sends the event of a client to Kafka.
+func (r *Relay) Publish(ctx context.Context, pubReq *pbv1.PublishRequest) (*emptypb.Empty, error) {
+	evt, err := binding.ToEvent(ctx, grpcprotocol.NewMessage(pubReq.Event))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to convert protobuf to cloudevent: %v", err))
+	}
+	if err := r.transport.Send(ctx, *evt); err != nil {
+		return nil, status.Error(codes.Unavailable, fmt.Sprintf("failed to send the event to kafka: %v", err))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Subscribe sends the events of the subscribed data type to a client until the client closes the stream.
+func (r *Relay) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv1.CloudEventService_SubscribeServer) error {
+	dataType, err := cetypes.ParseCloudEventsDataType(subReq.DataType)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid data type %v", err))
+	}
+
+	ctx := subServer.Context()
+	id := uuid.NewString()
+	sub := &relaySubscriber{
+		dataType: *dataType,
+		events:   make(chan *pbv1.CloudEvent),
+		done:     ctx.Done(),
+	}
+	r.mu.Lock()
+	r.subscribers[id] = sub
+	close(r.subscribed)
+	r.subscribed = make(chan struct{})
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.subscribers, id)
+		r.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt := <-sub.events:
+			if err := subServer.Send(evt); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// relay sends an event received from Kafka to the subscribers of its data type, the next event is not received
+// until a subscriber takes this one.
+func (r *Relay) relay(ctx context.Context, evt cloudevents.Event) {
+	logger := klog.FromContext(ctx)
+	eventType, err := cetypes.ParseCloudEventsType(evt.Type())
+	if err != nil {
+		logger.Error(err, "invalid event type", "eventType", evt.Type())
+		return
+	}
+
+	pbEvt := &pbv1.CloudEvent{}
+	if err := grpcprotocol.WritePBMessage(ctx, binding.ToMessage(&evt), pbEvt); err != nil {
+		logger.Error(err, "failed to convert cloudevent to protobuf", "eventID", evt.ID())
+		return
+	}
+
+	for {
+		subscribers, subscribed := r.subscribersOf(eventType.CloudEventsDataType)
+		if len(subscribers) == 0 {
+			logger.V(4).Info("waiting for a subscriber of the event", "eventID", evt.ID(), "dataType", eventType.CloudEventsDataType)
+			select {
+			case <-subscribed:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		delivered := false
+		for _, sub := range subscribers {
+			select {
+			case sub.events <- pbEvt:
+				delivered = true
+			case <-sub.done:
+			case <-ctx.Done():
+				return
+			}
+		}
+		// the subscribers are gone before they take the event, it is held for the next subscriber
+		if delivered {
+			return
+		}
+	}
+}
+
+// subscribersOf returns the subscribers of the data type, and the channel that is closed when a client subscribes.
+func (r *Relay) subscribersOf(dataType cetypes.CloudEventsDataType) ([]*relaySubscriber, <-chan struct{}) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subscribers := []*relaySubscriber{}
+	for _, sub := range r.subscribers {
+		if sub.dataType == dataType {
+			subscribers = append(subscribers, sub)
+		}
+	}
+	return subscribers, r.subscribed
+}
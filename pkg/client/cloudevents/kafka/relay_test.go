@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kfake"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	grpcoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+func TestRelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kafkaOptions := &KafkaOptions{TopicPrefix: "maestro"}
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions,
+		specTopic(kafkaOptions, "cluster1"),
+		statusTopic(kafkaOptions, "maestro"),
+		sourceBroadcastTopic(kafkaOptions),
+		agentBroadcastTopic(kafkaOptions),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cluster.Close()
+	kafkaOptions.BootstrapServers = cluster.ListenAddrs()
+
+	source := NewSourceOptions(kafkaOptions, "maestro-0", "maestro", true).CloudEventsTransport
+	relay := NewRelay(kafkaOptions, "cluster1", "cluster1-agent")
+	commitStartOffsets(ctx, t, kafkaOptions.BootstrapServers, source, relay.transport)
+	sourceEvents := startTransport(ctx, t, source)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := relay.Start(ctx, listener); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the spec of the source that is sent before the agent subscribes is held by the relay
+	spec := newEvent(t, cetypes.SubResourceSpec, "create_request", map[string]any{
+		cetypes.ExtensionClusterName: "cluster1",
+		cetypes.ExtensionResourceID:  "resource1",
+	})
+	if err := source.Send(ctx, spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	// the agent connects to the relay with a gRPC client, and the held spec is relayed to it
+	grpcOptions := &grpcoptions.GRPCOptions{Dialer: &grpcoptions.GRPCDialer{URL: listener.Addr().String()}}
+	agent := grpcoptions.NewAgentOptions(grpcOptions, "cluster1", "cluster1-agent",
+		workpayload.ManifestBundleEventDataType).CloudEventsTransport
+	agentEvents := startTransport(ctx, t, agent)
+	waitFor(t, func() bool { return len(agentEvents.list()) == 1 })
+	received := agentEvents.list()[0]
+	if received.ID() != spec.ID() || received.Type() != spec.Type() || string(received.Data()) != string(spec.Data()) {
+		t.Errorf("unexpected spec event: %v", received)
+	}
+
+	// the status of the agent is relayed to the source
+	status := newEvent(t, cetypes.SubResourceStatus, "update_request", map[string]any{
+		cetypes.ExtensionClusterName:    "cluster1",
+		cetypes.ExtensionOriginalSource: "maestro",
+		cetypes.ExtensionResourceID:     "resource1",
+	})
+	if err := agent.Send(ctx, status); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	waitFor(t, func() bool { return len(sourceEvents.list()) == 1 })
+	if received := sourceEvents.list()[0]; received.ID() != status.ID() || received.Type() != status.Type() {
+		t.Errorf("unexpected status event: %v", received)
+	}
+}
@@ -0,0 +1,201 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/klog/v2"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+// pubTopicGetter returns the topic and the key of the record that an event is published with.
+type pubTopicGetter func(evt cloudevents.Event) (string, string, error)
+
+// kafkaTransport is a CloudEventTransport implementation for Kafka.
+type kafkaTransport struct {
+	opts *KafkaOptions
+	// groupID is the consumer group that the transport joins to receive the events.
+	groupID         string
+	getPublishTopic pubTopicGetter
+	subscribeTopics []string
+
+	mu         sync.RWMutex
+	broker     Broker
+	subscribed bool
+	errorChan  chan error
+}
+
+var _ options.CloudEventTransport = &kafkaTransport{}
+
+func newTransport(opts *KafkaOptions, groupID string, getPublishTopic pubTopicGetter, subscribeTopics []string) *kafkaTransport {
+	return &kafkaTransport{
+		opts:            opts,
+		groupID:         groupID,
+		getPublishTopic: getPublishTopic,
+		subscribeTopics: subscribeTopics,
+		errorChan:       make(chan error, 1),
+	}
+}
+
+func (t *kafkaTransport) Connect(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	broker, err := dialFranz(ctx, t.opts)
+	if err != nil {
+		return err
+	}
+	t.broker = broker
+
+	klog.FromContext(ctx).Info("kafka is connected", "bootstrapServers", t.opts.BootstrapServers)
+	return nil
+}
+
+func (t *kafkaTransport) Send(ctx context.Context, evt cloudevents.Event) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.broker == nil {
+		return fmt.Errorf("transport not connected")
+	}
+
+	topic, key, err := t.getPublishTopic(evt)
+	if err != nil {
+		return err
+	}
+	if !topicNameRegex.MatchString(topic) {
+		return fmt.Errorf("invalid kafka topic %q", topic)
+	}
+
+	msg, err := encode(topic, key, evt)
+	if err != nil {
+		return err
+	}
+	return t.broker.Produce(ctx, msg)
+}
+
+func (t *kafkaTransport) Subscribe(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.broker == nil {
+		return fmt.Errorf("transport not connected")
+	}
+	if t.subscribed {
+		return fmt.Errorf("transport has already subscribed")
+	}
+	for _, topic := range t.subscribeTopics {
+		if !topicNameRegex.MatchString(topic) {
+			return fmt.Errorf("invalid kafka topic %q", topic)
+		}
+	}
+
+	t.subscribed = true
+	klog.FromContext(ctx).Info("subscribed to kafka", "topics", t.subscribeTopics, "groupID", t.groupID)
+	return nil
+}
+
+// Receive consumes the subscribed topics with the consumer group of the transport until the context is
+// canceled or the transport is closed, the events of a partition are handled in order.
+func (t *kafkaTransport) Receive(ctx context.Context, fn options.ReceiveHandlerFn) error {
+	t.mu.RLock()
+	if !t.subscribed {
+		t.mu.RUnlock()
+		return fmt.Errorf("transport not subscribed")
+	}
+	broker := t.broker
+	t.mu.RUnlock()
+
+	logger := klog.FromContext(ctx)
+	err := broker.Consume(ctx, t.groupID, t.subscribeTopics, func(ctx context.Context, msg *Message) {
+		evt, err := decode(msg)
+		if err != nil {
+			// the invalid record is committed, since it cannot be handled by a redelivery
+			logger.Error(err, "invalid event", "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+			return
+		}
+		fn(ctx, evt)
+	})
+	if err != nil {
+		select {
+		case t.errorChan <- err:
+		default:
+			logger.Error(err, "kafka consumer error")
+		}
+	}
+	return err
+}
+
+func (t *kafkaTransport) Close(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	klog.FromContext(ctx).Info("close kafka transport")
+
+	if t.broker == nil {
+		return nil
+	}
+
+	t.subscribed = false
+	err := t.broker.Close()
+	t.broker = nil
+	return err
+}
+
+func (t *kafkaTransport) ErrorChan() <-chan error {
+	return t.errorChan
+}
+
+// sourcePubTopic returns the topic and the key of an event that a source publishes. The status resync
+// requests to all agents are published to the source broadcast topic, the other events are published to the
+// spec topic of their consumers.
+func sourcePubTopic(o *KafkaOptions, sourceID string, evt cloudevents.Event) (string, string, error) {
+	eventType, err := cetypes.ParseCloudEventsType(evt.Type())
+	if err != nil {
+		return "", "", fmt.Errorf("unsupported event type %q, %v", evt.Type(), err)
+	}
+
+	clusterName, err := stringExtension(evt, cetypes.ExtensionClusterName)
+	if err != nil {
+		return "", "", err
+	}
+
+	if eventType.Action == cetypes.ResyncRequestAction && clusterName == cetypes.ClusterAll {
+		return sourceBroadcastTopic(o), sourceID, nil
+	}
+	return specTopic(o, clusterName), clusterName, nil
+}
+
+// agentPubTopic returns the topic and the key of an event that an agent publishes. The spec resync requests
+// to all sources are published to the agent broadcast topic, the other events are published to the status
+// topic of their sources. The events are keyed by the consumer name.
+func agentPubTopic(o *KafkaOptions, clusterName string, evt cloudevents.Event) (string, string, error) {
+	eventType, err := cetypes.ParseCloudEventsType(evt.Type())
+	if err != nil {
+		return "", "", fmt.Errorf("unsupported event type %q, %v", evt.Type(), err)
+	}
+
+	originalSource, err := stringExtension(evt, cetypes.ExtensionOriginalSource)
+	if err != nil {
+		return "", "", err
+	}
+
+	if eventType.Action == cetypes.ResyncRequestAction && originalSource == cetypes.SourceAll {
+		return agentBroadcastTopic(o), clusterName, nil
+	}
+	return statusTopic(o, originalSource), clusterName, nil
+}
+
+func stringExtension(evt cloudevents.Event, name string) (string, error) {
+	value, err := evt.Context.GetExtension(name)
+	if err != nil {
+		return "", err
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s extension must be a string, got %T", name, value)
+	}
+	return str, nil
+}
@@ -0,0 +1,292 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/twmb/franz-go/pkg/kfake"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+func newEvent(t *testing.T, subResource cetypes.EventSubResource, action cetypes.EventAction, extensions map[string]any) cloudevents.Event {
+	eventType := cetypes.CloudEventsType{
+		CloudEventsDataType: workpayload.ManifestBundleEventDataType,
+		SubResource:         subResource,
+		Action:              action,
+	}
+	evt := cloudevents.NewEvent()
+	evt.SetID(fmt.Sprintf("%d", time.Now().UnixNano()))
+	evt.SetSource("test")
+	evt.SetType(eventType.String())
+	for name, value := range extensions {
+		evt.SetExtension(name, value)
+	}
+	if err := evt.SetData(cloudevents.ApplicationJSON, map[string]string{"key": "value"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return evt
+}
+
+// receivedEvents collects the events that a transport receives.
+type receivedEvents struct {
+	mu     sync.Mutex
+	events []cloudevents.Event
+}
+
+func (r *receivedEvents) handle(_ context.Context, evt cloudevents.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, evt)
+}
+
+func (r *receivedEvents) list() []cloudevents.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]cloudevents.Event{}, r.events...)
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSubscriptionType(t *testing.T) {
+	cases := []struct {
+		name   string
+		shared bool
+	}{
+		{
+			name:   "shared subscription",
+			shared: true,
+		},
+		{
+			name:   "broadcast subscription",
+			shared: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			kafkaOptions := &KafkaOptions{TopicPrefix: "maestro"}
+			cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions,
+				statusTopic(kafkaOptions, "maestro"),
+				agentBroadcastTopic(kafkaOptions),
+			))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer cluster.Close()
+			kafkaOptions.BootstrapServers = cluster.ListenAddrs()
+
+			sources := []options.CloudEventTransport{}
+			for i := 0; i < 2; i++ {
+				sources = append(sources, NewSourceOptions(kafkaOptions, fmt.Sprintf("maestro-%d", i), "maestro", c.shared).CloudEventsTransport)
+			}
+			instances := []*receivedEvents{}
+			for _, source := range sources {
+				instances = append(instances, startTransport(ctx, t, source))
+			}
+			// the status is sent once the partitions are assigned to the instances
+			for _, source := range sources {
+				groupID := source.(*kafkaTransport).groupID
+				members := 1
+				if c.shared {
+					members = len(sources)
+				}
+				waitFor(t, func() bool { return groupMembers(ctx, t, kafkaOptions.BootstrapServers, groupID) == members })
+			}
+
+			consumers := []string{"cluster1", "cluster2", "cluster3", "cluster4", "cluster5", "cluster6"}
+			for _, consumer := range consumers {
+				agent := NewAgentOptions(kafkaOptions, consumer, consumer+"-agent").CloudEventsTransport
+				if err := agent.Connect(ctx); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				for i := 0; i < 5; i++ {
+					status := newEvent(t, cetypes.SubResourceStatus, "update_request", map[string]any{
+						cetypes.ExtensionOriginalSource:  "maestro",
+						cetypes.ExtensionClusterName:     consumer,
+						cetypes.ExtensionResourceVersion: fmt.Sprintf("%d", i),
+					})
+					if err := agent.Send(ctx, status); err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+				}
+				if err := agent.Close(ctx); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			expected := len(consumers) * 5
+			if !c.shared {
+				expected = expected * len(instances)
+			}
+			waitFor(t, func() bool {
+				total := 0
+				for _, instance := range instances {
+					total += len(instance.list())
+				}
+				return total == expected
+			})
+
+			for _, instance := range instances {
+				versions := map[string][]string{}
+				for _, evt := range instance.list() {
+					consumer := evt.Extensions()[cetypes.ExtensionClusterName].(string)
+					versions[consumer] = append(versions[consumer], evt.Extensions()[cetypes.ExtensionResourceVersion].(string))
+				}
+				for consumer, received := range versions {
+					// the status of a consumer is received by one instance in order
+					if strings.Join(received, ",") != "0,1,2,3,4" {
+						t.Errorf("unexpected status of the consumer %s: %v", consumer, received)
+					}
+				}
+				if !c.shared && len(versions) != len(consumers) {
+					t.Errorf("expected the status of all consumers but got: %v", versions)
+				}
+			}
+		})
+	}
+}
+
+func TestConsumerGroupRebalance(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions, "status"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cluster.Close()
+	kafkaOptions := &KafkaOptions{BootstrapServers: cluster.ListenAddrs(), TopicPrefix: "maestro"}
+	broker, err := dialFranz(ctx, kafkaOptions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer broker.Close()
+
+	produce := func(key string, count int) {
+		for i := 0; i < count; i++ {
+			if err := broker.Produce(ctx, &Message{Topic: "status", Key: key, Value: []byte(key)}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+
+	// the group that has no committed offsets starts from the latest offsets
+	produce("cluster1", 3)
+	var mu sync.Mutex
+	consumed := map[string]int{}
+	consume := func(ctx context.Context) {
+		_ = broker.Consume(ctx, "group", []string{"status"}, func(_ context.Context, msg *Message) {
+			mu.Lock()
+			defer mu.Unlock()
+			consumed[string(msg.Value)]++
+		})
+	}
+	firstCtx, stopFirst := context.WithCancel(ctx)
+	go consume(firstCtx)
+	go consume(ctx)
+	waitFor(t, func() bool { return groupMembers(ctx, t, kafkaOptions.BootstrapServers, "group") == 2 })
+
+	produce("cluster1", 2)
+	produce("cluster2", 2)
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return consumed["cluster1"] == 2 && consumed["cluster2"] == 2
+	})
+
+	// the partitions of a member that leaves are assigned to the other members from the committed offsets
+	stopFirst()
+	waitFor(t, func() bool { return groupMembers(ctx, t, kafkaOptions.BootstrapServers, "group") == 1 })
+	produce("cluster1", 1)
+	produce("cluster2", 1)
+	waitFor(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return consumed["cluster1"] == 3 && consumed["cluster2"] == 3
+	})
+}
+
+func TestBuildKafkaOptionsFromFlags(t *testing.T) {
+	cases := []struct {
+		name             string
+		config           string
+		expectedOptions  *KafkaOptions
+		expectedErrorMsg string
+	}{
+		{
+			name:   "default topic prefix",
+			config: "bootstrapServers:\n- kafka-0:9092\n- kafka-1:9092\n",
+			expectedOptions: &KafkaOptions{
+				BootstrapServers: []string{"kafka-0:9092", "kafka-1:9092"},
+				TopicPrefix:      "maestro",
+			},
+		},
+		{
+			name:   "custom topic prefix",
+			config: `{"bootstrapServers":["kafka-0:9092"],"topicPrefix":"maestro-prod"}`,
+			expectedOptions: &KafkaOptions{
+				BootstrapServers: []string{"kafka-0:9092"},
+				TopicPrefix:      "maestro-prod",
+			},
+		},
+		{
+			name:             "no bootstrap servers",
+			config:           `{"topicPrefix":"maestro"}`,
+			expectedErrorMsg: "the bootstrap servers are required",
+		},
+		{
+			name:             "invalid topic prefix",
+			config:           `{"bootstrapServers":["kafka-0:9092"],"topicPrefix":"maestro/prod"}`,
+			expectedErrorMsg: "invalid topic prefix \"maestro/prod\"",
+		},
+		{
+			name:             "client certificate without ca",
+			config:           `{"bootstrapServers":["kafka-0:9092"],"clientCertFile":"client.crt","clientKeyFile":"client.key"}`,
+			expectedErrorMsg: "the ca file is required by the client certificate",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "kafka.config")
+			if err := os.WriteFile(configPath, []byte(c.config), 0600); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			kafkaOptions, err := BuildKafkaOptionsFromFlags(configPath)
+			if c.expectedErrorMsg != "" {
+				if err == nil || err.Error() != c.expectedErrorMsg {
+					t.Errorf("expected error %q but got: %v", c.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(kafkaOptions.BootstrapServers, ",") != strings.Join(c.expectedOptions.BootstrapServers, ",") ||
+				kafkaOptions.TopicPrefix != c.expectedOptions.TopicPrefix {
+				t.Errorf("expected options %v but got: %v", c.expectedOptions, kafkaOptions)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package cloudevents
+
+import (
+	"context"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	ceoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+)
+
+// sharedTransport shares the transport of the source client of the manifest bundles with the source clients of
+// the other data types, so that the events of all the resource types are sent and received with one connection
+// and one subscription. The source client of the manifest bundles connects, subscribes and reconnects the
+// transport, and the events it receives are dispatched to the source clients of their data types.
+type sharedTransport struct {
+	ceoptions.CloudEventTransport
+
+	mu         sync.RWMutex
+	transports map[cetypes.CloudEventsDataType]*dataTypeTransport
+}
+
+func newSharedTransport(transport ceoptions.CloudEventTransport) *sharedTransport {
+	return &sharedTransport{
+		CloudEventTransport: transport,
+		transports:          map[cetypes.CloudEventsDataType]*dataTypeTransport{},
+	}
+}
+
+// Receive receives the events of the transport, the events of the data types that have their own source clients
+// are handled by them once they start receiving, and the other events are handled by fn.
+func (t *sharedTransport) Receive(ctx context.Context, fn ceoptions.ReceiveHandlerFn) error {
+	return t.CloudEventTransport.Receive(ctx, func(ctx context.Context, evt cloudevents.Event) {
+		if eventType, err := cetypes.ParseCloudEventsType(evt.Type()); err == nil {
+			t.mu.RLock()
+			transport, ok := t.transports[eventType.CloudEventsDataType]
+			t.mu.RUnlock()
+			if ok {
+				transport.handle(ctx, evt)
+				return
+			}
+		}
+		fn(ctx, evt)
+	})
+}
+
+// forDataType returns the transport of the source client of a data type, it sends the events with the shared
+// transport and receives the events of the data type from it.
+func (t *sharedTransport) forDataType(dataType cetypes.CloudEventsDataType) ceoptions.CloudEventTransport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	transport := &dataTypeTransport{
+		shared:    t,
+		receiving: make(chan struct{}),
+		errorChan: make(chan error),
+	}
+	t.transports[dataType] = transport
+	return transport
+}
+
+// dataTypeTransport is the transport of the source client of a data type on a shared transport. The connection
+// of the shared transport is not managed by it, so it never reports an error.
+type dataTypeTransport struct {
+	shared    *sharedTransport
+	errorChan chan error
+
+	mu        sync.RWMutex
+	receive   ceoptions.ReceiveHandlerFn
+	receiving chan struct{}
+	once      sync.Once
+}
+
+var _ ceoptions.CloudEventTransport = &dataTypeTransport{}
+
+func (t *dataTypeTransport) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (t *dataTypeTransport) Send(ctx context.Context, evt cloudevents.Event) error {
+	return t.shared.Send(ctx, evt)
+}
+
+func (t *dataTypeTransport) Subscribe(ctx context.Context) error {
+	return nil
+}
+
+// Receive handles the events of the data type that the shared transport receives with fn until the context is
+// canceled.
+func (t *dataTypeTransport) Receive(ctx context.Context, fn ceoptions.ReceiveHandlerFn) error {
+	t.mu.Lock()
+	t.receive = fn
+	t.mu.Unlock()
+	t.once.Do(func() { close(t.receiving) })
+
+	<-ctx.Done()
+	return nil
+}
+
+func (t *dataTypeTransport) Close(ctx context.Context) error {
+	return nil
+}
+
+func (t *dataTypeTransport) ErrorChan() <-chan error {
+	return t.errorChan
+}
+
+// handle hands an event of the shared transport to the source client of the data type, it waits until the source
+// client starts receiving, so that the events received before are not lost.
+func (t *dataTypeTransport) handle(ctx context.Context, evt cloudevents.Event) {
+	select {
+	case <-t.receiving:
+	case <-ctx.Done():
+		return
+	}
+
+	t.mu.RLock()
+	receive := t.receive
+	t.mu.RUnlock()
+	receive(ctx, evt)
+}
@@ -2,19 +2,15 @@ package cloudevents
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/json"
 	"fmt"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/klog/v2"
-	workv1 "open-cluster-management.io/api/work/v1"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 	cegeneric "open-cluster-management.io/sdk-go/pkg/cloudevents/generic"
 	ceclients "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/clients"
 	cemetrics "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/metrics"
 	ceoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
-	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 
 	"github.com/openshift-online/maestro/pkg/api"
@@ -32,28 +28,49 @@ type SourceClient interface {
 	SubscribedChan() <-chan struct{}
 }
 
+// SourceClientImpl publishes and receives the resources of every registered resource type with a source client
+// of its CloudEvents data type, the source clients share the transport of the source client of the manifest
+// bundles.
 type SourceClientImpl struct {
-	Codec                  cegeneric.Codec[*api.Resource]
-	CloudEventSourceClient *ceclients.CloudEventSourceClient[*api.Resource]
-	ResourceService        services.ResourceService
+	CloudEventSourceClients map[cetypes.CloudEventsDataType]*ceclients.CloudEventSourceClient[*api.Resource]
+	ResourceService         services.ResourceService
 }
 
+// NewSourceClient creates the source clients of the registered resource types, the resource types must be
+// registered before.
 func NewSourceClient(sourceOptions *ceoptions.CloudEventsSourceOptions, resourceService services.ResourceService) (SourceClient, error) {
 	ctx := context.Background()
-	codec := NewCodec(sourceOptions.SourceID)
-	ceSourceClient, err := ceclients.NewCloudEventSourceClient[*api.Resource](ctx, sourceOptions,
-		resourceService, ResourceStatusHashGetter, codec)
-	if err != nil {
-		return nil, err
+	transport := newSharedTransport(sourceOptions.CloudEventsTransport)
+	ceSourceClients := map[cetypes.CloudEventsDataType]*ceclients.CloudEventSourceClient[*api.Resource]{}
+	for _, resourceType := range api.ResourceTypes() {
+		typeCodec, err := api.ResourceTypeCodecFor(resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		dataType := typeCodec.EventDataType()
+		options := &ceoptions.CloudEventsSourceOptions{
+			SourceID:             sourceOptions.SourceID,
+			EventRateLimit:       sourceOptions.EventRateLimit,
+			CloudEventsTransport: transport,
+		}
+		if dataType != workpayload.ManifestBundleEventDataType {
+			options.CloudEventsTransport = transport.forDataType(dataType)
+		}
+		ceSourceClient, err := ceclients.NewCloudEventSourceClient[*api.Resource](ctx, options,
+			resourceService, ResourceStatusHashGetter, NewCodecForDataType(sourceOptions.SourceID, dataType))
+		if err != nil {
+			return nil, err
+		}
+		ceSourceClients[dataType] = ceSourceClient
 	}
 
 	// register resource resync metrics for cloud event source client
 	cemetrics.RegisterSourceCloudEventsMetrics(prometheus.DefaultRegisterer)
 
 	return &SourceClientImpl{
-		Codec:                  codec,
-		CloudEventSourceClient: ceSourceClient,
-		ResourceService:        resourceService,
+		CloudEventSourceClients: ceSourceClients,
+		ResourceService:         resourceService,
 	}, nil
 }
 
@@ -77,12 +94,7 @@ func (s *SourceClientImpl) OnCreate(ctx context.Context, id string) error {
 	}
 
 	logger.Info("Publishing resource for db row insert")
-	eventType := cetypes.CloudEventsType{
-		CloudEventsDataType: s.Codec.EventDataType(),
-		SubResource:         cetypes.SubResourceSpec,
-		Action:              cetypes.EventAction("create_request"),
-	}
-	if err := s.CloudEventSourceClient.Publish(ctx, eventType, resource); err != nil {
+	if err := s.publish(ctx, cetypes.EventAction("create_request"), resource); err != nil {
 		logger.Error(err, "Failed to publish resource")
 		return err
 	}
@@ -104,12 +116,7 @@ func (s *SourceClientImpl) OnUpdate(ctx context.Context, id string) error {
 	}
 
 	logger.Info("Publishing resource for db row update")
-	eventType := cetypes.CloudEventsType{
-		CloudEventsDataType: s.Codec.EventDataType(),
-		SubResource:         cetypes.SubResourceSpec,
-		Action:              cetypes.EventAction("update_request"),
-	}
-	if err := s.CloudEventSourceClient.Publish(ctx, eventType, resource); err != nil {
+	if err := s.publish(ctx, cetypes.EventAction("update_request"), resource); err != nil {
 		logger.Error(err, "Failed to publish resource")
 		return err
 	}
@@ -135,12 +142,7 @@ func (s *SourceClientImpl) OnDelete(ctx context.Context, id string) error {
 		return fmt.Errorf("resource %s has not been marked as deleting", resource.ID)
 	}
 	logger.Info("Publishing resource for db row delete")
-	eventType := cetypes.CloudEventsType{
-		CloudEventsDataType: s.Codec.EventDataType(),
-		SubResource:         cetypes.SubResourceSpec,
-		Action:              cetypes.EventAction("delete_request"),
-	}
-	if err := s.CloudEventSourceClient.Publish(ctx, eventType, resource); err != nil {
+	if err := s.publish(ctx, cetypes.EventAction("delete_request"), resource); err != nil {
 		logger.Error(err, "Failed to publish resource")
 		return err
 	}
@@ -148,64 +150,69 @@ func (s *SourceClientImpl) OnDelete(ctx context.Context, id string) error {
 	return nil
 }
 
+// Subscribe receives the status of the resources of all the resource types. The source clients of the other data
+// types start receiving before the source client of the manifest bundles, which receives the events of the
+// shared transport.
 func (s *SourceClientImpl) Subscribe(ctx context.Context, handlers ...cegeneric.ResourceHandler[*api.Resource]) {
-	s.CloudEventSourceClient.Subscribe(ctx, handlers...)
+	for dataType, ceSourceClient := range s.CloudEventSourceClients {
+		if dataType != workpayload.ManifestBundleEventDataType {
+			ceSourceClient.Subscribe(ctx, handlers...)
+		}
+	}
+	if ceSourceClient, ok := s.CloudEventSourceClients[workpayload.ManifestBundleEventDataType]; ok {
+		ceSourceClient.Subscribe(ctx, handlers...)
+	}
 }
 
+// Resync requests the status of the resources of all the resource types from the consumers.
 func (s *SourceClientImpl) Resync(ctx context.Context, consumers []string) error {
 	logger := klog.FromContext(ctx).WithValues("consumers", consumers)
 	ctx = klog.NewContext(ctx, logger)
 
 	logger.Info("Resyncing resource status from consumers")
 	for _, consumer := range consumers {
-		if err := s.CloudEventSourceClient.Resync(ctx, consumer); err != nil {
-			return err
+		for _, ceSourceClient := range s.CloudEventSourceClients {
+			if err := ceSourceClient.Resync(ctx, consumer); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// SubscribedChan returns the channel of the source client of the manifest bundles, it signals when the shared
+// transport is subscribed.
 func (s *SourceClientImpl) SubscribedChan() <-chan struct{} {
-	return s.CloudEventSourceClient.SubscribedChan()
+	return s.CloudEventSourceClients[workpayload.ManifestBundleEventDataType].SubscribedChan()
 }
 
-// ResourceStatusHashGetter returns a hash of the resource status.
-// It calculates the hash based on the manifestwork status to ensure consistency
-// with the agent's status calculation. The resource status is converted to
-// manifestwork status based on resource type before calculating the hash.
-func ResourceStatusHashGetter(res *api.Resource) (string, error) {
-	if len(res.Status) == 0 {
-		return fmt.Sprintf("%x", sha256.Sum256([]byte(""))), nil
-	}
-	evt, err := api.JSONMAPToCloudEvent(res.Status)
+// publish publishes the spec of a resource with the source client of the data type of its resource type.
+func (s *SourceClientImpl) publish(ctx context.Context, action cetypes.EventAction, resource *api.Resource) error {
+	typeCodec, err := api.ResourceTypeCodecFor(resource.Type)
 	if err != nil {
-		return "", fmt.Errorf("failed to convert resource status to cloud event, %v", err)
+		return err
 	}
-
-	// retrieve stash hash from status CloudEvent extension;
-	// if not found, calculate the status hash by itself
-	evtExtensions := evt.Context.GetExtensions()
-	statusHashVal, ok := evtExtensions[types.ExtensionStatusHash]
-	if ok {
-		return fmt.Sprintf("%v", statusHashVal), nil
+	ceSourceClient, ok := s.CloudEventSourceClients[typeCodec.EventDataType()]
+	if !ok {
+		return fmt.Errorf("the resource type %s is registered after the source client is created", resource.GetResourceType())
 	}
 
-	// calculate the status hash by itself
-	eventPayload := &workpayload.ManifestBundleStatus{}
-	if err := evt.DataAs(eventPayload); err != nil {
-		return "", fmt.Errorf("failed to decode cloudevent data as manifest bundle status: %v", err)
-	}
-	workStatus := workv1.ManifestWorkStatus{
-		Conditions: eventPayload.Conditions,
-		ResourceStatus: workv1.ManifestResourceStatus{
-			Manifests: eventPayload.ResourceStatus,
-		},
+	eventType := cetypes.CloudEventsType{
+		CloudEventsDataType: typeCodec.EventDataType(),
+		SubResource:         cetypes.SubResourceSpec,
+		Action:              action,
 	}
-	workStatusBytes, err := json.Marshal(workStatus)
+	return ceSourceClient.Publish(ctx, eventType, resource)
+}
+
+// ResourceStatusHashGetter returns a hash of the resource status.
+// It calculates the hash with the codec of the resource type to ensure consistency
+// with the agent's status calculation.
+func ResourceStatusHashGetter(res *api.Resource) (string, error) {
+	codec, err := api.ResourceTypeCodecFor(res.Type)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal work status, %v", err)
+		return "", err
 	}
-
-	return fmt.Sprintf("%x", sha256.Sum256(workStatusBytes)), nil
+	return codec.StatusHash(res.Status)
 }
@@ -0,0 +1,194 @@
+package cloudevents
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	ceoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+var configMapDataType = cetypes.CloudEventsDataType{
+	Group:    "maestro.io",
+	Version:  "v1",
+	Resource: "configmaps",
+}
+
+// fakeTransport is a message queue transport that records the sent events and delivers the queued events to its
+// receiver.
+type fakeTransport struct {
+	mu       sync.Mutex
+	sent     []cloudevents.Event
+	incoming chan cloudevents.Event
+}
+
+var _ ceoptions.CloudEventTransport = &fakeTransport{}
+
+func (t *fakeTransport) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (t *fakeTransport) Send(ctx context.Context, evt cloudevents.Event) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sent = append(t.sent, evt)
+	return nil
+}
+
+func (t *fakeTransport) Subscribe(ctx context.Context) error {
+	return nil
+}
+
+func (t *fakeTransport) Receive(ctx context.Context, fn ceoptions.ReceiveHandlerFn) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt := <-t.incoming:
+			fn(ctx, evt)
+		}
+	}
+}
+
+func (t *fakeTransport) Close(ctx context.Context) error {
+	return nil
+}
+
+func (t *fakeTransport) ErrorChan() <-chan error {
+	return nil
+}
+
+func (t *fakeTransport) sentTypes() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	types := []string{}
+	for _, evt := range t.sent {
+		types = append(types, evt.Type())
+	}
+	return types
+}
+
+func newStatusEvent(t *testing.T, dataType cetypes.CloudEventsDataType, resourceID string) cloudevents.Event {
+	eventType := cetypes.CloudEventsType{
+		CloudEventsDataType: dataType,
+		SubResource:         cetypes.SubResourceStatus,
+		Action:              cetypes.UpdateRequestAction,
+	}
+	evt := cetypes.NewEventBuilder("cluster1-agent", eventType).
+		WithResourceID(resourceID).
+		WithResourceVersion(1).
+		WithClusterName("cluster1").
+		WithOriginalSource("maestro").
+		NewEvent()
+	if err := evt.SetData(cloudevents.ApplicationJSON, map[string]any{"conditions": []any{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return evt
+}
+
+func TestSourceClientResourceTypes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the resource type is registered once for the repeated runs of the test
+	if _, err := api.ResourceTypeOf(configMapDataType); err != nil {
+		if err := api.RegisterResourceType("ConfigMap", api.NewGenericResourceTypeCodec(configMapDataType, nil)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	resourceDao := mocks.NewResourceDao()
+	resourceService := services.NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDao,
+		mocks.NewResourceRevisionDao(), services.NewEventService(mocks.NewEventDao()), nil, nil)
+	transport := &fakeTransport{incoming: make(chan cloudevents.Event)}
+	sourceClient, err := NewSourceClient(&ceoptions.CloudEventsSourceOptions{
+		SourceID:             "maestro",
+		CloudEventsTransport: transport,
+	}, resourceService)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	specEvt := cloudevents.NewEvent()
+	specEvt.SetID("1")
+	specEvt.SetSource("maestro")
+	specEvt.SetType(configMapDataType.String())
+	if err := specEvt.SetData(cloudevents.ApplicationJSON, map[string]any{"data": map[string]string{"key": "value"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	payload, err := api.CloudEventToJSONMap(&specEvt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := resourceDao.Create(ctx, &api.Resource{
+		Meta:         api.Meta{ID: "configmap1"},
+		Version:      1,
+		Source:       "maestro",
+		ConsumerName: "cluster1",
+		Type:         "ConfigMap",
+		Payload:      payload,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the resource is published with the data type of its resource type
+	if err := sourceClient.OnCreate(ctx, "configmap1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if types := transport.sentTypes(); len(types) != 1 || types[0] != configMapDataType.String()+".spec.create_request" {
+		t.Errorf("unexpected sent events: %v", types)
+	}
+
+	// the status of every resource type is received
+	received := make(chan *api.Resource, 2)
+	sourceClient.Subscribe(ctx, func(ctx context.Context, resource *api.Resource) error {
+		received <- resource
+		return nil
+	})
+	for _, evt := range []cloudevents.Event{
+		newStatusEvent(t, configMapDataType, "configmap1"),
+		newStatusEvent(t, workpayload.ManifestBundleEventDataType, "bundle1"),
+	} {
+		select {
+		case transport.incoming <- evt:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("the status event %s is not received", evt.Type())
+		}
+	}
+	for _, expected := range []api.Resource{
+		{Meta: api.Meta{ID: "configmap1"}, Type: "ConfigMap"},
+		{Meta: api.Meta{ID: "bundle1"}, Type: api.ManifestBundleResourceType},
+	} {
+		select {
+		case resource := <-received:
+			if resource.ID != expected.ID || resource.Type != expected.Type {
+				t.Errorf("expected the status of %s %s but got: %s %s", expected.Type, expected.ID, resource.Type, resource.ID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("the status of %s is not handled", expected.ID)
+		}
+	}
+
+	// the status of every resource type is resynced
+	if err := sourceClient.Resync(ctx, []string{"cluster1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resyncs := map[string]bool{}
+	for _, eventType := range transport.sentTypes()[1:] {
+		resyncs[eventType] = true
+	}
+	for _, dataType := range []cetypes.CloudEventsDataType{configMapDataType, workpayload.ManifestBundleEventDataType} {
+		if !resyncs[dataType.String()+".status.resync_request"] {
+			t.Errorf("expected the status resync request of %s but got: %v", dataType, resyncs)
+		}
+	}
+}
@@ -2,6 +2,29 @@ package grpcauthorizer
 
 import "context"
 
+// Actions and resource types understood by the authorizers.
+const (
+	// PubAction and SubAction are used by the gRPC server on the source resource type.
+	PubAction = "pub"
+	SubAction = "sub"
+
+	// ListAction, GetAction, CreateAction, UpdateAction and DeleteAction are used by the REST API
+	// on the consumer, consumer set, resource bundle, placement, operation and quota resource types.
+	ListAction   = "list"
+	GetAction    = "get"
+	CreateAction = "create"
+	UpdateAction = "update"
+	DeleteAction = "delete"
+
+	SourceResourceType         = "source"
+	ConsumerResourceType       = "consumer"
+	ConsumerSetResourceType    = "consumerset"
+	ResourceBundleResourceType = "resourcebundle"
+	PlacementResourceType      = "placement"
+	OperationResourceType      = "operation"
+	QuotaResourceType          = "quota"
+)
+
 // GRPCAuthorizer defines an interface for performing access reviews in a gRPC-based authorization.
 type GRPCAuthorizer interface {
 	// TokenReview validates the given token and returns the user and groups associated with it.
@@ -19,9 +42,10 @@ type GRPCAuthorizer interface {
 	//
 	// Parameters:
 	// - ctx: The context for managing request lifecycle.
-	// - action: The action being requested, e.g., "pub" (publish) or "sub" (subscribe).
-	// - resourceType: The type of resource, e.g., "source" or "cluster".
-	// - resource: The specific resource name within the given resource type.
+	// - action: The action being requested, e.g., "pub" (publish) or "sub" (subscribe) for a source,
+	//   "list", "get", "create", "update" or "delete" for a consumer or resource bundle.
+	// - resourceType: The type of resource, e.g., "source", "consumer" or "resourcebundle".
+	// - resource: The specific resource name within the given resource type (may be empty for "list" and "create").
 	// - user: The user requesting the action (may be empty if groups are used).
 	// - groups: The groups requesting the action (may be empty if user is used).
 	//
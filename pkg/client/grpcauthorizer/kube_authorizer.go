@@ -57,20 +57,9 @@ func (k *KubeGRPCAuthorizer) AccessReview(ctx context.Context, action, resourceT
 		return false, fmt.Errorf("groups must be set when user is specified")
 	}
 
-	if action != "pub" && action != "sub" {
-		return false, fmt.Errorf("unsupported action: %s", action)
-	}
-
-	if resource == "" {
-		return false, fmt.Errorf("resource cannot be empty")
-	}
-
-	nonResourceUrl := ""
-	switch resourceType {
-	case "source":
-		nonResourceUrl = fmt.Sprintf("/sources/%s", resource)
-	default:
-		return false, fmt.Errorf("unsupported resource type: %s", resourceType)
+	nonResourceUrl, err := nonResourceURL(action, resourceType, resource)
+	if err != nil {
+		return false, err
 	}
 
 	sar, err := k.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
@@ -90,3 +79,54 @@ func (k *KubeGRPCAuthorizer) AccessReview(ctx context.Context, action, resourceT
 
 	return sar.Status.Allowed, nil
 }
+
+// nonResourceURL maps the action on the given resource to the non-resource URL that is checked
+// by the SubjectAccessReview.
+//
+// The "source" resource type is used by the gRPC server with the "pub" and "sub" actions, the
+// "consumer", "consumerset", "resourcebundle", "placement", "operation" and "quota" resource types are used by the REST API with
+// the "list", "get", "create", "update" and "delete" actions. The resource may be empty for the "list"
+// and "create" actions, which are not bound to a specific resource, and for the "delete" action on
+// the collection, e.g. the deletion of the resource bundles that match a search.
+func nonResourceURL(action, resourceType, resource string) (string, error) {
+	switch resourceType {
+	case SourceResourceType:
+		if action != PubAction && action != SubAction {
+			return "", fmt.Errorf("unsupported action: %s", action)
+		}
+		if resource == "" {
+			return "", fmt.Errorf("resource cannot be empty")
+		}
+		return fmt.Sprintf("/sources/%s", resource), nil
+	case ConsumerResourceType, ConsumerSetResourceType, ResourceBundleResourceType, PlacementResourceType,
+		OperationResourceType, QuotaResourceType:
+		path := "/consumers"
+		switch resourceType {
+		case ConsumerSetResourceType:
+			path = "/consumer-sets"
+		case ResourceBundleResourceType:
+			path = "/resource-bundles"
+		case PlacementResourceType:
+			path = "/placements"
+		case OperationResourceType:
+			path = "/operations"
+		case QuotaResourceType:
+			path = "/quotas"
+		}
+		switch action {
+		case ListAction, CreateAction, DeleteAction:
+			if resource == "" {
+				return path, nil
+			}
+		case GetAction, UpdateAction:
+			if resource == "" {
+				return "", fmt.Errorf("resource cannot be empty")
+			}
+		default:
+			return "", fmt.Errorf("unsupported action: %s", action)
+		}
+		return fmt.Sprintf("%s/%s", path, resource), nil
+	default:
+		return "", fmt.Errorf("unsupported resource type: %s", resourceType)
+	}
+}
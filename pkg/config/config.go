@@ -20,6 +20,20 @@ type ApplicationConfig struct {
 	EventServer   *EventServerConfig   `json:"event_server"`
 	Database      *DatabaseConfig      `json:"database"`
 	MessageBroker *MessageBrokerConfig `json:"message_broker"`
+	// ConsumerLiveness is the configuration for tracking the liveness of the consumer agents.
+	ConsumerLiveness *ConsumerLivenessConfig `json:"consumer_liveness"`
+	// StaleStatus is the configuration for detecting the resource bundles whose status lags behind their version.
+	StaleStatus *StaleStatusConfig `json:"stale_status"`
+	// EventGC is the configuration for the garbage collection of the event tables.
+	EventGC *EventGCConfig `json:"event_gc"`
+	// Operation is the configuration for driving the operations to completion.
+	Operation *OperationConfig `json:"operation"`
+	// Placement is the configuration for rolling out the placements.
+	Placement *PlacementConfig `json:"placement"`
+	// Quota is the quotas of the resource bundles.
+	Quota *QuotaConfig `json:"quota"`
+	// Tenancy is the configuration to isolate the resource bundles of the tenants.
+	Tenancy *TenancyConfig `json:"tenancy"`
 }
 
 func NewApplicationConfig() *ApplicationConfig {
@@ -31,6 +45,14 @@ func NewApplicationConfig() *ApplicationConfig {
 		EventServer:   NewEventServerConfig(),
 		Database:      NewDatabaseConfig(),
 		MessageBroker: NewMessageBrokerConfig(),
+
+		ConsumerLiveness: NewConsumerLivenessConfig(),
+		StaleStatus:      NewStaleStatusConfig(),
+		EventGC:          NewEventGCConfig(),
+		Operation:        NewOperationConfig(),
+		Placement:        NewPlacementConfig(),
+		Quota:            NewQuotaConfig(),
+		Tenancy:          NewTenancyConfig(),
 	}
 }
 
@@ -43,6 +65,13 @@ func (c *ApplicationConfig) AddFlags(flagset *pflag.FlagSet) {
 	c.EventServer.AddFlags(flagset)
 	c.Database.AddFlags(flagset)
 	c.MessageBroker.AddFlags(flagset)
+	c.ConsumerLiveness.AddFlags(flagset)
+	c.StaleStatus.AddFlags(flagset)
+	c.EventGC.AddFlags(flagset)
+	c.Operation.AddFlags(flagset)
+	c.Placement.AddFlags(flagset)
+	c.Quota.AddFlags(flagset)
+	c.Tenancy.AddFlags(flagset)
 }
 
 func (c *ApplicationConfig) ReadFiles() []string {
@@ -55,6 +84,7 @@ func (c *ApplicationConfig) ReadFiles() []string {
 		{c.Metrics.ReadFiles, "Metrics"},
 		{c.HealthCheck.ReadFiles, "HealthCheck"},
 		{c.EventServer.ReadFiles, "EventServer"},
+		{c.Tenancy.ReadFiles, "Tenancy"},
 	}
 	messages := []string{}
 	for _, rf := range readFiles {
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// ConsumerLivenessConfig contains the configuration for tracking the liveness of the consumer agents.
+type ConsumerLivenessConfig struct {
+	// SilenceThreshold is the time after which a consumer whose agent is not seen is reported as silent,
+	// the silence is not reported if it is 0.
+	SilenceThreshold time.Duration `json:"silence_threshold"`
+	// WebhookURL is the URL that a silent consumer is posted to, it is optional.
+	WebhookURL string `json:"webhook_url"`
+}
+
+func NewConsumerLivenessConfig() *ConsumerLivenessConfig {
+	return &ConsumerLivenessConfig{
+		SilenceThreshold: 10 * time.Minute,
+	}
+}
+
+func (c *ConsumerLivenessConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.SilenceThreshold, "consumer-silence-threshold", c.SilenceThreshold, "Sets the time after which a consumer whose agent is not seen is reported as silent, 0 disables the report")
+	fs.StringVar(&c.WebhookURL, "consumer-silence-webhook-url", c.WebhookURL, "Sets the URL that the silent consumers are posted to")
+}
+
+func (c *ConsumerLivenessConfig) ReadFiles() error {
+	return nil
+}
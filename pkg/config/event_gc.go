@@ -0,0 +1,64 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// EventGCConfig contains the configuration for the garbage collection of the events, the status events, the
+// event instances, the completed operations and the revisions of the resources, a limit is not enforced if it is 0.
+type EventGCConfig struct {
+	// Interval is the interval of the garbage collection, the garbage collection is disabled if it is 0.
+	Interval time.Duration `json:"interval"`
+	// EventMaxAge is the maximum age of the events.
+	EventMaxAge time.Duration `json:"event_max_age"`
+	// EventMaxCount is the maximum number of the events.
+	EventMaxCount int64 `json:"event_max_count"`
+	// StatusEventMaxAge is the maximum age of the status events.
+	StatusEventMaxAge time.Duration `json:"status_event_max_age"`
+	// StatusEventMaxCount is the maximum number of the status events.
+	StatusEventMaxCount int64 `json:"status_event_max_count"`
+	// ResourceTombstoneMaxAge is the maximum age of the tombstones of the deleted resources.
+	ResourceTombstoneMaxAge time.Duration `json:"resource_tombstone_max_age"`
+	// ResourceTombstoneMaxCount is the maximum number of the tombstones of the deleted resources.
+	ResourceTombstoneMaxCount int64 `json:"resource_tombstone_max_count"`
+	// OperationMaxAge is the maximum age of the completed operations since their completion.
+	OperationMaxAge time.Duration `json:"operation_max_age"`
+	// OperationMaxCount is the maximum number of the completed operations.
+	OperationMaxCount int64 `json:"operation_max_count"`
+	// ResourceRevisionMaxCount is the maximum number of the revisions of a resource.
+	ResourceRevisionMaxCount int64 `json:"resource_revision_max_count"`
+	// DeadInstanceGracePeriod is the time after which the event instances of a dead maestro instance are purged.
+	DeadInstanceGracePeriod time.Duration `json:"dead_instance_grace_period"`
+}
+
+func NewEventGCConfig() *EventGCConfig {
+	return &EventGCConfig{
+		Interval:                 10 * time.Minute,
+		EventMaxAge:              24 * time.Hour,
+		StatusEventMaxAge:        24 * time.Hour,
+		ResourceTombstoneMaxAge:  24 * time.Hour,
+		OperationMaxAge:          7 * 24 * time.Hour,
+		ResourceRevisionMaxCount: 10,
+		DeadInstanceGracePeriod:  time.Hour,
+	}
+}
+
+func (c *EventGCConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.Interval, "event-gc-interval", c.Interval, "Sets the interval of the garbage collection of the events, the status events, the event instances, the completed operations and the revisions of the resource bundles, 0 disables the garbage collection")
+	fs.DurationVar(&c.EventMaxAge, "event-max-age", c.EventMaxAge, "Sets the maximum age of the events, the older events are purged whether they are reconciled or not, 0 disables the limit")
+	fs.Int64Var(&c.EventMaxCount, "event-max-count", c.EventMaxCount, "Sets the maximum number of the events, the oldest events exceeding it are purged, 0 disables the limit")
+	fs.DurationVar(&c.StatusEventMaxAge, "status-event-max-age", c.StatusEventMaxAge, "Sets the maximum age of the status events, the older status events are purged whether they are broadcast or not, it should be longer than --status-event-retention, 0 disables the limit")
+	fs.Int64Var(&c.StatusEventMaxCount, "status-event-max-count", c.StatusEventMaxCount, "Sets the maximum number of the status events, the oldest status events exceeding it are purged, 0 disables the limit")
+	fs.DurationVar(&c.ResourceTombstoneMaxAge, "resource-tombstone-max-age", c.ResourceTombstoneMaxAge, "Sets the maximum age of the tombstones of the deleted resource bundles, a watch cannot resume from a resource version before the purged tombstones, 0 disables the limit")
+	fs.Int64Var(&c.ResourceTombstoneMaxCount, "resource-tombstone-max-count", c.ResourceTombstoneMaxCount, "Sets the maximum number of the tombstones of the deleted resource bundles, the oldest tombstones exceeding it are purged, 0 disables the limit")
+	fs.DurationVar(&c.OperationMaxAge, "operation-max-age", c.OperationMaxAge, "Sets the maximum age of the completed operations since their completion, the older operations and their resources are purged, 0 disables the limit")
+	fs.Int64Var(&c.OperationMaxCount, "operation-max-count", c.OperationMaxCount, "Sets the maximum number of the completed operations, the oldest completed operations exceeding it are purged with their resources, 0 disables the limit")
+	fs.Int64Var(&c.ResourceRevisionMaxCount, "resource-revision-max-count", c.ResourceRevisionMaxCount, "Sets the maximum number of the revisions of a resource bundle, the oldest revisions exceeding it are purged and the resource bundle cannot be rolled back to them, 0 disables the limit")
+	fs.DurationVar(&c.DeadInstanceGracePeriod, "dead-instance-grace-period", c.DeadInstanceGracePeriod, "Sets the time after which a maestro instance that is not ready and stops sending heartbeats is dead, the event instances of the dead instances are purged, 0 disables the purge")
+}
+
+func (c *EventGCConfig) ReadFiles() error {
+	return nil
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 )
 
@@ -15,6 +17,9 @@ const (
 type EventServerConfig struct {
 	SubscriptionType     string                `json:"subscription_type"`
 	ConsistentHashConfig *ConsistentHashConfig `json:"consistent_hash_config"`
+	// StatusEventRetention is the minimum time the status events are kept after they are broadcast, so that a
+	// gRPC subscriber that reconnects within it can replay the status events it missed.
+	StatusEventRetention time.Duration `json:"status_event_retention"`
 }
 
 // ConsistentHashConfig contains the configuration for the consistent hashing algorithm.
@@ -29,6 +34,7 @@ func NewEventServerConfig() *EventServerConfig {
 	return &EventServerConfig{
 		SubscriptionType:     "shared",
 		ConsistentHashConfig: NewConsistentHashConfig(),
+		StatusEventRetention: time.Hour,
 	}
 }
 
@@ -50,8 +56,10 @@ func NewConsistentHashConfig() *ConsistentHashConfig {
 //     "shared" subscription type uses MQTT feature to ensure only one Maestro instance receives resource status messages.
 //     "broadcast" subscription type will make all Maestro instances to receive resource status messages and hash the message to determine which instance should process it.
 //     If subscription type is "broadcast", ConsistentHashConfig settings can be configured for the hashing algorithm.
+//   - "status-event-retention" specifies the minimum time the broadcast status events are kept for the gRPC subscribers to resume from.
 func (c *EventServerConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.SubscriptionType, "subscription-type", c.SubscriptionType, "Sets the subscription type for resource status updates from message broker, Options: \"shared\" (only one instance receives resource status message, MQTT feature ensures exclusivity) or \"broadcast\" (all instances receive messages, hashed to determine processing instance)")
+	fs.DurationVar(&c.StatusEventRetention, "status-event-retention", c.StatusEventRetention, "Sets the minimum time the broadcast status events are kept, a gRPC subscriber that reconnects within it replays the status events it missed instead of a full resync")
 	c.ConsistentHashConfig.AddFlags(fs)
 }
 
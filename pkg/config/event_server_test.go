@@ -3,6 +3,7 @@ package config
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -23,6 +24,7 @@ func TestEventServerConfig(t *testing.T) {
 					ReplicationFactor: 20,
 					Load:              1.25,
 				},
+				StatusEventRetention: time.Hour,
 			},
 		},
 		{
@@ -37,6 +39,7 @@ func TestEventServerConfig(t *testing.T) {
 					ReplicationFactor: 20,
 					Load:              1.25,
 				},
+				StatusEventRetention: time.Hour,
 			},
 		},
 		{
@@ -54,6 +57,22 @@ func TestEventServerConfig(t *testing.T) {
 					ReplicationFactor: 30,
 					Load:              1.5,
 				},
+				StatusEventRetention: time.Hour,
+			},
+		},
+		{
+			name: "custom status event retention",
+			input: map[string]string{
+				"status-event-retention": "30m",
+			},
+			want: &EventServerConfig{
+				SubscriptionType: "broadcast",
+				ConsistentHashConfig: &ConsistentHashConfig{
+					PartitionCount:    10,
+					ReplicationFactor: 30,
+					Load:              1.5,
+				},
+				StatusEventRetention: 30 * time.Minute,
 			},
 		},
 	}
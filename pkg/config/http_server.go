@@ -7,13 +7,19 @@ import (
 )
 
 type HTTPServerConfig struct {
-	Hostname      string        `json:"hostname"`
-	BindPort      string        `json:"bind_port"`
-	ReadTimeout   time.Duration `json:"read_timeout"`
-	WriteTimeout  time.Duration `json:"write_timeout"`
-	HTTPSCertFile string        `json:"https_cert_file"`
-	HTTPSKeyFile  string        `json:"https_key_file"`
-	EnableHTTPS   bool          `json:"enable_https"`
+	Hostname         string        `json:"hostname"`
+	BindPort         string        `json:"bind_port"`
+	ReadTimeout      time.Duration `json:"read_timeout"`
+	WriteTimeout     time.Duration `json:"write_timeout"`
+	HTTPSCertFile    string        `json:"https_cert_file"`
+	HTTPSKeyFile     string        `json:"https_key_file"`
+	EnableHTTPS      bool          `json:"enable_https"`
+	HTTPAuthNType    string        `json:"http_authn_type"`
+	JWKCertURL       string        `json:"jwk_cert_url"`
+	JWKCertFile      string        `json:"jwk_cert_file"`
+	JWTIssuer        string        `json:"jwt_issuer"`
+	JWTAudiences     []string      `json:"jwt_audiences"`
+	HTTPClientCAFile string        `json:"http_client_ca_file"`
 }
 
 func NewHTTPServerConfig() *HTTPServerConfig {
@@ -25,6 +31,7 @@ func NewHTTPServerConfig() *HTTPServerConfig {
 		EnableHTTPS:   false,
 		HTTPSCertFile: "",
 		HTTPSKeyFile:  "",
+		HTTPAuthNType: "jwt",
 	}
 }
 
@@ -36,6 +43,12 @@ func (s *HTTPServerConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.HTTPSCertFile, "https-cert-file", s.HTTPSCertFile, "The path to the tls.crt file.")
 	fs.StringVar(&s.HTTPSKeyFile, "https-key-file", s.HTTPSKeyFile, "The path to the tls.key file.")
 	fs.BoolVar(&s.EnableHTTPS, "enable-https", s.EnableHTTPS, "Enable HTTPS rather than HTTP")
+	fs.StringVar(&s.HTTPAuthNType, "http-authn-type", s.HTTPAuthNType, "Specify the REST API authentication type (e.g., mock, jwt, mtls or token)")
+	fs.StringVar(&s.JWKCertURL, "jwk-cert-url", s.JWKCertURL, "The URL of the JSON Web Key Set used to validate the bearer JWT when http-authn-type is jwt")
+	fs.StringVar(&s.JWKCertFile, "jwk-cert-file", s.JWKCertFile, "The path to the JSON Web Key Set file used to validate the bearer JWT when http-authn-type is jwt")
+	fs.StringVar(&s.JWTIssuer, "jwt-issuer", s.JWTIssuer, "The issuer that the iss claim of the bearer JWT must match when http-authn-type is jwt")
+	fs.StringSliceVar(&s.JWTAudiences, "jwt-audience", s.JWTAudiences, "The audiences that the aud claim of the bearer JWT must contain one of when http-authn-type is jwt")
+	fs.StringVar(&s.HTTPClientCAFile, "http-client-ca-file", s.HTTPClientCAFile, "The path to the CA file used to verify client certificates when http-authn-type is mtls")
 }
 
 func (s *HTTPServerConfig) ReadFiles() error {
@@ -32,6 +32,6 @@ func (c *MessageBrokerConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.EnableMock, "enable-message-broker-mock", c.EnableMock, "Enable message broker mock")
 	fs.StringVar(&c.SourceID, "source-id", c.SourceID, "Source ID")
 	fs.StringVar(&c.ClientID, "client-id", c.ClientID, "Client ID")
-	fs.StringVar(&c.MessageBrokerType, "message-broker-type", c.MessageBrokerType, "Message broker type ('grpc', 'mqtt' or 'pubsub'). Default is 'mqtt'.")
+	fs.StringVar(&c.MessageBrokerType, "message-broker-type", c.MessageBrokerType, "Message broker type ('grpc', 'mqtt', 'pubsub' or 'kafka'). Default is 'mqtt'.")
 	fs.StringVar(&c.MessageBrokerConfig, "message-broker-config-file", c.MessageBrokerConfig, "The config file path of message broker")
 }
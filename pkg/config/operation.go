@@ -0,0 +1,32 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// OperationConfig contains the configuration for driving the operations to completion.
+type OperationConfig struct {
+	// Timeout is the time after which a running operation fails, the operations do not time out if it is 0.
+	Timeout time.Duration `json:"timeout"`
+	// MaxResources is the maximum number of resource bundles that a bulk deletion marks as deleting, a bulk
+	// deletion whose search matches more resource bundles is rejected.
+	MaxResources int `json:"max_resources"`
+}
+
+func NewOperationConfig() *OperationConfig {
+	return &OperationConfig{
+		Timeout:      24 * time.Hour,
+		MaxResources: 10000,
+	}
+}
+
+func (c *OperationConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.Timeout, "operation-timeout", c.Timeout, "Sets the time after which an operation that is still running fails, e.g. when the agent of its consumer never acknowledges it, 0 disables the timeout")
+	fs.IntVar(&c.MaxResources, "operation-max-resources", c.MaxResources, "Sets the maximum number of resource bundles that a bulk deletion marks as deleting, a bulk deletion whose search matches more resource bundles is rejected")
+}
+
+func (c *OperationConfig) ReadFiles() error {
+	return nil
+}
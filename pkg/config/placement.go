@@ -0,0 +1,28 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// PlacementConfig contains the configuration for rolling out the placements.
+type PlacementConfig struct {
+	// ProgressDeadline is the time after which a rollout whose updated resource bundles are not available and that
+	// makes no progress is marked as stalled, a rollout is never marked as stalled if it is 0.
+	ProgressDeadline time.Duration `json:"progress_deadline"`
+}
+
+func NewPlacementConfig() *PlacementConfig {
+	return &PlacementConfig{
+		ProgressDeadline: 10 * time.Minute,
+	}
+}
+
+func (c *PlacementConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.ProgressDeadline, "placement-progress-deadline", c.ProgressDeadline, "Sets the time after which the rollout of a placement whose updated resource bundles are not available and that makes no progress is marked as stalled, 0 disables the deadline")
+}
+
+func (c *PlacementConfig) ReadFiles() error {
+	return nil
+}
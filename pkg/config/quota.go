@@ -0,0 +1,28 @@
+package config
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// QuotaConfig contains the quotas of the resource bundles, a quota is not enforced if it is 0.
+type QuotaConfig struct {
+	MaxResourceBundlesPerConsumer int64 `json:"max_resource_bundles_per_consumer"`
+	MaxResourceBundlesPerSource   int64 `json:"max_resource_bundles_per_source"`
+	MaxManifestsPerResourceBundle int64 `json:"max_manifests_per_resource_bundle"`
+	MaxPayloadBytes               int64 `json:"max_payload_bytes"`
+}
+
+func NewQuotaConfig() *QuotaConfig {
+	return &QuotaConfig{}
+}
+
+func (c *QuotaConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.Int64Var(&c.MaxResourceBundlesPerConsumer, "quota-max-resource-bundles-per-consumer", c.MaxResourceBundlesPerConsumer, "Sets the maximum number of resource bundles of a consumer, 0 is unlimited")
+	fs.Int64Var(&c.MaxResourceBundlesPerSource, "quota-max-resource-bundles-per-source", c.MaxResourceBundlesPerSource, "Sets the maximum number of resource bundles of a source, 0 is unlimited")
+	fs.Int64Var(&c.MaxManifestsPerResourceBundle, "quota-max-manifests-per-resource-bundle", c.MaxManifestsPerResourceBundle, "Sets the maximum number of manifests of a resource bundle, 0 is unlimited")
+	fs.Int64Var(&c.MaxPayloadBytes, "quota-max-payload-bytes", c.MaxPayloadBytes, "Sets the maximum size in bytes of the JSON encoded payload of a resource bundle, 0 is unlimited")
+}
+
+func (c *QuotaConfig) ReadFiles() error {
+	return nil
+}
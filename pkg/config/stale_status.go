@@ -0,0 +1,29 @@
+package config
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// StaleStatusConfig contains the configuration for detecting the resource bundles whose status lags behind their
+// version.
+type StaleStatusConfig struct {
+	// Threshold is the time after which a resource bundle whose status of the current version is not reported is
+	// marked as stale and resynced, the stale status is not detected if it is 0.
+	Threshold time.Duration `json:"threshold"`
+}
+
+func NewStaleStatusConfig() *StaleStatusConfig {
+	return &StaleStatusConfig{
+		Threshold: 10 * time.Minute,
+	}
+}
+
+func (c *StaleStatusConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.Threshold, "stale-status-threshold", c.Threshold, "Sets the time after which a resource bundle whose status of the current version is not reported is marked as stale and resynced, 0 disables the detection")
+}
+
+func (c *StaleStatusConfig) ReadFiles() error {
+	return nil
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/openshift-online/maestro/pkg/auth"
+)
+
+// TenancyConfig contains the configuration to isolate the resource bundles of the tenants by their sources, the
+// tenants are not isolated if the config file is not set.
+type TenancyConfig struct {
+	ConfigFile string `json:"config_file"`
+	// Tenancy is loaded from the config file.
+	Tenancy *auth.Tenancy `json:"-"`
+}
+
+func NewTenancyConfig() *TenancyConfig {
+	return &TenancyConfig{}
+}
+
+func (c *TenancyConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ConfigFile, "tenancy-config-file", c.ConfigFile, "Path to the file that binds the authenticated identities to the sources they are allowed to access")
+}
+
+func (c *TenancyConfig) ReadFiles() error {
+	if c.ConfigFile == "" {
+		return nil
+	}
+
+	tenancy, err := auth.LoadTenancy(c.ConfigFile)
+	if err != nil {
+		return err
+	}
+	c.Tenancy = tenancy
+	return nil
+}
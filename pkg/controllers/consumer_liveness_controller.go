@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/event"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// defaultConsumerLivenessSyncPeriod is the period to persist the seen consumers and to check the silent consumers.
+var defaultConsumerLivenessSyncPeriod = 30 * time.Second
+
+// ConsumerSilence is the payload that is posted to the webhook when a consumer is reported as silent.
+type ConsumerSilence struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	LastSeen   *time.Time `json:"last_seen,omitempty"`
+	SilencedAt *time.Time `json:"silenced_at,omitempty"`
+}
+
+// ConsumerLivenessController tracks whether the agents of the consumers are connected and when they are last
+// seen. An agent is seen when a status of its consumer is broadcast or while it subscribes to the gRPC broker,
+// the seen consumers are kept in memory and persisted periodically, so that the status traffic does not write
+// the consumers table on every status.
+//
+// A consumer whose agent is not seen longer than the silence threshold is marked as disconnected and reported
+// once with a metric and, if it is set, a webhook. The silence is reported by one of the maestro instances, the
+// instance that marks the consumer as silent.
+type ConsumerLivenessController struct {
+	consumers        services.ConsumerService
+	eventBroadcaster *event.EventBroadcaster
+	// connectedConsumers returns the consumers whose agents are connected to the current instance, it is nil if
+	// the agents are not connected to the maestro server directly, e.g. with a MQTT broker.
+	connectedConsumers func() []string
+	silenceThreshold   time.Duration
+	webhookURL         string
+	client             *http.Client
+
+	mu        sync.Mutex
+	seen      sets.Set[string]
+	connected sets.Set[string]
+}
+
+func NewConsumerLivenessController(consumers services.ConsumerService,
+	eventBroadcaster *event.EventBroadcaster,
+	connectedConsumers func() []string,
+	silenceThreshold time.Duration,
+	webhookURL string) *ConsumerLivenessController {
+	return &ConsumerLivenessController{
+		consumers:          consumers,
+		eventBroadcaster:   eventBroadcaster,
+		connectedConsumers: connectedConsumers,
+		silenceThreshold:   silenceThreshold,
+		webhookURL:         webhookURL,
+		client:             &http.Client{Timeout: 10 * time.Second},
+		seen:               sets.New[string](),
+		connected:          sets.New[string](),
+	}
+}
+
+// Seen records that the agent of a consumer is seen.
+func (lc *ConsumerLivenessController) Seen(consumerName string) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.seen.Insert(consumerName)
+}
+
+func (lc *ConsumerLivenessController) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting consumer liveness controller")
+
+	if lc.eventBroadcaster != nil {
+		id := lc.eventBroadcaster.RegisterAll(ctx, func(res *api.Resource, sequence int64) error {
+			// the status resyncs are not sent by the agents, and the deleted resources have no consumers
+			if sequence > 0 && res.ConsumerName != "" {
+				lc.Seen(res.ConsumerName)
+			}
+			return nil
+		})
+		defer lc.eventBroadcaster.Unregister(ctx, id)
+	}
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := lc.sync(ctx); err != nil {
+			logger.Error(err, "Failed to sync the consumer liveness")
+		}
+	}, defaultConsumerLivenessSyncPeriod)
+
+	logger.Info("Shutting down consumer liveness controller")
+}
+
+// sync persists the seen and disconnected consumers, and reports the silent consumers.
+func (lc *ConsumerLivenessController) sync(ctx context.Context) error {
+	now := time.Now()
+
+	lc.mu.Lock()
+	seen := lc.seen
+	lc.seen = sets.New[string]()
+	lc.mu.Unlock()
+
+	disconnected := sets.New[string]()
+	if lc.connectedConsumers != nil {
+		connected := sets.New(lc.connectedConsumers()...)
+		disconnected = lc.connected.Difference(connected)
+		seen = seen.Union(connected)
+		lc.connected = connected
+	}
+
+	if svcErr := lc.consumers.MarkSeen(ctx, sets.List(seen), now); svcErr != nil {
+		// keep the seen consumers to persist them in the next sync
+		lc.mu.Lock()
+		lc.seen = lc.seen.Union(seen)
+		lc.mu.Unlock()
+		return svcErr
+	}
+	if svcErr := lc.consumers.MarkDisconnected(ctx, sets.List(disconnected.Difference(seen))); svcErr != nil {
+		return svcErr
+	}
+
+	if lc.silenceThreshold > 0 {
+		silent, svcErr := lc.consumers.MarkSilent(ctx, now.Add(-lc.silenceThreshold))
+		if svcErr != nil {
+			return svcErr
+		}
+		for _, consumer := range silent {
+			lc.reportSilence(ctx, consumer)
+		}
+	}
+
+	count, svcErr := lc.consumers.CountConnected(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+	consumerLivenessConnected.Set(float64(count))
+	return nil
+}
+
+func (lc *ConsumerLivenessController) reportSilence(ctx context.Context, consumer *api.Consumer) {
+	logger := klog.FromContext(ctx).WithValues("consumer", consumer.Name)
+	logger.Info("Consumer is silent", "lastSeen", consumer.LastSeen)
+	consumerLivenessSilentTotal.WithLabelValues(consumer.Name).Inc()
+
+	if lc.webhookURL == "" {
+		return
+	}
+	if err := lc.postSilence(ctx, consumer); err != nil {
+		logger.Error(err, "Failed to post the consumer silence", "webhookURL", lc.webhookURL)
+	}
+}
+
+func (lc *ConsumerLivenessController) postSilence(ctx context.Context, consumer *api.Consumer) error {
+	body, err := json.Marshal(&ConsumerSilence{
+		ID:         consumer.ID,
+		Name:       consumer.Name,
+		LastSeen:   consumer.LastSeen,
+		SilencedAt: consumer.SilencedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, lc.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := lc.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+func TestConsumerLivenessSeen(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	consumerDao := mocks.NewConsumerDao()
+	consumers := services.NewConsumerService(consumerDao)
+	for _, name := range []string{"cluster1", "cluster2", "cluster3"} {
+		_, err := consumerDao.Create(ctx, &api.Consumer{Meta: api.Meta{ID: name}, Name: name})
+		Expect(err).To(BeNil())
+	}
+
+	grpcConsumers := []string{"cluster2"}
+	lc := NewConsumerLivenessController(consumers, nil, func() []string { return grpcConsumers }, 0, "")
+
+	// cluster1 sends a status and cluster2 subscribes to the gRPC broker
+	lc.Seen("cluster1")
+	Expect(lc.sync(ctx)).To(BeNil())
+	Expect(liveness(ctx, consumers, "cluster1")).To(Equal([]bool{true, true}))
+	Expect(liveness(ctx, consumers, "cluster2")).To(Equal([]bool{true, true}))
+	Expect(liveness(ctx, consumers, "cluster3")).To(Equal([]bool{false, false}))
+	count, svcErr := consumers.CountConnected(ctx)
+	Expect(svcErr).To(BeNil())
+	Expect(count).To(Equal(int64(2)))
+
+	// cluster2 is disconnected from the gRPC broker
+	grpcConsumers = []string{}
+	Expect(lc.sync(ctx)).To(BeNil())
+	Expect(liveness(ctx, consumers, "cluster2")).To(Equal([]bool{false, true}))
+	Expect(liveness(ctx, consumers, "cluster1")).To(Equal([]bool{true, true}))
+}
+
+func TestConsumerLivenessSilence(t *testing.T) {
+	RegisterTestingT(t)
+
+	var mu sync.Mutex
+	posted := []ConsumerSilence{}
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		silence := ConsumerSilence{}
+		if err := json.NewDecoder(r.Body).Decode(&silence); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		posted = append(posted, silence)
+		mu.Unlock()
+	}))
+	defer webhook.Close()
+
+	ctx := context.Background()
+	consumerDao := mocks.NewConsumerDao()
+	consumers := services.NewConsumerService(consumerDao)
+	lastSeen := time.Now().Add(-time.Hour)
+	_, err := consumerDao.Create(ctx, &api.Consumer{Meta: api.Meta{ID: "id1"}, Name: "cluster1", Connected: true, LastSeen: &lastSeen})
+	Expect(err).To(BeNil())
+	_, err = consumerDao.Create(ctx, &api.Consumer{Meta: api.Meta{ID: "id2", CreatedAt: time.Now()}, Name: "cluster2"})
+	Expect(err).To(BeNil())
+	_, err = consumerDao.Create(ctx, &api.Consumer{Meta: api.Meta{ID: "id3", CreatedAt: lastSeen}, Name: "cluster3"})
+	Expect(err).To(BeNil())
+
+	lc := NewConsumerLivenessController(consumers, nil, nil, 10*time.Minute, webhook.URL)
+
+	// cluster1 is not seen longer than the threshold, cluster2 is never seen but created within the threshold,
+	// cluster3 is never seen since it is created longer than the threshold ago
+	Expect(lc.sync(ctx)).To(BeNil())
+	Expect(liveness(ctx, consumers, "cluster1")).To(Equal([]bool{false, true}))
+	Expect(posted).To(HaveLen(2))
+	Expect(posted[0].ID).To(Equal("id1"))
+	Expect(posted[0].Name).To(Equal("cluster1"))
+	Expect(posted[0].SilencedAt).NotTo(BeNil())
+	Expect(posted[1].ID).To(Equal("id3"))
+	Expect(posted[1].LastSeen).To(BeNil())
+
+	// the silence is reported once
+	Expect(lc.sync(ctx)).To(BeNil())
+	Expect(posted).To(HaveLen(2))
+
+	// the silence is reset once the consumer is seen again
+	lc.Seen("cluster1")
+	Expect(lc.sync(ctx)).To(BeNil())
+	Expect(liveness(ctx, consumers, "cluster1")).To(Equal([]bool{true, true}))
+	consumer, svcErr := consumers.Get(ctx, "id1")
+	Expect(svcErr).To(BeNil())
+	Expect(consumer.SilencedAt).To(BeNil())
+	Expect(consumer.LastSeen.After(lastSeen)).To(BeTrue())
+}
+
+// liveness returns whether the consumer of the name is connected and whether it is seen.
+func liveness(ctx context.Context, consumers services.ConsumerService, name string) []bool {
+	list, svcErr := consumers.FindByNames(ctx, []string{name})
+	Expect(svcErr).To(BeNil())
+	Expect(list).To(HaveLen(1))
+	return []bool{list[0].Connected, list[0].LastSeen != nil}
+}
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// EventGCController periodically purges the events, the status events and the event instances that are out of
+// their retention. Only one maestro instance runs the garbage collection at a time, the others skip it.
+type EventGCController struct {
+	eventGC     services.EventGCService
+	lockFactory db.LockFactory
+	interval    time.Duration
+}
+
+func NewEventGCController(eventGC services.EventGCService, lockFactory db.LockFactory, interval time.Duration) *EventGCController {
+	return &EventGCController{
+		eventGC:     eventGC,
+		lockFactory: lockFactory,
+		interval:    interval,
+	}
+}
+
+func (gc *EventGCController) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting event gc controller", "interval", gc.interval)
+
+	// use a jitter to avoid multiple instances trying to run the garbage collection at the same time
+	wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
+		if err := gc.sync(ctx); err != nil {
+			logger.Error(err, "Failed to purge the event tables")
+		}
+	}, gc.interval, 0.25, true)
+
+	logger.Info("Shutting down event gc controller")
+}
+
+func (gc *EventGCController) sync(ctx context.Context) error {
+	lockOwnerID, acquired, err := gc.lockFactory.NewNonBlockingLock(ctx, "maestro-event-gc", db.Events)
+	// Ensure that the transaction related to this lock always end.
+	defer gc.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		return err
+	}
+	// skip if another maestro instance is running the garbage collection
+	if !acquired {
+		klog.FromContext(ctx).V(4).Info("Another maestro instance is purging the event tables, skip")
+		return nil
+	}
+
+	results, svcErr := gc.eventGC.Run(ctx, false)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	for _, result := range results {
+		eventGCTableRows.WithLabelValues(result.Table).Set(float64(result.Rows - result.Purged()))
+		eventGCPurgedRowsTotal.WithLabelValues(result.Table, "age").Add(float64(result.Expired))
+		eventGCPurgedRowsTotal.WithLabelValues(result.Table, "count").Add(float64(result.Exceeded))
+		eventGCPurgedRowsTotal.WithLabelValues(result.Table, "orphaned").Add(float64(result.Orphaned))
+		if result.Purged() > 0 {
+			klog.FromContext(ctx).Info("Purged the event table", "table", result.Table, "rows", result.Rows,
+				"expired", result.Expired, "exceeded", result.Exceeded, "orphaned", result.Orphaned)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+func TestEventGCSync(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	now := time.Now()
+	eventGCDao := mocks.NewEventGCDao(
+		map[string][]time.Time{
+			api.EventsTable:       {now.Add(-2 * time.Hour), now.Add(-time.Minute)},
+			api.StatusEventsTable: {now.Add(-3 * time.Minute), now.Add(-2 * time.Minute), now.Add(-time.Minute)},
+		},
+		api.EventInstanceList{{EventID: "e1", InstanceID: "gone"}},
+		nil,
+	)
+	eventGC := services.NewEventGCService(eventGCDao, api.EventGCPolicy{
+		Events:                  api.EventRetention{MaxAge: time.Hour},
+		StatusEvents:            api.EventRetention{MaxCount: 1},
+		DeadInstanceGracePeriod: time.Hour,
+	})
+
+	purged := func(table, reason string) float64 {
+		return testutil.ToFloat64(eventGCPurgedRowsTotal.WithLabelValues(table, reason))
+	}
+	expiredEvents := purged(api.EventsTable, "age")
+	exceededStatusEvents := purged(api.StatusEventsTable, "count")
+	orphanedEventInstances := purged(api.EventInstancesTable, "orphaned")
+
+	gc := NewEventGCController(eventGC, dbmocks.NewMockAdvisoryLockFactory(), time.Minute)
+	Expect(gc.sync(ctx)).To(BeNil())
+
+	Expect(purged(api.EventsTable, "age") - expiredEvents).To(Equal(float64(1)))
+	Expect(purged(api.StatusEventsTable, "count") - exceededStatusEvents).To(Equal(float64(2)))
+	Expect(purged(api.EventInstancesTable, "orphaned") - orphanedEventInstances).To(Equal(float64(1)))
+	Expect(testutil.ToFloat64(eventGCTableRows.WithLabelValues(api.EventsTable))).To(Equal(float64(1)))
+	Expect(testutil.ToFloat64(eventGCTableRows.WithLabelValues(api.StatusEventsTable))).To(Equal(float64(1)))
+	Expect(testutil.ToFloat64(eventGCTableRows.WithLabelValues(api.EventInstancesTable))).To(BeZero())
+}
@@ -10,6 +10,9 @@ const (
 	specControllerMetricsSubsystem   = "spec_controller"
 	statusControllerMetricsSubsystem = "status_controller"
 	workqueueMetricsSubsystem        = "workqueue"
+	consumerLivenessMetricsSubsystem = "consumer_liveness"
+	staleStatusMetricsSubsystem      = "stale_status"
+	eventGCMetricsSubsystem          = "event_gc"
 )
 
 // Names of the metrics:
@@ -24,6 +27,12 @@ const (
 	UnfinishedWorkSecondsMetric   = "unfinished_work_seconds"
 	LongestRunningProcessor       = "longest_running_processor_seconds"
 	RetriesTotalMetric            = "retries_total"
+	consumerSilentTotalMetric     = "silent_total"
+	consumerConnectedMetric       = "connected"
+	staleResourcesMetric          = "resources"
+	staleResyncTotalMetric        = "resync_total"
+	eventGCTableRowsMetric        = "table_rows"
+	eventGCPurgedRowsTotalMetric  = "purged_rows_total"
 )
 
 // Names of the labels added to metrics:
@@ -31,6 +40,9 @@ const (
 	controllerMetricsTypeLabel   = "event_type"
 	controllerMetricsStatusLabel = "status"
 	workqueueNameLabel           = "queue_name"
+	consumerNameLabel            = "consumer"
+	eventGCTableLabel            = "table"
+	eventGCReasonLabel           = "reason"
 )
 
 type controllerReconciledStatus string
@@ -119,6 +131,66 @@ var (
 		[]string{controllerMetricsStatusLabel},
 	)
 
+	// consumerLivenessSilentTotal is a counter of the total number of times that
+	// the consumers are reported as silent, labeled by consumer:
+	consumerLivenessSilentTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: consumerLivenessMetricsSubsystem,
+			Name:      consumerSilentTotalMetric,
+			Help:      "Total number of times the consumers are reported as silent",
+		},
+		[]string{consumerNameLabel},
+	)
+
+	// consumerLivenessConnected is a gauge of the number of the connected consumers:
+	consumerLivenessConnected = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: consumerLivenessMetricsSubsystem,
+			Name:      consumerConnectedMetric,
+			Help:      "Number of the consumers whose agents are connected",
+		},
+	)
+
+	// staleStatusResources is a gauge of the number of the resources whose status is stale:
+	staleStatusResources = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: staleStatusMetricsSubsystem,
+			Name:      staleResourcesMetric,
+			Help:      "Number of the resources whose status is stale",
+		},
+	)
+
+	// eventGCTableRows is a gauge of the number of the rows of the event tables after the last garbage
+	// collection, labeled by table:
+	eventGCTableRows = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: eventGCMetricsSubsystem,
+			Name:      eventGCTableRowsMetric,
+			Help:      "Number of the rows of the event tables after the last garbage collection",
+		},
+		[]string{eventGCTableLabel},
+	)
+
+	// eventGCPurgedRowsTotal is a counter of the total number of the rows purged from the event tables, labeled by
+	// table and reason:
+	eventGCPurgedRowsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: eventGCMetricsSubsystem,
+			Name:      eventGCPurgedRowsTotalMetric,
+			Help:      "Total number of the rows purged from the event tables",
+		},
+		[]string{eventGCTableLabel, eventGCReasonLabel},
+	)
+
+	// staleStatusResyncTotal is a counter of the total number of times that the stale resources are resynced:
+	staleStatusResyncTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: staleStatusMetricsSubsystem,
+			Name:      staleResyncTotalMetric,
+			Help:      "Total number of times the stale resources are resynced",
+		},
+	)
+
 	// workqueueDepth is a gauge of the current depth of workqueues, labeled by name:
 	workqueueDepth = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -217,6 +289,12 @@ func init() {
 	prometheus.MustRegister(statusEventReconciledTotal)
 	prometheus.MustRegister(statusEventReconcileDuration)
 	prometheus.MustRegister(statusControllerSyncEventOperationsTotal)
+	prometheus.MustRegister(consumerLivenessSilentTotal)
+	prometheus.MustRegister(consumerLivenessConnected)
+	prometheus.MustRegister(staleStatusResources)
+	prometheus.MustRegister(staleStatusResyncTotal)
+	prometheus.MustRegister(eventGCTableRows)
+	prometheus.MustRegister(eventGCPurgedRowsTotal)
 
 	// Register the Prometheus workqueue metrics globally:
 	for _, metric := range workqueueMetrics {
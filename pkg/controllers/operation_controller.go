@@ -0,0 +1,447 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	workv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+const OperationID ControllerHandlerContextKey = "operation"
+
+// resyncOperationsKey is the queue key that requeues all running operations, it is added periodically.
+const resyncOperationsKey = ""
+
+// defaultOperationResyncPeriod is the period to reconcile all running operations.
+var defaultOperationResyncPeriod = 30 * time.Second
+
+// OperationController drives the running operations to completion. For a deletion it marks the
+// resources of the operation as deleting, counts the resources whose deletion is acknowledged by
+// their consumers (the resource is removed), and completes the operation once all of them are
+// removed. A consumer deletion deletes the consumer after all of its resources are removed.
+//
+// The operation of a resource creation or update records when its event is dispatched to the
+// consumer, and is completed once the consumer reports that the version of the operation (or a
+// later one) is applied.
+//
+// An operation that is still running after the timeout fails, e.g. when the agent of its consumer never
+// acknowledges it.
+//
+// Multiple maestro instances run the controller, an operation is reconciled by one of them at a time
+// with a fail-fast advisory lock on the operation id.
+type OperationController struct {
+	operations  services.OperationService
+	resources   services.ResourceService
+	consumers   services.ConsumerService
+	events      services.EventService
+	lockFactory db.LockFactory
+	// timeout is the time after which a running operation fails, the operations do not time out if it is 0.
+	timeout time.Duration
+	queue   workqueue.TypedRateLimitingInterface[string]
+}
+
+func NewOperationController(operations services.OperationService,
+	resources services.ResourceService,
+	consumers services.ConsumerService,
+	events services.EventService,
+	lockFactory db.LockFactory,
+	timeout time.Duration) *OperationController {
+	return &OperationController{
+		operations:  operations,
+		resources:   resources,
+		consumers:   consumers,
+		events:      events,
+		lockFactory: lockFactory,
+		timeout:     timeout,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name:            "operation-controller",
+				MetricsProvider: prometheusMetricsProvider{},
+			},
+		),
+	}
+}
+
+// AddOperation adds an operation to the queue to be reconciled.
+func (oc *OperationController) AddOperation(id string) {
+	oc.queue.Add(id)
+}
+
+// OnStatusDelete requeues the running operations of a resource when the deletion of the resource is
+// acknowledged by its consumer, so that the progress of the operations is refreshed.
+func (oc *OperationController) OnStatusDelete(ctx context.Context, eventID, resourceID string) error {
+	operations, svcErr := oc.operations.FindRunningByResourceID(ctx, resourceID)
+	if svcErr != nil {
+		return svcErr
+	}
+	for _, operation := range operations {
+		oc.queue.Add(operation.ID)
+	}
+	return nil
+}
+
+// OnStatusUpdate requeues the running operations of a resource when the status of the resource is
+// updated, so that the operations are completed once the consumer applies the resource.
+func (oc *OperationController) OnStatusUpdate(ctx context.Context, eventID, resourceID string) error {
+	operations, svcErr := oc.operations.FindRunningByTargetID(ctx, resourceID)
+	if svcErr != nil {
+		return svcErr
+	}
+	for _, operation := range operations {
+		oc.queue.Add(operation.ID)
+	}
+	return nil
+}
+
+// OnDispatch records the dispatch time of the operations that are triggered by the event that is
+// handled, it is called by the event controller after the event is published to the consumer.
+func (oc *OperationController) OnDispatch(ctx context.Context, resourceID string) error {
+	eventID, ok := ctx.Value(EventID).(string)
+	if !ok || eventID == "" {
+		return nil
+	}
+	if svcErr := oc.operations.MarkDispatched(ctx, eventID); svcErr != nil {
+		return svcErr
+	}
+	return nil
+}
+
+func (oc *OperationController) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting operation controller")
+	defer oc.queue.ShutDown()
+
+	// use a jitter to avoid multiple instances syncing the operations at the same time
+	go wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
+		oc.queue.Add(resyncOperationsKey)
+	}, defaultOperationResyncPeriod, 0.25, true)
+
+	// the .Until will re-kick the runWorker one second after the runWorker completes
+	go wait.UntilWithContext(ctx, oc.runWorker, time.Second)
+
+	// wait until we're told to stop
+	<-ctx.Done()
+	logger.Info("Shutting down operation controller")
+}
+
+func (oc *OperationController) runWorker(ctx context.Context) {
+	// hot loop until we're told to stop. processNextOperation will automatically wait until there's work available,
+	// so we don't worry about secondary waits
+	for oc.processNextOperation(ctx) {
+	}
+}
+
+// processNextOperation deals with one key off the queue.
+func (oc *OperationController) processNextOperation(ctx context.Context) bool {
+	key, quit := oc.queue.Get()
+	if quit {
+		// the current queue is shutdown and becomes empty, quit this process
+		return false
+	}
+	defer oc.queue.Done(key)
+
+	if key == resyncOperationsKey {
+		if err := oc.resync(ctx); err != nil {
+			klog.FromContext(ctx).Error(err, "Failed to resync the operations")
+			oc.queue.AddRateLimited(key)
+			return true
+		}
+		oc.queue.Forget(key)
+		return true
+	}
+
+	logger := klog.FromContext(ctx).WithValues(OperationID, key)
+	if reconciled, err := oc.reconcile(klog.NewContext(ctx, logger), key); !reconciled {
+		if err != nil {
+			logger.Error(err, "Failed to reconcile the operation")
+		}
+
+		// the operation is not reconciled, we requeue it to work on later
+		// this method will add a backoff to avoid hotlooping on particular items
+		oc.queue.AddRateLimited(key)
+		return true
+	}
+
+	oc.queue.Forget(key)
+	return true
+}
+
+// resync adds all running operations to the queue.
+func (oc *OperationController) resync(ctx context.Context) error {
+	operations, svcErr := oc.operations.FindRunning(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+	for _, operation := range operations {
+		oc.queue.Add(operation.ID)
+	}
+	return nil
+}
+
+// reconcile refreshes the progress of an operation and completes it once all of its resources are
+// completed. It returns false if the operation is not reconciled and should be requeued.
+func (oc *OperationController) reconcile(ctx context.Context, id string) (bool, error) {
+	logger := klog.FromContext(ctx)
+
+	lockOwnerID, acquired, err := oc.lockFactory.NewNonBlockingLock(ctx, id, db.Operations)
+	// Ensure that the transaction related to this lock always end.
+	defer oc.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		return false, fmt.Errorf("error obtaining the operation lock: %v", err)
+	}
+	if !acquired {
+		logger.Info("Operation is reconciled by another worker")
+		return false, nil
+	}
+
+	operation, svcErr := oc.operations.Get(ctx, id)
+	if svcErr != nil {
+		if svcErr.Is404() {
+			return true, nil
+		}
+		return false, svcErr
+	}
+	if operation.Done() {
+		return true, nil
+	}
+	if oc.timeout > 0 && time.Since(operation.CreatedAt) > oc.timeout {
+		return oc.fail(ctx, operation, fmt.Sprintf("the operation is not completed in %s", oc.timeout))
+	}
+
+	dispatched, err := oc.syncDispatched(ctx, operation)
+	if err != nil {
+		return false, err
+	}
+	if operation.Type == api.CreateResourceBundleOperationType || operation.Type == api.UpdateResourceBundleOperationType {
+		return oc.reconcileApply(ctx, operation, dispatched)
+	}
+
+	var consumer *api.Consumer
+	if operation.Type == api.DeleteConsumerOperationType {
+		consumer, svcErr = oc.consumers.Get(ctx, operation.TargetID)
+		if svcErr != nil && !svcErr.Is404() {
+			return false, svcErr
+		}
+		if consumer != nil {
+			// the resources that are created for the consumer after the operation is started, e.g. by a
+			// placement, are deleted as well, otherwise the consumer cannot be deleted
+			if err := oc.addConsumerResources(ctx, operation, consumer); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	total := operation.Total
+	completed, err := oc.deleteResources(ctx, operation)
+	if err != nil {
+		return false, err
+	}
+
+	if completed == operation.Total && consumer != nil {
+		logger.Info("All resources of the consumer are deleted, deleting the consumer", "consumer", consumer.Name)
+		if svcErr := oc.consumers.Delete(ctx, consumer.ID); svcErr != nil {
+			// a new resource may be created for the consumer in the meantime, it is deleted in the next reconciliation
+			return false, svcErr
+		}
+	}
+
+	if completed == operation.Completed && operation.Total == total && completed != operation.Total && !dispatched {
+		// neither the progress nor the dispatch time is changed
+		return true, nil
+	}
+	return oc.updateProgress(ctx, operation, completed)
+}
+
+// syncDispatched sets the dispatch time of the operation from its event if it is not recorded when the
+// event is dispatched, e.g. the event is dispatched before the operation is created. It returns true if
+// the dispatch time is changed.
+func (oc *OperationController) syncDispatched(ctx context.Context, operation *api.Operation) (bool, error) {
+	if operation.EventID == "" || operation.DispatchedAt != nil {
+		return false, nil
+	}
+
+	event, svcErr := oc.events.Get(ctx, operation.EventID)
+	if svcErr != nil {
+		if svcErr.Is404() {
+			// the event is already purged
+			return false, nil
+		}
+		return false, svcErr
+	}
+	if event.ReconciledDate == nil {
+		return false, nil
+	}
+
+	operation.DispatchedAt = event.ReconciledDate
+	return true, nil
+}
+
+// reconcileApply completes the creation or update of a resource once its consumer reports that the
+// version of the operation, or a later one, is applied. The operation fails if the resource is deleted
+// before it is applied.
+func (oc *OperationController) reconcileApply(ctx context.Context, operation *api.Operation, changed bool) (bool, error) {
+	resource, svcErr := oc.resources.Get(ctx, operation.TargetID)
+	if svcErr != nil && !svcErr.Is404() {
+		return false, svcErr
+	}
+
+	if resource == nil {
+		return oc.fail(ctx, operation, "the resource is deleted before it is applied")
+	}
+
+	applied, message := resourceApplied(resource, operation.ResourceVersion)
+	if applied {
+		operation.Message = ""
+		return oc.updateProgress(ctx, operation, operation.Total)
+	}
+	if message != operation.Message {
+		operation.Message = message
+		changed = true
+	}
+
+	if !changed {
+		return true, nil
+	}
+	if _, svcErr := oc.operations.Replace(ctx, operation); svcErr != nil {
+		return false, svcErr
+	}
+	return true, nil
+}
+
+func (oc *OperationController) addConsumerResources(ctx context.Context, operation *api.Operation, consumer *api.Consumer) error {
+	resources, svcErr := oc.resources.FindByConsumerName(ctx, consumer.Name)
+	if svcErr != nil {
+		return svcErr
+	}
+	resourceIDs := []string{}
+	for _, resource := range resources {
+		resourceIDs = append(resourceIDs, resource.ID)
+	}
+	if svcErr := oc.operations.AddResources(ctx, operation.ID, resourceIDs); svcErr != nil {
+		return svcErr
+	}
+	return nil
+}
+
+// deleteResources marks the resources of the operation as deleting and completes the resources that
+// are removed. It returns the number of the completed resources of the operation, and updates the total
+// number of the resources of the operation.
+func (oc *OperationController) deleteResources(ctx context.Context, operation *api.Operation) (int32, error) {
+	logger := klog.FromContext(ctx)
+
+	operationResources, svcErr := oc.operations.FindResources(ctx, operation.ID)
+	if svcErr != nil {
+		return 0, svcErr
+	}
+	operation.Total = int32(len(operationResources))
+
+	completed := int32(0)
+	pendingIDs := []string{}
+	for _, operationResource := range operationResources {
+		if operationResource.CompletedAt != nil {
+			completed++
+			continue
+		}
+		pendingIDs = append(pendingIDs, operationResource.ResourceID)
+	}
+	if len(pendingIDs) == 0 {
+		return completed, nil
+	}
+
+	// the resources under deletion are found as well
+	resources, svcErr := oc.resources.FindByIDs(ctx, pendingIDs)
+	if svcErr != nil {
+		return 0, svcErr
+	}
+
+	errs := []error{}
+	existing := map[string]bool{}
+	for _, resource := range resources {
+		existing[resource.ID] = true
+		if !resource.DeletedAt.Time.IsZero() {
+			continue
+		}
+
+		logger.Info("Deleting the resource of the operation", "resourceID", resource.ID, "consumer", resource.ConsumerName)
+		if svcErr := oc.resources.MarkAsDeleting(ctx, resource.ID); svcErr != nil {
+			errs = append(errs, svcErr)
+		}
+	}
+
+	// the resources that no longer exist are deleted from their consumers
+	deletedIDs := []string{}
+	for _, id := range pendingIDs {
+		if !existing[id] {
+			deletedIDs = append(deletedIDs, id)
+		}
+	}
+	if svcErr := oc.operations.CompleteResources(ctx, operation.ID, deletedIDs); svcErr != nil {
+		errs = append(errs, svcErr)
+	} else {
+		completed += int32(len(deletedIDs))
+	}
+
+	return completed, utilerrors.NewAggregate(errs)
+}
+
+// resourceApplied returns true if the consumer reports that the given version, or a later one, of the
+// resource is applied. Otherwise it returns the message of the failed apply condition if there is one.
+func resourceApplied(resource *api.Resource, version int32) (bool, string) {
+	status, err := api.DecodeResourceBundleStatus(resource.Status)
+	if err != nil || status == nil || status.ManifestBundleStatus == nil {
+		return false, ""
+	}
+	if status.ObservedVersion < version {
+		return false, ""
+	}
+
+	condition := meta.FindStatusCondition(status.Conditions, workv1.WorkApplied)
+	if condition == nil {
+		return false, ""
+	}
+	if condition.Status == metav1.ConditionTrue {
+		return true, ""
+	}
+	return false, condition.Message
+}
+
+func (oc *OperationController) updateProgress(ctx context.Context, operation *api.Operation, completed int32) (bool, error) {
+	operation.Completed = completed
+	if completed == operation.Total {
+		now := time.Now()
+		operation.Phase = api.OperationSucceeded
+		operation.CompletedAt = &now
+		klog.FromContext(ctx).Info("Operation is succeeded", "type", operation.Type, "resources", operation.Total)
+	}
+
+	if _, svcErr := oc.operations.Replace(ctx, operation); svcErr != nil {
+		return false, svcErr
+	}
+	return true, nil
+}
+
+// fail completes the operation as failed with the message.
+func (oc *OperationController) fail(ctx context.Context, operation *api.Operation, message string) (bool, error) {
+	now := time.Now()
+	operation.Phase = api.OperationFailed
+	operation.Message = message
+	operation.CompletedAt = &now
+	klog.FromContext(ctx).Info("Operation is failed", "type", operation.Type, "message", message)
+
+	if _, svcErr := oc.operations.Replace(ctx, operation); svcErr != nil {
+		return false, svcErr
+	}
+	return true, nil
+}
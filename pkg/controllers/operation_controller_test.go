@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+func TestOperationDeleteConsumer(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	operationDao := mocks.NewOperationDao()
+	resourceDao := mocks.NewResourceDao()
+	consumerDao := mocks.NewConsumerDao()
+	operations := services.NewOperationService(operationDao, resourceDao, mocks.NewEventDao())
+	resources := services.NewResourceService(lockFactory, resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+	consumers := services.NewConsumerService(consumerDao)
+	oc := NewOperationController(operations, resources, consumers, services.NewEventService(mocks.NewEventDao()),
+		lockFactory, 0)
+
+	consumer, err := consumerDao.Create(ctx, &api.Consumer{Meta: api.Meta{ID: api.NewID()}, Name: "cluster1"})
+	Expect(err).To(BeNil())
+	for _, id := range []string{"resource1", "resource2"} {
+		_, err := resourceDao.Create(ctx, &api.Resource{Meta: api.Meta{ID: id}, ConsumerName: consumer.Name})
+		Expect(err).To(BeNil())
+	}
+
+	operation, svcErr := operations.DeleteConsumer(ctx, consumer)
+	Expect(svcErr).To(BeNil())
+	Expect(operation.Type).To(Equal(api.DeleteConsumerOperationType))
+	Expect(operation.Phase).To(Equal(api.OperationRunning))
+	Expect(operation.Total).To(Equal(int32(2)))
+
+	// the running deletion of the consumer is returned for the same consumer
+	running, svcErr := operations.DeleteConsumer(ctx, consumer)
+	Expect(svcErr).To(BeNil())
+	Expect(running.ID).To(Equal(operation.ID))
+
+	// the resources of the consumer are marked as deleting
+	reconcileOperation(ctx, oc, operation.ID)
+	Expect(deletingResources(ctx, resourceDao, consumer.Name)).To(ConsistOf("resource1", "resource2"))
+	Expect(operationProgress(ctx, operations, operation.ID)).To(Equal([]int32{0, 2}))
+
+	// the deletion of a resource is acknowledged
+	Expect(resourceDao.Delete(ctx, "resource1", true)).To(BeNil())
+	reconcileOperation(ctx, oc, operation.ID)
+	Expect(operationProgress(ctx, operations, operation.ID)).To(Equal([]int32{1, 2}))
+
+	// a resource that is created for the consumer after the operation is started is deleted as well
+	_, err = resourceDao.Create(ctx, &api.Resource{Meta: api.Meta{ID: "resource3"}, ConsumerName: consumer.Name})
+	Expect(err).To(BeNil())
+	reconcileOperation(ctx, oc, operation.ID)
+	Expect(deletingResources(ctx, resourceDao, consumer.Name)).To(ConsistOf("resource2", "resource3"))
+	Expect(operationProgress(ctx, operations, operation.ID)).To(Equal([]int32{1, 3}))
+
+	// the consumer is deleted once the deletion of all of its resources is acknowledged
+	Expect(resourceDao.Delete(ctx, "resource2", true)).To(BeNil())
+	Expect(resourceDao.Delete(ctx, "resource3", true)).To(BeNil())
+	reconcileOperation(ctx, oc, operation.ID)
+	_, svcErr = consumers.Get(ctx, consumer.ID)
+	Expect(svcErr).NotTo(BeNil())
+	Expect(svcErr.Is404()).To(BeTrue())
+
+	operation, svcErr = operations.Get(ctx, operation.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(operation.Phase).To(Equal(api.OperationSucceeded))
+	Expect(operation.Completed).To(Equal(int32(3)))
+	Expect(operation.CompletedAt).NotTo(BeNil())
+}
+
+func TestOperationDeleteResources(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	resourceDao := mocks.NewResourceDao()
+	operations := services.NewOperationService(mocks.NewOperationDao(), resourceDao, mocks.NewEventDao())
+	resources := services.NewResourceService(lockFactory, resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+	oc := NewOperationController(operations, resources, services.NewConsumerService(mocks.NewConsumerDao()),
+		services.NewEventService(mocks.NewEventDao()), lockFactory, 0)
+
+	// the deletion without resources is succeeded immediately
+	operation, svcErr := operations.DeleteResources(ctx, "consumer_name = 'cluster2'", nil)
+	Expect(svcErr).To(BeNil())
+	Expect(operation.Phase).To(Equal(api.OperationSucceeded))
+
+	for _, id := range []string{"resource1", "resource2"} {
+		_, err := resourceDao.Create(ctx, &api.Resource{Meta: api.Meta{ID: id}, ConsumerName: "cluster1"})
+		Expect(err).To(BeNil())
+	}
+	operation, svcErr = operations.DeleteResources(ctx, "consumer_name = 'cluster1'", []string{"resource1"})
+	Expect(svcErr).To(BeNil())
+	Expect(operation.Type).To(Equal(api.DeleteResourceBundlesOperationType))
+	Expect(operation.Search).To(Equal("consumer_name = 'cluster1'"))
+
+	// only the resources of the operation are deleted
+	reconcileOperation(ctx, oc, operation.ID)
+	Expect(deletingResources(ctx, resourceDao, "cluster1")).To(ConsistOf("resource1"))
+
+	Expect(resourceDao.Delete(ctx, "resource1", true)).To(BeNil())
+	// only the operation of the deleted resource is requeued
+	Expect(oc.OnStatusDelete(ctx, "event1", "resource1")).To(BeNil())
+	Expect(oc.queue.Len()).To(Equal(1))
+	key, _ := oc.queue.Get()
+	Expect(key).To(Equal(operation.ID))
+	oc.queue.Done(key)
+	Expect(oc.OnStatusDelete(ctx, "event2", "resource2")).To(BeNil())
+	Expect(oc.queue.Len()).To(Equal(0))
+	reconcileOperation(ctx, oc, operation.ID)
+	operation, svcErr = operations.Get(ctx, operation.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(operation.Phase).To(Equal(api.OperationSucceeded))
+	Expect(operationProgress(ctx, operations, operation.ID)).To(Equal([]int32{1, 1}))
+}
+
+func TestOperationTimeout(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	operationDao := mocks.NewOperationDao()
+	resourceDao := mocks.NewResourceDao()
+	operations := services.NewOperationService(operationDao, resourceDao, mocks.NewEventDao())
+	resources := services.NewResourceService(lockFactory, resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+	oc := NewOperationController(operations, resources, services.NewConsumerService(mocks.NewConsumerDao()),
+		services.NewEventService(mocks.NewEventDao()), lockFactory, time.Hour)
+
+	_, err := resourceDao.Create(ctx, &api.Resource{Meta: api.Meta{ID: "resource1"}, ConsumerName: "cluster1"})
+	Expect(err).To(BeNil())
+	for _, operation := range []*api.Operation{
+		{Meta: api.Meta{ID: "stuck", CreatedAt: time.Now().Add(-2 * time.Hour)}},
+		{Meta: api.Meta{ID: "recent", CreatedAt: time.Now()}},
+	} {
+		operation.Type = api.DeleteResourceBundlesOperationType
+		operation.Phase = api.OperationRunning
+		operation.Total = 1
+		_, err := operationDao.Create(ctx, operation)
+		Expect(err).To(BeNil())
+		Expect(operationDao.AddResources(ctx, operation.ID, []string{"resource1"})).To(BeNil())
+	}
+
+	// the operation that is running longer than the timeout fails
+	reconcileOperation(ctx, oc, "stuck")
+	operation, svcErr := operations.Get(ctx, "stuck")
+	Expect(svcErr).To(BeNil())
+	Expect(operation.Phase).To(Equal(api.OperationFailed))
+	Expect(operation.Message).To(Equal("the operation is not completed in 1h0m0s"))
+	Expect(operation.CompletedAt).NotTo(BeNil())
+
+	reconcileOperation(ctx, oc, "recent")
+	operation, svcErr = operations.Get(ctx, "recent")
+	Expect(svcErr).To(BeNil())
+	Expect(operation.Phase).To(Equal(api.OperationRunning))
+}
+
+func reconcileOperation(ctx context.Context, oc *OperationController, id string) {
+	reconciled, err := oc.reconcile(ctx, id)
+	Expect(err).To(BeNil())
+	Expect(reconciled).To(BeTrue())
+}
+
+func deletingResources(ctx context.Context, resourceDao dao.ResourceDao, consumer string) []string {
+	resources, err := resourceDao.FindByConsumerName(ctx, consumer)
+	Expect(err).To(BeNil())
+	deleting := []string{}
+	for _, resource := range resources {
+		if !resource.DeletedAt.Time.IsZero() {
+			deleting = append(deleting, resource.ID)
+		}
+	}
+	return deleting
+}
+
+// operationProgress returns the number of the completed resources and the total number of the resources of an operation.
+func operationProgress(ctx context.Context, operations services.OperationService, id string) []int32 {
+	operation, svcErr := operations.Get(ctx, id)
+	Expect(svcErr).To(BeNil())
+	return []int32{operation.Completed, operation.Total}
+}
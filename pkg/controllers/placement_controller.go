@@ -0,0 +1,491 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+const PlacementID ControllerHandlerContextKey = "placement"
+
+// resyncPlacementsKey is the queue key that requeues all placements, it is added periodically and
+// whenever a consumer is created or updated, since the consumer may gain or lose matching labels.
+const resyncPlacementsKey = ""
+
+// defaultPlacementResyncPeriod is the period to reconcile all placements.
+var defaultPlacementResyncPeriod = 1 * time.Minute
+
+// PlacementController materializes the resources of the placements. For each placement it creates a
+// resource from the placement template for every consumer that matches the consumer selector, updates
+// the resources when the template changes, marks the resources of the consumers that no longer match
+// as deleting, and aggregates the rollout status of the resources back on the placement.
+//
+// Multiple maestro instances run the controller, a placement is reconciled by one of them at a time
+// with a fail-fast advisory lock on the placement id.
+type PlacementController struct {
+	placements  services.PlacementService
+	resources   services.ResourceService
+	consumers   services.ConsumerService
+	lockFactory db.LockFactory
+	queue       workqueue.TypedRateLimitingInterface[string]
+	// progressDeadline is the time after which a rollout whose updated resources are not available and that
+	// makes no progress is marked as stalled, a rollout is never marked as stalled if it is 0.
+	progressDeadline time.Duration
+}
+
+func NewPlacementController(placements services.PlacementService,
+	resources services.ResourceService,
+	consumers services.ConsumerService,
+	lockFactory db.LockFactory,
+	progressDeadline time.Duration) *PlacementController {
+	return &PlacementController{
+		placements:       placements,
+		resources:        resources,
+		consumers:        consumers,
+		lockFactory:      lockFactory,
+		progressDeadline: progressDeadline,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name:            "placement-controller",
+				MetricsProvider: prometheusMetricsProvider{},
+			},
+		),
+	}
+}
+
+// AddPlacement adds a placement to the queue to be reconciled.
+func (pc *PlacementController) AddPlacement(id string) {
+	pc.queue.Add(id)
+}
+
+// AddConsumer requeues all placements when a consumer is created or updated.
+func (pc *PlacementController) AddConsumer(id string) {
+	pc.queue.Add(resyncPlacementsKey)
+}
+
+// OnStatusUpdate requeues the placement of a resource when the status of the resource is updated, so
+// that the rollout status of the placement is refreshed and the next wave of a progressive rollout
+// is started once the resources of the current wave are available.
+func (pc *PlacementController) OnStatusUpdate(ctx context.Context, eventID, resourceID string) error {
+	resource, svcErr := pc.resources.Get(ctx, resourceID)
+	if svcErr != nil {
+		if svcErr.Is404() {
+			// the resource is already deleted, its placement is requeued by the periodic resync
+			return nil
+		}
+		return svcErr
+	}
+
+	if resource.PlacementID != "" {
+		pc.queue.Add(resource.PlacementID)
+	}
+	return nil
+}
+
+func (pc *PlacementController) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting placement controller")
+	defer pc.queue.ShutDown()
+
+	// use a jitter to avoid multiple instances syncing the placements at the same time
+	go wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
+		pc.queue.Add(resyncPlacementsKey)
+	}, defaultPlacementResyncPeriod, 0.25, true)
+
+	// the .Until will re-kick the runWorker one second after the runWorker completes
+	go wait.UntilWithContext(ctx, pc.runWorker, time.Second)
+
+	// wait until we're told to stop
+	<-ctx.Done()
+	logger.Info("Shutting down placement controller")
+}
+
+func (pc *PlacementController) runWorker(ctx context.Context) {
+	// hot loop until we're told to stop. processNextPlacement will automatically wait until there's work available,
+	// so we don't worry about secondary waits
+	for pc.processNextPlacement(ctx) {
+	}
+}
+
+// processNextPlacement deals with one key off the queue.
+func (pc *PlacementController) processNextPlacement(ctx context.Context) bool {
+	key, quit := pc.queue.Get()
+	if quit {
+		// the current queue is shutdown and becomes empty, quit this process
+		return false
+	}
+	defer pc.queue.Done(key)
+
+	if key == resyncPlacementsKey {
+		if err := pc.resync(ctx); err != nil {
+			klog.FromContext(ctx).Error(err, "Failed to resync the placements")
+			pc.queue.AddRateLimited(key)
+			return true
+		}
+		pc.queue.Forget(key)
+		return true
+	}
+
+	logger := klog.FromContext(ctx).WithValues(PlacementID, key)
+	if reconciled, err := pc.reconcile(klog.NewContext(ctx, logger), key); !reconciled {
+		if err != nil {
+			logger.Error(err, "Failed to reconcile the placement")
+		}
+
+		// the placement is not reconciled, we requeue it to work on later
+		// this method will add a backoff to avoid hotlooping on particular items
+		pc.queue.AddRateLimited(key)
+		return true
+	}
+
+	pc.queue.Forget(key)
+	return true
+}
+
+// resync adds all placements to the queue, including the deleted placements that still have resources.
+func (pc *PlacementController) resync(ctx context.Context) error {
+	placements, svcErr := pc.placements.All(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+	for _, placement := range placements {
+		pc.queue.Add(placement.ID)
+	}
+
+	placementIDs, svcErr := pc.resources.FindPlacementIDs(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+	for _, id := range placementIDs {
+		pc.queue.Add(id)
+	}
+	return nil
+}
+
+// reconcile reconciles the resources and the rollout status of a placement. It returns false if the
+// placement is not reconciled and should be requeued.
+func (pc *PlacementController) reconcile(ctx context.Context, id string) (bool, error) {
+	logger := klog.FromContext(ctx)
+
+	lockOwnerID, acquired, err := pc.lockFactory.NewNonBlockingLock(ctx, id, db.Placements)
+	// Ensure that the transaction related to this lock always end.
+	defer pc.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		return false, fmt.Errorf("error obtaining the placement lock: %v", err)
+	}
+	if !acquired {
+		logger.Info("Placement is reconciled by another worker")
+		return false, nil
+	}
+
+	resources, svcErr := pc.resources.FindByPlacementID(ctx, id)
+	if svcErr != nil {
+		return false, svcErr
+	}
+
+	placement, svcErr := pc.placements.Get(ctx, id)
+	if svcErr != nil {
+		if !svcErr.Is404() {
+			return false, svcErr
+		}
+
+		// the placement is deleted, delete all of its resources
+		logger.Info("Placement is deleted, deleting its resources", "resources", len(resources))
+		errs := []error{}
+		for _, resource := range resources {
+			if !resource.DeletedAt.Time.IsZero() {
+				continue
+			}
+			if svcErr := pc.resources.MarkAsDeleting(ctx, resource.ID); svcErr != nil {
+				errs = append(errs, svcErr)
+			}
+		}
+		return len(errs) == 0, utilerrors.NewAggregate(errs)
+	}
+
+	if err := pc.syncResources(ctx, placement, resources); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (pc *PlacementController) syncResources(ctx context.Context, placement *api.Placement, resources api.ResourceList) error {
+	logger := klog.FromContext(ctx)
+
+	selector, err := placement.Selector()
+	if err != nil {
+		return err
+	}
+	strategy, err := placement.Rollout()
+	if err != nil {
+		return err
+	}
+	template, err := api.DecodeManifestBundle(placement.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode placement template: %v", err)
+	}
+
+	consumers, svcErr := pc.consumers.All(ctx)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	desired := map[string]bool{}
+	for _, consumer := range consumers {
+		var consumerLabels labels.Set
+		if consumer.Labels != nil {
+			consumerLabels = labels.Set(*consumer.Labels)
+		}
+		if selector.Matches(consumerLabels) {
+			desired[consumer.Name] = true
+		}
+	}
+
+	previous, err := api.DecodePlacementStatus(placement.Status)
+	if err != nil {
+		return err
+	}
+	status := &api.PlacementStatus{
+		Desired:         int32(len(desired)),
+		ObservedVersion: placement.Version,
+	}
+	// acknowledged are the consumers whose failed resources paused the rollout of the same placement version
+	// before it is resumed, they neither pause the rollout again nor hold back its next wave.
+	acknowledged := map[string]bool{}
+	if previous.ObservedVersion == placement.Version {
+		for _, consumer := range previous.PausedFailures {
+			acknowledged[consumer] = true
+		}
+	}
+
+	errs := []error{}
+	existing := map[string]bool{}
+	// pending are the resources to be created or updated with the current template, they are
+	// created or updated in the waves of the rollout.
+	pending := []pendingResource{}
+	failures := []string{}
+	// unavailable are the consumers of the updated resources that are not available, except the acknowledged ones
+	unavailable := []string{}
+	// waiting is the number of the updated resources that the next wave waits for to be available
+	var waiting int32
+	for _, resource := range resources {
+		existing[resource.ConsumerName] = true
+		status.Current++
+
+		// the resource is under deletion, a new resource is created once it is deleted if the consumer still matches
+		if !resource.DeletedAt.Time.IsZero() {
+			continue
+		}
+
+		if !desired[resource.ConsumerName] {
+			logger.Info("Consumer does not match the placement, deleting the resource",
+				"consumer", resource.ConsumerName, "resourceID", resource.ID)
+			if svcErr := pc.resources.MarkAsDeleting(ctx, resource.ID); svcErr != nil {
+				errs = append(errs, svcErr)
+			}
+			continue
+		}
+
+		payload, err := api.NewManifestBundle(placement.Source, resource.ID, template)
+		if err != nil {
+			return fmt.Errorf("failed to create manifest bundle: %v", err)
+		}
+		diff, err := api.DiffManifestBundles(resource.Payload, payload)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if diff.Changed() {
+			pending = append(pending, pendingResource{resource: &api.Resource{
+				Meta:         api.Meta{ID: resource.ID},
+				ConsumerName: resource.ConsumerName,
+				Version:      resource.Version,
+				Payload:      payload,
+			}})
+			continue
+		}
+
+		status.Updated++
+		rollout := api.ResourceBundleRolloutOf(resource)
+		if rollout.Applied {
+			status.Applied++
+			if rollout.Available {
+				status.Available++
+			}
+		}
+		if rollout.Failed {
+			status.Failed++
+			failures = append(failures, resource.ConsumerName)
+		}
+		if rollout.Applied && rollout.Available || rollout.Failed && acknowledged[resource.ConsumerName] {
+			continue
+		}
+		waiting++
+		if !rollout.Available {
+			unavailable = append(unavailable, resource.ConsumerName)
+		}
+	}
+
+	for _, consumer := range consumers {
+		if !desired[consumer.Name] || existing[consumer.Name] {
+			continue
+		}
+
+		id := api.NewID()
+		payload, err := api.NewManifestBundle(placement.Source, id, template)
+		if err != nil {
+			return fmt.Errorf("failed to create manifest bundle: %v", err)
+		}
+		pending = append(pending, pendingResource{create: true, resource: &api.Resource{
+			Meta:         api.Meta{ID: id},
+			ConsumerName: consumer.Name,
+			Source:       placement.Source,
+			PlacementID:  placement.ID,
+			Payload:      payload,
+		}})
+	}
+
+	phase := placement.RolloutPhase
+	if phase == "" || phase == api.RolloutCompleted {
+		phase = api.RolloutProgressing
+	}
+	sort.Strings(failures)
+	newFailures := []string{}
+	for _, consumer := range failures {
+		if !acknowledged[consumer] {
+			newFailures = append(newFailures, consumer)
+		}
+	}
+	if phase == api.RolloutProgressing && strategy.Type == api.ProgressiveRolloutStrategyType && len(newFailures) > 0 {
+		logger.Info("Pausing the rollout of the placement, the resources failed on the consumers", "consumers", newFailures)
+		phase = api.RolloutPaused
+		status.Message = fmt.Sprintf("the rollout is paused, the resources failed on the consumers: %s", strings.Join(failures, ", "))
+	}
+	// the failures of a paused rollout are acknowledged when it is resumed, the acknowledged failures are kept
+	// while the resources are still failing
+	for _, consumer := range failures {
+		if phase == api.RolloutPaused || acknowledged[consumer] {
+			status.PausedFailures = append(status.PausedFailures, consumer)
+		}
+	}
+
+	// create or update the resources of the next wave, the resources are sorted by consumer name so
+	// that the waves are deterministic across the reconciliations.
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].resource.ConsumerName < pending[j].resource.ConsumerName
+	})
+	wave := pending
+	switch {
+	case phase != api.RolloutProgressing:
+		wave = nil
+	case strategy.Type == api.ProgressiveRolloutStrategyType:
+		// the next wave starts once all of the updated resources are available on their consumers
+		if waiting > 0 {
+			wave = nil
+		} else if int32(len(wave)) > strategy.BatchSize {
+			wave = wave[:strategy.BatchSize]
+		}
+	}
+
+	for _, p := range wave {
+		resource := p.resource
+		if p.create {
+			logger.Info("Creating the resource for the consumer", "consumer", resource.ConsumerName, "resourceID", resource.ID)
+			if _, svcErr := pc.resources.Create(ctx, resource); svcErr != nil {
+				errs = append(errs, svcErr)
+				continue
+			}
+			status.Current++
+			status.Updated++
+			continue
+		}
+
+		logger.Info("Updating the resource with the placement template",
+			"consumer", resource.ConsumerName, "resourceID", resource.ID)
+		if _, svcErr := pc.resources.Update(ctx, resource); svcErr != nil {
+			errs = append(errs, svcErr)
+			continue
+		}
+		status.Updated++
+	}
+
+	if phase == api.RolloutProgressing && len(pending) == 0 &&
+		status.Updated == status.Desired && status.Available == status.Desired {
+		phase = api.RolloutCompleted
+	}
+	pc.syncProgress(ctx, phase, previous, status, waiting, unavailable)
+
+	if err := pc.updateStatus(ctx, placement, phase, status); err != nil {
+		errs = append(errs, err)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// syncProgress records the last time the rollout made progress, and marks the rollout as stalled if it waits for
+// its updated resources to be available and it makes no progress within the progress deadline, e.g. when the next
+// wave of a progressive rollout never starts since a consumer never reports its resource as available.
+func (pc *PlacementController) syncProgress(ctx context.Context, phase api.RolloutPhase,
+	previous, status *api.PlacementStatus, waiting int32, unavailable []string) {
+	now := time.Now()
+	status.ProgressedAt = previous.ProgressedAt
+	if status.ProgressedAt == nil || status.ObservedVersion != previous.ObservedVersion ||
+		status.Updated > previous.Updated || status.Available > previous.Available {
+		status.ProgressedAt = &now
+	}
+
+	if pc.progressDeadline <= 0 || phase != api.RolloutProgressing || waiting == 0 ||
+		now.Sub(*status.ProgressedAt) < pc.progressDeadline {
+		return
+	}
+	sort.Strings(unavailable)
+	if !previous.Stalled {
+		klog.FromContext(ctx).Info("The rollout of the placement is stalled, the resources are not available on the consumers",
+			"consumers", unavailable, "progressedAt", status.ProgressedAt)
+	}
+	status.Stalled = true
+	status.Message = fmt.Sprintf("the rollout is stalled, the resources are not available on the consumers since %s: %s",
+		status.ProgressedAt.UTC().Format(time.RFC3339), strings.Join(unavailable, ", "))
+}
+
+func (pc *PlacementController) updateStatus(ctx context.Context, placement *api.Placement, phase api.RolloutPhase, status *api.PlacementStatus) error {
+	if phase == api.RolloutPaused && status.Message == "" {
+		status.Message = "the rollout is paused"
+	}
+	statusJSON, err := api.EncodePlacementStatus(status)
+	if err != nil {
+		return fmt.Errorf("failed to encode placement status: %v", err)
+	}
+
+	// neither the status nor the rollout phase is changed, the update status action is not needed.
+	if reflect.DeepEqual(statusJSON, placement.Status) && phase == placement.RolloutPhase {
+		return nil
+	}
+
+	placement.Status = statusJSON
+	placement.RolloutPhase = phase
+	if _, svcErr := pc.placements.UpdateStatus(ctx, placement); svcErr != nil {
+		return svcErr
+	}
+	return nil
+}
+
+// pendingResource is a resource to be created or updated with the current placement template.
+type pendingResource struct {
+	resource *api.Resource
+	create   bool
+}
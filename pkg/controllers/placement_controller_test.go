@@ -0,0 +1,476 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	"github.com/openshift-online/maestro/pkg/db"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+func TestPlacementReconcile(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	placementDao := mocks.NewPlacementDao()
+	resourceDao := mocks.NewResourceDao()
+	consumerDao := mocks.NewConsumerDao()
+	placements := services.NewPlacementService(lockFactory, placementDao)
+	resources := services.NewResourceService(lockFactory, resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+	consumers := services.NewConsumerService(consumerDao)
+	pc := NewPlacementController(placements, resources, consumers, lockFactory, 0)
+
+	for name, env := range map[string]string{"cluster1": "prod", "cluster2": "prod", "cluster3": "dev"} {
+		_, err := consumerDao.Create(ctx, &api.Consumer{
+			Meta:   api.Meta{ID: name},
+			Name:   name,
+			Labels: db.EmptyMapToNilStringMap(&map[string]string{"env": env}),
+		})
+		Expect(err).To(BeNil())
+	}
+
+	placementID := api.NewID()
+	payload, err := api.NewManifestBundle("maestro", placementID, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap("v1")},
+	})
+	Expect(err).To(BeNil())
+	placement, svcErr := placements.Create(ctx, &api.Placement{
+		Meta:    api.Meta{ID: placementID},
+		Name:    "prod-placement",
+		Source:  "maestro",
+		Version: 1,
+		ConsumerSelector: map[string]interface{}{
+			"matchLabels": map[string]interface{}{"env": "prod"},
+		},
+		Payload: payload,
+	})
+	Expect(svcErr).To(BeNil())
+
+	// a resource is created for each matching consumer
+	reconciled, err := pc.reconcile(ctx, placement.ID)
+	Expect(err).To(BeNil())
+	Expect(reconciled).To(BeTrue())
+	placementResources, err := resourceDao.FindByPlacementID(ctx, placement.ID)
+	Expect(err).To(BeNil())
+	Expect(placementResources).To(HaveLen(2))
+	for _, resource := range placementResources {
+		Expect(resource.ConsumerName).To(BeElementOf("cluster1", "cluster2"))
+		Expect(resource.Source).To(Equal("maestro"))
+	}
+	Expect(rolloutCounts(ctx, placements, placement.ID)).To(Equal(&api.PlacementStatus{
+		Desired: 2, Current: 2, Updated: 2, ObservedVersion: 1,
+	}))
+
+	// the resource of a consumer that no longer matches is deleted
+	_, err = consumerDao.Replace(ctx, &api.Consumer{
+		Meta:   api.Meta{ID: "cluster2"},
+		Name:   "cluster2",
+		Labels: db.EmptyMapToNilStringMap(&map[string]string{"env": "dev"}),
+	})
+	Expect(err).To(BeNil())
+	reconciled, err = pc.reconcile(ctx, placement.ID)
+	Expect(err).To(BeNil())
+	Expect(reconciled).To(BeTrue())
+	for _, resource := range placementResources {
+		Expect(resource.DeletedAt.Time.IsZero()).To(Equal(resource.ConsumerName == "cluster1"))
+	}
+	Expect(rolloutCounts(ctx, placements, placement.ID)).To(Equal(&api.PlacementStatus{
+		Desired: 1, Current: 2, Updated: 1, ObservedVersion: 1,
+	}))
+
+	// the resources are updated with the new template
+	payload, err = api.PatchManifestBundle(placement.Payload, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap("v2")},
+	})
+	Expect(err).To(BeNil())
+	placement, svcErr = placements.Update(ctx, &api.Placement{
+		Meta:             api.Meta{ID: placement.ID},
+		Version:          placement.Version,
+		ConsumerSelector: placement.ConsumerSelector,
+		Payload:          payload,
+	})
+	Expect(svcErr).To(BeNil())
+	Expect(placement.Version).To(Equal(int32(2)))
+	reconciled, err = pc.reconcile(ctx, placement.ID)
+	Expect(err).To(BeNil())
+	Expect(reconciled).To(BeTrue())
+	resource, err := resourceDao.FindByConsumerName(ctx, "cluster1")
+	Expect(err).To(BeNil())
+	Expect(resource).To(HaveLen(1))
+	manifestBundle, err := api.DecodeManifestBundle(resource[0].Payload)
+	Expect(err).To(BeNil())
+	Expect(manifestBundle.Manifests).To(HaveLen(1))
+	Expect(manifestBundle.Manifests[0]["data"]).To(Equal(map[string]interface{}{"version": "v2"}))
+
+	// the resources are deleted with the placement
+	Expect(placements.Delete(ctx, placement.ID)).To(BeNil())
+	reconciled, err = pc.reconcile(ctx, placement.ID)
+	Expect(err).To(BeNil())
+	Expect(reconciled).To(BeTrue())
+	placementResources, err = resourceDao.FindByPlacementID(ctx, placement.ID)
+	Expect(err).To(BeNil())
+	for _, resource := range placementResources {
+		Expect(resource.DeletedAt.Time.IsZero()).To(BeFalse())
+	}
+}
+
+func TestPlacementProgressiveRollout(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	placementDao := mocks.NewPlacementDao()
+	resourceDao := mocks.NewResourceDao()
+	consumerDao := mocks.NewConsumerDao()
+	placements := services.NewPlacementService(lockFactory, placementDao)
+	resources := services.NewResourceService(lockFactory, resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+	pc := NewPlacementController(placements, resources, services.NewConsumerService(consumerDao), lockFactory, 0)
+
+	for _, name := range []string{"cluster1", "cluster2", "cluster3"} {
+		_, err := consumerDao.Create(ctx, &api.Consumer{
+			Meta:   api.Meta{ID: name},
+			Name:   name,
+			Labels: db.EmptyMapToNilStringMap(&map[string]string{"env": "prod"}),
+		})
+		Expect(err).To(BeNil())
+	}
+
+	strategy, err := api.EncodeRolloutStrategy(&api.PlacementRolloutStrategy{
+		Type:      api.ProgressiveRolloutStrategyType,
+		BatchSize: 2,
+	})
+	Expect(err).To(BeNil())
+	placementID := api.NewID()
+	payload, err := api.NewManifestBundle("maestro", placementID, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap("v1")},
+	})
+	Expect(err).To(BeNil())
+	placement, svcErr := placements.Create(ctx, &api.Placement{
+		Meta:    api.Meta{ID: placementID},
+		Name:    "prod-placement",
+		Source:  "maestro",
+		Version: 1,
+		ConsumerSelector: map[string]interface{}{
+			"matchLabels": map[string]interface{}{"env": "prod"},
+		},
+		Payload:         payload,
+		RolloutStrategy: strategy,
+		RolloutPhase:    api.RolloutProgressing,
+	})
+	Expect(svcErr).To(BeNil())
+
+	// the first wave creates the resources of two consumers
+	reconcileRollout(ctx, pc, placement.ID)
+	Expect(placementConsumers(ctx, resourceDao, placement.ID)).To(ConsistOf("cluster1", "cluster2"))
+
+	// the next wave waits until the resources of the first wave are available
+	setResourceStatus(ctx, t, resourceDao, "cluster1", metav1.ConditionTrue, metav1.ConditionTrue)
+	reconcileRollout(ctx, pc, placement.ID)
+	Expect(placementConsumers(ctx, resourceDao, placement.ID)).To(ConsistOf("cluster1", "cluster2"))
+	Expect(rolloutCounts(ctx, placements, placement.ID)).To(Equal(&api.PlacementStatus{
+		Desired: 3, Current: 2, Updated: 2, Applied: 1, Available: 1, ObservedVersion: 1,
+	}))
+
+	setResourceStatus(ctx, t, resourceDao, "cluster2", metav1.ConditionTrue, metav1.ConditionTrue)
+	reconcileRollout(ctx, pc, placement.ID)
+	Expect(placementConsumers(ctx, resourceDao, placement.ID)).To(ConsistOf("cluster1", "cluster2", "cluster3"))
+
+	setResourceStatus(ctx, t, resourceDao, "cluster3", metav1.ConditionTrue, metav1.ConditionTrue)
+	reconcileRollout(ctx, pc, placement.ID)
+	found, svcErr := placements.Get(ctx, placement.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(found.RolloutPhase).To(Equal(api.RolloutCompleted))
+
+	// the rollout of a new template is paused automatically when a resource fails
+	payload, err = api.PatchManifestBundle(found.Payload, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap("v2")},
+	})
+	Expect(err).To(BeNil())
+	placement, svcErr = placements.Update(ctx, &api.Placement{
+		Meta:             api.Meta{ID: found.ID},
+		Version:          found.Version,
+		ConsumerSelector: found.ConsumerSelector,
+		Payload:          payload,
+		RolloutStrategy:  found.RolloutStrategy,
+	})
+	Expect(svcErr).To(BeNil())
+	Expect(placement.RolloutPhase).To(Equal(api.RolloutProgressing))
+
+	reconcileRollout(ctx, pc, placement.ID)
+	Expect(placementStatus(ctx, placements, placement.ID).Updated).To(Equal(int32(2)))
+	setResourceStatus(ctx, t, resourceDao, "cluster1", metav1.ConditionFalse, metav1.ConditionFalse)
+	reconcileRollout(ctx, pc, placement.ID)
+	found, svcErr = placements.Get(ctx, placement.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(found.RolloutPhase).To(Equal(api.RolloutPaused))
+	status := placementStatus(ctx, placements, placement.ID)
+	Expect(status.Failed).To(Equal(int32(1)))
+	Expect(status.Message).To(ContainSubstring("cluster1"))
+
+	// the paused rollout can be aborted, but not paused again
+	_, svcErr = placements.SetRolloutPhase(ctx, placement.ID, api.RolloutPaused)
+	Expect(svcErr).NotTo(BeNil())
+	found, svcErr = placements.SetRolloutPhase(ctx, placement.ID, api.RolloutAborted)
+	Expect(svcErr).To(BeNil())
+	Expect(found.RolloutPhase).To(Equal(api.RolloutAborted))
+
+	// no resource is updated after the rollout is aborted
+	setResourceStatus(ctx, t, resourceDao, "cluster1", metav1.ConditionTrue, metav1.ConditionTrue)
+	setResourceStatus(ctx, t, resourceDao, "cluster2", metav1.ConditionTrue, metav1.ConditionTrue)
+	reconcileRollout(ctx, pc, placement.ID)
+	Expect(placementStatus(ctx, placements, placement.ID).Updated).To(Equal(int32(2)))
+}
+
+func TestPlacementResumeWithFailures(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	placementDao := mocks.NewPlacementDao()
+	resourceDao := mocks.NewResourceDao()
+	consumerDao := mocks.NewConsumerDao()
+	placements := services.NewPlacementService(lockFactory, placementDao)
+	resources := services.NewResourceService(lockFactory, resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+	pc := NewPlacementController(placements, resources, services.NewConsumerService(consumerDao), lockFactory, 0)
+
+	for _, name := range []string{"cluster1", "cluster2", "cluster3"} {
+		_, err := consumerDao.Create(ctx, &api.Consumer{
+			Meta:   api.Meta{ID: name},
+			Name:   name,
+			Labels: db.EmptyMapToNilStringMap(&map[string]string{"env": "prod"}),
+		})
+		Expect(err).To(BeNil())
+	}
+
+	strategy, err := api.EncodeRolloutStrategy(&api.PlacementRolloutStrategy{
+		Type:      api.ProgressiveRolloutStrategyType,
+		BatchSize: 1,
+	})
+	Expect(err).To(BeNil())
+	placementID := api.NewID()
+	payload, err := api.NewManifestBundle("maestro", placementID, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap("v1")},
+	})
+	Expect(err).To(BeNil())
+	placement, svcErr := placements.Create(ctx, &api.Placement{
+		Meta:    api.Meta{ID: placementID},
+		Name:    "prod-placement",
+		Source:  "maestro",
+		Version: 1,
+		ConsumerSelector: map[string]interface{}{
+			"matchLabels": map[string]interface{}{"env": "prod"},
+		},
+		Payload:         payload,
+		RolloutStrategy: strategy,
+		RolloutPhase:    api.RolloutProgressing,
+	})
+	Expect(svcErr).To(BeNil())
+
+	// the rollout is paused when the resource of the first wave fails
+	reconcileRollout(ctx, pc, placement.ID)
+	setResourceStatus(ctx, t, resourceDao, "cluster1", metav1.ConditionFalse, metav1.ConditionFalse)
+	reconcileRollout(ctx, pc, placement.ID)
+	found, svcErr := placements.Get(ctx, placement.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(found.RolloutPhase).To(Equal(api.RolloutPaused))
+	Expect(placementStatus(ctx, placements, placement.ID).PausedFailures).To(Equal([]string{"cluster1"}))
+
+	// the resumed rollout proceeds to the next wave while the acknowledged resource is still failing
+	_, svcErr = placements.SetRolloutPhase(ctx, placement.ID, api.RolloutProgressing)
+	Expect(svcErr).To(BeNil())
+	reconcileRollout(ctx, pc, placement.ID)
+	found, svcErr = placements.Get(ctx, placement.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(found.RolloutPhase).To(Equal(api.RolloutProgressing))
+	Expect(placementConsumers(ctx, resourceDao, placement.ID)).To(ConsistOf("cluster1", "cluster2"))
+	status := placementStatus(ctx, placements, placement.ID)
+	Expect(status.Failed).To(Equal(int32(1)))
+	Expect(status.PausedFailures).To(Equal([]string{"cluster1"}))
+
+	// the rollout is paused again on a new failure
+	setResourceStatus(ctx, t, resourceDao, "cluster2", metav1.ConditionFalse, metav1.ConditionFalse)
+	reconcileRollout(ctx, pc, placement.ID)
+	found, svcErr = placements.Get(ctx, placement.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(found.RolloutPhase).To(Equal(api.RolloutPaused))
+	Expect(placementConsumers(ctx, resourceDao, placement.ID)).To(ConsistOf("cluster1", "cluster2"))
+	status = placementStatus(ctx, placements, placement.ID)
+	Expect(status.Message).To(ContainSubstring("cluster2"))
+	Expect(status.PausedFailures).To(Equal([]string{"cluster1", "cluster2"}))
+}
+
+func TestPlacementRolloutStalled(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	placementDao := mocks.NewPlacementDao()
+	resourceDao := mocks.NewResourceDao()
+	consumerDao := mocks.NewConsumerDao()
+	placements := services.NewPlacementService(lockFactory, placementDao)
+	resources := services.NewResourceService(lockFactory, resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+	pc := NewPlacementController(placements, resources, services.NewConsumerService(consumerDao), lockFactory, time.Hour)
+
+	for _, name := range []string{"cluster1", "cluster2"} {
+		_, err := consumerDao.Create(ctx, &api.Consumer{
+			Meta:   api.Meta{ID: name},
+			Name:   name,
+			Labels: db.EmptyMapToNilStringMap(&map[string]string{"env": "prod"}),
+		})
+		Expect(err).To(BeNil())
+	}
+
+	strategy, err := api.EncodeRolloutStrategy(&api.PlacementRolloutStrategy{
+		Type:      api.ProgressiveRolloutStrategyType,
+		BatchSize: 1,
+	})
+	Expect(err).To(BeNil())
+	placementID := api.NewID()
+	payload, err := api.NewManifestBundle("maestro", placementID, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap("v1")},
+	})
+	Expect(err).To(BeNil())
+	placement, svcErr := placements.Create(ctx, &api.Placement{
+		Meta:    api.Meta{ID: placementID},
+		Name:    "prod-placement",
+		Source:  "maestro",
+		Version: 1,
+		ConsumerSelector: map[string]interface{}{
+			"matchLabels": map[string]interface{}{"env": "prod"},
+		},
+		Payload:         payload,
+		RolloutStrategy: strategy,
+		RolloutPhase:    api.RolloutProgressing,
+	})
+	Expect(svcErr).To(BeNil())
+
+	// the first wave is created, the rollout is not stalled within the progress deadline
+	reconcileRollout(ctx, pc, placement.ID)
+	reconcileRollout(ctx, pc, placement.ID)
+	Expect(placementConsumers(ctx, resourceDao, placement.ID)).To(ConsistOf("cluster1"))
+	Expect(placementStatus(ctx, placements, placement.ID).Stalled).To(BeFalse())
+
+	// the rollout makes no progress longer than the progress deadline
+	found, svcErr := placements.Get(ctx, placement.ID)
+	Expect(svcErr).To(BeNil())
+	status, err := api.DecodePlacementStatus(found.Status)
+	Expect(err).To(BeNil())
+	progressedAt := time.Now().Add(-2 * time.Hour)
+	status.ProgressedAt = &progressedAt
+	found.Status, err = api.EncodePlacementStatus(status)
+	Expect(err).To(BeNil())
+	_, svcErr = placements.UpdateStatus(ctx, found)
+	Expect(svcErr).To(BeNil())
+
+	reconcileRollout(ctx, pc, placement.ID)
+	found, svcErr = placements.Get(ctx, placement.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(found.RolloutPhase).To(Equal(api.RolloutProgressing))
+	status = placementStatus(ctx, placements, placement.ID)
+	Expect(status.Stalled).To(BeTrue())
+	Expect(status.Message).To(ContainSubstring("stalled"))
+	Expect(status.Message).To(ContainSubstring("cluster1"))
+
+	// the stalled rollout proceeds once the resource becomes available
+	setResourceStatus(ctx, t, resourceDao, "cluster1", metav1.ConditionTrue, metav1.ConditionTrue)
+	reconcileRollout(ctx, pc, placement.ID)
+	Expect(placementConsumers(ctx, resourceDao, placement.ID)).To(ConsistOf("cluster1", "cluster2"))
+	status = placementStatus(ctx, placements, placement.ID)
+	Expect(status.Stalled).To(BeFalse())
+	Expect(status.Message).To(BeEmpty())
+	Expect(status.ProgressedAt.After(progressedAt)).To(BeTrue())
+}
+
+func reconcileRollout(ctx context.Context, pc *PlacementController, id string) {
+	reconciled, err := pc.reconcile(ctx, id)
+	Expect(err).To(BeNil())
+	Expect(reconciled).To(BeTrue())
+}
+
+func placementConsumers(ctx context.Context, resourceDao dao.ResourceDao, placementID string) []string {
+	resources, err := resourceDao.FindByPlacementID(ctx, placementID)
+	Expect(err).To(BeNil())
+	consumers := []string{}
+	for _, resource := range resources {
+		consumers = append(consumers, resource.ConsumerName)
+	}
+	return consumers
+}
+
+// setResourceStatus sets the status of the current version of the resource on the given consumer.
+func setResourceStatus(ctx context.Context, t *testing.T, resourceDao dao.ResourceDao, consumer string,
+	applied, available metav1.ConditionStatus) {
+	resources, err := resourceDao.FindByConsumerName(ctx, consumer)
+	Expect(err).To(BeNil())
+	Expect(resources).To(HaveLen(1))
+
+	evt := cloudevents.NewEvent()
+	evt.SetID(uuid.NewString())
+	evt.SetSource(consumer + "-work-agent")
+	evt.SetType("io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request")
+	evt.SetExtension(cetypes.ExtensionResourceVersion, resources[0].Version)
+	evt.SetExtension(cetypes.ExtensionStatusUpdateSequenceID, uuid.NewString())
+	if err := evt.SetData(cloudevents.ApplicationJSON, &workpayload.ManifestBundleStatus{
+		Conditions: []metav1.Condition{
+			{Type: workv1.WorkApplied, Status: applied},
+			{Type: workv1.WorkAvailable, Status: available},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	status, err := api.CloudEventToJSONMap(&evt)
+	Expect(err).To(BeNil())
+
+	resources[0].Status = status
+	_, err = resourceDao.UpdateStatus(ctx, resources[0])
+	Expect(err).To(BeNil())
+}
+
+func newConfigMap(version string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+		},
+		"data": map[string]interface{}{
+			"version": version,
+		},
+	}
+}
+
+// rolloutCounts returns the status of the placement without the time of its last progress, the rollout of the
+// placement must have progressed.
+func rolloutCounts(ctx context.Context, placements services.PlacementService, id string) *api.PlacementStatus {
+	status := placementStatus(ctx, placements, id)
+	Expect(status.ProgressedAt).NotTo(BeNil())
+	status.ProgressedAt = nil
+	return status
+}
+
+func placementStatus(ctx context.Context, placements services.PlacementService, id string) *api.PlacementStatus {
+	placement, svcErr := placements.Get(ctx, id)
+	Expect(svcErr).To(BeNil())
+	status, err := api.DecodePlacementStatus(placement.Status)
+	Expect(err).To(BeNil())
+	return status
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// defaultStaleStatusSyncPeriod is the period to detect the stale resources.
+var defaultStaleStatusSyncPeriod = time.Minute
+
+// StaleStatusController detects the resources whose status of the current version is not reported longer than
+// the threshold, e.g. when the agent misses an update of the resource. The stale resources are flagged until
+// their status is reported, and resynced once per threshold while they are stale.
+//
+// Every maestro instance resyncs the stale resources of the consumers that it handles, the resync is skipped if
+// the event server of the instance cannot resync the status.
+type StaleStatusController struct {
+	resources services.ResourceService
+	// resync resyncs the status of the resources from their agents, it is nil if the status cannot be resynced.
+	resync    func(ctx context.Context, resources api.ResourceList) error
+	threshold time.Duration
+
+	// resynced is the last time that each stale resource is resynced.
+	resynced map[string]time.Time
+}
+
+func NewStaleStatusController(resources services.ResourceService,
+	resync func(ctx context.Context, resources api.ResourceList) error,
+	threshold time.Duration) *StaleStatusController {
+	return &StaleStatusController{
+		resources: resources,
+		resync:    resync,
+		threshold: threshold,
+		resynced:  map[string]time.Time{},
+	}
+}
+
+func (sc *StaleStatusController) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting stale status controller", "threshold", sc.threshold)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := sc.sync(ctx); err != nil {
+			logger.Error(err, "Failed to sync the stale status")
+		}
+	}, defaultStaleStatusSyncPeriod)
+
+	logger.Info("Shutting down stale status controller")
+}
+
+// sync flags the stale resources and resyncs the stale resources that are not resynced within the threshold.
+func (sc *StaleStatusController) sync(ctx context.Context) error {
+	stale, svcErr := sc.resources.SyncStale(ctx, sc.threshold)
+	if svcErr != nil {
+		return svcErr
+	}
+	staleStatusResources.Set(float64(len(stale)))
+
+	if sc.resync == nil {
+		return nil
+	}
+
+	now := time.Now()
+	resynced := map[string]time.Time{}
+	toResync := api.ResourceList{}
+	for _, resource := range stale {
+		if last, ok := sc.resynced[resource.ID]; ok && now.Sub(last) < sc.threshold {
+			resynced[resource.ID] = last
+			continue
+		}
+		resynced[resource.ID] = now
+		toResync = append(toResync, resource)
+	}
+	// forget the resources that are no longer stale
+	sc.resynced = resynced
+
+	if len(toResync) == 0 {
+		return nil
+	}
+	klog.FromContext(ctx).Info("Resyncing the stale resources", "count", len(toResync))
+	staleStatusResyncTotal.Add(float64(len(toResync)))
+	return sc.resync(ctx, toResync)
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+func TestStaleStatusSync(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	resourceDao := mocks.NewResourceDao()
+	resources := services.NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDao, mocks.NewResourceRevisionDao(),
+		services.NewEventService(mocks.NewEventDao()), nil, nil)
+
+	longAgo := time.Now().Add(-time.Hour)
+	now := time.Now()
+	for consumer, specUpdatedAt := range map[string]*time.Time{"cluster1": &longAgo, "cluster2": &now, "cluster3": &longAgo} {
+		_, err := resourceDao.Create(ctx, &api.Resource{Meta: api.Meta{ID: consumer}, ConsumerName: consumer, Version: 1,
+			SpecUpdatedAt: specUpdatedAt})
+		Expect(err).To(BeNil())
+	}
+	setResourceStatus(ctx, t, resourceDao, "cluster3", metav1.ConditionTrue, metav1.ConditionTrue)
+
+	resynced := []string{}
+	sc := NewStaleStatusController(resources, func(ctx context.Context, resources api.ResourceList) error {
+		for _, resource := range resources {
+			resynced = append(resynced, resource.ConsumerName)
+		}
+		return nil
+	}, 10*time.Minute)
+
+	// the status of cluster1 is not reported longer than the threshold, cluster2 is updated recently
+	Expect(sc.sync(ctx)).To(BeNil())
+	Expect(resynced).To(Equal([]string{"cluster1"}))
+	stale, err := resourceDao.FindStale(ctx)
+	Expect(err).To(BeNil())
+	Expect(stale).To(HaveLen(1))
+	Expect(stale[0].ID).To(Equal("cluster1"))
+	Expect(stale[0].StaleSince).NotTo(BeNil())
+
+	// the stale resource is not resynced again within the threshold
+	Expect(sc.sync(ctx)).To(BeNil())
+	Expect(resynced).To(HaveLen(1))
+
+	// the stale resource is cleared once its status is reported
+	setResourceStatus(ctx, t, resourceDao, "cluster1", metav1.ConditionTrue, metav1.ConditionTrue)
+	Expect(sc.sync(ctx)).To(BeNil())
+	stale, err = resourceDao.FindStale(ctx)
+	Expect(err).To(BeNil())
+	Expect(stale).To(BeEmpty())
+	Expect(sc.resynced).To(BeEmpty())
+}
@@ -24,16 +24,21 @@ type StatusController struct {
 	instanceDao      dao.InstanceDao
 	eventInstanceDao dao.EventInstanceDao
 	eventsQueue      workqueue.TypedRateLimitingInterface[string]
+	// retention is the minimum time the handled status events are kept, the gRPC subscribers replay the
+	// status events they missed from them.
+	retention time.Duration
 }
 
 func NewStatusController(statusEvents services.StatusEventService,
 	instanceDao dao.InstanceDao,
-	eventInstanceDao dao.EventInstanceDao) *StatusController {
+	eventInstanceDao dao.EventInstanceDao,
+	retention time.Duration) *StatusController {
 	return &StatusController{
 		controllers:      map[api.StatusEventType][]StatusHandlerFunc{},
 		statusEvents:     statusEvents,
 		instanceDao:      instanceDao,
 		eventInstanceDao: eventInstanceDao,
+		retention:        retention,
 		eventsQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{
@@ -169,18 +174,20 @@ func (sc *StatusController) syncStatusEvents(ctx context.Context) {
 	}
 	logger.V(2).Info("purge status events on the ready instances", "readyInstanceIDs", readyInstanceIDs)
 
-	// find the status events that already were dispatched to all ready instances
-	statusEventIDs, err := sc.eventInstanceDao.GetEventsAssociatedWithInstances(ctx, readyInstanceIDs)
+	// find the status events that already were dispatched to all ready instances and are out of the retention,
+	// the retained status events are not returned on each sync
+	before := time.Now().Add(-sc.retention)
+	statusEventIDs, err := sc.eventInstanceDao.GetEventsAssociatedWithInstances(ctx, readyInstanceIDs, before)
 	if err != nil {
 		logger.Error(err, "Failed to find handled status events from db")
 		statusControllerSyncEventOperationsTotal.WithLabelValues(string(controllerSyncEventStatusError)).Inc()
 		return
 	}
 
-	// batch delete the handled status events
+	// batch delete the handled status events that are out of the retention
 	batches := batchStatusEventIDs(statusEventIDs, 500)
 	for _, batch := range batches {
-		if err := sc.statusEvents.DeleteAllEvents(ctx, batch); err != nil {
+		if err := sc.statusEvents.DeleteEventsCreatedBefore(ctx, batch, before); err != nil {
 			logger.Error(err, "Failed to delete handled status events from db")
 			statusControllerSyncEventOperationsTotal.WithLabelValues(string(controllerSyncEventStatusError)).Inc()
 			return
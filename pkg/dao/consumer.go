@@ -2,6 +2,8 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"gorm.io/gorm/clause"
 
@@ -17,8 +19,22 @@ type ConsumerDao interface {
 	FindByIDs(ctx context.Context, ids []string) (api.ConsumerList, error)
 	FindByNames(ctx context.Context, names []string) (api.ConsumerList, error)
 	All(ctx context.Context) (api.ConsumerList, error)
+
+	// MarkSeen marks the consumers of the names as connected and seen at the time.
+	MarkSeen(ctx context.Context, names []string, seenAt time.Time) error
+	// MarkDisconnected marks the consumers of the names as disconnected.
+	MarkDisconnected(ctx context.Context, names []string) error
+	// MarkSilent marks the consumers that are not seen since the time and not yet reported as silent,
+	// and returns them, so that a silent consumer is reported once by one of the maestro instances.
+	MarkSilent(ctx context.Context, before, silencedAt time.Time) (api.ConsumerList, error)
+	// CountConnected returns the number of the connected consumers.
+	CountConnected(ctx context.Context) (int64, error)
 }
 
+// consumersChannel is the channel that is notified when a consumer is created or updated, so that
+// the placements can be reconciled against the consumer labels.
+const consumersChannel = "consumers"
+
 var _ ConsumerDao = &sqlConsumerDao{}
 
 type sqlConsumerDao struct {
@@ -44,15 +60,25 @@ func (d *sqlConsumerDao) Create(ctx context.Context, consumer *api.Consumer) (*a
 		db.MarkForRollback(ctx, err)
 		return nil, err
 	}
+
+	if err := g2.Exec(fmt.Sprintf("select pg_notify('%s', '%s')", consumersChannel, consumer.ID)).Error; err != nil {
+		return nil, err
+	}
 	return consumer, nil
 }
 
 func (d *sqlConsumerDao) Replace(ctx context.Context, consumer *api.Consumer) (*api.Consumer, error) {
 	g2 := (*d.sessionFactory).New(ctx)
-	if err := g2.Omit(clause.Associations).Save(consumer).Error; err != nil {
+	// only the labels are mutable by the users, the liveness columns are owned by the liveness controller and are
+	// not overwritten with the values that are read before the replace
+	if err := g2.Model(consumer).Omit(clause.Associations).Select("labels", "updated_at").Updates(consumer).Error; err != nil {
 		db.MarkForRollback(ctx, err)
 		return nil, err
 	}
+
+	if err := g2.Exec(fmt.Sprintf("select pg_notify('%s', '%s')", consumersChannel, consumer.ID)).Error; err != nil {
+		return nil, err
+	}
 	return consumer, nil
 }
 
@@ -95,3 +121,53 @@ func (d *sqlConsumerDao) All(ctx context.Context) (api.ConsumerList, error) {
 	}
 	return consumers, nil
 }
+
+// The liveness columns are updated without the updated_at and the consumers notification, since they are
+// not changes of the consumers.
+
+func (d *sqlConsumerDao) MarkSeen(ctx context.Context, names []string, seenAt time.Time) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Model(&api.Consumer{}).Where("name in (?)", names).UpdateColumns(map[string]interface{}{
+		"connected":   true,
+		"last_seen":   seenAt,
+		"silenced_at": nil,
+	}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlConsumerDao) MarkDisconnected(ctx context.Context, names []string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Model(&api.Consumer{}).Where("name in (?)", names).UpdateColumn("connected", false).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlConsumerDao) MarkSilent(ctx context.Context, before, silencedAt time.Time) (api.ConsumerList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	consumers := api.ConsumerList{}
+	if err := g2.Model(&consumers).Clauses(clause.Returning{}).
+		// a consumer whose agent is never seen is measured against its creation
+		Where("coalesce(last_seen, created_at) < ? and silenced_at is null", before).
+		UpdateColumns(map[string]interface{}{
+			"connected":   false,
+			"silenced_at": silencedAt,
+		}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return consumers, nil
+}
+
+func (d *sqlConsumerDao) CountConnected(ctx context.Context) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var count int64
+	if err := g2.Model(&api.Consumer{}).Where("connected = ?", true).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,75 @@
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+type ConsumerSetDao interface {
+	Get(ctx context.Context, id string) (*api.ConsumerSet, error)
+	Create(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, error)
+	Replace(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, error)
+	Delete(ctx context.Context, id string) error
+	All(ctx context.Context) (api.ConsumerSetList, error)
+}
+
+var _ ConsumerSetDao = &sqlConsumerSetDao{}
+
+type sqlConsumerSetDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewConsumerSetDao(sessionFactory *db.SessionFactory) ConsumerSetDao {
+	return &sqlConsumerSetDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlConsumerSetDao) Get(ctx context.Context, id string) (*api.ConsumerSet, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var consumerSet api.ConsumerSet
+	if err := g2.Take(&consumerSet, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &consumerSet, nil
+}
+
+func (d *sqlConsumerSetDao) Create(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(consumerSet).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return consumerSet, nil
+}
+
+func (d *sqlConsumerSetDao) Replace(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Save(consumerSet).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return consumerSet, nil
+}
+
+// Delete permanently deletes the consumer set, so that its name can be reused. The consumers in the set
+// and their resources are not affected.
+func (d *sqlConsumerSetDao) Delete(ctx context.Context, id string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Unscoped().Omit(clause.Associations).Delete(&api.ConsumerSet{Meta: api.Meta{ID: id}}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlConsumerSetDao) All(ctx context.Context) (api.ConsumerSetList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	consumerSets := api.ConsumerSetList{}
+	if err := g2.Find(&consumerSets).Error; err != nil {
+		return nil, err
+	}
+	return consumerSets, nil
+}
@@ -16,6 +16,7 @@ type EventDao interface {
 	Replace(ctx context.Context, event *api.Event) (*api.Event, error)
 	Delete(ctx context.Context, id string) error
 	FindByIDs(ctx context.Context, ids []string) (api.EventList, error)
+	FindLatest(ctx context.Context, source, sourceID string, eventType api.EventType) (*api.Event, error)
 	All(ctx context.Context) (api.EventList, error)
 
 	DeleteAllReconciledEvents(ctx context.Context) error
@@ -94,6 +95,17 @@ func (d *sqlEventDao) FindByIDs(ctx context.Context, ids []string) (api.EventLis
 	return events, nil
 }
 
+// FindLatest returns the latest event of the given type for the source object.
+func (d *sqlEventDao) FindLatest(ctx context.Context, source, sourceID string, eventType api.EventType) (*api.Event, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var event api.Event
+	if err := g2.Where("source = ? AND source_id = ? AND event_type = ?", source, sourceID, eventType).
+		Order("created_at desc").Take(&event).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
 func (d *sqlEventDao) FindAllUnreconciledEvents(ctx context.Context) (api.EventList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	events := api.EventList{}
@@ -0,0 +1,188 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// deadInstanceEvents selects the event instances of the maestro instances that are deleted or are not ready and
+// have not sent heartbeats since the given time.
+const deadInstanceEvents = "instance_id NOT IN (SELECT id FROM server_instances WHERE ready OR last_heartbeat >= ?)"
+
+// EventGCDao counts and purges the rows of the event tables, the rows of the events and the status events are
+// purged from the oldest and their event instances are purged with them. The completed operations are purged from
+// the oldest completion with their resources, the rows of the operations table are its completed operations. The
+// revisions of a resource are purged from the oldest, the rows of the resource revisions table are the revisions
+// that are not the current revisions of their resources.
+type EventGCDao interface {
+	// Count returns the number of the rows of an event table.
+	Count(ctx context.Context, table string) (int64, error)
+	// CountCreatedBefore returns the number of the rows of an event table that are created before the given time.
+	CountCreatedBefore(ctx context.Context, table string, before time.Time) (int64, error)
+	// DeleteCreatedBefore deletes the rows of an event table that are created before the given time.
+	DeleteCreatedBefore(ctx context.Context, table string, before time.Time) (int64, error)
+	// CountExceeding returns the number of the oldest rows of an event table that exceed the given count.
+	CountExceeding(ctx context.Context, table string, maxCount int64) (int64, error)
+	// DeleteExceeding deletes the oldest rows of an event table that exceed the given count, the revisions exceed
+	// the count of the revisions of their resource.
+	DeleteExceeding(ctx context.Context, table string, maxCount int64) (int64, error)
+	// CountDeadInstanceEvents returns the number of the event instances of the dead maestro instances.
+	CountDeadInstanceEvents(ctx context.Context, deadBefore time.Time) (int64, error)
+	// DeleteDeadInstanceEvents deletes the event instances of the dead maestro instances.
+	DeleteDeadInstanceEvents(ctx context.Context, deadBefore time.Time) (int64, error)
+}
+
+var _ EventGCDao = &sqlEventGCDao{}
+
+type sqlEventGCDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewEventGCDao(sessionFactory *db.SessionFactory) EventGCDao {
+	return &sqlEventGCDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlEventGCDao) Count(ctx context.Context, table string) (int64, error) {
+	if err := validateEventTable(table, true); err != nil {
+		return 0, err
+	}
+	condition, _ := purgeableRows(table)
+	g2 := (*d.sessionFactory).New(ctx)
+	var count int64
+	if err := g2.Table(table).Where(condition).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *sqlEventGCDao) CountCreatedBefore(ctx context.Context, table string, before time.Time) (int64, error) {
+	if err := validateEventTable(table, false); err != nil {
+		return 0, err
+	}
+	condition, timeColumn := purgeableRows(table)
+	g2 := (*d.sessionFactory).New(ctx)
+	var count int64
+	if err := g2.Table(table).Where(fmt.Sprintf("%s AND %s < ?", condition, timeColumn), before).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *sqlEventGCDao) DeleteCreatedBefore(ctx context.Context, table string, before time.Time) (int64, error) {
+	if err := validateEventTable(table, false); err != nil {
+		return 0, err
+	}
+	condition, timeColumn := purgeableRows(table)
+	return d.delete(ctx, table, fmt.Sprintf("%s AND %s < ?", condition, timeColumn), before)
+}
+
+func (d *sqlEventGCDao) CountExceeding(ctx context.Context, table string, maxCount int64) (int64, error) {
+	if err := validateEventTable(table, false); err != nil {
+		return 0, err
+	}
+	g2 := (*d.sessionFactory).New(ctx)
+	var count int64
+	if err := g2.Table(table).Where(exceedingRows(table), maxCount).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *sqlEventGCDao) DeleteExceeding(ctx context.Context, table string, maxCount int64) (int64, error) {
+	if err := validateEventTable(table, false); err != nil {
+		return 0, err
+	}
+	return d.delete(ctx, table, exceedingRows(table), maxCount)
+}
+
+// delete deletes the rows of an event table that match the condition. The latest resource version of the purged
+// tombstones is recorded as the compacted resource version in the same statement, so that a watch never resumes
+// across a purged deletion.
+func (d *sqlEventGCDao) delete(ctx context.Context, table, condition string, values ...interface{}) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if table != api.ResourceTombstonesTable {
+		result := g2.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s", table, condition), values...)
+		if result.Error != nil {
+			db.MarkForRollback(ctx, result.Error)
+			return 0, result.Error
+		}
+		return result.RowsAffected, nil
+	}
+
+	var deleted int64
+	if err := g2.Raw(fmt.Sprintf("WITH purged AS (DELETE FROM %s WHERE %s RETURNING id), "+
+		"compacted AS (UPDATE resource_compactions SET resource_version = "+
+		"GREATEST(resource_version, (SELECT MAX(id) FROM purged)) WHERE EXISTS (SELECT 1 FROM purged)) "+
+		"SELECT COUNT(*) FROM purged", table, condition), values...).Scan(&deleted).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return 0, err
+	}
+	return deleted, nil
+}
+
+func (d *sqlEventGCDao) CountDeadInstanceEvents(ctx context.Context, deadBefore time.Time) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var count int64
+	if err := g2.Table(api.EventInstancesTable).Where(deadInstanceEvents, deadBefore).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *sqlEventGCDao) DeleteDeadInstanceEvents(ctx context.Context, deadBefore time.Time) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	result := g2.Exec("DELETE FROM "+api.EventInstancesTable+" WHERE "+deadInstanceEvents, deadBefore)
+	if result.Error != nil {
+		db.MarkForRollback(ctx, result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// validateEventTable ensures that only the event tables are counted and purged, the event instances are only
+// purged with their events or their maestro instances, and the operation resources with their operations.
+func validateEventTable(table string, countOnly bool) error {
+	switch table {
+	case api.EventsTable, api.StatusEventsTable, api.ResourceTombstonesTable, api.OperationsTable,
+		api.ResourceRevisionsTable:
+		return nil
+	case api.EventInstancesTable, api.OperationResourcesTable:
+		if countOnly {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported event table %q", table)
+}
+
+// purgeableRows returns the condition of the rows of a table that can be purged and the column their age is
+// measured from. The running operations are never purged, and a completed operation is aged from its completion.
+// The current revision of a resource is never purged.
+func purgeableRows(table string) (string, string) {
+	switch table {
+	case api.OperationsTable:
+		return "completed_at IS NOT NULL", "completed_at"
+	case api.ResourceRevisionsTable:
+		return "version < (SELECT resources.version FROM resources " +
+			"WHERE resources.id = resource_revisions.resource_id)", "created_at"
+	}
+	return "TRUE", "created_at"
+}
+
+// exceedingRows returns the condition of the oldest purgeable rows of a table that exceed the count of the
+// parameter. The revisions are counted for each of their resources from the latest version, so the current
+// revision of a resource is kept.
+func exceedingRows(table string) string {
+	condition, timeColumn := purgeableRows(table)
+	if table == api.ResourceRevisionsTable {
+		return fmt.Sprintf("%s AND id IN (SELECT id FROM (SELECT id, ROW_NUMBER() OVER "+
+			"(PARTITION BY resource_id ORDER BY version DESC) AS recency FROM %s) AS ranked WHERE recency > ?)",
+			condition, table)
+	}
+	return fmt.Sprintf("id IN (SELECT id FROM %s WHERE %s ORDER BY %s DESC, id DESC OFFSET ?)",
+		table, condition, timeColumn)
+}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm/clause"
 
@@ -14,7 +15,9 @@ type EventInstanceDao interface {
 	Create(ctx context.Context, eventInstance *api.EventInstance) (*api.EventInstance, error)
 
 	FindStatusEvents(ctx context.Context, ids []string) (api.EventInstanceList, error)
-	GetEventsAssociatedWithInstances(ctx context.Context, instanceIDs []string) ([]string, error)
+	// GetEventsAssociatedWithInstances returns the status events that were broadcast by all the given instances and
+	// are created before the given time, the status events that are broadcast and still retained are excluded.
+	GetEventsAssociatedWithInstances(ctx context.Context, instanceIDs []string, before time.Time) ([]string, error)
 }
 
 var _ EventInstanceDao = &sqlEventInstanceDao{}
@@ -56,7 +59,7 @@ func (d *sqlEventInstanceDao) FindStatusEvents(ctx context.Context, ids []string
 	return eventInstances, nil
 }
 
-func (d *sqlEventInstanceDao) GetEventsAssociatedWithInstances(ctx context.Context, instanceIDs []string) ([]string, error) {
+func (d *sqlEventInstanceDao) GetEventsAssociatedWithInstances(ctx context.Context, instanceIDs []string, before time.Time) ([]string, error) {
 	var eventIDs []string
 
 	instanceCount := len(instanceIDs)
@@ -71,6 +74,7 @@ func (d *sqlEventInstanceDao) GetEventsAssociatedWithInstances(ctx context.Conte
 	if err := g2.Table("event_instances").
 		Select("event_id").
 		Where("instance_id IN ?", instanceIDs).
+		Where("event_id IN (SELECT id FROM status_events WHERE created_at < ?)", before).
 		Group("event_id").
 		Having("COUNT(DISTINCT instance_id) = ?", instanceCount).
 		Scan(&eventIDs).Error; err != nil {
@@ -19,6 +19,7 @@ type GenericDao interface {
 	Joins(sql string)
 	Group(sql string)
 	Where(sql string, values []interface{})
+	Select(columns []string)
 	Count(model interface{}, total *int64)
 	Validate(resourceList interface{}) error
 
@@ -77,6 +78,10 @@ func (d *sqlGenericDao) Where(sql string, values []interface{}) {
 	d.g2 = d.g2.Where(sql, values...)
 }
 
+func (d *sqlGenericDao) Select(columns []string) {
+	d.g2 = d.g2.Select(columns)
+}
+
 func (d *sqlGenericDao) Count(model interface{}, total *int64) {
 	g2 := d.g2.Session(&gorm.Session{DryRun: false}).Model(model)
 	// There is no need in ORDER BY, GROUP BY and LIMIT in order to count records
@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -35,10 +36,11 @@ func (d *consumerDaoMock) Create(ctx context.Context, consumer *api.Consumer) (*
 }
 
 func (d *consumerDaoMock) Replace(ctx context.Context, consumer *api.Consumer) (*api.Consumer, error) {
-	for i, c := range d.consumers {
+	for _, c := range d.consumers {
 		if c.ID == consumer.ID {
-			d.consumers[i] = consumer
-			return consumer, nil
+			c.Labels = consumer.Labels
+			c.UpdatedAt = consumer.UpdatedAt
+			return c, nil
 		}
 	}
 	return nil, gorm.ErrRecordNotFound
@@ -87,3 +89,53 @@ func (d *consumerDaoMock) FindByNames(ctx context.Context, names []string) (api.
 func (d *consumerDaoMock) All(ctx context.Context) (api.ConsumerList, error) {
 	return d.consumers, nil
 }
+
+func (d *consumerDaoMock) MarkSeen(ctx context.Context, names []string, seenAt time.Time) error {
+	for _, consumer := range d.consumers {
+		for _, name := range names {
+			if consumer.Name == name {
+				consumer.Connected = true
+				consumer.LastSeen = &seenAt
+				consumer.SilencedAt = nil
+			}
+		}
+	}
+	return nil
+}
+
+func (d *consumerDaoMock) MarkDisconnected(ctx context.Context, names []string) error {
+	for _, consumer := range d.consumers {
+		for _, name := range names {
+			if consumer.Name == name {
+				consumer.Connected = false
+			}
+		}
+	}
+	return nil
+}
+
+func (d *consumerDaoMock) MarkSilent(ctx context.Context, before, silencedAt time.Time) (api.ConsumerList, error) {
+	consumers := api.ConsumerList{}
+	for _, consumer := range d.consumers {
+		lastSeen := consumer.CreatedAt
+		if consumer.LastSeen != nil {
+			lastSeen = *consumer.LastSeen
+		}
+		if lastSeen.Before(before) && consumer.SilencedAt == nil {
+			consumer.Connected = false
+			consumer.SilencedAt = &silencedAt
+			consumers = append(consumers, consumer)
+		}
+	}
+	return consumers, nil
+}
+
+func (d *consumerDaoMock) CountConnected(ctx context.Context) (int64, error) {
+	var count int64
+	for _, consumer := range d.consumers {
+		if consumer.Connected {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.ConsumerSetDao = &consumerSetDaoMock{}
+
+type consumerSetDaoMock struct {
+	consumerSets api.ConsumerSetList
+}
+
+func NewConsumerSetDao() *consumerSetDaoMock {
+	return &consumerSetDaoMock{}
+}
+
+func (d *consumerSetDaoMock) Get(ctx context.Context, id string) (*api.ConsumerSet, error) {
+	for _, consumerSet := range d.consumerSets {
+		if consumerSet.ID == id {
+			return consumerSet, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *consumerSetDaoMock) Create(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, error) {
+	d.consumerSets = append(d.consumerSets, consumerSet)
+	return consumerSet, nil
+}
+
+func (d *consumerSetDaoMock) Replace(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, error) {
+	for i, s := range d.consumerSets {
+		if s.ID == consumerSet.ID {
+			d.consumerSets[i] = consumerSet
+			return consumerSet, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *consumerSetDaoMock) Delete(ctx context.Context, id string) error {
+	consumerSets := api.ConsumerSetList{}
+	for _, consumerSet := range d.consumerSets {
+		if consumerSet.ID != id {
+			consumerSets = append(consumerSets, consumerSet)
+		}
+	}
+	d.consumerSets = consumerSets
+	return nil
+}
+
+func (d *consumerSetDaoMock) All(ctx context.Context) (api.ConsumerSetList, error) {
+	return d.consumerSets, nil
+}
@@ -69,6 +69,16 @@ func (d *eventDaoMock) FindByIDs(ctx context.Context, ids []string) (api.EventLi
 	return filteredEvents, nil
 }
 
+func (d *eventDaoMock) FindLatest(ctx context.Context, source, sourceID string, eventType api.EventType) (*api.Event, error) {
+	for i := len(d.events) - 1; i >= 0; i-- {
+		e := d.events[i]
+		if e.Source == source && e.SourceID == sourceID && e.EventType == eventType {
+			return e, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
 func (d *eventDaoMock) All(ctx context.Context) (api.EventList, error) {
 	return d.events, nil
 }
@@ -0,0 +1,126 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.EventGCDao = &eventGCDaoMock{}
+
+type eventGCDaoMock struct {
+	mux sync.RWMutex
+	// rows are the creation time of the rows of the events and the status events tables.
+	rows           map[string][]time.Time
+	eventInstances api.EventInstanceList
+	instances      api.ServerInstanceList
+}
+
+// NewEventGCDao returns an event gc dao with the creation time of the rows of the events and the status events
+// tables, the event instances and the maestro instances.
+func NewEventGCDao(rows map[string][]time.Time, eventInstances api.EventInstanceList,
+	instances api.ServerInstanceList) *eventGCDaoMock {
+	return &eventGCDaoMock{rows: rows, eventInstances: eventInstances, instances: instances}
+}
+
+func (d *eventGCDaoMock) Count(ctx context.Context, table string) (int64, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	if table == api.EventInstancesTable {
+		return int64(len(d.eventInstances)), nil
+	}
+	return int64(len(d.rows[table])), nil
+}
+
+func (d *eventGCDaoMock) CountCreatedBefore(ctx context.Context, table string, before time.Time) (int64, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	var count int64
+	for _, createdAt := range d.rows[table] {
+		if createdAt.Before(before) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (d *eventGCDaoMock) DeleteCreatedBefore(ctx context.Context, table string, before time.Time) (int64, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	kept := []time.Time{}
+	for _, createdAt := range d.rows[table] {
+		if !createdAt.Before(before) {
+			kept = append(kept, createdAt)
+		}
+	}
+	deleted := int64(len(d.rows[table]) - len(kept))
+	d.rows[table] = kept
+	return deleted, nil
+}
+
+func (d *eventGCDaoMock) CountExceeding(ctx context.Context, table string, maxCount int64) (int64, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	if rows := int64(len(d.rows[table])); rows > maxCount {
+		return rows - maxCount, nil
+	}
+	return 0, nil
+}
+
+func (d *eventGCDaoMock) DeleteExceeding(ctx context.Context, table string, maxCount int64) (int64, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	rows := d.rows[table]
+	if int64(len(rows)) <= maxCount {
+		return 0, nil
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].After(rows[j]) })
+	d.rows[table] = rows[:maxCount]
+	return int64(len(rows)) - maxCount, nil
+}
+
+func (d *eventGCDaoMock) CountDeadInstanceEvents(ctx context.Context, deadBefore time.Time) (int64, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	var count int64
+	for _, eventInstance := range d.eventInstances {
+		if d.isDead(eventInstance.InstanceID, deadBefore) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (d *eventGCDaoMock) DeleteDeadInstanceEvents(ctx context.Context, deadBefore time.Time) (int64, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	kept := api.EventInstanceList{}
+	for _, eventInstance := range d.eventInstances {
+		if !d.isDead(eventInstance.InstanceID, deadBefore) {
+			kept = append(kept, eventInstance)
+		}
+	}
+	deleted := int64(len(d.eventInstances) - len(kept))
+	d.eventInstances = kept
+	return deleted, nil
+}
+
+func (d *eventGCDaoMock) isDead(instanceID string, deadBefore time.Time) bool {
+	for _, instance := range d.instances {
+		if instance.ID == instanceID {
+			return !instance.Ready && instance.LastHeartbeat.Before(deadBefore)
+		}
+	}
+	return true
+}
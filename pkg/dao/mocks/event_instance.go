@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/dao"
@@ -58,7 +59,7 @@ func (d *eventInstanceDaoMock) FindStatusEvents(ctx context.Context, ids []strin
 	return eventInstances, nil
 }
 
-func (d *eventInstanceDaoMock) GetEventsAssociatedWithInstances(ctx context.Context, instanceIDs []string) ([]string, error) {
+func (d *eventInstanceDaoMock) GetEventsAssociatedWithInstances(ctx context.Context, instanceIDs []string, before time.Time) ([]string, error) {
 	d.mux.RLock()
 	defer d.mux.RUnlock()
 
@@ -0,0 +1,122 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.OperationDao = &operationDaoMock{}
+
+type operationDaoMock struct {
+	operations         api.OperationList
+	operationResources api.OperationResourceList
+}
+
+func NewOperationDao() *operationDaoMock {
+	return &operationDaoMock{}
+}
+
+func (d *operationDaoMock) Get(ctx context.Context, id string) (*api.Operation, error) {
+	for _, operation := range d.operations {
+		if operation.ID == id {
+			return operation, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *operationDaoMock) Create(ctx context.Context, operation *api.Operation) (*api.Operation, error) {
+	d.operations = append(d.operations, operation)
+	return operation, nil
+}
+
+func (d *operationDaoMock) Replace(ctx context.Context, operation *api.Operation) (*api.Operation, error) {
+	for i, o := range d.operations {
+		if o.ID == operation.ID {
+			d.operations[i] = operation
+			return operation, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *operationDaoMock) FindByPhase(ctx context.Context, phase api.OperationPhase) (api.OperationList, error) {
+	operations := api.OperationList{}
+	for _, operation := range d.operations {
+		if operation.Phase == phase {
+			operations = append(operations, operation)
+		}
+	}
+	return operations, nil
+}
+
+func (d *operationDaoMock) FindByTargetID(ctx context.Context, targetID string, phase api.OperationPhase) (api.OperationList, error) {
+	operations := api.OperationList{}
+	for _, operation := range d.operations {
+		if operation.TargetID == targetID && operation.Phase == phase {
+			operations = append(operations, operation)
+		}
+	}
+	return operations, nil
+}
+
+func (d *operationDaoMock) FindByResourceID(ctx context.Context, resourceID string, phase api.OperationPhase) (api.OperationList, error) {
+	operations := api.OperationList{}
+	for _, operation := range d.operations {
+		if operation.Phase == phase && (operation.TargetID == resourceID || d.findResource(operation.ID, resourceID) != nil) {
+			operations = append(operations, operation)
+		}
+	}
+	return operations, nil
+}
+
+func (d *operationDaoMock) MarkDispatched(ctx context.Context, eventID string, dispatchedAt time.Time) error {
+	for _, operation := range d.operations {
+		if operation.EventID == eventID && operation.DispatchedAt == nil {
+			operation.DispatchedAt = &dispatchedAt
+		}
+	}
+	return nil
+}
+
+func (d *operationDaoMock) AddResources(ctx context.Context, id string, resourceIDs []string) error {
+	for _, resourceID := range resourceIDs {
+		if d.findResource(id, resourceID) == nil {
+			d.operationResources = append(d.operationResources, &api.OperationResource{OperationID: id, ResourceID: resourceID})
+		}
+	}
+	return nil
+}
+
+func (d *operationDaoMock) FindResources(ctx context.Context, id string) (api.OperationResourceList, error) {
+	operationResources := api.OperationResourceList{}
+	for _, operationResource := range d.operationResources {
+		if operationResource.OperationID == id {
+			operationResources = append(operationResources, operationResource)
+		}
+	}
+	return operationResources, nil
+}
+
+func (d *operationDaoMock) CompleteResources(ctx context.Context, id string, resourceIDs []string, completedAt time.Time) error {
+	for _, resourceID := range resourceIDs {
+		if operationResource := d.findResource(id, resourceID); operationResource != nil {
+			operationResource.CompletedAt = &completedAt
+		}
+	}
+	return nil
+}
+
+func (d *operationDaoMock) findResource(id, resourceID string) *api.OperationResource {
+	for _, operationResource := range d.operationResources {
+		if operationResource.OperationID == id && operationResource.ResourceID == resourceID {
+			return operationResource
+		}
+	}
+	return nil
+}
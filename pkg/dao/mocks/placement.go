@@ -0,0 +1,70 @@
+package mocks
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.PlacementDao = &placementDaoMock{}
+
+type placementDaoMock struct {
+	placements api.PlacementList
+}
+
+func NewPlacementDao() *placementDaoMock {
+	return &placementDaoMock{}
+}
+
+func (d *placementDaoMock) Get(ctx context.Context, id string) (*api.Placement, error) {
+	for _, placement := range d.placements {
+		if placement.ID == id {
+			return placement, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *placementDaoMock) Create(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	d.placements = append(d.placements, placement)
+	return placement, nil
+}
+
+func (d *placementDaoMock) Replace(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	for i, p := range d.placements {
+		if p.ID == placement.ID {
+			d.placements[i] = placement
+			return placement, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *placementDaoMock) UpdateStatus(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	for i, p := range d.placements {
+		if p.ID == placement.ID {
+			d.placements[i].Status = placement.Status
+			d.placements[i].RolloutPhase = placement.RolloutPhase
+			return d.placements[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *placementDaoMock) Delete(ctx context.Context, id string) error {
+	placements := api.PlacementList{}
+	for _, placement := range d.placements {
+		if placement.ID != id {
+			placements = append(placements, placement)
+		}
+	}
+	d.placements = placements
+	return nil
+}
+
+func (d *placementDaoMock) All(ctx context.Context) (api.PlacementList, error) {
+	return d.placements, nil
+}
@@ -2,6 +2,9 @@ package mocks
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -13,7 +16,9 @@ import (
 var _ dao.ResourceDao = &resourceDaoMock{}
 
 type resourceDaoMock struct {
-	resources api.ResourceList
+	resources       api.ResourceList
+	tombstones      api.ResourceTombstoneList
+	resourceVersion int64
 }
 
 func NewResourceDao() *resourceDaoMock {
@@ -30,18 +35,42 @@ func (d *resourceDaoMock) Get(ctx context.Context, id string) (*api.Resource, er
 }
 
 func (d *resourceDaoMock) Create(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
+	resource.ResourceVersion = d.nextResourceVersion()
 	d.resources = append(d.resources, resource)
 	return resource, nil
 }
 
 func (d *resourceDaoMock) Update(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
-	return nil, errors.NotImplemented("Resource").AsError()
+	for i, r := range d.resources {
+		if r.ID == resource.ID {
+			now := time.Now()
+			resource.SpecUpdatedAt = &now
+			resource.StaleSince = nil
+			resource.ResourceVersion = d.nextResourceVersion()
+			d.resources[i] = resource
+			return resource, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
 }
 
 func (d *resourceDaoMock) UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
 	for i, r := range d.resources {
 		if r.ID == resource.ID {
 			d.resources[i].Status = resource.Status
+			d.resources[i].DriftedSince = resource.DriftedSince
+			d.resources[i].ResourceVersion = d.nextResourceVersion()
+			return d.resources[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *resourceDaoMock) UpdateManifestManagers(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
+	for i, r := range d.resources {
+		if r.ID == resource.ID {
+			d.resources[i].ManifestManagers = resource.ManifestManagers
+			d.resources[i].ResourceVersion = d.nextResourceVersion()
 			return d.resources[i], nil
 		}
 	}
@@ -49,11 +78,42 @@ func (d *resourceDaoMock) UpdateStatus(ctx context.Context, resource *api.Resour
 }
 
 func (d *resourceDaoMock) Delete(ctx context.Context, id string, unscoped bool) error {
-	return errors.NotImplemented("Resource").AsError()
+	for i, r := range d.resources {
+		if r.ID != id {
+			continue
+		}
+		if unscoped {
+			d.tombstones = append(d.tombstones, &api.ResourceTombstone{
+				ID:           d.nextResourceVersion(),
+				ResourceID:   r.ID,
+				Version:      r.Version,
+				Source:       r.Source,
+				ConsumerName: r.ConsumerName,
+				Name:         r.Name,
+				Payload:      r.Payload,
+				CreatedAt:    time.Now(),
+			})
+			d.resources = append(d.resources[:i], d.resources[i+1:]...)
+			return nil
+		}
+		d.resources[i].DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		d.resources[i].ResourceVersion = d.nextResourceVersion()
+		return nil
+	}
+	return nil
 }
 
 func (d *resourceDaoMock) FindByIDs(ctx context.Context, ids []string) (api.ResourceList, error) {
-	return nil, errors.NotImplemented("Resource").AsError()
+	var resources api.ResourceList
+	for _, resource := range d.resources {
+		for _, id := range ids {
+			if resource.ID == id {
+				resources = append(resources, resource)
+				break
+			}
+		}
+	}
+	return resources, nil
 }
 
 func (d *resourceDaoMock) FindByConsumerName(ctx context.Context, consumerID string) (api.ResourceList, error) {
@@ -66,6 +126,16 @@ func (d *resourceDaoMock) FindByConsumerName(ctx context.Context, consumerID str
 	return resources, nil
 }
 
+func (d *resourceDaoMock) FindByConsumerNames(ctx context.Context, consumerNames []string) (api.ResourceList, error) {
+	var resources api.ResourceList
+	for _, resource := range d.resources {
+		if !resource.DeletedAt.Valid && slices.Contains(consumerNames, resource.ConsumerName) {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
 func (d *resourceDaoMock) FindBySource(ctx context.Context, source string) (api.ResourceList, error) {
 	var resources api.ResourceList
 	for _, resource := range d.resources {
@@ -83,3 +153,193 @@ func (d *resourceDaoMock) All(ctx context.Context) (api.ResourceList, error) {
 func (d *resourceDaoMock) FirstByConsumerName(ctx context.Context, consumerName string, unscoped bool) (api.Resource, error) {
 	return *d.resources[0], errors.NotImplemented("Resource").AsError()
 }
+
+func (d *resourceDaoMock) FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, error) {
+	resources := api.ResourceList{}
+	for _, resource := range d.resources {
+		if resource.PlacementID == placementID {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+func (d *resourceDaoMock) FindPlacementIDs(ctx context.Context) ([]string, error) {
+	placementIDs := []string{}
+	found := map[string]bool{}
+	for _, resource := range d.resources {
+		if resource.PlacementID != "" && !found[resource.PlacementID] {
+			found[resource.PlacementID] = true
+			placementIDs = append(placementIDs, resource.PlacementID)
+		}
+	}
+	return placementIDs, nil
+}
+
+func (d *resourceDaoMock) CountByConsumerName(ctx context.Context, consumerName string) (int64, error) {
+	counts, _ := d.CountPerConsumer(ctx, nil)
+	return counts[consumerName], nil
+}
+
+func (d *resourceDaoMock) CountBySource(ctx context.Context, source string) (int64, error) {
+	counts, _ := d.CountPerSource(ctx, nil)
+	return counts[source], nil
+}
+
+func (d *resourceDaoMock) CountPerConsumer(ctx context.Context, sources []string) (map[string]int64, error) {
+	counts := map[string]int64{}
+	for _, resource := range d.resources {
+		if !resource.DeletedAt.Valid && (sources == nil || slices.Contains(sources, resource.Source)) {
+			counts[resource.ConsumerName]++
+		}
+	}
+	return counts, nil
+}
+
+func (d *resourceDaoMock) CountPerSource(ctx context.Context, sources []string) (map[string]int64, error) {
+	counts := map[string]int64{}
+	for _, resource := range d.resources {
+		if !resource.DeletedAt.Valid && (sources == nil || slices.Contains(sources, resource.Source)) {
+			counts[resource.Source]++
+		}
+	}
+	return counts, nil
+}
+
+func (d *resourceDaoMock) MarkStale(ctx context.Context, laggingBefore, now time.Time) error {
+	for _, resource := range d.resources {
+		if resource.DeletedAt.Valid || resource.StaleSince != nil {
+			continue
+		}
+		if resource.SpecUpdatedAt != nil && resource.SpecUpdatedAt.Before(laggingBefore) && statusLagging(resource) {
+			staleSince := now
+			resource.StaleSince = &staleSince
+		}
+	}
+	return nil
+}
+
+func (d *resourceDaoMock) ClearStale(ctx context.Context) error {
+	for _, resource := range d.resources {
+		if resource.StaleSince != nil && (resource.DeletedAt.Valid || !statusLagging(resource)) {
+			resource.StaleSince = nil
+		}
+	}
+	return nil
+}
+
+func (d *resourceDaoMock) FindStale(ctx context.Context) (api.ResourceList, error) {
+	resources := api.ResourceList{}
+	for _, resource := range d.resources {
+		if !resource.DeletedAt.Valid && resource.StaleSince != nil {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
+// statusLagging returns true if the status of the current version of a resource is not reported.
+func statusLagging(resource *api.Resource) bool {
+	status, err := api.DecodeResourceBundleStatus(resource.Status)
+	if err != nil {
+		return false
+	}
+	return status == nil || status.ObservedVersion < resource.Version
+}
+
+func (d *resourceDaoMock) Summarize(ctx context.Context, groupBy api.ResourceBundleSummaryGroupBy, label string, sources []string) ([]api.ResourceBundleSummaryItem, error) {
+	items := map[string]*api.ResourceBundleSummaryItem{}
+	for _, resource := range d.resources {
+		if sources != nil && !slices.Contains(sources, resource.Source) {
+			continue
+		}
+
+		var key string
+		switch groupBy {
+		case api.ResourceBundleSummaryByConsumer:
+			key = resource.ConsumerName
+		case api.ResourceBundleSummaryBySource:
+			key = resource.Source
+		case api.ResourceBundleSummaryByLabel:
+			key = labelOf(resource, label)
+		default:
+			return nil, errors.NotImplemented("ResourceBundleSummary").AsError()
+		}
+
+		item, ok := items[key]
+		if !ok {
+			item = &api.ResourceBundleSummaryItem{Key: key}
+			items[key] = item
+		}
+		item.Total++
+		rollout := api.ResourceBundleRolloutOf(resource)
+		if rollout.Applied {
+			item.Applied++
+		}
+		if rollout.Available {
+			item.Available++
+		}
+		if rollout.Failed {
+			item.Degraded++
+		}
+		if resource.DeletedAt.Valid {
+			item.Deleting++
+		}
+		if len(resource.Status) == 0 {
+			item.NotReported++
+		}
+	}
+
+	summary := []api.ResourceBundleSummaryItem{}
+	for _, item := range items {
+		summary = append(summary, *item)
+	}
+	slices.SortFunc(summary, func(a, b api.ResourceBundleSummaryItem) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return summary, nil
+}
+
+// labelOf returns the value of a label in the metadata of the payload of a resource.
+func labelOf(resource *api.Resource, label string) string {
+	metadata, _ := resource.Payload["metadata"].(map[string]interface{})
+	labels, _ := metadata["labels"].(map[string]interface{})
+	value, _ := labels[label].(string)
+	return value
+}
+
+func (d *resourceDaoMock) nextResourceVersion() int64 {
+	d.resourceVersion++
+	return d.resourceVersion
+}
+
+func (d *resourceDaoMock) LatestResourceVersion(ctx context.Context) (int64, error) {
+	return d.resourceVersion, nil
+}
+
+func (d *resourceDaoMock) CompactedResourceVersion(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (d *resourceDaoMock) FindChangedSince(ctx context.Context, resourceVersion int64) (api.ResourceList, error) {
+	resources := api.ResourceList{}
+	for _, resource := range d.resources {
+		if resource.ResourceVersion > resourceVersion {
+			resources = append(resources, resource)
+		}
+	}
+	slices.SortFunc(resources, func(a, b *api.Resource) int {
+		return int(a.ResourceVersion - b.ResourceVersion)
+	})
+	return resources, nil
+}
+
+func (d *resourceDaoMock) FindTombstonesSince(ctx context.Context, resourceVersion int64) (api.ResourceTombstoneList, error) {
+	tombstones := api.ResourceTombstoneList{}
+	for _, tombstone := range d.tombstones {
+		if tombstone.ID > resourceVersion {
+			tombstones = append(tombstones, tombstone)
+		}
+	}
+	return tombstones, nil
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.ResourceRevisionDao = &resourceRevisionDaoMock{}
+
+type resourceRevisionDaoMock struct {
+	revisions api.ResourceRevisionList
+}
+
+func NewResourceRevisionDao() *resourceRevisionDaoMock {
+	return &resourceRevisionDaoMock{}
+}
+
+func (d *resourceRevisionDaoMock) Get(ctx context.Context, resourceID string, version int32) (*api.ResourceRevision, error) {
+	for _, revision := range d.revisions {
+		if revision.ResourceID == resourceID && revision.Version == version {
+			return revision, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *resourceRevisionDaoMock) Create(ctx context.Context, revision *api.ResourceRevision) (*api.ResourceRevision, error) {
+	d.revisions = append(d.revisions, revision)
+	return revision, nil
+}
+
+func (d *resourceRevisionDaoMock) FindByResourceID(ctx context.Context, resourceID string) (api.ResourceRevisionList, error) {
+	revisions := api.ResourceRevisionList{}
+	for _, revision := range d.revisions {
+		if revision.ResourceID == resourceID {
+			revisions = append(revisions, revision)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Version > revisions[j].Version
+	})
+	return revisions, nil
+}
@@ -0,0 +1,160 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// operationsChannel is the channel that is notified when an operation is created.
+const operationsChannel = "operations"
+
+// operationResourcesBatchSize is the number of the operation resources inserted by one statement, it keeps
+// the number of the statement parameters under the postgres limit.
+const operationResourcesBatchSize = 1000
+
+type OperationDao interface {
+	Get(ctx context.Context, id string) (*api.Operation, error)
+	Create(ctx context.Context, operation *api.Operation) (*api.Operation, error)
+	Replace(ctx context.Context, operation *api.Operation) (*api.Operation, error)
+	FindByPhase(ctx context.Context, phase api.OperationPhase) (api.OperationList, error)
+	FindByTargetID(ctx context.Context, targetID string, phase api.OperationPhase) (api.OperationList, error)
+	FindByResourceID(ctx context.Context, resourceID string, phase api.OperationPhase) (api.OperationList, error)
+	MarkDispatched(ctx context.Context, eventID string, dispatchedAt time.Time) error
+
+	AddResources(ctx context.Context, id string, resourceIDs []string) error
+	FindResources(ctx context.Context, id string) (api.OperationResourceList, error)
+	CompleteResources(ctx context.Context, id string, resourceIDs []string, completedAt time.Time) error
+}
+
+var _ OperationDao = &sqlOperationDao{}
+
+type sqlOperationDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewOperationDao(sessionFactory *db.SessionFactory) OperationDao {
+	return &sqlOperationDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlOperationDao) Get(ctx context.Context, id string) (*api.Operation, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var operation api.Operation
+	if err := g2.Take(&operation, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+func (d *sqlOperationDao) Create(ctx context.Context, operation *api.Operation) (*api.Operation, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(operation).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+
+	if err := g2.Exec(fmt.Sprintf("select pg_notify('%s', '%s')", operationsChannel, operation.ID)).Error; err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+func (d *sqlOperationDao) Replace(ctx context.Context, operation *api.Operation) (*api.Operation, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Save(operation).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return operation, nil
+}
+
+func (d *sqlOperationDao) FindByPhase(ctx context.Context, phase api.OperationPhase) (api.OperationList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	operations := api.OperationList{}
+	if err := g2.Where("phase = ?", phase).Find(&operations).Error; err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+func (d *sqlOperationDao) FindByTargetID(ctx context.Context, targetID string, phase api.OperationPhase) (api.OperationList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	operations := api.OperationList{}
+	if err := g2.Where("target_id = ? AND phase = ?", targetID, phase).Find(&operations).Error; err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+// FindByResourceID returns the operations that target the resource or that the resource is added to.
+func (d *sqlOperationDao) FindByResourceID(ctx context.Context, resourceID string, phase api.OperationPhase) (api.OperationList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	operations := api.OperationList{}
+	if err := g2.Where("phase = ? AND (target_id = ? OR id IN (?))", phase, resourceID,
+		g2.Model(&api.OperationResource{}).Select("operation_id").Where("resource_id = ?", resourceID)).
+		Find(&operations).Error; err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+// MarkDispatched sets the dispatch time of the operations that are triggered by the event, the
+// operations that are already dispatched are not changed.
+func (d *sqlOperationDao) MarkDispatched(ctx context.Context, eventID string, dispatchedAt time.Time) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Model(&api.Operation{}).
+		Where("event_id = ? AND dispatched_at IS NULL", eventID).
+		Update("dispatched_at", dispatchedAt).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+// AddResources adds the resources to the operation, the resources that are already added are ignored.
+func (d *sqlOperationDao) AddResources(ctx context.Context, id string, resourceIDs []string) error {
+	if len(resourceIDs) == 0 {
+		return nil
+	}
+
+	operationResources := api.OperationResourceList{}
+	for _, resourceID := range resourceIDs {
+		operationResources = append(operationResources, &api.OperationResource{OperationID: id, ResourceID: resourceID})
+	}
+
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(&operationResources, operationResourcesBatchSize).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlOperationDao) FindResources(ctx context.Context, id string) (api.OperationResourceList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	operationResources := api.OperationResourceList{}
+	if err := g2.Where("operation_id = ?", id).Find(&operationResources).Error; err != nil {
+		return nil, err
+	}
+	return operationResources, nil
+}
+
+func (d *sqlOperationDao) CompleteResources(ctx context.Context, id string, resourceIDs []string, completedAt time.Time) error {
+	if len(resourceIDs) == 0 {
+		return nil
+	}
+
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Model(&api.OperationResource{}).
+		Where("operation_id = ? AND resource_id in (?)", id, resourceIDs).
+		Update("completed_at", completedAt).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
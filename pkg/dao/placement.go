@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// placementsChannel is the channel that is notified when a placement is created, updated or deleted.
+const placementsChannel = "placements"
+
+type PlacementDao interface {
+	Get(ctx context.Context, id string) (*api.Placement, error)
+	Create(ctx context.Context, placement *api.Placement) (*api.Placement, error)
+	Replace(ctx context.Context, placement *api.Placement) (*api.Placement, error)
+	UpdateStatus(ctx context.Context, placement *api.Placement) (*api.Placement, error)
+	Delete(ctx context.Context, id string) error
+	All(ctx context.Context) (api.PlacementList, error)
+}
+
+var _ PlacementDao = &sqlPlacementDao{}
+
+type sqlPlacementDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewPlacementDao(sessionFactory *db.SessionFactory) PlacementDao {
+	return &sqlPlacementDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlPlacementDao) Get(ctx context.Context, id string) (*api.Placement, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var placement api.Placement
+	if err := g2.Take(&placement, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &placement, nil
+}
+
+func (d *sqlPlacementDao) Create(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(placement).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+
+	if err := d.notify(ctx, placement.ID); err != nil {
+		return nil, err
+	}
+	return placement, nil
+}
+
+func (d *sqlPlacementDao) Replace(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Save(placement).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+
+	if err := d.notify(ctx, placement.ID); err != nil {
+		return nil, err
+	}
+	return placement, nil
+}
+
+// UpdateStatus updates the placement status and rollout phase only, the placement controller is not notified.
+func (d *sqlPlacementDao) UpdateStatus(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).
+		Model(&api.Placement{Meta: api.Meta{ID: placement.ID}}).
+		Updates(map[string]interface{}{
+			"status":        placement.Status,
+			"rollout_phase": placement.RolloutPhase,
+		}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return placement, nil
+}
+
+// Delete permanently deletes the placement, so that its name can be reused. The resources of the
+// placement are deleted by the placement controller.
+func (d *sqlPlacementDao) Delete(ctx context.Context, id string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Unscoped().Omit(clause.Associations).Delete(&api.Placement{Meta: api.Meta{ID: id}}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+
+	return d.notify(ctx, id)
+}
+
+func (d *sqlPlacementDao) All(ctx context.Context) (api.PlacementList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	placements := api.PlacementList{}
+	if err := g2.Find(&placements).Error; err != nil {
+		return nil, err
+	}
+	return placements, nil
+}
+
+func (d *sqlPlacementDao) notify(ctx context.Context, id string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	return g2.Exec(fmt.Sprintf("select pg_notify('%s', '%s')", placementsChannel, id)).Error
+}
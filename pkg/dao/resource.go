@@ -2,6 +2,8 @@ package dao
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"gorm.io/gorm/clause"
 
@@ -14,12 +16,54 @@ type ResourceDao interface {
 	Create(ctx context.Context, resource *api.Resource) (*api.Resource, error)
 	Update(ctx context.Context, resource *api.Resource) (*api.Resource, error)
 	UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, error)
+	// UpdateManifestManagers updates the manifest managers of a resource without changing its version, e.g. when a
+	// field manager takes the ownership of the manifests that it applies without changes.
+	UpdateManifestManagers(ctx context.Context, resource *api.Resource) (*api.Resource, error)
 	Delete(ctx context.Context, id string, unscoped bool) error
 	FindByIDs(ctx context.Context, ids []string) (api.ResourceList, error)
 	FindBySource(ctx context.Context, source string) (api.ResourceList, error)
 	FindByConsumerName(ctx context.Context, consumerName string) (api.ResourceList, error)
+	// FindByConsumerNames returns the resources of the consumers, excluding the resources under deletion.
+	FindByConsumerNames(ctx context.Context, consumerNames []string) (api.ResourceList, error)
 	All(ctx context.Context) (api.ResourceList, error)
 	FirstByConsumerName(ctx context.Context, name string, unscoped bool) (api.Resource, error)
+	FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, error)
+	FindPlacementIDs(ctx context.Context) ([]string, error)
+
+	// CountByConsumerName returns the number of the resources of a consumer, excluding the resources under deletion.
+	CountByConsumerName(ctx context.Context, consumerName string) (int64, error)
+	// CountBySource returns the number of the resources of a source, excluding the resources under deletion.
+	CountBySource(ctx context.Context, source string) (int64, error)
+	// CountPerConsumer returns the number of the resources of each consumer, excluding the resources under deletion.
+	// Only the resources of the given sources are counted if the sources are not nil.
+	CountPerConsumer(ctx context.Context, sources []string) (map[string]int64, error)
+	// CountPerSource returns the number of the resources of each source, excluding the resources under deletion.
+	// Only the given sources are counted if they are not nil.
+	CountPerSource(ctx context.Context, sources []string) (map[string]int64, error)
+
+	// MarkStale marks the resources whose status lags behind their version since before the given time as stale,
+	// excluding the resources under deletion.
+	MarkStale(ctx context.Context, laggingBefore, now time.Time) error
+	// ClearStale clears the resources whose status is no longer stale.
+	ClearStale(ctx context.Context) error
+	// FindStale returns the resources whose status is stale.
+	FindStale(ctx context.Context) (api.ResourceList, error)
+
+	// Summarize returns the number of the resources in each state grouped by consumer, source or the value of a
+	// label, including the resources under deletion. The resources are not filtered by source if sources is nil.
+	Summarize(ctx context.Context, groupBy api.ResourceBundleSummaryGroupBy, label string, sources []string) ([]api.ResourceBundleSummaryItem, error)
+
+	// LatestResourceVersion returns the latest resource version of the committed changes of the resources.
+	LatestResourceVersion(ctx context.Context) (int64, error)
+	// CompactedResourceVersion returns the latest resource version of the purged tombstones, the deletions up to
+	// it are no longer recorded.
+	CompactedResourceVersion(ctx context.Context) (int64, error)
+	// FindChangedSince returns the resources that are changed after the given resource version, including the
+	// resources under deletion, ordered by their resource versions.
+	FindChangedSince(ctx context.Context, resourceVersion int64) (api.ResourceList, error)
+	// FindTombstonesSince returns the tombstones of the resources that are deleted after the given resource
+	// version, ordered by their resource versions.
+	FindTombstonesSince(ctx context.Context, resourceVersion int64) (api.ResourceTombstoneList, error)
 }
 
 var _ ResourceDao = &sqlResourceDao{}
@@ -52,12 +96,19 @@ func (d *sqlResourceDao) Create(ctx context.Context, resource *api.Resource) (*a
 
 func (d *sqlResourceDao) Update(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
 	g2 := (*d.sessionFactory).New(ctx)
+	// the status of the new version is not stale until it lags behind the version again
+	now := time.Now()
+	resource.SpecUpdatedAt = &now
+	resource.StaleSince = nil
 	if err := g2.Unscoped().Omit(clause.Associations).
 		Where("id = ?", resource.ID).
-		Select("version", "payload").
+		Select("version", "payload", "manifest_managers", "spec_updated_at", "stale_since").
 		Updates(api.Resource{
-			Version: resource.Version,
-			Payload: resource.Payload,
+			Version:          resource.Version,
+			Payload:          resource.Payload,
+			ManifestManagers: resource.ManifestManagers,
+			SpecUpdatedAt:    resource.SpecUpdatedAt,
+			StaleSince:       resource.StaleSince,
 		}).Error; err != nil {
 		db.MarkForRollback(ctx, err)
 		return nil, err
@@ -69,9 +120,24 @@ func (d *sqlResourceDao) UpdateStatus(ctx context.Context, resource *api.Resourc
 	g2 := (*d.sessionFactory).New(ctx)
 	if err := g2.Unscoped().Omit(clause.Associations).
 		Where("id = ?", resource.ID).
-		Select("status").
+		Select("status", "drifted_since").
+		Updates(api.Resource{
+			Status:       resource.Status,
+			DriftedSince: resource.DriftedSince,
+		}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return resource, nil
+}
+
+func (d *sqlResourceDao) UpdateManifestManagers(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Unscoped().Omit(clause.Associations).
+		Where("id = ?", resource.ID).
+		Select("manifest_managers").
 		Updates(api.Resource{
-			Status: resource.Status,
+			ManifestManagers: resource.ManifestManagers,
 		}).Error; err != nil {
 		db.MarkForRollback(ctx, err)
 		return nil, err
@@ -119,6 +185,15 @@ func (d *sqlResourceDao) FindByConsumerName(ctx context.Context, consumerName st
 	return resources, nil
 }
 
+func (d *sqlResourceDao) FindByConsumerNames(ctx context.Context, consumerNames []string) (api.ResourceList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	resources := api.ResourceList{}
+	if err := g2.Where("consumer_name in (?)", consumerNames).Find(&resources).Error; err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
 func (d *sqlResourceDao) All(ctx context.Context) (api.ResourceList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	resources := api.ResourceList{}
@@ -139,3 +214,199 @@ func (d *sqlResourceDao) FirstByConsumerName(ctx context.Context, consumerName s
 	err := g2.Where("consumer_name = ?", consumerName).First(&resource).Error
 	return resource, err
 }
+
+// FindByPlacementID returns the resources created from the placement, including the resources under deletion.
+func (d *sqlResourceDao) FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	resources := api.ResourceList{}
+	if err := g2.Unscoped().Where("placement_id = ?", placementID).Find(&resources).Error; err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// FindPlacementIDs returns the ids of the placements that have resources.
+func (d *sqlResourceDao) FindPlacementIDs(ctx context.Context) ([]string, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var placementIDs []string
+	if err := g2.Unscoped().Model(&api.Resource{}).
+		Where("placement_id <> ''").Distinct().Pluck("placement_id", &placementIDs).Error; err != nil {
+		return nil, err
+	}
+	return placementIDs, nil
+}
+
+func (d *sqlResourceDao) CountByConsumerName(ctx context.Context, consumerName string) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var count int64
+	if err := g2.Model(&api.Resource{}).Where("consumer_name = ?", consumerName).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *sqlResourceDao) CountBySource(ctx context.Context, source string) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var count int64
+	if err := g2.Model(&api.Resource{}).Where("source = ?", source).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (d *sqlResourceDao) CountPerConsumer(ctx context.Context, sources []string) (map[string]int64, error) {
+	return d.countPer(ctx, "consumer_name", sources)
+}
+
+func (d *sqlResourceDao) CountPerSource(ctx context.Context, sources []string) (map[string]int64, error) {
+	return d.countPer(ctx, "source", sources)
+}
+
+// countPer returns the number of the resources of the sources grouped by a column, the resources of all sources
+// are counted if the sources are nil.
+func (d *sqlResourceDao) countPer(ctx context.Context, column string, sources []string) (map[string]int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var rows []struct {
+		Name  string
+		Count int64
+	}
+	query := g2.Model(&api.Resource{}).Select(column + " as name, count(*) as count")
+	if sources != nil {
+		query = query.Where("source in (?)", sources)
+	}
+	if err := query.Group(column).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{}
+	for _, row := range rows {
+		counts[row.Name] = row.Count
+	}
+	return counts, nil
+}
+
+// statusLagging is the condition of the resources whose status of the current version is not reported.
+const statusLagging = `(status is null or status::jsonb = '{}'::jsonb or ` +
+	`(status::jsonb->>'resourceversion')::int < version)`
+
+func (d *sqlResourceDao) MarkStale(ctx context.Context, laggingBefore, now time.Time) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Model(&api.Resource{}).
+		Where("stale_since is null and spec_updated_at < ? and "+statusLagging, laggingBefore).
+		UpdateColumn("stale_since", now).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlResourceDao) ClearStale(ctx context.Context) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Unscoped().Model(&api.Resource{}).
+		Where("stale_since is not null and (deleted_at is not null or not "+statusLagging+")").
+		UpdateColumn("stale_since", nil).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlResourceDao) FindStale(ctx context.Context) (api.ResourceList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	resources := api.ResourceList{}
+	if err := g2.Where("stale_since is not null").Find(&resources).Error; err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// the states of the resources in the summary, a state of the applied, available and degraded states is only
+// counted if the status is reported for the current version of the resource.
+const (
+	currentVersionReported = `(status::jsonb->>'resourceversion')::int = version`
+	statusConditions       = `status::jsonb->'data'->'conditions'`
+	summarySelect          = `count(*) as total, ` +
+		`count(*) filter (where ` + currentVersionReported + ` and ` +
+		statusConditions + ` @> '[{"type":"Applied","status":"True"}]') as applied, ` +
+		`count(*) filter (where ` + currentVersionReported + ` and ` +
+		statusConditions + ` @> '[{"type":"Available","status":"True"}]') as available, ` +
+		`count(*) filter (where ` + currentVersionReported + ` and (` +
+		statusConditions + ` @> '[{"type":"Applied","status":"False"}]' or ` +
+		statusConditions + ` @> '[{"type":"Degraded","status":"True"}]')) as degraded, ` +
+		`count(*) filter (where deleted_at is not null) as deleting, ` +
+		`count(*) filter (where status is null or status::jsonb = '{}'::jsonb) as not_reported`
+)
+
+func (d *sqlResourceDao) Summarize(ctx context.Context, groupBy api.ResourceBundleSummaryGroupBy, label string, sources []string) ([]api.ResourceBundleSummaryItem, error) {
+	var key string
+	var args []interface{}
+	switch groupBy {
+	case api.ResourceBundleSummaryByConsumer:
+		key = "consumer_name"
+	case api.ResourceBundleSummaryBySource:
+		key = "source"
+	case api.ResourceBundleSummaryByLabel:
+		key = "coalesce(payload->'metadata'->'labels'->>?, '')"
+		args = append(args, label)
+	default:
+		return nil, fmt.Errorf("unsupported summary group %q", groupBy)
+	}
+
+	g2 := (*d.sessionFactory).New(ctx)
+	// Unscoped is used to count the resources under deletion
+	query := g2.Unscoped().Model(&api.Resource{}).Select(key+" as key, "+summarySelect, args...)
+	if sources != nil {
+		query = query.Where("source in (?)", sources)
+	}
+
+	items := []api.ResourceBundleSummaryItem{}
+	if err := query.Group("1").Order("1").Scan(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// latestResourceVersion selects the latest resource version of the resources, of the tombstones of the deleted
+// resources and of the purged tombstones.
+const latestResourceVersion = `SELECT GREATEST(` +
+	`(SELECT COALESCE(MAX(resource_version), 0) FROM resources), ` +
+	`(SELECT COALESCE(MAX(id), 0) FROM resource_tombstones), ` +
+	`(SELECT COALESCE(MAX(resource_version), 0) FROM resource_compactions))`
+
+func (d *sqlResourceDao) LatestResourceVersion(ctx context.Context) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var resourceVersion int64
+	if err := g2.Raw(latestResourceVersion).Scan(&resourceVersion).Error; err != nil {
+		return 0, err
+	}
+	return resourceVersion, nil
+}
+
+func (d *sqlResourceDao) CompactedResourceVersion(ctx context.Context) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var resourceVersion int64
+	if err := g2.Raw("SELECT COALESCE(MAX(resource_version), 0) FROM resource_compactions").
+		Scan(&resourceVersion).Error; err != nil {
+		return 0, err
+	}
+	return resourceVersion, nil
+}
+
+func (d *sqlResourceDao) FindChangedSince(ctx context.Context, resourceVersion int64) (api.ResourceList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	resources := api.ResourceList{}
+	if err := g2.Unscoped().Where("resource_version > ?", resourceVersion).
+		Order("resource_version").Find(&resources).Error; err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+func (d *sqlResourceDao) FindTombstonesSince(ctx context.Context, resourceVersion int64) (api.ResourceTombstoneList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	tombstones := api.ResourceTombstoneList{}
+	if err := g2.Where("id > ?", resourceVersion).Order("id").Find(&tombstones).Error; err != nil {
+		return nil, err
+	}
+	return tombstones, nil
+}
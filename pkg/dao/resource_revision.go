@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+type ResourceRevisionDao interface {
+	Get(ctx context.Context, resourceID string, version int32) (*api.ResourceRevision, error)
+	Create(ctx context.Context, revision *api.ResourceRevision) (*api.ResourceRevision, error)
+	FindByResourceID(ctx context.Context, resourceID string) (api.ResourceRevisionList, error)
+}
+
+var _ ResourceRevisionDao = &sqlResourceRevisionDao{}
+
+type sqlResourceRevisionDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewResourceRevisionDao(sessionFactory *db.SessionFactory) ResourceRevisionDao {
+	return &sqlResourceRevisionDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlResourceRevisionDao) Get(ctx context.Context, resourceID string, version int32) (*api.ResourceRevision, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var revision api.ResourceRevision
+	if err := g2.Take(&revision, "resource_id = ? AND version = ?", resourceID, version).Error; err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+func (d *sqlResourceRevisionDao) Create(ctx context.Context, revision *api.ResourceRevision) (*api.ResourceRevision, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(revision).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return revision, nil
+}
+
+// FindByResourceID returns the revisions of the resource, ordered from the newest to the oldest.
+func (d *sqlResourceRevisionDao) FindByResourceID(ctx context.Context, resourceID string) (api.ResourceRevisionList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	revisions := api.ResourceRevisionList{}
+	if err := g2.Where("resource_id = ?", resourceID).Order("version desc").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm/clause"
 
@@ -20,7 +21,9 @@ type StatusEventDao interface {
 
 	DeleteAllReconciledEvents(ctx context.Context) error
 	DeleteAllEvents(ctx context.Context, eventIDs []string) error
+	DeleteEventsCreatedBefore(ctx context.Context, eventIDs []string, before time.Time) error
 	FindAllUnreconciledEvents(ctx context.Context) (api.StatusEventList, error)
+	FindBySourceAfterSequence(ctx context.Context, source string, sequence int64) (api.StatusEventList, error)
 }
 
 var _ StatusEventDao = &sqlStatusEventDao{}
@@ -108,6 +111,19 @@ func (d *sqlStatusEventDao) DeleteAllEvents(ctx context.Context, eventIDs []stri
 	return nil
 }
 
+func (d *sqlStatusEventDao) DeleteEventsCreatedBefore(ctx context.Context, eventIDs []string, before time.Time) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Unscoped().Omit(clause.Associations).Where("id IN ? AND created_at < ?", eventIDs, before).Delete(&api.StatusEvent{}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
 func (d *sqlStatusEventDao) FindAllUnreconciledEvents(ctx context.Context) (api.StatusEventList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	statusEvents := api.StatusEventList{}
@@ -117,6 +133,17 @@ func (d *sqlStatusEventDao) FindAllUnreconciledEvents(ctx context.Context) (api.
 	return statusEvents, nil
 }
 
+// FindBySourceAfterSequence returns the status events of the resources from the given source whose sequence
+// is greater than the given sequence, ordered by their sequence.
+func (d *sqlStatusEventDao) FindBySourceAfterSequence(ctx context.Context, source string, sequence int64) (api.StatusEventList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	statusEvents := api.StatusEventList{}
+	if err := g2.Where("resource_source = ? AND sequence > ?", source, sequence).Order("sequence asc").Find(&statusEvents).Error; err != nil {
+		return nil, err
+	}
+	return statusEvents, nil
+}
+
 func (d *sqlStatusEventDao) All(ctx context.Context) (api.StatusEventList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	statusEvents := api.StatusEventList{}
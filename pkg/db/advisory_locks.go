@@ -23,6 +23,9 @@ const (
 	ResourceStatus LockType = "resource_status"
 	Events         LockType = "events"
 	Instances      LockType = "instances"
+	Placements     LockType = "placements"
+	Operations     LockType = "operations"
+	ResourceQuotas LockType = "resource_quotas"
 )
 
 // LockFactory provides the blocking/unblocking locks based on PostgreSQL advisory lock.
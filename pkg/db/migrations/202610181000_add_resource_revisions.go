@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addResourceRevisions() *gormigrate.Migration {
+	type ResourceRevision struct {
+		Model
+		ResourceID string `gorm:"uniqueIndex:idx_resource_revision;not null"` // primary key of resources table
+		Version    int    `gorm:"uniqueIndex:idx_resource_revision;not null"`
+		// Payload is the resource payload of this version with CloudEvent format (JSON representation).
+		Payload datatypes.JSON `gorm:"type:json"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ResourceRevision{}); err != nil {
+				return err
+			}
+
+			return CreateFK(tx, fkMigration{
+				"resource_revisions", "resources", "resource_id", "resources(id)", "ON DELETE CASCADE",
+			})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&ResourceRevision{})
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addPlacements() *gormigrate.Migration {
+	type Placement struct {
+		Model
+		Name    string `gorm:"uniqueIndex;not null"`
+		Source  string `gorm:"index"`
+		Version int    `gorm:"not null"`
+		// ConsumerSelector is the label selector over the consumer labels (JSON representation).
+		ConsumerSelector datatypes.JSON `gorm:"type:json"`
+		// Payload is the resource bundle template with CloudEvent format (JSON representation).
+		Payload datatypes.JSON `gorm:"type:json"`
+		// Status is the rollout status aggregated from the resources of the placement (JSON representation).
+		Status datatypes.JSON `gorm:"type:json"`
+	}
+
+	type Resource struct {
+		// PlacementID is the primary key of the placements table, it is empty if the resource
+		// is not created from a placement.
+		PlacementID string `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181100",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&Placement{}); err != nil {
+				return err
+			}
+
+			return tx.AutoMigrate(&Resource{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&Resource{}, "placement_id"); err != nil {
+				return err
+			}
+
+			return tx.Migrator().DropTable(&Placement{})
+		},
+	}
+}
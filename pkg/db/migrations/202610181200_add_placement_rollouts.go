@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addPlacementRollouts() *gormigrate.Migration {
+	type Placement struct {
+		// RolloutStrategy is the rollout strategy of the placement (JSON representation).
+		RolloutStrategy datatypes.JSON `gorm:"type:json"`
+		// RolloutPhase is the phase of the rollout of the current placement version.
+		RolloutPhase string
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181200",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Placement{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&Placement{}, "rollout_phase"); err != nil {
+				return err
+			}
+
+			return tx.Migrator().DropColumn(&Placement{}, "rollout_strategy")
+		},
+	}
+}
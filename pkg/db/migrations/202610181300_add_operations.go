@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addOperations() *gormigrate.Migration {
+	type Operation struct {
+		Model
+		Type string `gorm:"index"`
+		// TargetID is the id of the object the operation is performed on, e.g. a consumer id.
+		TargetID  string `gorm:"index"`
+		Search    string
+		Phase     string `gorm:"index"`
+		Total     int    `gorm:"not null"`
+		Completed int    `gorm:"not null"`
+		Message   string
+		// Sources are the sources whose tenants can access the operation (JSON representation), the
+		// operations without sources are only accessible to the admins.
+		Sources datatypes.JSON `gorm:"type:json"`
+		// CompletedAt is the time the operation is succeeded or failed.
+		CompletedAt *time.Time
+	}
+
+	type OperationResource struct {
+		OperationID string `gorm:"primaryKey"`       // primary key of operations table
+		ResourceID  string `gorm:"primaryKey;index"` // primary key of resources table
+		// CompletedAt is the time the resource of the operation is completed, e.g. the resource is
+		// deleted from the consumer.
+		CompletedAt *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181300",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&Operation{}, &OperationResource{}); err != nil {
+				return err
+			}
+
+			return CreateFK(tx, fkMigration{
+				"operation_resources", "operations", "operation_id", "operations(id)", "ON DELETE CASCADE ON UPDATE RESTRICT",
+			})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable(&OperationResource{}); err != nil {
+				return err
+			}
+
+			return tx.Migrator().DropTable(&Operation{})
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addOperationEvents() *gormigrate.Migration {
+	type Operation struct {
+		// EventID is the id of the event that triggered the operation of a single resource.
+		EventID string `gorm:"index"`
+		// ResourceVersion is the version of the resource the consumer must apply.
+		ResourceVersion int `gorm:"not null;default:0"`
+		// DispatchedAt is the time the event of the operation is dispatched to the consumer.
+		DispatchedAt *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181400",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Operation{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&Operation{}, "dispatched_at"); err != nil {
+				return err
+			}
+
+			if err := tx.Migrator().DropColumn(&Operation{}, "resource_version"); err != nil {
+				return err
+			}
+
+			return tx.Migrator().DropColumn(&Operation{}, "event_id")
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// assignStatusEventSequence assigns the sequence of a status event when its transaction commits. The sequence
+// that is taken by the column default at insert is provisional, a transaction that inserts later may commit first
+// with a greater sequence, so that a subscriber resuming from it would miss the smaller one. The deferred constraint
+// trigger takes the next sequence at commit and holds the advisory lock until the commit ends, so the sequences are
+// visible in the order they are assigned.
+const assignStatusEventSequence = `
+CREATE OR REPLACE FUNCTION assign_status_event_sequence() RETURNS trigger AS $$
+BEGIN
+	PERFORM pg_advisory_xact_lock(hashtext('status_event_sequences'));
+	UPDATE status_events SET sequence = nextval(pg_get_serial_sequence('status_events', 'sequence'))
+		WHERE id = NEW.id;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER status_event_sequence_insert AFTER INSERT ON status_events
+	DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION assign_status_event_sequence();
+`
+
+const dropStatusEventSequenceTrigger = `
+DROP TRIGGER IF EXISTS status_event_sequence_insert ON status_events;
+DROP FUNCTION IF EXISTS assign_status_event_sequence();
+`
+
+func addStatusEventSequence() *gormigrate.Migration {
+	type StatusEvent struct {
+		ResourceSource string `gorm:"index:idx_status_events_source_sequence,priority:1"`
+		// Sequence orders the status events, the subscribers resume from the last sequence they received.
+		Sequence int64 `gorm:"autoIncrement;index:idx_status_events_source_sequence,priority:2"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181500",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&StatusEvent{}); err != nil {
+				return err
+			}
+			return tx.Exec(assignStatusEventSequence).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec(dropStatusEventSequenceTrigger).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropIndex(&StatusEvent{}, "idx_status_events_source_sequence"); err != nil {
+				return err
+			}
+
+			return tx.Migrator().DropColumn(&StatusEvent{}, "sequence")
+		},
+	}
+}
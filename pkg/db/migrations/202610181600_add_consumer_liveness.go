@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addConsumerLiveness() *gormigrate.Migration {
+	type Consumer struct {
+		Connected  bool       `gorm:"not null;default:false"`
+		LastSeen   *time.Time `gorm:"index"`
+		SilencedAt *time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181600",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Consumer{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"connected", "last_seen", "silenced_at"} {
+				if err := tx.Migrator().DropColumn(&Consumer{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
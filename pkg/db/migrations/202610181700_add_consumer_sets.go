@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addConsumerSets() *gormigrate.Migration {
+	type ConsumerSet struct {
+		Model
+		Name string `gorm:"uniqueIndex;not null"`
+		// Members are the names of the consumers of a static set (JSON representation).
+		Members datatypes.JSON `gorm:"type:json"`
+		// ConsumerSelector is the label selector over the consumer labels (JSON representation).
+		ConsumerSelector datatypes.JSON `gorm:"type:json"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181700",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&ConsumerSet{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&ConsumerSet{})
+		},
+	}
+}
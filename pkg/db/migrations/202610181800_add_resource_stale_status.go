@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addResourceStaleStatus() *gormigrate.Migration {
+	type Resource struct {
+		// SpecUpdatedAt is the time when the current version of the resource is created.
+		SpecUpdatedAt *time.Time
+		// StaleSince is the time when the status of the resource is detected as lagging behind its version.
+		StaleSince *time.Time `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181800",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&Resource{}); err != nil {
+				return err
+			}
+			// the existing resources are considered as updated at their last update
+			return tx.Exec("UPDATE resources SET spec_updated_at = updated_at WHERE spec_updated_at IS NULL").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"spec_updated_at", "stale_since"} {
+				if err := tx.Migrator().DropColumn(&Resource{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addResourceDriftedSince() *gormigrate.Migration {
+	type Resource struct {
+		// DriftedSince is the time since the live objects of the resource diverge from its manifests.
+		DriftedSince *time.Time `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610181900",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Resource{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&Resource{}, "drifted_since")
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// assignResourceVersion assigns the next resource version to a changed resource and records a tombstone for a
+// deleted resource. It runs as a deferred constraint trigger, so the version is assigned when the transaction
+// commits, and the advisory lock is held until the commit ends, so the resource versions are visible in the order
+// they are assigned. A reader that sees a resource version has seen all of the smaller ones.
+//
+// Only the updates that change the spec, the status or the deletion of a resource are assigned a resource version,
+// so the updates of the bookkeeping columns, e.g. the stale resources that are marked in bulk, are not serialized
+// with the changes that the watches replay. The payload and the status are json columns, which have no equality
+// operator, so they are compared as jsonb.
+const assignResourceVersion = `
+CREATE OR REPLACE FUNCTION assign_resource_version() RETURNS trigger AS $$
+BEGIN
+	PERFORM pg_advisory_xact_lock(hashtext('resource_versions'));
+	IF TG_OP = 'DELETE' THEN
+		INSERT INTO resource_tombstones (id, resource_id, version, source, consumer_name, name, payload, created_at)
+		VALUES (nextval('resource_versions'), OLD.id, OLD.version, OLD.source, OLD.consumer_name, OLD.name,
+			OLD.payload::jsonb, now());
+	ELSE
+		UPDATE resources SET resource_version = nextval('resource_versions') WHERE id = NEW.id;
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER resource_version_insert AFTER INSERT ON resources
+	DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION assign_resource_version();
+CREATE CONSTRAINT TRIGGER resource_version_update AFTER UPDATE ON resources
+	DEFERRABLE INITIALLY DEFERRED FOR EACH ROW
+	WHEN (OLD.resource_version IS NOT DISTINCT FROM NEW.resource_version AND (
+		OLD.version IS DISTINCT FROM NEW.version OR
+		OLD.payload::jsonb IS DISTINCT FROM NEW.payload::jsonb OR
+		OLD.status::jsonb IS DISTINCT FROM NEW.status::jsonb OR
+		OLD.deleted_at IS DISTINCT FROM NEW.deleted_at))
+	EXECUTE FUNCTION assign_resource_version();
+CREATE CONSTRAINT TRIGGER resource_version_delete AFTER DELETE ON resources
+	DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION assign_resource_version();
+`
+
+const dropResourceVersionTriggers = `
+DROP TRIGGER IF EXISTS resource_version_insert ON resources;
+DROP TRIGGER IF EXISTS resource_version_update ON resources;
+DROP TRIGGER IF EXISTS resource_version_delete ON resources;
+DROP FUNCTION IF EXISTS assign_resource_version();
+`
+
+func addResourceVersions() *gormigrate.Migration {
+	type Resource struct {
+		// ResourceVersion is the global version of the last change of the resource, it is assigned by the database.
+		ResourceVersion int64 `gorm:"not null;default:0;index"`
+	}
+	type ResourceTombstone struct {
+		// ID is the resource version of the deletion.
+		ID           int64  `gorm:"primaryKey;autoIncrement:false"`
+		ResourceID   string `gorm:"index"` // primary key of resources table
+		Version      int32
+		Source       string
+		ConsumerName string
+		Name         string
+		Payload      datatypes.JSONMap `gorm:"type:jsonb"`
+		CreatedAt    time.Time         `gorm:"index"`
+	}
+	type ResourceCompaction struct {
+		ID int `gorm:"primaryKey;autoIncrement:false"`
+		// ResourceVersion is the latest resource version of the purged tombstones, a watch cannot resume from a
+		// resource version before it.
+		ResourceVersion int64 `gorm:"not null;default:0"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610182000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Exec("CREATE SEQUENCE IF NOT EXISTS resource_versions").Error; err != nil {
+				return err
+			}
+			if err := tx.AutoMigrate(&Resource{}, &ResourceTombstone{}, &ResourceCompaction{}); err != nil {
+				return err
+			}
+			if err := tx.Exec("INSERT INTO resource_compactions (id, resource_version) VALUES (1, 0) " +
+				"ON CONFLICT DO NOTHING").Error; err != nil {
+				return err
+			}
+			// the existing resources are the changes since the first resource version
+			if err := tx.Exec("UPDATE resources SET resource_version = nextval('resource_versions')").Error; err != nil {
+				return err
+			}
+			return tx.Exec(assignResourceVersion).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec(dropResourceVersionTriggers).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropTable(&ResourceTombstone{}, &ResourceCompaction{}); err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&Resource{}, "resource_version"); err != nil {
+				return err
+			}
+			return tx.Exec("DROP SEQUENCE IF EXISTS resource_versions").Error
+		},
+	}
+}
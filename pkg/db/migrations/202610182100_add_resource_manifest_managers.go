@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addResourceManifestManagers() *gormigrate.Migration {
+	type Resource struct {
+		// ManifestManagers maps the keys of the manifests of the resource to the field managers that own them.
+		ManifestManagers datatypes.JSONMap `gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610182100",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Resource{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&Resource{}, "manifest_managers")
+		},
+	}
+}
@@ -35,6 +35,18 @@ var MigrationList = []*gormigrate.Migration{
 	addEventInstances(),
 	addLastHeartBeatAndReadyColumnInServerInstancesTable(),
 	alterEventInstances(),
+	addResourceRevisions(),
+	addPlacements(),
+	addPlacementRollouts(),
+	addOperations(),
+	addOperationEvents(),
+	addStatusEventSequence(),
+	addConsumerLiveness(),
+	addConsumerSets(),
+	addResourceStaleStatus(),
+	addResourceDriftedSince(),
+	addResourceVersions(),
+	addResourceManifestManagers(),
 }
 
 // CleanUpDirtyData clean up the dirty data before migrating the tables.
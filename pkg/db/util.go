@@ -33,3 +33,21 @@ func EmptyMapToNilStringMap(a *map[string]string) *StringMap {
 	sm := StringMap(*a)
 	return &sm
 }
+
+// StringSlice is a list of strings that is stored as a JSON array
+type StringSlice []string
+
+func (s *StringSlice) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+	return json.Unmarshal(value.([]byte), s)
+}
+
+func (s StringSlice) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal([]string{})
+	}
+	return json.Marshal([]string(s))
+}
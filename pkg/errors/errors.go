@@ -56,6 +56,12 @@ const (
 
 	// DatabaseAdvisoryLock occurs whe the advisory lock is failed to get
 	ErrorDatabaseAdvisoryLock ServiceErrorCode = 26
+
+	// QuotaExceeded occurs when a request exceeds the quotas of the resource bundles
+	ErrorQuotaExceeded ServiceErrorCode = 27
+
+	// Gone occurs when a watch resumes from a resource version that is compacted
+	ErrorGone ServiceErrorCode = 28
 )
 
 type ServiceErrorCode int
@@ -86,6 +92,8 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorBadRequest, "Bad request", http.StatusBadRequest},
 		ServiceError{ErrorFailedToParseSearch, "Failed to parse search query", http.StatusBadRequest},
 		ServiceError{ErrorDatabaseAdvisoryLock, "Database advisory lock error", http.StatusInternalServerError},
+		ServiceError{ErrorQuotaExceeded, "Quota exceeded", http.StatusForbidden},
+		ServiceError{ErrorGone, "The requested resource version is compacted", http.StatusGone},
 	}
 }
 
@@ -131,6 +139,14 @@ func (e *ServiceError) IsConflict() bool {
 	return e.Code == Conflict("").Code
 }
 
+func (e *ServiceError) IsQuotaExceeded() bool {
+	return e.Code == QuotaExceeded("").Code
+}
+
+func (e *ServiceError) IsGone() bool {
+	return e.Code == Gone("").Code
+}
+
 func (e *ServiceError) IsForbidden() bool {
 	return e.Code == Forbidden("").Code
 }
@@ -199,6 +215,14 @@ func FailedToParseSearch(reason string, values ...interface{}) *ServiceError {
 	return New(ErrorFailedToParseSearch, message, values...)
 }
 
+func QuotaExceeded(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorQuotaExceeded, reason, values...)
+}
+
+func Gone(reason string, values ...interface{}) *ServiceError {
+	return New(ErrorGone, reason, values...)
+}
+
 func DatabaseAdvisoryLock(err error) *ServiceError {
 	return New(ErrorDatabaseAdvisoryLock, err.Error(), []string{})
 }
@@ -10,13 +10,22 @@ import (
 	"github.com/openshift-online/maestro/pkg/api"
 )
 
-// resourceHandler is a function that can handle resource status change events.
-type resourceHandler func(res *api.Resource) error
+// resourceHandler is a function that can handle resource status change events. The sequence is the sequence of
+// the status event of the change, it is 0 if the change is not from a status event, e.g. a status resync.
+type resourceHandler func(res *api.Resource, sequence int64) error
+
+// statusChange is a resource status change event with the sequence of its status event.
+type statusChange struct {
+	resource *api.Resource
+	sequence int64
+}
 
 // eventClient is a client that can receive and handle resource status change events.
 type eventClient struct {
-	source  string
-	handler resourceHandler
+	source string
+	// allSources is true if the client handles the events of all sources, e.g. a REST watch.
+	allSources bool
+	handler    resourceHandler
 }
 
 // EventBroadcaster is a component that can broadcast resource status change events to registered clients.
@@ -27,14 +36,14 @@ type EventBroadcaster struct {
 	clients map[string]*eventClient
 
 	// inbound messages from the clients.
-	broadcast chan *api.Resource
+	broadcast chan statusChange
 }
 
 // NewEventBroadcaster creates a new event broadcaster.
 func NewEventBroadcaster() *EventBroadcaster {
 	return &EventBroadcaster{
 		clients:   make(map[string]*eventClient),
-		broadcast: make(chan *api.Resource),
+		broadcast: make(chan statusChange),
 	}
 }
 
@@ -57,6 +66,25 @@ func (h *EventBroadcaster) Register(ctx context.Context, source string, handler
 	return id
 }
 
+// RegisterAll registers a client that handles the resource status change events of all sources, e.g. a
+// REST watch of resource bundles, and returns the client id.
+func (h *EventBroadcaster) RegisterAll(ctx context.Context, handler resourceHandler) string {
+	logger := klog.FromContext(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	id := uuid.NewString()
+	h.clients[id] = &eventClient{
+		allSources: true,
+		handler:    handler,
+	}
+
+	logger.Info("registered a broadcaster client for all sources", "id", id)
+
+	return id
+}
+
 // Unregister unregisters a client by id
 func (h *EventBroadcaster) Unregister(ctx context.Context, id string) {
 	logger := klog.FromContext(ctx).WithValues("id", id)
@@ -71,12 +99,20 @@ func (h *EventBroadcaster) Unregister(ctx context.Context, id string) {
 
 	delete(h.clients, id)
 	logger.Info("unregistered broadcaster client", "source", client.source)
-	grpcRegisteredSourceClientsGaugeMetric.WithLabelValues(client.source).Dec()
+	if !client.allSources {
+		grpcRegisteredSourceClientsGaugeMetric.WithLabelValues(client.source).Dec()
+	}
 }
 
 // Broadcast broadcasts a resource status change event to all registered clients.
 func (h *EventBroadcaster) Broadcast(res *api.Resource) {
-	h.broadcast <- res
+	h.broadcast <- statusChange{resource: res}
+}
+
+// BroadcastStatusEvent broadcasts a resource status change event with the sequence of its status event to all
+// registered clients.
+func (h *EventBroadcaster) BroadcastStatusEvent(res *api.Resource, sequence int64) {
+	h.broadcast <- statusChange{resource: res, sequence: sequence}
 }
 
 // Start starts the event broadcaster and waits for events to broadcast.
@@ -88,7 +124,8 @@ func (h *EventBroadcaster) Start(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
-		case res := <-h.broadcast:
+		case change := <-h.broadcast:
+			res := change.resource
 			h.mu.RLock()
 
 			if len(h.clients) == 0 {
@@ -96,8 +133,8 @@ func (h *EventBroadcaster) Start(ctx context.Context) {
 			}
 
 			for _, client := range h.clients {
-				if client.source == res.Source {
-					if err := client.handler(res); err != nil {
+				if client.allSources || client.source == res.Source {
+					if err := client.handler(res, change.sequence); err != nil {
 						logger.Error(err, "failed to handle resource", "resourceID", res.ID)
 					}
 				}
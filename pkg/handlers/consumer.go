@@ -11,21 +11,25 @@ import (
 	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/services"
+	"github.com/openshift-online/maestro/pkg/util"
 )
 
 var _ RestHandler = consumerHandler{}
 
 type consumerHandler struct {
-	consumer services.ConsumerService
-	resource services.ResourceService
-	generic  services.GenericService
+	consumer  services.ConsumerService
+	resource  services.ResourceService
+	operation services.OperationService
+	generic   services.GenericService
 }
 
-func NewConsumerHandler(consumer services.ConsumerService, resource services.ResourceService, generic services.GenericService) *consumerHandler {
+func NewConsumerHandler(consumer services.ConsumerService, resource services.ResourceService,
+	operation services.OperationService, generic services.GenericService) *consumerHandler {
 	return &consumerHandler{
-		consumer: consumer,
-		resource: resource,
-		generic:  generic,
+		consumer:  consumer,
+		resource:  resource,
+		operation: operation,
+		generic:   generic,
 	}
 }
 
@@ -84,6 +88,9 @@ func (h consumerHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
+			if err := services.ConsumerAdminRequired(ctx); err != nil {
+				return nil, err
+			}
 
 			listArgs := services.NewListArguments(r.URL.Query())
 			consumers := []api.Consumer{}
@@ -92,11 +99,12 @@ func (h consumerHandler) List(w http.ResponseWriter, r *http.Request) {
 				return nil, err
 			}
 			consumerList := openapi.ConsumerList{
-				Kind:  *presenters.ObjectKind(consumers),
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []openapi.Consumer{},
+				Kind:     *presenters.ObjectKind(consumers),
+				Page:     int32(paging.Page),
+				Size:     int32(paging.Size),
+				Total:    int32(paging.Total),
+				Continue: util.EmptyStringToNil(paging.Continue),
+				Items:    []openapi.Consumer{},
 			}
 
 			for _, consumer := range consumers {
@@ -134,7 +142,20 @@ func (h consumerHandler) Get(w http.ResponseWriter, r *http.Request) {
 	handleGet(w, r, cfg)
 }
 
+// Delete deletes a consumer that has no resource bundles. With the cascade query parameter, an operation
+// is started to delete the resource bundles of the consumer, the consumer is deleted once the deletion
+// of all of its resource bundles is acknowledged by the agent.
 func (h consumerHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cascade, serviceErr := cascadeFromRequest(r)
+	if serviceErr != nil {
+		handleError(r.Context(), w, serviceErr)
+		return
+	}
+	if cascade {
+		h.deleteCascade(w, r)
+		return
+	}
+
 	cfg := &handlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
@@ -146,3 +167,23 @@ func (h consumerHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 	handleDelete(w, r, cfg, http.StatusNoContent)
 }
+
+func (h consumerHandler) deleteCascade(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			consumer, err := h.consumer.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			operation, err := h.operation.DeleteConsumer(ctx, consumer)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentOperation(operation), nil
+		},
+	}
+	handleDelete(w, r, cfg, http.StatusAccepted)
+}
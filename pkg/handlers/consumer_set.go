@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+	"github.com/openshift-online/maestro/pkg/util"
+)
+
+var _ RestHandler = consumerSetHandler{}
+
+type consumerSetHandler struct {
+	consumerSet services.ConsumerSetService
+	generic     services.GenericService
+}
+
+func NewConsumerSetHandler(consumerSet services.ConsumerSetService, generic services.GenericService) *consumerSetHandler {
+	return &consumerSetHandler{
+		consumerSet: consumerSet,
+		generic:     generic,
+	}
+}
+
+// Create creates a consumer set with either the static members or a label selector over the consumer labels.
+func (h consumerSetHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var cs openapi.ConsumerSet
+	cfg := &handlerConfig{
+		&cs,
+		[]validate{
+			validateEmpty(&cs, "Id", "id"),
+			validateNotEmpty(&cs, "Name", "name"),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			consumerSet, serviceErr := h.consumerSet.Create(ctx, presenters.ConvertConsumerSet(cs))
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			return h.present(r, consumerSet)
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusCreated)
+}
+
+// Patch replaces the membership of a consumer set, either the members or the consumer selector is required.
+func (h consumerSetHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	var patch openapi.ConsumerSetPatchRequest
+	cfg := &handlerConfig{
+		&patch,
+		[]validate{},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			consumerSet, serviceErr := h.consumerSet.Update(ctx, &api.ConsumerSet{
+				Meta:             api.Meta{ID: id},
+				Members:          patch.Members,
+				ConsumerSelector: patch.ConsumerSelector,
+			})
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			return h.present(r, consumerSet)
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+func (h consumerSetHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			consumerSet, serviceErr := h.consumerSet.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			return h.present(r, consumerSet)
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+func (h consumerSetHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+
+			listArgs := services.NewListArguments(r.URL.Query())
+			consumerSets := []api.ConsumerSet{}
+			paging, serviceErr := h.generic.List(ctx, "username", listArgs, &consumerSets)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			// the statuses of the consumer sets in the page are aggregated at once
+			page := api.ConsumerSetList{}
+			for i := range consumerSets {
+				page = append(page, &consumerSets[i])
+			}
+			statuses, serviceErr := h.consumerSet.Statuses(ctx, page)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			consumerSetList := openapi.ConsumerSetList{
+				Kind:     *presenters.ObjectKind(consumerSets),
+				Page:     int32(paging.Page),
+				Size:     int32(paging.Size),
+				Total:    int32(paging.Total),
+				Continue: util.EmptyStringToNil(paging.Continue),
+				Items:    []openapi.ConsumerSet{},
+			}
+			for _, consumerSet := range page {
+				consumerSetList.Items = append(consumerSetList.Items, presenters.PresentConsumerSet(consumerSet, statuses[consumerSet.ID]))
+			}
+			if listArgs.Fields != nil {
+				filteredItems, err := presenters.SliceFilter(listArgs.Fields, consumerSetList.Items)
+				if err != nil {
+					return nil, err
+				}
+				return filteredItems, nil
+			}
+			return consumerSetList, nil
+		},
+	}
+
+	handleList(w, r, cfg)
+}
+
+// Delete deletes a consumer set, the consumers in the set and their resource bundles are not affected.
+func (h consumerSetHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			if err := h.consumerSet.Delete(r.Context(), id); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	}
+	handleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// present presents a consumer set with its status aggregated from the resource bundles of its consumers.
+func (h consumerSetHandler) present(r *http.Request, consumerSet *api.ConsumerSet) (interface{}, *errors.ServiceError) {
+	statuses, serviceErr := h.consumerSet.Statuses(r.Context(), api.ConsumerSetList{consumerSet})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	return presenters.PresentConsumerSet(consumerSet, statuses[consumerSet.ID]), nil
+}
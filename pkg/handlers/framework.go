@@ -102,6 +102,21 @@ func handleDelete(w http.ResponseWriter, r *http.Request, cfg *handlerConfig, ht
 
 }
 
+// handleAction runs an action that has no request body, e.g. pausing the rollout of a placement.
+func handleAction(w http.ResponseWriter, r *http.Request, cfg *handlerConfig, httpStatus int) {
+	if cfg.ErrorHandler == nil {
+		cfg.ErrorHandler = handleError
+	}
+
+	result, serviceErr := cfg.Action()
+	switch {
+	case serviceErr != nil:
+		cfg.ErrorHandler(r.Context(), w, serviceErr)
+	default:
+		writeJSONResponse(w, httpStatus, result)
+	}
+}
+
 func handleGet(w http.ResponseWriter, r *http.Request, cfg *handlerConfig) {
 	if cfg.ErrorHandler == nil {
 		cfg.ErrorHandler = handleError
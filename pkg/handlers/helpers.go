@@ -4,6 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"reflect"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
 )
 
 func writeJSONResponse(w http.ResponseWriter, code int, payload interface{}) {
@@ -36,3 +42,58 @@ func determineListRange(obj interface{}, page int, size int64) (list []interface
 
 	return list, total
 }
+
+// dryRunFromRequest returns the value of the dryRun query parameter, false if it is not set.
+func dryRunFromRequest(r *http.Request) (bool, *errors.ServiceError) {
+	value := r.URL.Query().Get("dryRun")
+	if value == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.BadRequest("invalid dryRun value %q", value)
+	}
+	return dryRun, nil
+}
+
+// cascadeFromRequest returns the value of the cascade query parameter.
+func cascadeFromRequest(r *http.Request) (bool, *errors.ServiceError) {
+	value := r.URL.Query().Get("cascade")
+	if value == "" {
+		return false, nil
+	}
+	cascade, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.BadRequest("invalid cascade value %q", value)
+	}
+	return cascade, nil
+}
+
+// applyOptionsFromRequest returns the apply options in the fieldManager and force query parameters, the field
+// manager is empty if it is not set.
+func applyOptionsFromRequest(r *http.Request) (api.ApplyOptions, *errors.ServiceError) {
+	options := api.ApplyOptions{FieldManager: r.URL.Query().Get("fieldManager")}
+	value := r.URL.Query().Get("force")
+	if value == "" {
+		return options, nil
+	}
+	force, err := strconv.ParseBool(value)
+	if err != nil {
+		return api.ApplyOptions{}, errors.BadRequest("invalid force value %q", value)
+	}
+	if force && options.FieldManager == "" {
+		return api.ApplyOptions{}, errors.BadRequest("force requires a fieldManager")
+	}
+	options.Force = force
+	return options, nil
+}
+
+// versionFromRequest returns the value of the version path variable.
+func versionFromRequest(r *http.Request) (int32, *errors.ServiceError) {
+	value := mux.Vars(r)["version"]
+	version, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, errors.BadRequest("invalid version %q", value)
+	}
+	return int32(version), nil
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+	"github.com/openshift-online/maestro/pkg/util"
+)
+
+type operationHandler struct {
+	operation services.OperationService
+	generic   services.GenericService
+}
+
+func NewOperationHandler(operation services.OperationService, generic services.GenericService) *operationHandler {
+	return &operationHandler{
+		operation: operation,
+		generic:   generic,
+	}
+}
+
+func (h operationHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+
+			listArgs := services.NewListArguments(r.URL.Query())
+			listArgs.Scope = h.operation.Scope(ctx)
+			operations := []api.Operation{}
+			paging, err := h.generic.List(ctx, "username", listArgs, &operations)
+			if err != nil {
+				return nil, err
+			}
+			operationList := openapi.OperationList{
+				Kind:     *presenters.ObjectKind(operations),
+				Page:     int32(paging.Page),
+				Size:     int32(paging.Size),
+				Total:    int32(paging.Total),
+				Continue: util.EmptyStringToNil(paging.Continue),
+				Items:    []openapi.Operation{},
+			}
+
+			for _, operation := range operations {
+				converted := presenters.PresentOperation(&operation)
+				operationList.Items = append(operationList.Items, converted)
+			}
+			if listArgs.Fields != nil {
+				filteredItems, err := presenters.SliceFilter(listArgs.Fields, operationList.Items)
+				if err != nil {
+					return nil, err
+				}
+				return filteredItems, nil
+			}
+			return operationList, nil
+		},
+	}
+
+	handleList(w, r, cfg)
+}
+
+func (h operationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			operation, err := h.operation.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			return presenters.PresentOperation(operation), nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+	"github.com/openshift-online/maestro/pkg/util"
+)
+
+var _ RestHandler = placementHandler{}
+
+type placementHandler struct {
+	placement services.PlacementService
+	generic   services.GenericService
+}
+
+func NewPlacementHandler(placement services.PlacementService, generic services.GenericService) *placementHandler {
+	return &placementHandler{
+		placement: placement,
+		generic:   generic,
+	}
+}
+
+// Create creates a placement, a resource bundle is created from the placement template for every
+// consumer that matches the consumer selector of the placement.
+func (h placementHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var p openapi.Placement
+	cfg := &handlerConfig{
+		&p,
+		[]validate{
+			validateEmpty(&p, "Id", "id"),
+			validateNotEmpty(&p, "Name", "name"),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if util.NilToEmptyString(p.Source) == "" {
+				p.Source = openapi.PtrString(defaultSource(ctx))
+			}
+			placement, err := presenters.ConvertPlacement(p)
+			if err != nil {
+				return nil, errors.Validation("the placement is invalid, %v", err)
+			}
+
+			placement, serviceErr := h.placement.Create(ctx, placement)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			created, err := presenters.PresentPlacement(placement)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present placement: %s", err)
+			}
+			return created, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusCreated)
+}
+
+// Patch updates the consumer selector, the resource bundle template and the rollout strategy of a placement. The version
+// of the placement is required, the update is rejected with a conflict if it is not the latest version
+// of the placement.
+func (h placementHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	var patch openapi.PlacementPatchRequest
+	cfg := &handlerConfig{
+		&patch,
+		[]validate{
+			validateNotEmpty(&patch, "Version", "version"),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			found, serviceErr := h.placement.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			payload, err := api.PatchManifestBundle(found.Payload, &api.ManifestBundleWrapper{
+				Meta:            patch.Metadata,
+				Manifests:       patch.Manifests,
+				ManifestConfigs: patch.ManifestConfigs,
+				DeleteOption:    patch.DeleteOption,
+			})
+			if err != nil {
+				return nil, errors.Validation("the placement is invalid, %v", err)
+			}
+			found.Payload = payload
+			if patch.ConsumerSelector != nil {
+				found.ConsumerSelector = patch.ConsumerSelector
+			}
+			if patch.RolloutStrategy != nil {
+				strategy, err := presenters.ConvertRolloutStrategy(patch.RolloutStrategy)
+				if err != nil {
+					return nil, errors.Validation("the rollout strategy is invalid, %v", err)
+				}
+				found.RolloutStrategy = strategy
+			}
+			found.Version = *patch.Version
+
+			placement, serviceErr := h.placement.Update(ctx, found)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			updated, err := presenters.PresentPlacement(placement)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present placement: %s", err)
+			}
+			return updated, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+func (h placementHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			placement, serviceErr := h.placement.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			p, err := presenters.PresentPlacement(placement)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present placement: %s", err)
+			}
+			return p, nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+func (h placementHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+
+			listArgs := services.NewListArguments(r.URL.Query())
+			listArgs.Scope = h.placement.Scope(ctx)
+			placements := []api.Placement{}
+			paging, serviceErr := h.generic.List(ctx, "username", listArgs, &placements)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			placementList := openapi.PlacementList{
+				Kind:     *presenters.ObjectKind(placements),
+				Page:     int32(paging.Page),
+				Size:     int32(paging.Size),
+				Total:    int32(paging.Total),
+				Continue: util.EmptyStringToNil(paging.Continue),
+				Items:    []openapi.Placement{},
+			}
+
+			for _, placement := range placements {
+				converted, err := presenters.PresentPlacement(&placement)
+				if err != nil {
+					return nil, errors.GeneralError("failed to present placement: %s", err)
+				}
+				placementList.Items = append(placementList.Items, *converted)
+			}
+			if listArgs.Fields != nil {
+				filteredItems, err := presenters.SliceFilter(listArgs.Fields, placementList.Items)
+				if err != nil {
+					return nil, err
+				}
+				return filteredItems, nil
+			}
+			return placementList, nil
+		},
+	}
+
+	handleList(w, r, cfg)
+}
+
+// Pause pauses the rollout of a placement, no resource bundle of the placement is created or updated
+// until the rollout is resumed.
+func (h placementHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	h.setRolloutPhase(w, r, api.RolloutPaused)
+}
+
+// Resume resumes the paused rollout of a placement.
+func (h placementHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	h.setRolloutPhase(w, r, api.RolloutProgressing)
+}
+
+// Abort aborts the rollout of a placement, the resource bundles that are not updated keep the previous
+// template until the placement is updated again.
+func (h placementHandler) Abort(w http.ResponseWriter, r *http.Request) {
+	h.setRolloutPhase(w, r, api.RolloutAborted)
+}
+
+func (h placementHandler) setRolloutPhase(w http.ResponseWriter, r *http.Request, phase api.RolloutPhase) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			placement, serviceErr := h.placement.SetRolloutPhase(r.Context(), id, phase)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			p, err := presenters.PresentPlacement(placement)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present placement: %s", err)
+			}
+			return p, nil
+		},
+	}
+
+	handleAction(w, r, cfg, http.StatusOK)
+}
+
+// Delete deletes a placement, the resource bundles of the placement are deleted from their consumers
+// by the placement controller.
+func (h placementHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			if err := h.placement.Delete(r.Context(), id); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	}
+	handleDelete(w, r, cfg, http.StatusNoContent)
+}
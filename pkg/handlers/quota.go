@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+type quotaHandler struct {
+	quota services.QuotaService
+}
+
+func NewQuotaHandler(quota services.QuotaService) *quotaHandler {
+	return &quotaHandler{
+		quota: quota,
+	}
+}
+
+// Get returns the quotas of the resource bundles and the number of the resource bundles of each consumer and
+// each source.
+func (h quotaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			usage, err := h.quota.Usage(r.Context())
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentQuotaUsage(usage), nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
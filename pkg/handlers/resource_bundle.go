@@ -1,39 +1,247 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/gorilla/mux"
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/event"
 	"github.com/openshift-online/maestro/pkg/services"
+	"github.com/openshift-online/maestro/pkg/util"
 )
 
 var _ RestHandler = resourceBundleHandler{}
 
+// defaultResourceBundleSource is the source of the resource bundles created through the REST API
+// without an explicit source.
+const defaultResourceBundleSource = "maestro"
+
+// defaultSource returns the source of a resource bundle that is created without an explicit source, it is the
+// first source of the tenant if the request is scoped to the sources of a tenant.
+func defaultSource(ctx context.Context) string {
+	if sources, scoped := auth.SourcesFromContext(ctx); scoped && len(sources) > 0 {
+		return sources[0]
+	}
+	return defaultResourceBundleSource
+}
+
 type resourceBundleHandler struct {
-	resource services.ResourceService
-	generic  services.GenericService
+	resource    services.ResourceService
+	operation   services.OperationService
+	generic     services.GenericService
+	broadcaster *event.EventBroadcaster
+	// watchDone is closed when the server shuts down to end the watches.
+	watchDone <-chan struct{}
+	// maxDeleteCollection is the maximum number of resource bundles that a bulk deletion marks as deleting.
+	maxDeleteCollection int
 }
 
-func NewResourceBundleHandler(resource services.ResourceService, generic services.GenericService) *resourceBundleHandler {
+func NewResourceBundleHandler(resource services.ResourceService, operation services.OperationService,
+	generic services.GenericService, broadcaster *event.EventBroadcaster, watchDone <-chan struct{},
+	maxDeleteCollection int) *resourceBundleHandler {
 	return &resourceBundleHandler{
-		resource: resource,
-		generic:  generic,
+		resource:            resource,
+		operation:           operation,
+		generic:             generic,
+		broadcaster:         broadcaster,
+		watchDone:           watchDone,
+		maxDeleteCollection: maxDeleteCollection,
 	}
 }
 
+// Create creates a resource bundle, the operation that tracks the creation until the agent applies
+// the resource bundle is returned in the response header. With the dryRun query parameter the resource
+// bundle is only validated, and the manifests that would be created are returned.
 func (h resourceBundleHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// not implemented
-	http.Error(w, "Not Implemented Yet", http.StatusNotImplemented)
+	dryRun, serviceErr := dryRunFromRequest(r)
+	if serviceErr != nil {
+		handleError(r.Context(), w, serviceErr)
+		return
+	}
+	applyOptions, serviceErr := applyOptionsFromRequest(r)
+	if serviceErr != nil {
+		handleError(r.Context(), w, serviceErr)
+		return
+	}
+
+	var rb openapi.ResourceBundle
+	cfg := &handlerConfig{
+		&rb,
+		[]validate{
+			validateEmpty(&rb, "Id", "id"),
+			validateNotEmpty(&rb, "ConsumerName", "consumer_name"),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if util.NilToEmptyString(rb.Source) == "" {
+				rb.Source = openapi.PtrString(defaultSource(ctx))
+			}
+			resource, err := presenters.ConvertResourceBundle(rb)
+			if err != nil {
+				return nil, errors.Validation("the resource bundle is invalid, %v", err)
+			}
+			// the manifests of a resource bundle created by a field manager are owned by it
+			resource.ManifestManagers, err = api.ManifestManagersOf(resource.Payload, applyOptions.FieldManager)
+			if err != nil {
+				return nil, errors.Validation("the resource bundle is invalid, %v", err)
+			}
+			if dryRun {
+				diff, serviceErr := h.resource.DryRunCreate(ctx, resource)
+				if serviceErr != nil {
+					return nil, serviceErr
+				}
+				// the id is generated on creation, it is not known until the resource bundle is created
+				diff.ResourceID = ""
+				return presenters.PresentResourceBundleDiff(diff), nil
+			}
+
+			resource, serviceErr := h.resource.Create(ctx, resource)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := h.trackResource(w, r, api.CreateEventType, resource); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			created, err := presenters.PresentResourceBundle(resource)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+			}
+			return created, nil
+		},
+		handleError,
+	}
+
+	status := http.StatusCreated
+	if dryRun {
+		status = http.StatusOK
+	}
+	handle(w, r, cfg, status)
 }
 
+// Patch updates the metadata, manifests, manifest configs and delete option of a resource bundle.
+// The version of the resource bundle is required, the update is rejected with a conflict if it is
+// not the latest version of the resource bundle. The operation that tracks the update is returned in
+// the response header. With the dryRun query parameter the resource bundle is not updated, and the
+// difference between the stored resource bundle and the patched one is returned.
+//
+// With the fieldManager query parameter the manifests are applied on behalf of the field manager,
+// only the manifests it owns are replaced or removed, and the version is optional.
 func (h resourceBundleHandler) Patch(w http.ResponseWriter, r *http.Request) {
-	// not implemented
-	http.Error(w, "Not Implemented Yet", http.StatusNotImplemented)
+	dryRun, serviceErr := dryRunFromRequest(r)
+	if serviceErr != nil {
+		handleError(r.Context(), w, serviceErr)
+		return
+	}
+	applyOptions, serviceErr := applyOptionsFromRequest(r)
+	if serviceErr != nil {
+		handleError(r.Context(), w, serviceErr)
+		return
+	}
+
+	var patch openapi.ResourceBundlePatchRequest
+	validates := []validate{}
+	if applyOptions.FieldManager == "" {
+		validates = append(validates, validateNotEmpty(&patch, "Version", "version"))
+	}
+	cfg := &handlerConfig{
+		&patch,
+		validates,
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			if applyOptions.FieldManager != "" {
+				return h.apply(w, r, id, &patch, applyOptions, dryRun)
+			}
+
+			found, serviceErr := h.resource.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			payload, err := api.PatchManifestBundle(found.Payload, &api.ManifestBundleWrapper{
+				Meta:            patch.Metadata,
+				Manifests:       patch.Manifests,
+				ManifestConfigs: patch.ManifestConfigs,
+				DeleteOption:    patch.DeleteOption,
+			})
+			if err != nil {
+				return nil, errors.Validation("the resource bundle is invalid, %v", err)
+			}
+			found.Payload = payload
+			found.Version = *patch.Version
+
+			if dryRun {
+				diff, serviceErr := h.resource.DryRunUpdate(ctx, found)
+				if serviceErr != nil {
+					return nil, serviceErr
+				}
+				return presenters.PresentResourceBundleDiff(diff), nil
+			}
+
+			resource, serviceErr := h.resource.Update(ctx, found)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := h.trackResource(w, r, api.UpdateEventType, resource); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			updated, err := presenters.PresentResourceBundle(resource)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+			}
+			return updated, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+// apply applies the manifests of a patch on behalf of a field manager.
+func (h resourceBundleHandler) apply(w http.ResponseWriter, r *http.Request, id string,
+	patch *openapi.ResourceBundlePatchRequest, options api.ApplyOptions, dryRun bool) (interface{}, *errors.ServiceError) {
+	ctx := r.Context()
+	applied := &api.ManifestBundleWrapper{
+		Meta:            patch.Metadata,
+		Manifests:       patch.Manifests,
+		ManifestConfigs: patch.ManifestConfigs,
+		DeleteOption:    patch.DeleteOption,
+	}
+	version := patch.GetVersion()
+
+	if dryRun {
+		diff, serviceErr := h.resource.DryRunApply(ctx, id, version, applied, options)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+		return presenters.PresentResourceBundleDiff(diff), nil
+	}
+
+	resource, serviceErr := h.resource.Apply(ctx, id, version, applied, options)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if serviceErr := h.trackResource(w, r, api.UpdateEventType, resource); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	updated, err := presenters.PresentResourceBundle(resource)
+	if err != nil {
+		return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+	}
+	return updated, nil
 }
 
 func (h resourceBundleHandler) Get(w http.ResponseWriter, r *http.Request) {
@@ -50,6 +258,13 @@ func (h resourceBundleHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
 			}
+			if fields := services.NewListArguments(r.URL.Query()).Fields; fields != nil {
+				filtered, serviceErr := presenters.PathFilter(fields, rb)
+				if serviceErr != nil {
+					return nil, serviceErr
+				}
+				return filtered, nil
+			}
 			return rb, nil
 		},
 	}
@@ -57,24 +272,103 @@ func (h resourceBundleHandler) Get(w http.ResponseWriter, r *http.Request) {
 	handleGet(w, r, cfg)
 }
 
+// filterFromRequest returns the structured filter in the filter query parameter, nil is returned if it is not set.
+func filterFromRequest(r *http.Request) (*api.ResourceFilter, *errors.ServiceError) {
+	value := strings.TrimSpace(r.URL.Query().Get("filter"))
+	if value == "" {
+		return nil, nil
+	}
+
+	filter := openapi.ResourceBundleFilter{}
+	if err := json.Unmarshal([]byte(value), &filter); err != nil {
+		return nil, errors.BadRequest("invalid filter: %s", err)
+	}
+	resourceFilter := presenters.ConvertResourceBundleFilter(filter)
+	if err := resourceFilter.Validate(); err != nil {
+		return nil, errors.BadRequest("invalid filter: %s", err)
+	}
+	return resourceFilter, nil
+}
+
+// setFilter restricts the list to the resource bundles that match the filter and the field selector of the
+// request.
+func setFilter(r *http.Request, args *services.ListArguments) *errors.ServiceError {
+	filters := squirrel.And{}
+	filter, serviceErr := filterFromRequest(r)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if filter != nil {
+		sqlizer, err := services.NewResourceFilterSqlizer(filter)
+		if err != nil {
+			return errors.BadRequest("invalid filter: %s", err)
+		}
+		filters = append(filters, sqlizer)
+	}
+
+	if selector := strings.TrimSpace(r.URL.Query().Get("fieldSelector")); selector != "" {
+		sqlizer, err := services.NewFieldSelectorSqlizer(selector)
+		if err != nil {
+			return errors.BadRequest("invalid field selector: %s", err)
+		}
+		filters = append(filters, sqlizer)
+	}
+
+	if len(filters) != 0 {
+		args.Filter = filters
+	}
+	return nil
+}
+
+// List lists the resource bundles. With the watch query parameter the status changes of the resource
+// bundles that match the search are streamed instead.
 func (h resourceBundleHandler) List(w http.ResponseWriter, r *http.Request) {
+	watch, serviceErr := watchFromRequest(r)
+	if serviceErr != nil {
+		handleError(r.Context(), w, serviceErr)
+		return
+	}
+	if watch {
+		h.watch(w, r)
+		return
+	}
+
 	cfg := &handlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 
 			listArgs := services.NewListArguments(r.URL.Query())
+			if serviceErr := setFilter(r, listArgs); serviceErr != nil {
+				return nil, serviceErr
+			}
+			if listArgs.Fields != nil {
+				// only load the columns that the fields are presented from
+				columns, serviceErr := presenters.ResourceBundleColumns(listArgs.Fields)
+				if serviceErr != nil {
+					return nil, serviceErr
+				}
+				listArgs.Columns = columns
+			}
+			// the resource version is read before the list, so that the list has seen all of the changes up to it
+			// and a watch from it sends the changes after the list
+			resourceVersion, serviceErr := h.resource.LatestResourceVersion(ctx)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
 			var resources []api.Resource
 			paging, serviceErr := h.resource.ListWithArgs(ctx, "username", listArgs, &resources)
 			if serviceErr != nil {
 				return nil, serviceErr
 			}
 			resourceBundleList := openapi.ResourceBundleList{
-				Kind:  *presenters.ObjectKind(resources),
-				Page:  int32(paging.Page),
-				Size:  int32(paging.Size),
-				Total: int32(paging.Total),
-				Items: []openapi.ResourceBundle{},
+				Kind:     *presenters.ObjectKind(resources),
+				Page:     int32(paging.Page),
+				Size:     int32(paging.Size),
+				Total:    int32(paging.Total),
+				Continue: util.EmptyStringToNil(paging.Continue),
+				Items:    []openapi.ResourceBundle{},
 			}
+			resourceBundleList.ResourceVersion = openapi.PtrString(strconv.FormatInt(resourceVersion, 10))
 
 			for _, resource := range resources {
 				converted, err := presenters.PresentResourceBundle(&resource)
@@ -84,7 +378,7 @@ func (h resourceBundleHandler) List(w http.ResponseWriter, r *http.Request) {
 				resourceBundleList.Items = append(resourceBundleList.Items, *converted)
 			}
 			if listArgs.Fields != nil {
-				filteredItems, err := presenters.SliceFilter(listArgs.Fields, resourceBundleList.Items)
+				filteredItems, err := presenters.SlicePathFilter(listArgs.Fields, resourceBundleList)
 				if err != nil {
 					return nil, err
 				}
@@ -97,12 +391,141 @@ func (h resourceBundleHandler) List(w http.ResponseWriter, r *http.Request) {
 	handleList(w, r, cfg)
 }
 
+// Summary returns the number of the resource bundles in each state grouped by consumer, source or the value of
+// a label, consumer is the default group.
+func (h resourceBundleHandler) Summary(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			query := r.URL.Query()
+			groupBy := api.ResourceBundleSummaryGroupBy(query.Get("group_by"))
+			if groupBy == "" {
+				groupBy = api.ResourceBundleSummaryByConsumer
+			}
+
+			summary, serviceErr := h.resource.Summarize(r.Context(), groupBy, query.Get("label"))
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			return presenters.PresentResourceBundleSummary(summary), nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+// ListRevisions returns the revisions of a resource bundle, ordered from the newest to the oldest.
+func (h resourceBundleHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			revisions, serviceErr := h.resource.ListRevisions(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			revisionList := openapi.ResourceBundleRevisionList{
+				Kind:  *presenters.ObjectKind(revisions),
+				Page:  1,
+				Size:  int32(len(revisions)),
+				Total: int32(len(revisions)),
+				Items: []openapi.ResourceBundleRevision{},
+			}
+			for _, revision := range revisions {
+				converted, err := presenters.PresentResourceBundleRevision(revision)
+				if err != nil {
+					return nil, errors.GeneralError("failed to present resource bundle revision: %s", err)
+				}
+				revisionList.Items = append(revisionList.Items, *converted)
+			}
+			return revisionList, nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+func (h resourceBundleHandler) GetRevision(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			version, serviceErr := versionFromRequest(r)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			revision, serviceErr := h.resource.GetRevision(ctx, id, version)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			rbr, err := presenters.PresentResourceBundleRevision(revision)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle revision: %s", err)
+			}
+			return rbr, nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+// Rollback re-applies the payload of a previous revision of a resource bundle, the rollback is
+// applied as a new version of the resource bundle and is tracked by an operation like an update.
+func (h resourceBundleHandler) Rollback(w http.ResponseWriter, r *http.Request) {
+	var rollback openapi.ResourceBundleRollbackRequest
+	cfg := &handlerConfig{
+		&rollback,
+		[]validate{
+			func() *errors.ServiceError {
+				if rollback.Version <= 0 {
+					return errors.Validation("version must be greater than 0")
+				}
+				return nil
+			},
+		},
+		func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			resource, serviceErr := h.resource.Rollback(ctx, id, rollback.Version)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := h.trackResource(w, r, api.UpdateEventType, resource); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			rb, err := presenters.PresentResourceBundle(resource)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+			}
+			return rb, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+// trackResource starts an operation that tracks the event of the resource until the agent applies or
+// removes the resource, the id of the operation is returned in the response header.
+func (h resourceBundleHandler) trackResource(w http.ResponseWriter, r *http.Request, eventType api.EventType, resource *api.Resource) *errors.ServiceError {
+	operation, serviceErr := h.operation.TrackResource(r.Context(), eventType, resource)
+	if serviceErr != nil {
+		return serviceErr
+	}
+	w.Header().Set(api.OperationIDHeader, operation.ID)
+	return nil
+}
+
 // Resource Bundle Deletion Flow:
 // 1. User requests deletion
 // 2. Maestro marks resource bundle as deleting, adds delete event to DB
 // 3. Maestro handles delete event and sends CloudEvent to work-agent
 // 4. Work-agent deletes resource bundle, sends CloudEvent back to Maestro
 // 5. Maestro deletes resource bundle from DB
+// The operation that tracks the deletion is completed at step 5.
 func (h resourceBundleHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
@@ -112,8 +535,58 @@ func (h resourceBundleHandler) Delete(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			if err := h.trackResource(w, r, api.DeleteEventType, &api.Resource{Meta: api.Meta{ID: id}}); err != nil {
+				return nil, err
+			}
 			return nil, nil
 		},
 	}
 	handleDelete(w, r, cfg, http.StatusNoContent)
 }
+
+// DeleteCollection marks every resource bundle that matches the search as deleting, and returns an
+// operation that is completed once the deletion of all of them is acknowledged by the agents. The search
+// is rejected if it matches more resource bundles than a bulk deletion is allowed to delete.
+func (h resourceBundleHandler) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Validate: []validate{
+			func() *errors.ServiceError {
+				if strings.TrimSpace(r.URL.Query().Get("search")) == "" {
+					return errors.Validation("search is required to delete resource bundles")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			listArgs := services.NewListArguments(r.URL.Query())
+			listArgs.Page = 1
+			listArgs.Size = int64(h.maxDeleteCollection)
+			listArgs.Fields = nil
+			listArgs.Columns = []string{"id"}
+
+			// the resource bundles of a bulk deletion are bounded, so that a request does not load all of the
+			// resource bundles and a single operation does not track them
+			resources := []api.Resource{}
+			paging, serviceErr := h.resource.ListWithArgs(ctx, "username", listArgs, &resources)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if paging.Total > int64(h.maxDeleteCollection) {
+				return nil, errors.Validation("the search matches %d resource bundles, at most %d resource bundles "+
+					"can be deleted at a time", paging.Total, h.maxDeleteCollection)
+			}
+			resourceIDs := []string{}
+			for _, resource := range resources {
+				resourceIDs = append(resourceIDs, resource.ID)
+			}
+
+			operation, serviceErr := h.operation.DeleteResources(ctx, listArgs.Search, resourceIDs)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			return presenters.PresentOperation(operation), nil
+		},
+	}
+	handleDelete(w, r, cfg, http.StatusAccepted)
+}
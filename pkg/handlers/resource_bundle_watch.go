@@ -0,0 +1,386 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/klog/v2"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/common"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+const (
+	// WatchModifiedEventType is the type of the watch events of the resource bundles whose status is changed.
+	WatchModifiedEventType = "MODIFIED"
+	// WatchDeletedEventType is the type of the watch events of the resource bundles that are deleted.
+	WatchDeletedEventType = "DELETED"
+	// WatchBookmarkEventType is the type of the watch event that is sent after the changes since a resource
+	// version are replayed, its data has the resource version that the replay is complete up to.
+	WatchBookmarkEventType = "BOOKMARK"
+
+	// watchBufferSize is the number of status changes that are buffered for a watch. A watch that falls behind
+	// is closed, and the client resumes it from its last resume token.
+	watchBufferSize = 100
+
+	// watchReplayPageSize is the number of the changed resource bundles that are listed at a time when a watch
+	// is resumed.
+	watchReplayPageSize = 500
+)
+
+// watchHeartbeatInterval is the interval of the comments that keep an idle watch connection open.
+var watchHeartbeatInterval = 30 * time.Second
+
+// IsWatchRequest returns true if the request watches the resource bundles, i.e. the watch query parameter
+// is true. A watch streams until the client disconnects, so it is served without a database transaction.
+func IsWatchRequest(r *http.Request) bool {
+	watch, err := watchFromRequest(r)
+	return err == nil && watch
+}
+
+// watchFromRequest returns the value of the watch query parameter, false if it is not set.
+func watchFromRequest(r *http.Request) (bool, *errors.ServiceError) {
+	value := r.URL.Query().Get("watch")
+	if value == "" {
+		return false, nil
+	}
+	watch, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.BadRequest("invalid watch value %q", value)
+	}
+	return watch, nil
+}
+
+// resumeTokenFromRequest returns the time the watch resumes from, it is read from the Last-Event-ID header
+// that an EventSource sends when it reconnects, or from the resumeToken query parameter. Nil is returned if
+// the watch doesn't resume.
+func resumeTokenFromRequest(r *http.Request) (*time.Time, *errors.ServiceError) {
+	token := r.Header.Get("Last-Event-ID")
+	if token == "" {
+		token = r.URL.Query().Get("resumeToken")
+	}
+	if token == "" {
+		return nil, nil
+	}
+
+	resumeFrom, err := time.Parse(time.RFC3339Nano, token)
+	if err != nil {
+		return nil, errors.BadRequest("invalid resume token %q", token)
+	}
+	return &resumeFrom, nil
+}
+
+// resourceVersionFromRequest returns the resource version that the watch replays the changes after, it is read
+// from the resourceVersion query parameter. Nil is returned if it is not set.
+func resourceVersionFromRequest(r *http.Request) (*int64, *errors.ServiceError) {
+	value := r.URL.Query().Get("resourceVersion")
+	if value == "" {
+		return nil, nil
+	}
+	resourceVersion, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || resourceVersion < 0 {
+		return nil, errors.BadRequest("invalid resource version %q", value)
+	}
+	return &resourceVersion, nil
+}
+
+// watch streams the status changes of the resource bundles that match the search and the filter as Server-Sent
+// Events. The id of each event is a resume token, a watch that resumes from a token first replays the resource
+// bundles that are changed after the token, then streams the status changes. A watch from the resource version of
+// a list first replays the changes after the resource version, including the deletions, then sends a bookmark, so
+// that a client can resume without listing again. The resume token takes precedence over the resource version,
+// since it is more recent. The events are delivered at least once, a status change can be sent again when a watch
+// resumes.
+func (h resourceBundleHandler) watch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := klog.FromContext(ctx)
+
+	if r.URL.Query().Get("fieldSelector") != "" {
+		handleError(ctx, w, errors.BadRequest("the field selector is not supported by watch, use the filter instead"))
+		return
+	}
+	filter, serviceErr := filterFromRequest(r)
+	if serviceErr != nil {
+		handleError(ctx, w, serviceErr)
+		return
+	}
+	matcher, serviceErr := services.NewResourceMatcher(r.URL.Query().Get("search"), filter)
+	if serviceErr != nil {
+		handleError(ctx, w, serviceErr)
+		return
+	}
+	resumeFrom, serviceErr := resumeTokenFromRequest(r)
+	if serviceErr != nil {
+		handleError(ctx, w, serviceErr)
+		return
+	}
+	resourceVersion, serviceErr := resourceVersionFromRequest(r)
+	if serviceErr != nil {
+		handleError(ctx, w, serviceErr)
+		return
+	}
+
+	stream := &resourceBundleStream{
+		writer:     w,
+		controller: http.NewResponseController(w),
+		resumeFrom: time.Now(),
+		sent:       map[string]bool{},
+	}
+	if resumeFrom != nil {
+		stream.resumeFrom = *resumeFrom
+	}
+
+	// register before the replay, so that no status change is missed in between
+	events := make(chan *api.Resource, watchBufferSize)
+	overflow := make(chan struct{})
+	var closeOverflow sync.Once
+	clientID := h.broadcaster.RegisterAll(ctx, func(res *api.Resource, _ int64) error {
+		select {
+		case events <- res:
+		default:
+			closeOverflow.Do(func() { close(overflow) })
+		}
+		return nil
+	})
+	defer h.broadcaster.Unregister(ctx, clientID)
+
+	var replayed []api.Resource
+	var changes []api.ResourceChange
+	var bookmark int64
+	switch {
+	case resumeFrom != nil:
+		replayed, serviceErr = h.changedResources(r, *resumeFrom, "")
+	case resourceVersion != nil:
+		changes, bookmark, serviceErr = h.changesSince(ctx, *resourceVersion, matcher)
+	}
+	if serviceErr != nil {
+		handleError(ctx, w, serviceErr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	// send the resume token, so that the client can resume before any status change is sent
+	if err := stream.writeResumeToken(); err != nil {
+		logger.Error(err, "failed to start the watch")
+		return
+	}
+
+	// the changed resource bundles are replayed page by page
+	for len(replayed) > 0 {
+		for i := range replayed {
+			if err := stream.send(WatchModifiedEventType, &replayed[i]); err != nil {
+				logger.Error(err, "failed to send the watch event", "resourceID", replayed[i].ID)
+				return
+			}
+		}
+		if len(replayed) < watchReplayPageSize {
+			break
+		}
+		last := replayed[len(replayed)-1]
+		if replayed, serviceErr = h.changedResources(r, last.UpdatedAt, last.ID); serviceErr != nil {
+			logger.Error(serviceErr, "failed to replay the watch")
+			return
+		}
+	}
+	if resumeFrom == nil && resourceVersion != nil {
+		for _, change := range changes {
+			eventType := WatchModifiedEventType
+			if change.Deleted {
+				eventType = WatchDeletedEventType
+			}
+			if err := stream.send(eventType, change.Resource); err != nil {
+				logger.Error(err, "failed to send the watch event", "resourceID", change.Resource.ID)
+				return
+			}
+		}
+		if err := stream.writeBookmark(bookmark); err != nil {
+			logger.Error(err, "failed to send the watch bookmark")
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.watchDone:
+			return
+		case <-overflow:
+			logger.Info("the watch falls behind the status changes, closing it")
+			return
+		case <-heartbeat.C:
+			if err := stream.writeHeartbeat(); err != nil {
+				logger.Error(err, "failed to send the watch heartbeat")
+				return
+			}
+		case res := <-events:
+			// the watch of a tenant only sees the resource bundles of its sources
+			if !auth.SourceAllowed(ctx, res.Source) {
+				continue
+			}
+			eventType := watchEventType(res)
+			// the resource of a deletion only has its id, source and type, it is sent if it matches the
+			// search, or if the watch has sent the resource before
+			if !matcher.Match(res) && !(eventType == WatchDeletedEventType && stream.sent[res.ID]) {
+				continue
+			}
+			if err := stream.send(eventType, res); err != nil {
+				logger.Error(err, "failed to send the watch event", "resourceID", res.ID)
+				return
+			}
+		}
+	}
+}
+
+// changedResources lists a page of the resource bundles that match the search and the filter of the watch and
+// are changed after the given resource bundle, the resource bundles are ordered by the time they are changed and
+// their id, so that the next page is listed after the last resource bundle of a page.
+func (h resourceBundleHandler) changedResources(r *http.Request, after time.Time, afterID string) ([]api.Resource, *errors.ServiceError) {
+	var resources []api.Resource
+	args := &services.ListArguments{
+		Page:    1,
+		Size:    watchReplayPageSize,
+		Search:  r.URL.Query().Get("search"),
+		OrderBy: []string{"updated_at asc", "id asc"},
+	}
+	if serviceErr := setFilter(r, args); serviceErr != nil {
+		return nil, serviceErr
+	}
+	// the position of the page is bound as parameters with the filter of the watch
+	changed := squirrel.Expr("(resources.updated_at, resources.id) > (?, ?)", after, afterID)
+	if args.Filter != nil {
+		args.Filter = squirrel.And{changed, args.Filter}
+	} else {
+		args.Filter = changed
+	}
+	if _, serviceErr := h.resource.ListWithArgs(r.Context(), "username", args, &resources); serviceErr != nil {
+		return nil, serviceErr
+	}
+	return resources, nil
+}
+
+// changesSince returns the changes of the resource bundles that match the matcher of the watch after the given
+// resource version, and the resource version that the changes are complete up to.
+func (h resourceBundleHandler) changesSince(ctx context.Context, resourceVersion int64,
+	matcher *services.ResourceMatcher) ([]api.ResourceChange, int64, *errors.ServiceError) {
+	// the latest resource version is read before the changes, so that they are complete up to it
+	bookmark, serviceErr := h.resource.LatestResourceVersion(ctx)
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+	changes, serviceErr := h.resource.ListChangedSince(ctx, resourceVersion)
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	matched := []api.ResourceChange{}
+	for _, change := range changes {
+		if change.Resource.ResourceVersion > bookmark {
+			bookmark = change.Resource.ResourceVersion
+		}
+		if matcher.Match(change.Resource) {
+			matched = append(matched, change)
+		}
+	}
+	if bookmark < resourceVersion {
+		bookmark = resourceVersion
+	}
+	return matched, bookmark, nil
+}
+
+// watchEventType returns the watch event type of a resource status change.
+func watchEventType(res *api.Resource) string {
+	status, err := api.DecodeResourceBundleStatus(res.Status)
+	if err != nil || status == nil || status.ManifestBundleStatus == nil {
+		return WatchModifiedEventType
+	}
+	if meta.IsStatusConditionTrue(status.ManifestBundleStatus.Conditions, common.ResourceDeleted) {
+		return WatchDeletedEventType
+	}
+	return WatchModifiedEventType
+}
+
+// resourceBundleStream writes the watch events of resource bundles as Server-Sent Events.
+type resourceBundleStream struct {
+	writer     http.ResponseWriter
+	controller *http.ResponseController
+	// resumeFrom is the latest update time of the sent resource bundles, the watch resumes from it.
+	resumeFrom time.Time
+	// sent records the resource bundles that are sent, so that their deletion is sent as well.
+	sent map[string]bool
+}
+
+func (s *resourceBundleStream) send(eventType string, res *api.Resource) error {
+	bundle, err := presenters.PresentResourceBundle(res)
+	if err != nil {
+		return fmt.Errorf("failed to present resource bundle: %v", err)
+	}
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return fmt.Errorf("failed to marshal resource bundle: %v", err)
+	}
+
+	if res.UpdatedAt.After(s.resumeFrom) {
+		s.resumeFrom = res.UpdatedAt
+	}
+	if eventType == WatchDeletedEventType {
+		delete(s.sent, res.ID)
+	} else {
+		s.sent[res.ID] = true
+	}
+
+	if _, err := fmt.Fprintf(s.writer, "id: %s\nevent: %s\ndata: %s\n\n", s.resumeToken(), eventType, data); err != nil {
+		return err
+	}
+	return s.controller.Flush()
+}
+
+// writeResumeToken writes an event without data, it only sets the last event id of the client.
+func (s *resourceBundleStream) writeResumeToken() error {
+	if _, err := fmt.Fprintf(s.writer, "id: %s\n\n", s.resumeToken()); err != nil {
+		return err
+	}
+	return s.controller.Flush()
+}
+
+// writeBookmark writes a bookmark event, its data has the resource version that the replayed changes are
+// complete up to.
+func (s *resourceBundleStream) writeBookmark(resourceVersion int64) error {
+	data, err := json.Marshal(map[string]string{"resource_version": strconv.FormatInt(resourceVersion, 10)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal the bookmark: %v", err)
+	}
+	if _, err := fmt.Fprintf(s.writer, "id: %s\nevent: %s\ndata: %s\n\n", s.resumeToken(), WatchBookmarkEventType,
+		data); err != nil {
+		return err
+	}
+	return s.controller.Flush()
+}
+
+func (s *resourceBundleStream) writeHeartbeat() error {
+	if _, err := fmt.Fprint(s.writer, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+	return s.controller.Flush()
+}
+
+func (s *resourceBundleStream) resumeToken() string {
+	return s.resumeFrom.UTC().Format(time.RFC3339Nano)
+}
@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/errors"
 )
@@ -17,6 +19,17 @@ type ConsumerService interface {
 
 	FindByIDs(ctx context.Context, ids []string) (api.ConsumerList, *errors.ServiceError)
 	FindByNames(ctx context.Context, names []string) (api.ConsumerList, *errors.ServiceError)
+
+	// MarkSeen marks the consumers of the names as connected and seen at the time.
+	MarkSeen(ctx context.Context, names []string, seenAt time.Time) *errors.ServiceError
+	// MarkDisconnected marks the consumers of the names as disconnected.
+	MarkDisconnected(ctx context.Context, names []string) *errors.ServiceError
+	// MarkSilent marks the consumers that are not seen since the time as silent and returns them, a silent
+	// consumer is returned once until it is seen again. A consumer that is never seen is silent if it is
+	// created before the time.
+	MarkSilent(ctx context.Context, before time.Time) (api.ConsumerList, *errors.ServiceError)
+	// CountConnected returns the number of the connected consumers.
+	CountConnected(ctx context.Context) (int64, *errors.ServiceError)
 }
 
 func NewConsumerService(consumerDao dao.ConsumerDao) ConsumerService {
@@ -31,6 +44,15 @@ type sqlConsumerService struct {
 	consumerDao dao.ConsumerDao
 }
 
+// ConsumerAdminRequired returns a forbidden error if ctx is scoped to the sources of a tenant. The consumers are
+// shared by all tenants, a tenant can get a consumer but only the admins can list, create, update and delete them.
+func ConsumerAdminRequired(ctx context.Context) *errors.ServiceError {
+	if _, scoped := auth.SourcesFromContext(ctx); scoped {
+		return errors.Forbidden("the consumers can only be listed and managed by the admins")
+	}
+	return nil
+}
+
 func (s *sqlConsumerService) Get(ctx context.Context, id string) (*api.Consumer, *errors.ServiceError) {
 	consumer, err := s.consumerDao.Get(ctx, id)
 	if err != nil {
@@ -40,6 +62,10 @@ func (s *sqlConsumerService) Get(ctx context.Context, id string) (*api.Consumer,
 }
 
 func (s *sqlConsumerService) Create(ctx context.Context, consumer *api.Consumer) (*api.Consumer, *errors.ServiceError) {
+	if svcErr := ConsumerAdminRequired(ctx); svcErr != nil {
+		return nil, svcErr
+	}
+
 	if consumer.Name != "" {
 		if err := ValidateConsumer(consumer); err != nil {
 			return nil, handleCreateError("Consumer", err)
@@ -54,6 +80,10 @@ func (s *sqlConsumerService) Create(ctx context.Context, consumer *api.Consumer)
 }
 
 func (s *sqlConsumerService) Replace(ctx context.Context, consumer *api.Consumer) (*api.Consumer, *errors.ServiceError) {
+	if svcErr := ConsumerAdminRequired(ctx); svcErr != nil {
+		return nil, svcErr
+	}
+
 	consumer, err := s.consumerDao.Replace(ctx, consumer)
 	if err != nil {
 		return nil, handleUpdateError("Consumer", err)
@@ -66,6 +96,10 @@ func (s *sqlConsumerService) Replace(ctx context.Context, consumer *api.Consumer
 // 2. Forbid consumer deletion if there are associated resources(include the marked as deleted resources).
 // TODO: Add deletion options or strategies.
 func (s *sqlConsumerService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	if svcErr := ConsumerAdminRequired(ctx); svcErr != nil {
+		return svcErr
+	}
+
 	if err := s.consumerDao.Delete(ctx, id, true); err != nil {
 		return handleDeleteError("Consumer", err)
 	}
@@ -95,3 +129,39 @@ func (s *sqlConsumerService) All(ctx context.Context) (api.ConsumerList, *errors
 	}
 	return consumers, nil
 }
+
+func (s *sqlConsumerService) MarkSeen(ctx context.Context, names []string, seenAt time.Time) *errors.ServiceError {
+	if len(names) == 0 {
+		return nil
+	}
+	if err := s.consumerDao.MarkSeen(ctx, names, seenAt); err != nil {
+		return errors.GeneralError("Unable to mark consumers as seen: %s", err)
+	}
+	return nil
+}
+
+func (s *sqlConsumerService) MarkDisconnected(ctx context.Context, names []string) *errors.ServiceError {
+	if len(names) == 0 {
+		return nil
+	}
+	if err := s.consumerDao.MarkDisconnected(ctx, names); err != nil {
+		return errors.GeneralError("Unable to mark consumers as disconnected: %s", err)
+	}
+	return nil
+}
+
+func (s *sqlConsumerService) MarkSilent(ctx context.Context, before time.Time) (api.ConsumerList, *errors.ServiceError) {
+	consumers, err := s.consumerDao.MarkSilent(ctx, before, time.Now())
+	if err != nil {
+		return nil, errors.GeneralError("Unable to mark consumers as silent: %s", err)
+	}
+	return consumers, nil
+}
+
+func (s *sqlConsumerService) CountConnected(ctx context.Context) (int64, *errors.ServiceError) {
+	count, err := s.consumerDao.CountConnected(ctx)
+	if err != nil {
+		return 0, errors.GeneralError("Unable to count connected consumers: %s", err)
+	}
+	return count, nil
+}
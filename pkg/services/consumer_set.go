@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+type ConsumerSetService interface {
+	Get(ctx context.Context, id string) (*api.ConsumerSet, *errors.ServiceError)
+	Create(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, *errors.ServiceError)
+	Update(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, *errors.ServiceError)
+	Delete(ctx context.Context, id string) *errors.ServiceError
+	All(ctx context.Context) (api.ConsumerSetList, *errors.ServiceError)
+
+	// Statuses aggregates the statuses of the consumer sets from the resource bundles of their members, the
+	// statuses are indexed by the consumer set id.
+	Statuses(ctx context.Context, consumerSets api.ConsumerSetList) (map[string]*api.ConsumerSetStatus, *errors.ServiceError)
+}
+
+func NewConsumerSetService(consumerSetDao dao.ConsumerSetDao, consumerDao dao.ConsumerDao, resourceDao dao.ResourceDao) ConsumerSetService {
+	return &sqlConsumerSetService{
+		consumerSetDao: consumerSetDao,
+		consumerDao:    consumerDao,
+		resourceDao:    resourceDao,
+	}
+}
+
+var _ ConsumerSetService = &sqlConsumerSetService{}
+
+type sqlConsumerSetService struct {
+	consumerSetDao dao.ConsumerSetDao
+	consumerDao    dao.ConsumerDao
+	resourceDao    dao.ResourceDao
+}
+
+func (s *sqlConsumerSetService) Get(ctx context.Context, id string) (*api.ConsumerSet, *errors.ServiceError) {
+	consumerSet, err := s.consumerSetDao.Get(ctx, id)
+	if err != nil {
+		return nil, handleGetError("ConsumerSet", "id", id, err)
+	}
+	return consumerSet, nil
+}
+
+func (s *sqlConsumerSetService) Create(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, *errors.ServiceError) {
+	if err := ValidateConsumerSet(consumerSet); err != nil {
+		return nil, errors.Validation("the consumer set is invalid, %v", err)
+	}
+
+	consumerSet, err := s.consumerSetDao.Create(ctx, consumerSet)
+	if err != nil {
+		return nil, handleCreateError("ConsumerSet", err)
+	}
+	return consumerSet, nil
+}
+
+// Update replaces the members or the consumer selector of a consumer set, a static set can be changed
+// to a selector set and vice versa.
+func (s *sqlConsumerSetService) Update(ctx context.Context, consumerSet *api.ConsumerSet) (*api.ConsumerSet, *errors.ServiceError) {
+	found, err := s.consumerSetDao.Get(ctx, consumerSet.ID)
+	if err != nil {
+		return nil, handleGetError("ConsumerSet", "id", consumerSet.ID, err)
+	}
+
+	found.Members = consumerSet.Members
+	found.ConsumerSelector = consumerSet.ConsumerSelector
+	if err := ValidateConsumerSet(found); err != nil {
+		return nil, errors.Validation("the consumer set is invalid, %v", err)
+	}
+
+	updated, err := s.consumerSetDao.Replace(ctx, found)
+	if err != nil {
+		return nil, handleUpdateError("ConsumerSet", err)
+	}
+	return updated, nil
+}
+
+func (s *sqlConsumerSetService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	if _, err := s.consumerSetDao.Get(ctx, id); err != nil {
+		return handleGetError("ConsumerSet", "id", id, err)
+	}
+
+	if err := s.consumerSetDao.Delete(ctx, id); err != nil {
+		return handleDeleteError("ConsumerSet", err)
+	}
+	return nil
+}
+
+func (s *sqlConsumerSetService) All(ctx context.Context) (api.ConsumerSetList, *errors.ServiceError) {
+	consumerSets, err := s.consumerSetDao.All(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to get all consumer sets: %s", err)
+	}
+	return consumerSets, nil
+}
+
+// Statuses loads the consumers and the resource bundles of the members once for all consumer sets. The
+// resource bundles under deletion and the resource bundles of the sources that ctx is not allowed to access
+// are not counted.
+func (s *sqlConsumerSetService) Statuses(ctx context.Context, consumerSets api.ConsumerSetList) (map[string]*api.ConsumerSetStatus, *errors.ServiceError) {
+	statuses := map[string]*api.ConsumerSetStatus{}
+	if len(consumerSets) == 0 {
+		return statuses, nil
+	}
+
+	consumers, err := s.consumerDao.All(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to get all consumers: %s", err)
+	}
+
+	members := map[string]api.ConsumerList{}
+	names := []string{}
+	seen := map[string]bool{}
+	for _, consumerSet := range consumerSets {
+		selected, err := consumerSet.Select(consumers)
+		if err != nil {
+			return nil, errors.GeneralError("Unable to select the consumers of consumer set %s: %s", consumerSet.Name, err)
+		}
+		members[consumerSet.ID] = selected
+		for _, consumer := range selected {
+			if !seen[consumer.Name] {
+				seen[consumer.Name] = true
+				names = append(names, consumer.Name)
+			}
+		}
+	}
+
+	rollouts := map[string][]api.ResourceBundleRollout{}
+	if len(names) > 0 {
+		resources, err := s.resourceDao.FindByConsumerNames(ctx, names)
+		if err != nil {
+			return nil, errors.GeneralError("Unable to get the resources of the consumers: %s", err)
+		}
+		for _, resource := range resources {
+			if !auth.SourceAllowed(ctx, resource.Source) {
+				continue
+			}
+			rollouts[resource.ConsumerName] = append(rollouts[resource.ConsumerName], api.ResourceBundleRolloutOf(resource))
+		}
+	}
+
+	for _, consumerSet := range consumerSets {
+		status := &api.ConsumerSetStatus{Members: int32(len(members[consumerSet.ID]))}
+		for _, consumer := range members[consumerSet.ID] {
+			for _, rollout := range rollouts[consumer.Name] {
+				status.ResourceBundles++
+				if rollout.Applied {
+					status.Applied++
+				}
+				if rollout.Available {
+					status.Available++
+				}
+				if rollout.Failed {
+					status.Degraded++
+				}
+			}
+		}
+		statuses[consumerSet.ID] = status
+	}
+	return statuses, nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	gm "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+func TestConsumerSetStatuses(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	consumerDao := mocks.NewConsumerDao()
+	resourceDao := mocks.NewResourceDao()
+	consumerSets := NewConsumerSetService(mocks.NewConsumerSetDao(), consumerDao, resourceDao)
+
+	for name, env := range map[string]string{"cluster1": "prod", "cluster2": "prod", "cluster3": "dev"} {
+		_, err := consumerDao.Create(ctx, &api.Consumer{Meta: api.Meta{ID: name}, Name: name, Labels: &db.StringMap{"env": env}})
+		gm.Expect(err).To(gm.BeNil())
+	}
+
+	resources := []*api.Resource{
+		{Meta: api.Meta{ID: "applied"}, ConsumerName: "cluster1", Source: "source1", Version: 1,
+			Status: consumerSetTestStatus(t, 1, metav1.ConditionTrue, metav1.ConditionTrue)},
+		{Meta: api.Meta{ID: "failed"}, ConsumerName: "cluster2", Source: "source1", Version: 1,
+			Status: consumerSetTestStatus(t, 1, metav1.ConditionFalse, metav1.ConditionFalse)},
+		// the status is reported for a previous version
+		{Meta: api.Meta{ID: "outdated"}, ConsumerName: "cluster2", Source: "source2", Version: 2,
+			Status: consumerSetTestStatus(t, 1, metav1.ConditionTrue, metav1.ConditionTrue)},
+		{Meta: api.Meta{ID: "pending"}, ConsumerName: "cluster3", Source: "source1", Version: 1},
+	}
+	for _, resource := range resources {
+		_, err := resourceDao.Create(ctx, resource)
+		gm.Expect(err).To(gm.BeNil())
+	}
+
+	prod, svcErr := consumerSets.Create(ctx, &api.ConsumerSet{
+		Meta:             api.Meta{ID: "prod"},
+		Name:             "prod",
+		ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "prod"}},
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	static, svcErr := consumerSets.Create(ctx, &api.ConsumerSet{Meta: api.Meta{ID: "static"}, Name: "static", Members: []string{"cluster1", "cluster3", "missing"}})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	statuses, svcErr := consumerSets.Statuses(ctx, api.ConsumerSetList{prod, static})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(statuses[prod.ID]).To(gm.Equal(&api.ConsumerSetStatus{Members: 2, ResourceBundles: 3, Applied: 1, Available: 1, Degraded: 1}))
+	gm.Expect(statuses[static.ID]).To(gm.Equal(&api.ConsumerSetStatus{Members: 2, ResourceBundles: 2, Applied: 1, Available: 1}))
+
+	// the resource bundles of the other tenants are not counted
+	tenancy := &auth.Tenancy{Tenants: []auth.Tenant{{Name: "tenant2", Subjects: auth.Subjects{Users: []string{"user2"}}, Sources: []string{"source2"}}}}
+	statuses, svcErr = consumerSets.Statuses(auth.NewContextWithTenancy(ctx, tenancy, "user2", nil), api.ConsumerSetList{prod})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(statuses[prod.ID]).To(gm.Equal(&api.ConsumerSetStatus{Members: 2, ResourceBundles: 1}))
+
+	// a static set can be changed to a selector set
+	updated, svcErr := consumerSets.Update(ctx, &api.ConsumerSet{
+		Meta:             api.Meta{ID: static.ID},
+		ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "dev"}},
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated.Members).To(gm.BeEmpty())
+	statuses, svcErr = consumerSets.Statuses(ctx, api.ConsumerSetList{updated})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(statuses[static.ID]).To(gm.Equal(&api.ConsumerSetStatus{Members: 1, ResourceBundles: 1}))
+
+	_, svcErr = consumerSets.Update(ctx, &api.ConsumerSet{Meta: api.Meta{ID: static.ID}})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorValidation))
+}
+
+func consumerSetTestStatus(t *testing.T, observedVersion int32, applied, available metav1.ConditionStatus) map[string]interface{} {
+	evt := cloudevents.NewEvent()
+	evt.SetID(uuid.NewString())
+	evt.SetSource("cluster-work-agent")
+	evt.SetType("io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request")
+	evt.SetExtension(cetypes.ExtensionResourceVersion, observedVersion)
+	evt.SetExtension(cetypes.ExtensionStatusUpdateSequenceID, uuid.NewString())
+	if err := evt.SetData(cloudevents.ApplicationJSON, &workpayload.ManifestBundleStatus{
+		Conditions: []metav1.Condition{
+			{Type: workv1.WorkApplied, Status: applied},
+			{Type: workv1.WorkAvailable, Status: available},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	status, err := api.CloudEventToJSONMap(&evt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return status
+}
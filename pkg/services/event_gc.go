@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// EventGCService purges the events, the status events, the event instances, the tombstones of the deleted
+// resources, the completed operations and the revisions of the resources that are out of their retention.
+//
+// The handled events are purged by the controllers as they are reconciled, but the events that are never
+// reconciled, e.g. when a maestro instance dies while handling them, and the event instances of the dead maestro
+// instances are kept. The retention bounds the event tables whether their rows are handled or not. The completed
+// operations are kept for their clients to read until they are out of the retention.
+type EventGCService interface {
+	// Run runs a garbage collection of the event tables, the rows to purge are only counted if dryRun is true.
+	Run(ctx context.Context, dryRun bool) ([]api.EventGCResult, *errors.ServiceError)
+}
+
+func NewEventGCService(eventGCDao dao.EventGCDao, policy api.EventGCPolicy) EventGCService {
+	return &sqlEventGCService{
+		eventGCDao: eventGCDao,
+		policy:     policy,
+	}
+}
+
+var _ EventGCService = &sqlEventGCService{}
+
+type sqlEventGCService struct {
+	eventGCDao dao.EventGCDao
+	policy     api.EventGCPolicy
+}
+
+func (s *sqlEventGCService) Run(ctx context.Context, dryRun bool) ([]api.EventGCResult, *errors.ServiceError) {
+	now := time.Now()
+	results := []api.EventGCResult{}
+	for _, table := range []struct {
+		name      string
+		retention api.EventRetention
+	}{
+		{name: api.EventsTable, retention: s.policy.Events},
+		{name: api.StatusEventsTable, retention: s.policy.StatusEvents},
+		{name: api.ResourceTombstonesTable, retention: s.policy.ResourceTombstones},
+	} {
+		result, err := s.purge(ctx, table.name, table.retention, now, dryRun)
+		if err != nil {
+			return nil, errors.GeneralError("Unable to purge %s: %s", table.name, err)
+		}
+		results = append(results, result)
+	}
+
+	// the event instances of the purged events are purged with them, so they are counted after the events
+	result := api.EventGCResult{Table: api.EventInstancesTable}
+	var err error
+	if result.Rows, err = s.eventGCDao.Count(ctx, api.EventInstancesTable); err != nil {
+		return nil, errors.GeneralError("Unable to count %s: %s", api.EventInstancesTable, err)
+	}
+	if s.policy.DeadInstanceGracePeriod > 0 {
+		deadBefore := now.Add(-s.policy.DeadInstanceGracePeriod)
+		if dryRun {
+			result.Orphaned, err = s.eventGCDao.CountDeadInstanceEvents(ctx, deadBefore)
+		} else {
+			result.Orphaned, err = s.eventGCDao.DeleteDeadInstanceEvents(ctx, deadBefore)
+		}
+		if err != nil {
+			return nil, errors.GeneralError("Unable to purge %s: %s", api.EventInstancesTable, err)
+		}
+	}
+	results = append(results, result)
+
+	if result, err = s.purge(ctx, api.OperationsTable, s.policy.Operations, now, dryRun); err != nil {
+		return nil, errors.GeneralError("Unable to purge %s: %s", api.OperationsTable, err)
+	}
+	results = append(results, result)
+
+	// the resources of the purged operations are purged with them, so they are counted after the operations
+	result = api.EventGCResult{Table: api.OperationResourcesTable}
+	if result.Rows, err = s.eventGCDao.Count(ctx, api.OperationResourcesTable); err != nil {
+		return nil, errors.GeneralError("Unable to count %s: %s", api.OperationResourcesTable, err)
+	}
+	results = append(results, result)
+
+	// the revisions are only bounded by their number for each resource
+	revisions := api.EventRetention{MaxCount: s.policy.ResourceRevisions.MaxCount}
+	if result, err = s.purge(ctx, api.ResourceRevisionsTable, revisions, now, dryRun); err != nil {
+		return nil, errors.GeneralError("Unable to purge %s: %s", api.ResourceRevisionsTable, err)
+	}
+	return append(results, result), nil
+}
+
+// purge purges the rows of an event table that are older than the max age and then the oldest rows that exceed
+// the max count.
+func (s *sqlEventGCService) purge(ctx context.Context, table string, retention api.EventRetention,
+	now time.Time, dryRun bool) (api.EventGCResult, error) {
+	result := api.EventGCResult{Table: table}
+	var err error
+	if result.Rows, err = s.eventGCDao.Count(ctx, table); err != nil {
+		return result, err
+	}
+
+	if retention.MaxAge > 0 {
+		before := now.Add(-retention.MaxAge)
+		if dryRun {
+			result.Expired, err = s.eventGCDao.CountCreatedBefore(ctx, table, before)
+		} else {
+			result.Expired, err = s.eventGCDao.DeleteCreatedBefore(ctx, table, before)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+
+	if retention.MaxCount > 0 {
+		if dryRun && table == api.ResourceRevisionsTable {
+			// the revisions exceed the max count of the revisions of their resource
+			result.Exceeded, err = s.eventGCDao.CountExceeding(ctx, table, retention.MaxCount)
+			if err != nil {
+				return result, err
+			}
+		} else if dryRun {
+			// the expired rows are the oldest rows, so they are purged before the exceeding rows
+			if remaining := result.Rows - result.Expired; remaining > retention.MaxCount {
+				result.Exceeded = remaining - retention.MaxCount
+			}
+		} else if result.Exceeded, err = s.eventGCDao.DeleteExceeding(ctx, table, retention.MaxCount); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+)
+
+func TestEventGCRun(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	now := time.Now()
+	createdAt := func(ages ...time.Duration) []time.Time {
+		rows := []time.Time{}
+		for _, age := range ages {
+			rows = append(rows, now.Add(-age))
+		}
+		return rows
+	}
+
+	eventGCDao := mocks.NewEventGCDao(
+		map[string][]time.Time{
+			api.EventsTable:             createdAt(2*time.Hour, 3*time.Hour, time.Minute, 2*time.Minute, 3*time.Minute, 4*time.Minute),
+			api.StatusEventsTable:       createdAt(time.Minute, 2*time.Minute),
+			api.ResourceTombstonesTable: createdAt(2*time.Hour, time.Minute),
+			api.OperationsTable:         createdAt(3*time.Hour, 2*time.Hour, time.Minute),
+			api.ResourceRevisionsTable:  createdAt(3*time.Hour, 2*time.Hour, time.Hour),
+		},
+		api.EventInstanceList{
+			{EventID: "e1", InstanceID: "ready"},
+			{EventID: "e1", InstanceID: "dead"},
+			{EventID: "e2", InstanceID: "gone"},
+			{EventID: "e2", InstanceID: "recent"},
+		},
+		api.ServerInstanceList{
+			{Meta: api.Meta{ID: "ready"}, Ready: true, LastHeartbeat: now.Add(-2 * time.Hour)},
+			{Meta: api.Meta{ID: "dead"}, LastHeartbeat: now.Add(-2 * time.Hour)},
+			{Meta: api.Meta{ID: "recent"}, LastHeartbeat: now.Add(-time.Minute)},
+		},
+	)
+	// the revisions are only purged by their number, not by their age
+	eventGC := NewEventGCService(eventGCDao, api.EventGCPolicy{
+		Events:                  api.EventRetention{MaxAge: time.Hour, MaxCount: 3},
+		StatusEvents:            api.EventRetention{MaxAge: time.Hour},
+		ResourceTombstones:      api.EventRetention{MaxAge: time.Hour},
+		Operations:              api.EventRetention{MaxAge: 150 * time.Minute, MaxCount: 1},
+		ResourceRevisions:       api.EventRetention{MaxAge: time.Minute, MaxCount: 2},
+		DeadInstanceGracePeriod: time.Hour,
+	})
+
+	expected := []api.EventGCResult{
+		{Table: api.EventsTable, Rows: 6, Expired: 2, Exceeded: 1},
+		{Table: api.StatusEventsTable, Rows: 2},
+		{Table: api.ResourceTombstonesTable, Rows: 2, Expired: 1},
+		{Table: api.EventInstancesTable, Rows: 4, Orphaned: 2},
+		{Table: api.OperationsTable, Rows: 3, Expired: 1, Exceeded: 1},
+		{Table: api.OperationResourcesTable},
+		{Table: api.ResourceRevisionsTable, Rows: 3, Exceeded: 1},
+	}
+
+	// the rows to purge are only counted in a dry run
+	results, err := eventGC.Run(ctx, true)
+	Expect(err).To(BeNil())
+	Expect(results).To(Equal(expected))
+	results, err = eventGC.Run(ctx, true)
+	Expect(err).To(BeNil())
+	Expect(results).To(Equal(expected))
+
+	results, err = eventGC.Run(ctx, false)
+	Expect(err).To(BeNil())
+	Expect(results).To(Equal(expected))
+
+	// the rows in the retention are kept
+	results, err = eventGC.Run(ctx, false)
+	Expect(err).To(BeNil())
+	Expect(results).To(Equal([]api.EventGCResult{
+		{Table: api.EventsTable, Rows: 3},
+		{Table: api.StatusEventsTable, Rows: 2},
+		{Table: api.ResourceTombstonesTable, Rows: 1},
+		{Table: api.EventInstancesTable, Rows: 2},
+		{Table: api.OperationsTable, Rows: 1},
+		{Table: api.OperationResourcesTable},
+		{Table: api.ResourceRevisionsTable, Rows: 2},
+	}))
+	remaining, _ := eventGCDao.CountCreatedBefore(ctx, api.EventsTable, now.Add(-3*time.Minute))
+	Expect(remaining).To(BeZero())
+}
+
+func TestEventGCRunWithoutLimits(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	eventGC := NewEventGCService(mocks.NewEventGCDao(
+		map[string][]time.Time{api.EventsTable: {time.Now().Add(-24 * time.Hour)}},
+		api.EventInstanceList{{EventID: "e1", InstanceID: "gone"}},
+		nil,
+	), api.EventGCPolicy{})
+
+	results, err := eventGC.Run(ctx, false)
+	Expect(err).To(BeNil())
+	Expect(results).To(Equal([]api.EventGCResult{
+		{Table: api.EventsTable, Rows: 1},
+		{Table: api.StatusEventsTable},
+		{Table: api.ResourceTombstonesTable},
+		{Table: api.EventInstancesTable, Rows: 1},
+		{Table: api.OperationsTable},
+		{Table: api.OperationResourcesTable},
+		{Table: api.ResourceRevisionsTable},
+	}))
+}
@@ -2,13 +2,15 @@ package services
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	e "errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
-	"github.com/openshift-online/ocm-common/pkg/utils/parser/sql_parser"
 	"github.com/yaacov/tree-search-language/pkg/tsl"
 	"github.com/yaacov/tree-search-language/pkg/walkers/ident"
 	sqlFilter "github.com/yaacov/tree-search-language/pkg/walkers/sql"
@@ -91,6 +93,12 @@ func (s *sqlGenericService) List(ctx context.Context, username string, args *Lis
 		// add "ORDER BY"
 		s.buildOrderBy,
 
+		// add the "WHERE" of the scope that the service restricts the list to.
+		s.buildScope,
+
+		// add the "WHERE" of the compiled structured filter.
+		s.buildFilter,
+
 		// translate "search" into "WHERE"(s), and "JOIN"(s) if related resource is searched.
 		s.buildSearch,
 
@@ -134,6 +142,9 @@ func (s *sqlGenericService) buildPreload(listCtx *listContext, d *dao.GenericDao
 
 func (s *sqlGenericService) buildOrderBy(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
 	if len(listCtx.args.OrderBy) != 0 {
+		if listCtx.args.Continue != "" {
+			return false, errors.BadRequest("The continue token is not supported with orderBy")
+		}
 		orderByArgs, serviceErr := db.ArgsToOrderBy(listCtx.args.OrderBy, *listCtx.disallowedFields)
 		if serviceErr != nil {
 			return false, serviceErr
@@ -141,6 +152,39 @@ func (s *sqlGenericService) buildOrderBy(listCtx *listContext, d *dao.GenericDao
 		for _, orderByArg := range orderByArgs {
 			(*d).OrderBy(orderByArg)
 		}
+		return false, nil
+	}
+
+	// order by the keyset of the continue token, so that a list is continued from its last object
+	table := (*d).GetTableName()
+	(*d).OrderBy(fmt.Sprintf("%s.created_at, %s.id", table, table))
+	return false, nil
+}
+
+func (s *sqlGenericService) buildScope(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
+	if listCtx.args.Scope == nil {
+		return false, nil
+	}
+
+	sql, values, err := listCtx.args.Scope.ToSql()
+	if err != nil {
+		return false, errors.GeneralError("%s", err.Error())
+	}
+	(*d).Where(sql, values)
+	return false, nil
+}
+
+func (s *sqlGenericService) buildFilter(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
+	if listCtx.args.Filter == nil {
+		return false, nil
+	}
+
+	sql, values, err := listCtx.args.Filter.ToSql()
+	if err != nil {
+		return false, errors.GeneralError("%s", err.Error())
+	}
+	if sql != "" {
+		(*d).Where(sql, values)
 	}
 	return false, nil
 }
@@ -151,14 +195,11 @@ func (s *sqlGenericService) buildSearch(listCtx *listContext, d *dao.GenericDao)
 		return true, nil
 	}
 
+	// the raw SQL of the searches on JSONB fields is not accepted, the labels and the conditions are searched
+	// with the filter, which is compiled to parameterized SQL
 	if isJSONBSearch(listCtx.args.Search) {
-		parser := sql_parser.NewSQLParser()
-		sql, values, err := parser.Parse(listCtx.args.Search)
-		if err != nil {
-			return false, errors.BadRequest("failed to parse the search query: %v", err)
-		}
-		(*d).Where(sql, values.([]interface{}))
-		return true, nil
+		return false, errors.BadRequest("the search of JSONB fields is not supported, use the filter instead: %s",
+			listCtx.args.Search)
 	}
 
 	// create the TSL tree
@@ -223,6 +264,18 @@ func (s *sqlGenericService) loadList(listCtx *listContext, d *dao.GenericDao) *e
 
 	(*d).Count(listCtx.resourceList, &listCtx.pagingMeta.Total)
 
+	// the objects are listed after the last object of the continue token, the total still counts all of them
+	offset := (args.Page - 1) * int(args.Size)
+	if args.Continue != "" {
+		cursor, err := decodeContinue(args.Continue)
+		if err != nil {
+			return errors.BadRequest("Invalid continue token: %s", err)
+		}
+		table := (*d).GetTableName()
+		(*d).Where(fmt.Sprintf("(%s.created_at, %s.id) > (?, ?)", table, table), []interface{}{cursor.CreatedAt, cursor.ID})
+		offset = 0
+	}
+
 	// Set resourceList to be an empty slice with zero capacity. Real space will be allocated by g2.Find()
 	if err := zeroSlice(listCtx.resourceList, 0); err != nil {
 		return err
@@ -242,18 +295,81 @@ func (s *sqlGenericService) loadList(listCtx *listContext, d *dao.GenericDao) *e
 
 	// NOTE: Limit no longer supports '0' size and will cause issues. There is an early return, do not remove it.
 	//       https://github.com/go-gorm/gorm/blob/master/clause/limit.go#L18-L21
-	if err := (*d).Fetch((args.Page-1)*int(args.Size), int(args.Size), listCtx.resourceList); err != nil {
+	// fetch one more object to find whether the list can be continued, the objects are only ordered by the
+	// keyset of the continue token without orderBy
+	limit := int(args.Size)
+	keyset := len(args.OrderBy) == 0 && args.Size > 0
+	if keyset {
+		limit++
+	}
+	if len(args.Columns) != 0 {
+		(*d).Select(selectColumns((*d).GetTableName(), args.Columns))
+	}
+	if err := (*d).Fetch(offset, limit, listCtx.resourceList); err != nil {
 		if e.Is(err, gorm.ErrRecordNotFound) {
 			listCtx.pagingMeta.Size = 0
 		} else {
 			return errors.GeneralError("Unable to list resources: %s", err)
 		}
 	}
-	listCtx.pagingMeta.Size = int64(reflect.ValueOf(listCtx.resourceList).Elem().Len())
+
+	items := reflect.ValueOf(listCtx.resourceList).Elem()
+	if keyset && items.Len() > int(args.Size) {
+		items.Set(items.Slice(0, int(args.Size)))
+		listCtx.pagingMeta.Continue = encodeContinue(items.Index(items.Len() - 1))
+	}
+	listCtx.pagingMeta.Size = int64(items.Len())
 
 	return nil
 }
 
+// selectColumns qualifies the columns with the table, the id and the creation time are always selected since
+// they are the keyset of the continue token.
+func selectColumns(table string, columns []string) []string {
+	selected := []string{fmt.Sprintf("%s.id", table), fmt.Sprintf("%s.created_at", table)}
+	for _, column := range columns {
+		if column == "id" || column == "created_at" {
+			continue
+		}
+		selected = append(selected, fmt.Sprintf("%s.%s", table, column))
+	}
+	return selected
+}
+
+// listCursor is the keyset of the last object of a list that the list is continued from.
+type listCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        string    `json:"id"`
+}
+
+// encodeContinue returns the opaque continue token of the last object of a list.
+func encodeContinue(last reflect.Value) string {
+	createdAt, id := last.FieldByName("CreatedAt"), last.FieldByName("ID")
+	if !createdAt.IsValid() || !id.IsValid() {
+		return ""
+	}
+	data, err := json.Marshal(listCursor{CreatedAt: createdAt.Interface().(time.Time), ID: id.String()})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeContinue(token string) (*listCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &listCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, err
+	}
+	if cursor.ID == "" {
+		return nil, fmt.Errorf("the id of the last object is required")
+	}
+	return cursor, nil
+}
+
 // Allocate a slice with size 'cap' of the type i
 func zeroSlice(i interface{}, cap int64) *errors.ServiceError {
 	v := reflect.ValueOf(i)
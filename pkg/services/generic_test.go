@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
@@ -77,3 +79,43 @@ func TestSQLTranslation(t *testing.T) {
 		Expect(values).To(valuesReal)
 	}
 }
+
+func TestJSONBSearchRejected(t *testing.T) {
+	RegisterTestingT(t)
+
+	genericService := sqlGenericService{}
+	for _, search := range []string{
+		"payload->'metadata'->'labels'->>'app' = 'nginx'",
+		`payload @> '{"metadata":{"name":"nginx"}}'`,
+	} {
+		list := []api.Resource{}
+		listCtx, _, serviceErr := genericService.newListContext(context.Background(), "", &ListArguments{Search: search}, &list)
+		Expect(serviceErr).To(BeNil())
+		var d dao.GenericDao
+		_, serviceErr = genericService.buildSearch(listCtx, &d)
+		Expect(serviceErr).NotTo(BeNil())
+		Expect(serviceErr.Code).To(Equal(errors.ErrorBadRequest))
+		Expect(serviceErr.Reason).To(ContainSubstring("the search of JSONB fields is not supported"))
+	}
+}
+
+func TestListContinueToken(t *testing.T) {
+	RegisterTestingT(t)
+
+	resource := api.Resource{Meta: api.Meta{ID: "abc", CreatedAt: time.Date(2026, 10, 18, 9, 0, 0, 123456000, time.UTC)}}
+	token := encodeContinue(reflect.ValueOf(resource))
+	Expect(token).NotTo(BeEmpty())
+
+	cursor, err := decodeContinue(token)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cursor.ID).To(Equal("abc"))
+	Expect(cursor.CreatedAt.Equal(resource.CreatedAt)).To(BeTrue())
+
+	for _, invalid := range []string{"invalid!", "e30", "bm90IGpzb24"} {
+		_, err := decodeContinue(invalid)
+		Expect(err).To(HaveOccurred())
+	}
+
+	// the objects without the keyset have no continue token
+	Expect(encodeContinue(reflect.ValueOf(struct{ Name string }{Name: "abc"}))).To(BeEmpty())
+}
@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	e "errors"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+type OperationService interface {
+	Get(ctx context.Context, id string) (*api.Operation, *errors.ServiceError)
+	Replace(ctx context.Context, operation *api.Operation) (*api.Operation, *errors.ServiceError)
+	FindRunning(ctx context.Context) (api.OperationList, *errors.ServiceError)
+	FindRunningByTargetID(ctx context.Context, targetID string) (api.OperationList, *errors.ServiceError)
+	// FindRunningByResourceID returns the running operations that target the resource or that delete it.
+	FindRunningByResourceID(ctx context.Context, resourceID string) (api.OperationList, *errors.ServiceError)
+
+	// DeleteConsumer starts an operation that deletes the resources of the consumer and then the consumer.
+	DeleteConsumer(ctx context.Context, consumer *api.Consumer) (*api.Operation, *errors.ServiceError)
+	// DeleteResources starts an operation that deletes the given resources, which match the search.
+	DeleteResources(ctx context.Context, search string, resourceIDs []string) (*api.Operation, *errors.ServiceError)
+	// TrackResource starts an operation that tracks the latest event of the given type of the resource
+	// until the consumer applies or removes the resource.
+	TrackResource(ctx context.Context, eventType api.EventType, resource *api.Resource) (*api.Operation, *errors.ServiceError)
+	// MarkDispatched records that the event is dispatched to its consumer.
+	MarkDispatched(ctx context.Context, eventID string) *errors.ServiceError
+
+	AddResources(ctx context.Context, id string, resourceIDs []string) *errors.ServiceError
+	FindResources(ctx context.Context, id string) (api.OperationResourceList, *errors.ServiceError)
+	CompleteResources(ctx context.Context, id string, resourceIDs []string) *errors.ServiceError
+
+	// Scope returns the scope of the operations that ctx is allowed to list, it is nil if ctx can list all
+	// operations.
+	Scope(ctx context.Context) squirrel.Sqlizer
+}
+
+func NewOperationService(operationDao dao.OperationDao, resourceDao dao.ResourceDao, eventDao dao.EventDao) OperationService {
+	return &sqlOperationService{
+		operationDao: operationDao,
+		resourceDao:  resourceDao,
+		eventDao:     eventDao,
+	}
+}
+
+var _ OperationService = &sqlOperationService{}
+
+type sqlOperationService struct {
+	operationDao dao.OperationDao
+	resourceDao  dao.ResourceDao
+	eventDao     dao.EventDao
+}
+
+func (s *sqlOperationService) Get(ctx context.Context, id string) (*api.Operation, *errors.ServiceError) {
+	operation, err := s.operationDao.Get(ctx, id)
+	if err != nil {
+		return nil, handleGetError("Operation", "id", id, err)
+	}
+	if !operationAllowed(ctx, operation) {
+		return nil, handleGetError("Operation", "id", id, gorm.ErrRecordNotFound)
+	}
+	return operation, nil
+}
+
+func (s *sqlOperationService) Replace(ctx context.Context, operation *api.Operation) (*api.Operation, *errors.ServiceError) {
+	operation, err := s.operationDao.Replace(ctx, operation)
+	if err != nil {
+		return nil, handleUpdateError("Operation", err)
+	}
+	return operation, nil
+}
+
+func (s *sqlOperationService) FindRunning(ctx context.Context) (api.OperationList, *errors.ServiceError) {
+	operations, err := s.operationDao.FindByPhase(ctx, api.OperationRunning)
+	if err != nil {
+		return nil, handleGetError("Operation", "phase", api.OperationRunning, err)
+	}
+	return operations, nil
+}
+
+func (s *sqlOperationService) FindRunningByTargetID(ctx context.Context, targetID string) (api.OperationList, *errors.ServiceError) {
+	operations, err := s.operationDao.FindByTargetID(ctx, targetID, api.OperationRunning)
+	if err != nil {
+		return nil, handleGetError("Operation", "target_id", targetID, err)
+	}
+	return operations, nil
+}
+
+func (s *sqlOperationService) FindRunningByResourceID(ctx context.Context, resourceID string) (api.OperationList, *errors.ServiceError) {
+	operations, err := s.operationDao.FindByResourceID(ctx, resourceID, api.OperationRunning)
+	if err != nil {
+		return nil, handleGetError("Operation", "resource_id", resourceID, err)
+	}
+	return operations, nil
+}
+
+// DeleteConsumer returns the running deletion of the consumer if there is one, otherwise it starts a
+// new one with the current resources of the consumer. The resources are marked as deleting and the
+// consumer is deleted by the operation controller. Only the admins can delete a consumer, the placements
+// of any tenant may target it.
+func (s *sqlOperationService) DeleteConsumer(ctx context.Context, consumer *api.Consumer) (*api.Operation, *errors.ServiceError) {
+	if svcErr := ConsumerAdminRequired(ctx); svcErr != nil {
+		return nil, svcErr
+	}
+
+	resources, err := s.resourceDao.FindByConsumerName(ctx, consumer.Name)
+	if err != nil {
+		return nil, handleGetError("Resource", "consumer_name", consumer.Name, err)
+	}
+	resourceIDs := []string{}
+	for _, resource := range resources {
+		resourceIDs = append(resourceIDs, resource.ID)
+	}
+
+	running, svcErr := s.FindRunning(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	for _, operation := range running {
+		if operation.Type == api.DeleteConsumerOperationType && operation.TargetID == consumer.ID {
+			return operation, nil
+		}
+	}
+
+	return s.create(ctx, &api.Operation{
+		Meta:     api.Meta{ID: api.NewID()},
+		Type:     api.DeleteConsumerOperationType,
+		TargetID: consumer.ID,
+		Sources:  tenantSources(ctx),
+		Phase:    api.OperationRunning,
+	}, resourceIDs)
+}
+
+// DeleteResources starts an operation that deletes the given resources, the resources are marked as
+// deleting by the operation controller. The operation succeeds immediately if there is no resource.
+func (s *sqlOperationService) DeleteResources(ctx context.Context, search string, resourceIDs []string) (*api.Operation, *errors.ServiceError) {
+	operation := &api.Operation{
+		Meta:    api.Meta{ID: api.NewID()},
+		Type:    api.DeleteResourceBundlesOperationType,
+		Search:  search,
+		Sources: tenantSources(ctx),
+		Phase:   api.OperationRunning,
+	}
+	if len(resourceIDs) == 0 {
+		now := time.Now()
+		operation.Phase = api.OperationSucceeded
+		operation.CompletedAt = &now
+	}
+	return s.create(ctx, operation, resourceIDs)
+}
+
+// TrackResource starts an operation for the latest event of the given type of the resource, it is the
+// event that produced the current version of the resource. There is no event if an update does not
+// change the resource that is created, the operation is then completed once the consumer applies the
+// current version of the resource.
+func (s *sqlOperationService) TrackResource(ctx context.Context, eventType api.EventType, resource *api.Resource) (*api.Operation, *errors.ServiceError) {
+	operation := &api.Operation{
+		Meta:            api.Meta{ID: api.NewID()},
+		Type:            api.ResourceOperationType(eventType),
+		TargetID:        resource.ID,
+		Sources:         db.StringSlice{resource.Source},
+		ResourceVersion: resource.Version,
+		Phase:           api.OperationRunning,
+	}
+
+	event, err := s.eventDao.FindLatest(ctx, "Resources", resource.ID, eventType)
+	if err != nil && !e.Is(err, gorm.ErrRecordNotFound) {
+		return nil, handleGetError("Event", "source_id", resource.ID, err)
+	}
+	if event != nil {
+		operation.EventID = event.ID
+		// the event may be dispatched before the operation is created
+		operation.DispatchedAt = event.ReconciledDate
+	}
+
+	return s.create(ctx, operation, []string{resource.ID})
+}
+
+// MarkDispatched sets the dispatch time of the operations that are triggered by the event.
+func (s *sqlOperationService) MarkDispatched(ctx context.Context, eventID string) *errors.ServiceError {
+	if err := s.operationDao.MarkDispatched(ctx, eventID, time.Now()); err != nil {
+		return handleUpdateError("Operation", err)
+	}
+	return nil
+}
+
+func (s *sqlOperationService) create(ctx context.Context, operation *api.Operation, resourceIDs []string) (*api.Operation, *errors.ServiceError) {
+	operation.Total = int32(len(resourceIDs))
+	operation, err := s.operationDao.Create(ctx, operation)
+	if err != nil {
+		return nil, handleCreateError("Operation", err)
+	}
+	if err := s.operationDao.AddResources(ctx, operation.ID, resourceIDs); err != nil {
+		return nil, handleCreateError("Operation", err)
+	}
+	return operation, nil
+}
+
+func (s *sqlOperationService) AddResources(ctx context.Context, id string, resourceIDs []string) *errors.ServiceError {
+	if err := s.operationDao.AddResources(ctx, id, resourceIDs); err != nil {
+		return handleUpdateError("Operation", err)
+	}
+	return nil
+}
+
+func (s *sqlOperationService) FindResources(ctx context.Context, id string) (api.OperationResourceList, *errors.ServiceError) {
+	operationResources, err := s.operationDao.FindResources(ctx, id)
+	if err != nil {
+		return nil, handleGetError("OperationResource", "operation_id", id, err)
+	}
+	return operationResources, nil
+}
+
+func (s *sqlOperationService) CompleteResources(ctx context.Context, id string, resourceIDs []string) *errors.ServiceError {
+	if err := s.operationDao.CompleteResources(ctx, id, resourceIDs, time.Now()); err != nil {
+		return handleUpdateError("Operation", err)
+	}
+	return nil
+}
+
+// Scope restricts the operations of a tenant to those whose sources are all of the sources of the tenant.
+func (s *sqlOperationService) Scope(ctx context.Context) squirrel.Sqlizer {
+	sources, scoped := auth.SourcesFromContext(ctx)
+	if !scoped {
+		return nil
+	}
+	data, err := json.Marshal(sources)
+	if err != nil {
+		// the operations are not visible if the sources cannot be encoded
+		return squirrel.Expr("false")
+	}
+	return squirrel.Expr("json_array_length(operations.sources) > 0 and operations.sources::jsonb <@ ?::jsonb", string(data))
+}
+
+// operationAllowed returns true if ctx is allowed to access the operation, see Scope.
+func operationAllowed(ctx context.Context, operation *api.Operation) bool {
+	sources, scoped := auth.SourcesFromContext(ctx)
+	if !scoped {
+		return true
+	}
+	if len(operation.Sources) == 0 {
+		return false
+	}
+	for _, source := range operation.Sources {
+		if !slices.Contains(sources, source) {
+			return false
+		}
+	}
+	return true
+}
+
+// tenantSources returns the sources of the tenant of ctx, it is nil if ctx can access all sources.
+func tenantSources(ctx context.Context) db.StringSlice {
+	if sources, scoped := auth.SourcesFromContext(ctx); scoped {
+		return db.StringSlice(sources)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+)
+
+func TestOperationTenancy(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	tenancy := &auth.Tenancy{
+		Admins: auth.Subjects{Users: []string{"admin"}},
+		Tenants: []auth.Tenant{
+			{Name: "tenant1", Subjects: auth.Subjects{Users: []string{"user1"}}, Sources: []string{"source1"}},
+			{Name: "tenant2", Subjects: auth.Subjects{Users: []string{"user2"}}, Sources: []string{"source2"}},
+		},
+	}
+	tenantCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "user1", nil)
+	otherTenantCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "user2", nil)
+	adminCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "admin", nil)
+
+	resourceDao := mocks.NewResourceDao()
+	operations := NewOperationService(mocks.NewOperationDao(), resourceDao, mocks.NewEventDao())
+	for _, resource := range []*api.Resource{
+		{Meta: api.Meta{ID: "resource1"}, ConsumerName: "cluster1", Source: "source1"},
+		{Meta: api.Meta{ID: "resource2"}, ConsumerName: "cluster2", Source: "source1"},
+		{Meta: api.Meta{ID: "resource3"}, ConsumerName: "cluster2", Source: "source2"},
+	} {
+		_, err := resourceDao.Create(context.Background(), resource)
+		gm.Expect(err).To(gm.BeNil())
+	}
+
+	// the operation of a resource is only visible to the tenant of its source
+	tracked, svcErr := operations.TrackResource(tenantCtx, api.CreateEventType, &api.Resource{Meta: api.Meta{ID: "resource1"}, Source: "source1"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = operations.Get(tenantCtx, tracked.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = operations.Get(otherTenantCtx, tracked.ID)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	// the operation started by the admin is not visible to the tenants
+	deletion, svcErr := operations.DeleteResources(adminCtx, "consumer_name = 'cluster1'", []string{"resource1"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = operations.Get(adminCtx, deletion.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = operations.Get(tenantCtx, deletion.ID)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	// the operation started by a tenant is visible to the tenant only
+	deletion, svcErr = operations.DeleteResources(tenantCtx, "consumer_name = 'cluster1'", []string{"resource1"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = operations.Get(tenantCtx, deletion.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = operations.Get(otherTenantCtx, deletion.ID)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	// a tenant cannot delete a consumer, even if all of its resources are of the sources of the tenant, the
+	// placements of another tenant may target it
+	for _, name := range []string{"cluster1", "cluster2"} {
+		_, svcErr = operations.DeleteConsumer(tenantCtx, &api.Consumer{Meta: api.Meta{ID: name}, Name: name})
+		gm.Expect(svcErr).NotTo(gm.BeNil())
+		gm.Expect(svcErr.IsForbidden()).To(gm.BeTrue())
+	}
+
+	deletion, svcErr = operations.DeleteConsumer(adminCtx, &api.Consumer{Meta: api.Meta{ID: "cluster2"}, Name: "cluster2"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(deletion.Total).To(gm.Equal(int32(2)))
+
+	// the consumers are only listed and managed by the admins
+	consumers := NewConsumerService(mocks.NewConsumerDao())
+	_, svcErr = consumers.Create(tenantCtx, &api.Consumer{Name: "cluster3"})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsForbidden()).To(gm.BeTrue())
+	gm.Expect(ConsumerAdminRequired(otherTenantCtx).IsForbidden()).To(gm.BeTrue())
+	gm.Expect(ConsumerAdminRequired(adminCtx)).To(gm.BeNil())
+	consumer, svcErr := consumers.Create(adminCtx, &api.Consumer{Name: "cluster3"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = consumers.Replace(tenantCtx, consumer)
+	gm.Expect(svcErr.IsForbidden()).To(gm.BeTrue())
+	gm.Expect(consumers.Delete(tenantCtx, consumer.ID).IsForbidden()).To(gm.BeTrue())
+
+	// the list of operations is scoped by the sources of the tenant
+	gm.Expect(operations.Scope(adminCtx)).To(gm.BeNil())
+	sql, args, err := operations.Scope(tenantCtx).ToSql()
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(sql).To(gm.Equal("json_array_length(operations.sources) > 0 and operations.sources::jsonb <@ ?::jsonb"))
+	gm.Expect(args).To(gm.Equal([]interface{}{`["source1"]`}))
+}
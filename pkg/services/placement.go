@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/Masterminds/squirrel"
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+type PlacementService interface {
+	Get(ctx context.Context, id string) (*api.Placement, *errors.ServiceError)
+	Create(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError)
+	Update(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError)
+	UpdateStatus(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError)
+	SetRolloutPhase(ctx context.Context, id string, phase api.RolloutPhase) (*api.Placement, *errors.ServiceError)
+	Delete(ctx context.Context, id string) *errors.ServiceError
+	All(ctx context.Context) (api.PlacementList, *errors.ServiceError)
+	// Scope returns the scope of the placements that ctx is allowed to list, it is nil if ctx can list all
+	// placements.
+	Scope(ctx context.Context) squirrel.Sqlizer
+}
+
+func NewPlacementService(lockFactory db.LockFactory, placementDao dao.PlacementDao) PlacementService {
+	return &sqlPlacementService{
+		lockFactory:  lockFactory,
+		placementDao: placementDao,
+	}
+}
+
+var _ PlacementService = &sqlPlacementService{}
+
+type sqlPlacementService struct {
+	lockFactory  db.LockFactory
+	placementDao dao.PlacementDao
+}
+
+func (s *sqlPlacementService) Get(ctx context.Context, id string) (*api.Placement, *errors.ServiceError) {
+	return s.getVisible(ctx, id)
+}
+
+// Create creates a placement, the resources of the placement are created by the placement controller.
+func (s *sqlPlacementService) Create(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError) {
+	if err := ValidatePlacement(placement); err != nil {
+		return nil, errors.Validation("the placement is invalid, %v", err)
+	}
+	if err := ValidateManifestBundle(placement.Payload); err != nil {
+		return nil, errors.Validation("the manifest bundle in the placement is invalid, %v", err)
+	}
+	if !auth.SourceAllowed(ctx, placement.Source) {
+		return nil, errors.Forbidden("the source %s is not allowed for the tenant", placement.Source)
+	}
+
+	placement, err := s.placementDao.Create(ctx, placement)
+	if err != nil {
+		return nil, handleCreateError("Placement", err)
+	}
+	return placement, nil
+}
+
+// Update updates the consumer selector, the resource bundle template and the rollout strategy of a
+// placement, the version of the placement is increased if any of them is changed. A new rollout is
+// started if the consumer selector or the template is changed. The resources of the placement are
+// updated by the placement controller.
+func (s *sqlPlacementService) Update(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError) {
+	// the advisory lock is used here to prevent the race conditions among concurrent updates (read–modify–write)
+	lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, placement.ID, db.Placements)
+	// Ensure that the transaction related to this lock always end.
+	defer s.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		return nil, errors.DatabaseAdvisoryLock(err)
+	}
+
+	found, svcErr := s.getVisible(ctx, placement.ID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	// Make sure the requested placement version is consistent with its database version.
+	if found.Version != placement.Version {
+		return nil, errors.Conflict("the placement version is not the latest, the latest version: %d", found.Version)
+	}
+
+	targetChanged := !reflect.DeepEqual(placement.ConsumerSelector, found.ConsumerSelector) ||
+		!reflect.DeepEqual(placement.Payload, found.Payload)
+	// Neither the consumer selector, the template nor the rollout strategy is changed, the update action is not needed.
+	if !targetChanged && reflect.DeepEqual(placement.RolloutStrategy, found.RolloutStrategy) {
+		return found, nil
+	}
+
+	found.ConsumerSelector = placement.ConsumerSelector
+	found.Payload = placement.Payload
+	found.RolloutStrategy = placement.RolloutStrategy
+	if targetChanged {
+		found.RolloutPhase = api.RolloutProgressing
+	}
+	if err := ValidatePlacement(found); err != nil {
+		return nil, errors.Validation("the placement is invalid, %v", err)
+	}
+	if err := ValidateManifestBundle(found.Payload); err != nil {
+		return nil, errors.Validation("the manifest bundle in the placement is invalid, %v", err)
+	}
+	found.Version = found.Version + 1
+
+	updated, err := s.placementDao.Replace(ctx, found)
+	if err != nil {
+		return nil, handleUpdateError("Placement", err)
+	}
+	return updated, nil
+}
+
+// SetRolloutPhase pauses, resumes or aborts the rollout of a placement. A rollout in progress can be
+// paused, a paused rollout can be resumed, and a rollout that is not completed can be aborted.
+func (s *sqlPlacementService) SetRolloutPhase(ctx context.Context, id string, phase api.RolloutPhase) (*api.Placement, *errors.ServiceError) {
+	lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, id, db.Placements)
+	// Ensure that the transaction related to this lock always end.
+	defer s.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		return nil, errors.DatabaseAdvisoryLock(err)
+	}
+
+	found, svcErr := s.getVisible(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var allowed bool
+	switch phase {
+	case api.RolloutPaused:
+		allowed = found.RolloutPhase == api.RolloutProgressing
+	case api.RolloutProgressing:
+		allowed = found.RolloutPhase == api.RolloutPaused
+	case api.RolloutAborted:
+		allowed = found.RolloutPhase == api.RolloutProgressing || found.RolloutPhase == api.RolloutPaused
+	default:
+		return nil, errors.Validation("unsupported rollout phase %s", phase)
+	}
+	if !allowed {
+		return nil, errors.BadRequest("the rollout of the placement is %s, it cannot be changed to %s", found.RolloutPhase, phase)
+	}
+
+	found.RolloutPhase = phase
+	updated, err := s.placementDao.Replace(ctx, found)
+	if err != nil {
+		return nil, handleUpdateError("Placement", err)
+	}
+	return updated, nil
+}
+
+// UpdateStatus updates the rollout status and the rollout phase of a placement.
+func (s *sqlPlacementService) UpdateStatus(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError) {
+	updated, err := s.placementDao.UpdateStatus(ctx, placement)
+	if err != nil {
+		return nil, handleUpdateError("Placement", err)
+	}
+	return updated, nil
+}
+
+// Delete deletes a placement, the resources of the placement are marked as deleting by the placement
+// controller and deleted once they are deleted from their consumers.
+func (s *sqlPlacementService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	if _, svcErr := s.getVisible(ctx, id); svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.placementDao.Delete(ctx, id); err != nil {
+		return handleDeleteError("Placement", err)
+	}
+	return nil
+}
+
+func (s *sqlPlacementService) All(ctx context.Context) (api.PlacementList, *errors.ServiceError) {
+	placements, err := s.placementDao.All(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to get all placements: %s", err)
+	}
+	return placements, nil
+}
+
+func (s *sqlPlacementService) Scope(ctx context.Context) squirrel.Sqlizer {
+	if sources, scoped := auth.SourcesFromContext(ctx); scoped {
+		return squirrel.Eq{"placements.source": sources}
+	}
+	return nil
+}
+
+// getVisible returns the placement if ctx is allowed to access its source, the placements of the other
+// sources are not found.
+func (s *sqlPlacementService) getVisible(ctx context.Context, id string) (*api.Placement, *errors.ServiceError) {
+	placement, err := s.placementDao.Get(ctx, id)
+	if err != nil {
+		return nil, handleGetError("Placement", "id", id, err)
+	}
+	if !auth.SourceAllowed(ctx, placement.Source) {
+		return nil, handleGetError("Placement", "id", id, gorm.ErrRecordNotFound)
+	}
+	return placement, nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+)
+
+func TestPlacementTenancy(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	tenancy := &auth.Tenancy{
+		Admins: auth.Subjects{Users: []string{"admin"}},
+		Tenants: []auth.Tenant{
+			{Name: "tenant1", Subjects: auth.Subjects{Users: []string{"user1"}}, Sources: []string{"source1"}},
+			{Name: "tenant2", Subjects: auth.Subjects{Users: []string{"user2"}}, Sources: []string{"source2"}},
+		},
+	}
+	tenantCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "user1", nil)
+	otherTenantCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "user2", nil)
+	adminCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "admin", nil)
+
+	placements := NewPlacementService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewPlacementDao())
+	newPlacement := func(source string) *api.Placement {
+		return &api.Placement{
+			Meta:             api.Meta{ID: api.NewID()},
+			Name:             "placement-" + source,
+			Source:           source,
+			Version:          1,
+			ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "prod"}},
+			Payload:          newPayload(t, quotaTestPayload),
+		}
+	}
+
+	// a tenant cannot create a placement of the source of another tenant
+	_, svcErr := placements.Create(tenantCtx, newPlacement("source2"))
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsForbidden()).To(gm.BeTrue())
+
+	placement, svcErr := placements.Create(tenantCtx, newPlacement("source1"))
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// the placement is not found by another tenant
+	_, svcErr = placements.Get(otherTenantCtx, placement.ID)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	_, svcErr = placements.Update(otherTenantCtx, &api.Placement{
+		Meta:             api.Meta{ID: placement.ID},
+		Version:          placement.Version,
+		ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "dev"}},
+		Payload:          placement.Payload,
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	_, svcErr = placements.SetRolloutPhase(otherTenantCtx, placement.ID, api.RolloutPaused)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	svcErr = placements.Delete(otherTenantCtx, placement.ID)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	// the list of placements is scoped by the sources of the tenant
+	gm.Expect(placements.Scope(adminCtx)).To(gm.BeNil())
+	sql, args, err := placements.Scope(otherTenantCtx).ToSql()
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(sql).To(gm.Equal("placements.source IN (?)"))
+	gm.Expect(args).To(gm.Equal([]interface{}{"source2"}))
+
+	// the admin and the tenant of the source can access the placement
+	_, svcErr = placements.Get(adminCtx, placement.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+	svcErr = placements.Delete(tenantCtx, placement.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+}
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+func init() {
+	// Register the metrics for quota service
+	RegisterQuotaMetrics()
+}
+
+// QuotaService enforces the quotas of the resource bundles and reports their usage.
+//
+// The quotas are checked against the stored resource bundles when a resource bundle is created or updated. The
+// resource service creates the resource bundles that are counted by the same key one at a time, so that the
+// concurrent creations do not exceed a quota of their consumer or source.
+type QuotaService interface {
+	// Check checks a resource against the quotas, the number of the resources of its consumer and its source are
+	// checked only when the resource is created.
+	Check(ctx context.Context, resource *api.Resource, create bool) *errors.ServiceError
+	// CountKeys returns the keys that a resource is counted by against the quotas, they are its consumer and its
+	// source if the number of their resources is limited.
+	CountKeys(resource *api.Resource) []string
+	// Usage returns the quotas and the number of the resources of each consumer and each source, the quota
+	// metrics are refreshed with the usage. The usage of a tenant only counts the resources of its sources, and
+	// it does not refresh the metrics.
+	Usage(ctx context.Context) (*api.QuotaUsage, *errors.ServiceError)
+}
+
+func NewQuotaService(resourceDao dao.ResourceDao, quota api.ResourceQuota) QuotaService {
+	return &sqlQuotaService{
+		resourceDao: resourceDao,
+		quota:       quota,
+	}
+}
+
+var _ QuotaService = &sqlQuotaService{}
+
+type sqlQuotaService struct {
+	resourceDao dao.ResourceDao
+	quota       api.ResourceQuota
+}
+
+func (s *sqlQuotaService) Check(ctx context.Context, resource *api.Resource, create bool) *errors.ServiceError {
+	if s.quota.MaxPayloadBytes > 0 {
+		payload, err := json.Marshal(resource.Payload)
+		if err != nil {
+			return errors.GeneralError("Unable to encode the resource payload: %s", err)
+		}
+		if size := int64(len(payload)); size > s.quota.MaxPayloadBytes {
+			return errors.QuotaExceeded("the payload of the resource is %d bytes, exceeds the quota of %d bytes",
+				size, s.quota.MaxPayloadBytes)
+		}
+	}
+
+	if s.quota.MaxManifestsPerResource > 0 && resource.GetResourceType() == api.ManifestBundleResourceType {
+		manifestBundle, err := api.DecodeManifestBundle(resource.Payload)
+		if err != nil {
+			return errors.Validation("the payload in the resource is invalid, %v", err)
+		}
+		if manifestBundle != nil && int64(len(manifestBundle.Manifests)) > s.quota.MaxManifestsPerResource {
+			return errors.QuotaExceeded("the resource has %d manifests, exceeds the quota of %d manifests",
+				len(manifestBundle.Manifests), s.quota.MaxManifestsPerResource)
+		}
+	}
+
+	if !create {
+		return nil
+	}
+
+	if s.quota.MaxResourcesPerConsumer > 0 {
+		count, err := s.resourceDao.CountByConsumerName(ctx, resource.ConsumerName)
+		if err != nil {
+			return errors.GeneralError("Unable to count the resources of consumer %s: %s", resource.ConsumerName, err)
+		}
+		if count >= s.quota.MaxResourcesPerConsumer {
+			return errors.QuotaExceeded("the consumer %s has %d resources, reaches the quota of %d resources",
+				resource.ConsumerName, count, s.quota.MaxResourcesPerConsumer)
+		}
+	}
+
+	if s.quota.MaxResourcesPerSource > 0 {
+		count, err := s.resourceDao.CountBySource(ctx, resource.Source)
+		if err != nil {
+			return errors.GeneralError("Unable to count the resources of source %s: %s", resource.Source, err)
+		}
+		if count >= s.quota.MaxResourcesPerSource {
+			return errors.QuotaExceeded("the source %s has %d resources, reaches the quota of %d resources",
+				resource.Source, count, s.quota.MaxResourcesPerSource)
+		}
+	}
+
+	return nil
+}
+
+func (s *sqlQuotaService) CountKeys(resource *api.Resource) []string {
+	keys := []string{}
+	if s.quota.MaxResourcesPerConsumer > 0 {
+		keys = append(keys, "consumer/"+resource.ConsumerName)
+	}
+	if s.quota.MaxResourcesPerSource > 0 {
+		keys = append(keys, "source/"+resource.Source)
+	}
+	return keys
+}
+
+func (s *sqlQuotaService) Usage(ctx context.Context) (*api.QuotaUsage, *errors.ServiceError) {
+	scopedSources, scoped := auth.SourcesFromContext(ctx)
+
+	consumers, err := s.resourceDao.CountPerConsumer(ctx, scopedSources)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to count the resources of consumers: %s", err)
+	}
+	sources, err := s.resourceDao.CountPerSource(ctx, scopedSources)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to count the resources of sources: %s", err)
+	}
+
+	usage := &api.QuotaUsage{
+		Quota:     s.quota,
+		Consumers: consumers,
+		Sources:   sources,
+	}
+	if !scoped {
+		recordQuotaUsage(usage)
+	}
+	return usage, nil
+}
+
+// Subsystem used to define the quota metrics:
+const quotaMetricsSubsystem = "resource_quota"
+
+// Names of the labels added to quota metrics:
+const (
+	quotaMetricsScopeLabel = "scope"
+	quotaMetricsNameLabel  = "name"
+	quotaMetricsQuotaLabel = "quota"
+)
+
+// Names of the quota metrics:
+const (
+	quotaUsageMetric = "usage"
+	quotaLimitMetric = "limit"
+)
+
+// Description of the quota usage metric:
+var resourceQuotaUsageMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: quotaMetricsSubsystem,
+		Name:      quotaUsageMetric,
+		Help:      "Number of resources of a consumer or a source, the resources under deletion are not counted.",
+	},
+	[]string{quotaMetricsScopeLabel, quotaMetricsNameLabel},
+)
+
+// Description of the quota limit metric:
+var resourceQuotaLimitMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: quotaMetricsSubsystem,
+		Name:      quotaLimitMetric,
+		Help:      "Quotas of the resources, a quota is not enforced if it is 0.",
+	},
+	[]string{quotaMetricsQuotaLabel},
+)
+
+// Register the quota metrics:
+func RegisterQuotaMetrics() {
+	prometheus.MustRegister(resourceQuotaUsageMetric)
+	prometheus.MustRegister(resourceQuotaLimitMetric)
+}
+
+// recordQuotaUsage replaces the quota metrics with the usage, so that the consumers and the sources that no
+// longer have resources are removed.
+func recordQuotaUsage(usage *api.QuotaUsage) {
+	resourceQuotaUsageMetric.Reset()
+	for name, count := range usage.Consumers {
+		resourceQuotaUsageMetric.WithLabelValues("consumer", name).Set(float64(count))
+	}
+	for name, count := range usage.Sources {
+		resourceQuotaUsageMetric.WithLabelValues("source", name).Set(float64(count))
+	}
+
+	resourceQuotaLimitMetric.WithLabelValues("resources_per_consumer").Set(float64(usage.Quota.MaxResourcesPerConsumer))
+	resourceQuotaLimitMetric.WithLabelValues("resources_per_source").Set(float64(usage.Quota.MaxResourcesPerSource))
+	resourceQuotaLimitMetric.WithLabelValues("manifests_per_resource").Set(float64(usage.Quota.MaxManifestsPerResource))
+	resourceQuotaLimitMetric.WithLabelValues("payload_bytes").Set(float64(usage.Quota.MaxPayloadBytes))
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+)
+
+// quotaTestPayload is a manifest bundle with two manifests.
+const quotaTestPayload = "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"cluster1\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"v1\",\"kind\":\"Secret\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}]}}"
+
+func TestQuotaResourcesPerConsumerAndSource(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	resourceDAO := mocks.NewResourceDao()
+	quotas := NewQuotaService(resourceDAO, api.ResourceQuota{MaxResourcesPerConsumer: 2, MaxResourcesPerSource: 3})
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(),
+		NewEventService(mocks.NewEventDao()), nil, quotas)
+
+	for _, consumer := range []string{"cluster1", "cluster1", "cluster2"} {
+		_, svcErr := resourceService.Create(ctx, &api.Resource{ConsumerName: consumer, Source: "source1", Payload: newPayload(t, quotaTestPayload)})
+		gm.Expect(svcErr).To(gm.BeNil())
+	}
+
+	// the consumer reaches its quota
+	_, svcErr := resourceService.Create(ctx, &api.Resource{ConsumerName: "cluster1", Source: "source2", Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsQuotaExceeded()).To(gm.BeTrue())
+
+	// the source reaches its quota
+	_, svcErr = resourceService.Create(ctx, &api.Resource{ConsumerName: "cluster2", Source: "source1", Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsQuotaExceeded()).To(gm.BeTrue())
+
+	// the dry run checks the quotas as well
+	_, svcErr = resourceService.DryRunCreate(ctx, &api.Resource{ConsumerName: "cluster1", Source: "source2", Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsQuotaExceeded()).To(gm.BeTrue())
+
+	_, svcErr = resourceService.Create(ctx, &api.Resource{ConsumerName: "cluster2", Source: "source2", Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	usage, svcErr := quotas.Usage(ctx)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(usage.Consumers).To(gm.Equal(map[string]int64{"cluster1": 2, "cluster2": 2}))
+	gm.Expect(usage.Sources).To(gm.Equal(map[string]int64{"source1": 3, "source2": 1}))
+
+	// the usage of a tenant only counts the resources of its sources
+	tenancy := &auth.Tenancy{Tenants: []auth.Tenant{{Name: "tenant1", Subjects: auth.Subjects{Users: []string{"user1"}}, Sources: []string{"source2"}}}}
+	usage, svcErr = quotas.Usage(auth.NewContextWithTenancy(ctx, tenancy, "user1", nil))
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(usage.Consumers).To(gm.Equal(map[string]int64{"cluster2": 1}))
+	gm.Expect(usage.Sources).To(gm.Equal(map[string]int64{"source2": 1}))
+
+	// an identity without a tenant does not see any usage
+	usage, svcErr = quotas.Usage(auth.NewContextWithTenancy(ctx, tenancy, "user2", nil))
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(usage.Consumers).To(gm.BeEmpty())
+	gm.Expect(usage.Sources).To(gm.BeEmpty())
+}
+
+func TestQuotaManifestsAndPayloadBytes(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	resourceDAO := mocks.NewResourceDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(),
+		NewEventService(mocks.NewEventDao()), nil, NewQuotaService(resourceDAO, api.ResourceQuota{MaxManifestsPerResource: 1}))
+
+	_, svcErr := resourceService.Create(ctx, &api.Resource{ConsumerName: "cluster1", Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsQuotaExceeded()).To(gm.BeTrue())
+
+	resourceService = NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(),
+		NewEventService(mocks.NewEventDao()), nil, NewQuotaService(resourceDAO, api.ResourceQuota{MaxPayloadBytes: int64(len(quotaTestPayload))}))
+
+	resource, svcErr := resourceService.Create(ctx, &api.Resource{Meta: api.Meta{ID: "resource1"}, ConsumerName: "cluster1", Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// the update whose payload exceeds the quota is rejected
+	payload := newPayload(t, quotaTestPayload)
+	payload["data"].(map[string]interface{})["deleteOption"] = map[string]interface{}{"propagationPolicy": "Foreground"}
+	_, svcErr = resourceService.Update(ctx, &api.Resource{Meta: api.Meta{ID: resource.ID}, Version: resource.Version, Payload: payload})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsQuotaExceeded()).To(gm.BeTrue())
+}
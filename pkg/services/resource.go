@@ -3,15 +3,20 @@ package services
 import (
 	"context"
 	"reflect"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/squirrel"
 	cloudeventstypes "github.com/cloudevents/sdk-go/v2/types"
 	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
 	"k8s.io/klog/v2"
 	cegeneric "open-cluster-management.io/sdk-go/pkg/cloudevents/generic"
 	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/errors"
@@ -26,6 +31,16 @@ type ResourceService interface {
 	Get(ctx context.Context, id string) (*api.Resource, *errors.ServiceError)
 	Create(ctx context.Context, resource *api.Resource) (*api.Resource, *errors.ServiceError)
 	Update(ctx context.Context, resource *api.Resource) (*api.Resource, *errors.ServiceError)
+	DryRunCreate(ctx context.Context, resource *api.Resource) (*api.ResourceBundleDiff, *errors.ServiceError)
+	DryRunUpdate(ctx context.Context, resource *api.Resource) (*api.ResourceBundleDiff, *errors.ServiceError)
+	// Apply applies the manifests of a field manager to a resource bundle, the manifests that are owned by other
+	// field managers are kept. A Conflict error is returned if the applied manifests change the manifests that are
+	// owned by other field managers, unless the options force the field manager to take their ownership. The
+	// version is not checked if it is zero.
+	Apply(ctx context.Context, id string, version int32, applied *api.ManifestBundleWrapper, options api.ApplyOptions) (*api.Resource, *errors.ServiceError)
+	// DryRunApply runs the same checks as Apply and returns the difference between the stored manifest bundle and
+	// the applied one.
+	DryRunApply(ctx context.Context, id string, version int32, applied *api.ManifestBundleWrapper, options api.ApplyOptions) (*api.ResourceBundleDiff, *errors.ServiceError)
 	UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, bool, *errors.ServiceError)
 	MarkAsDeleting(ctx context.Context, id string) *errors.ServiceError
 	Delete(ctx context.Context, id string) *errors.ServiceError
@@ -33,16 +48,40 @@ type ResourceService interface {
 
 	FindByIDs(ctx context.Context, ids []string) (api.ResourceList, *errors.ServiceError)
 	FindBySource(ctx context.Context, source string) (api.ResourceList, *errors.ServiceError)
+	FindByConsumerName(ctx context.Context, consumerName string) (api.ResourceList, *errors.ServiceError)
+	FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, *errors.ServiceError)
+	FindPlacementIDs(ctx context.Context) ([]string, *errors.ServiceError)
 	List(ctx context.Context, listOpts cetypes.ListOptions) ([]*api.Resource, error)
 	ListWithArgs(ctx context.Context, username string, args *ListArguments, resources *[]api.Resource) (*api.PagingMeta, *errors.ServiceError)
+	// SyncStale marks the resources whose status lags behind their version longer than the threshold as stale and
+	// clears the resources whose status is reported since, it returns the stale resources.
+	SyncStale(ctx context.Context, threshold time.Duration) (api.ResourceList, *errors.ServiceError)
+	// Summarize returns the number of the resources in each state grouped by consumer, source or the value of a
+	// label, the resources are scoped to the sources of the tenant of ctx.
+	Summarize(ctx context.Context, groupBy api.ResourceBundleSummaryGroupBy, label string) (*api.ResourceBundleSummary, *errors.ServiceError)
+	// LatestResourceVersion returns the latest resource version of the committed changes of the resources, a list
+	// that reads it first has seen all of the changes up to it.
+	LatestResourceVersion(ctx context.Context) (int64, *errors.ServiceError)
+	// ListChangedSince returns the changes of the resources after the given resource version ordered by their
+	// resource versions, the resources are scoped to the sources of the tenant of ctx. A Gone error is returned if
+	// the deletions after the resource version are purged.
+	ListChangedSince(ctx context.Context, resourceVersion int64) ([]api.ResourceChange, *errors.ServiceError)
+
+	ListRevisions(ctx context.Context, id string) (api.ResourceRevisionList, *errors.ServiceError)
+	GetRevision(ctx context.Context, id string, version int32) (*api.ResourceRevision, *errors.ServiceError)
+	Rollback(ctx context.Context, id string, toVersion int32) (*api.Resource, *errors.ServiceError)
 }
 
-func NewResourceService(lockFactory db.LockFactory, resourceDao dao.ResourceDao, events EventService, generic GenericService) ResourceService {
+// NewResourceService creates the resource service, the quotas are not enforced if the quota service is nil.
+func NewResourceService(lockFactory db.LockFactory, resourceDao dao.ResourceDao, revisionDao dao.ResourceRevisionDao,
+	events EventService, generic GenericService, quotas QuotaService) ResourceService {
 	return &sqlResourceService{
 		lockFactory: lockFactory,
 		resourceDao: resourceDao,
+		revisionDao: revisionDao,
 		events:      events,
 		generic:     generic,
+		quotas:      quotas,
 	}
 }
 
@@ -51,14 +90,16 @@ var _ ResourceService = &sqlResourceService{}
 type sqlResourceService struct {
 	lockFactory db.LockFactory
 	resourceDao dao.ResourceDao
+	revisionDao dao.ResourceRevisionDao
 	events      EventService
 	generic     GenericService
+	quotas      QuotaService
 }
 
 func (s *sqlResourceService) Get(ctx context.Context, id string) (*api.Resource, *errors.ServiceError) {
-	resource, err := s.resourceDao.Get(ctx, id)
-	if err != nil {
-		return nil, handleGetError("Resource", "id", id, err)
+	resource, svcErr := s.getVisible(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
 	}
 
 	// sync the creationTimestamp and deletionTimestamp from resource meta to work metadata
@@ -73,8 +114,25 @@ func (s *sqlResourceService) Create(ctx context.Context, resource *api.Resource)
 			return nil, errors.Validation("the name in the resource is invalid, %v", err)
 		}
 	}
-	if err := ValidateManifestBundle(resource.Payload); err != nil {
-		return nil, errors.Validation("the manifest bundle in the resource is invalid, %v", err)
+	if err := ValidateResourcePayload(resource.Type, resource.Payload); err != nil {
+		return nil, errors.Validation("the payload in the resource is invalid, %v", err)
+	}
+	if !auth.SourceAllowed(ctx, resource.Source) {
+		return nil, errors.Forbidden("the source %s is not allowed for the tenant", resource.Source)
+	}
+
+	lockOwnerIDs, svcErr := s.lockQuotas(ctx, resource)
+	// Ensure that the transactions related to the locks always end.
+	defer func() {
+		for _, lockOwnerID := range lockOwnerIDs {
+			s.lockFactory.Unlock(ctx, lockOwnerID)
+		}
+	}()
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if err := s.checkQuotas(ctx, resource, true); err != nil {
+		return nil, err
 	}
 
 	resource, err := s.resourceDao.Create(ctx, resource)
@@ -82,6 +140,10 @@ func (s *sqlResourceService) Create(ctx context.Context, resource *api.Resource)
 		return nil, handleCreateError("Resource", err)
 	}
 
+	if err := s.createRevision(ctx, resource); err != nil {
+		return nil, err
+	}
+
 	_, eErr := s.events.Create(ctx, &api.Event{
 		Source:    "Resources",
 		SourceID:  resource.ID,
@@ -105,9 +167,9 @@ func (s *sqlResourceService) Update(ctx context.Context, resource *api.Resource)
 		return nil, errors.DatabaseAdvisoryLock(err)
 	}
 
-	found, err := s.resourceDao.Get(ctx, resource.ID)
-	if err != nil {
-		return nil, handleGetError("Resource", "id", resource.ID, err)
+	found, svcErr := s.getVisible(ctx, resource.ID)
+	if svcErr != nil {
+		return nil, svcErr
 	}
 
 	if !found.DeletedAt.Time.IsZero() {
@@ -119,26 +181,52 @@ func (s *sqlResourceService) Update(ctx context.Context, resource *api.Resource)
 		return nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
 	}
 
-	// New manifest is not changed, the update action is not needed.
-	if reflect.DeepEqual(resource.Payload, found.Payload) {
+	// New manifest is not changed, the update action is not needed. The manifest is also compared with the
+	// manifest bundle as it is returned by Get, so that a manifest bundle read from and sent back to the server
+	// keeps its version.
+	if reflect.DeepEqual(resource.Payload, found.Payload) || reflect.DeepEqual(resource.Payload, s.syncedPayload(found)) {
 		return found, nil
 	}
 
-	if err := ValidateManifestBundle(resource.Payload); err != nil {
-		return nil, errors.Validation("the new manifest bundle in the resource is invalid, %v", err)
+	if resource.Type != "" && resource.GetResourceType() != found.GetResourceType() {
+		return nil, errors.Validation("the resource type cannot be changed from %s to %s", found.GetResourceType(), resource.Type)
+	}
+
+	// the update is not applied by a field manager, the owners of the removed manifests are dropped
+	managers, err := api.PruneManifestManagers(found.ManifestManagers, resource.Payload)
+	if err != nil {
+		return nil, errors.Validation("the new payload in the resource is invalid, %v", err)
+	}
+
+	return s.update(ctx, found, resource.Payload, managers)
+}
+
+// update updates the payload and the manifest managers of a found resource with a new version, the caller must
+// hold the advisory lock of the resource.
+func (s *sqlResourceService) update(ctx context.Context, found *api.Resource, payload, managers datatypes.JSONMap) (*api.Resource, *errors.ServiceError) {
+	if err := ValidateResourcePayload(found.Type, payload); err != nil {
+		return nil, errors.Validation("the new payload in the resource is invalid, %v", err)
+	}
+	if err := s.checkQuotas(ctx, &api.Resource{Type: found.Type, Payload: payload}, false); err != nil {
+		return nil, err
 	}
 
 	// Increase the current resource version and update its manifest.
 	// Note: Maestro agent sets work metadata generation from the current resource version,
 	// ignoring the `generation` and `resourceVersion` from the CloudEvents metadata extension.
 	found.Version = found.Version + 1
-	found.Payload = resource.Payload
+	found.Payload = payload
+	found.ManifestManagers = managers
 
 	updated, err := s.resourceDao.Update(ctx, found)
 	if err != nil {
 		return nil, handleUpdateError("Resource", err)
 	}
 
+	if err := s.createRevision(ctx, updated); err != nil {
+		return nil, err
+	}
+
 	if _, err := s.events.Create(ctx, &api.Event{
 		Source:    "Resources",
 		SourceID:  updated.ID,
@@ -159,6 +247,190 @@ func (s *sqlResourceService) Update(ctx context.Context, resource *api.Resource)
 	return updated, nil
 }
 
+// DryRunCreate runs the same validation as Create and returns the manifests that would be created,
+// the resource is neither persisted nor is an event emitted for it.
+func (s *sqlResourceService) DryRunCreate(ctx context.Context, resource *api.Resource) (*api.ResourceBundleDiff, *errors.ServiceError) {
+	if resource.Name != "" {
+		if err := ValidateResourceName(resource); err != nil {
+			return nil, errors.Validation("the name in the resource is invalid, %v", err)
+		}
+	}
+	if err := ValidateResourcePayload(resource.Type, resource.Payload); err != nil {
+		return nil, errors.Validation("the payload in the resource is invalid, %v", err)
+	}
+	if !auth.SourceAllowed(ctx, resource.Source) {
+		return nil, errors.Forbidden("the source %s is not allowed for the tenant", resource.Source)
+	}
+	if err := s.checkQuotas(ctx, resource, true); err != nil {
+		return nil, err
+	}
+
+	if resource.ID != "" {
+		if _, err := s.resourceDao.Get(ctx, resource.ID); err == nil {
+			return nil, errors.Conflict("This Resource already exists")
+		}
+	}
+
+	if resource.GetResourceType() != api.ManifestBundleResourceType {
+		return nil, errors.Validation("the dry run is not supported by the resource type %s", resource.Type)
+	}
+
+	diff, err := api.DiffManifestBundles(nil, resource.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to diff Resource: %s", err)
+	}
+	diff.ResourceID = resource.ID
+	diff.Version = resource.Version
+	return diff, nil
+}
+
+// DryRunUpdate runs the same checks and validation as Update and returns the difference between
+// the stored manifest bundle and the proposed one, the resource is neither updated nor is an event
+// emitted for it.
+func (s *sqlResourceService) DryRunUpdate(ctx context.Context, resource *api.Resource) (*api.ResourceBundleDiff, *errors.ServiceError) {
+	found, svcErr := s.getVisible(ctx, resource.ID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if !found.DeletedAt.Time.IsZero() {
+		return nil, errors.Conflict("the resource is under deletion, id: %s", resource.ID)
+	}
+
+	if found.Version != resource.Version {
+		return nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
+	}
+
+	if resource.Type != "" && resource.GetResourceType() != found.GetResourceType() {
+		return nil, errors.Validation("the resource type cannot be changed from %s to %s", found.GetResourceType(), resource.Type)
+	}
+
+	if err := ValidateResourcePayload(found.Type, resource.Payload); err != nil {
+		return nil, errors.Validation("the new payload in the resource is invalid, %v", err)
+	}
+	if err := s.checkQuotas(ctx, &api.Resource{Type: found.Type, Payload: resource.Payload}, false); err != nil {
+		return nil, err
+	}
+
+	if found.GetResourceType() != api.ManifestBundleResourceType {
+		return nil, errors.Validation("the dry run is not supported by the resource type %s", found.Type)
+	}
+
+	// compare with the manifest bundle as it is returned by Get, so that a manifest bundle read
+	// from and sent back to the server is reported as unchanged
+	s.syncTimestampsFromResourceMeta(found)
+	diff, err := api.DiffManifestBundles(found.Payload, resource.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to diff Resource: %s", err)
+	}
+	diff.ResourceID = found.ID
+	diff.Version = found.Version
+	return diff, nil
+}
+
+func (s *sqlResourceService) Apply(ctx context.Context, id string, version int32, applied *api.ManifestBundleWrapper,
+	options api.ApplyOptions) (*api.Resource, *errors.ServiceError) {
+	// the manifests of the other field managers are read and written back, the advisory lock is used to
+	// prevent the race conditions among the field managers, the same as Update.
+	lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, id, db.Resources)
+	// Ensure that the transaction related to this lock always end.
+	defer s.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		return nil, errors.DatabaseAdvisoryLock(err)
+	}
+
+	found, svcErr := s.getApplicable(ctx, id, version)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	payload, managers, svcErr := applyManifestBundle(found, applied, options)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	diff, err := api.DiffManifestBundles(found.Payload, payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to diff Resource: %s", err)
+	}
+	if !diff.Changed() {
+		// the manifests are not changed, but the field manager may take the ownership of them
+		if reflect.DeepEqual(managers, found.ManifestManagers) {
+			return found, nil
+		}
+		found.ManifestManagers = managers
+		updated, err := s.resourceDao.UpdateManifestManagers(ctx, found)
+		if err != nil {
+			return nil, handleUpdateError("Resource", err)
+		}
+		return updated, nil
+	}
+
+	return s.update(ctx, found, payload, managers)
+}
+
+func (s *sqlResourceService) DryRunApply(ctx context.Context, id string, version int32, applied *api.ManifestBundleWrapper,
+	options api.ApplyOptions) (*api.ResourceBundleDiff, *errors.ServiceError) {
+	found, svcErr := s.getApplicable(ctx, id, version)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	// apply to the manifest bundle as it is returned by Get, so that the diff of DryRunUpdate is not changed by
+	// the timestamps in its metadata
+	s.syncTimestampsFromResourceMeta(found)
+	payload, _, svcErr := applyManifestBundle(found, applied, options)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return s.DryRunUpdate(ctx, &api.Resource{
+		Meta:    api.Meta{ID: found.ID},
+		Version: found.Version,
+		Payload: payload,
+	})
+}
+
+// getApplicable gets a resource bundle that the manifests of a field manager can be applied to, the version is
+// not checked if it is zero.
+func (s *sqlResourceService) getApplicable(ctx context.Context, id string, version int32) (*api.Resource, *errors.ServiceError) {
+	found, svcErr := s.getVisible(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if !found.DeletedAt.Time.IsZero() {
+		return nil, errors.Conflict("the resource is under deletion, id: %s", id)
+	}
+
+	if version != 0 && found.Version != version {
+		return nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
+	}
+
+	if found.GetResourceType() != api.ManifestBundleResourceType {
+		return nil, errors.Validation("the apply is not supported by the resource type %s", found.Type)
+	}
+	return found, nil
+}
+
+// applyManifestBundle applies the manifests of a field manager to the payload of a found resource bundle, and
+// returns the applied payload and manifest managers.
+func applyManifestBundle(found *api.Resource, applied *api.ManifestBundleWrapper,
+	options api.ApplyOptions) (datatypes.JSONMap, datatypes.JSONMap, *errors.ServiceError) {
+	payload, managers, conflicts, err := api.ApplyManifestBundle(found.Payload, found.ManifestManagers, applied, options)
+	if err != nil {
+		return nil, nil, errors.Validation("the resource bundle is invalid, %v", err)
+	}
+	if len(conflicts) != 0 {
+		owned := make([]string, 0, len(conflicts))
+		for _, conflict := range conflicts {
+			owned = append(owned, conflict.String())
+		}
+		return nil, nil, errors.Conflict("the manifests are owned by other field managers: %s", strings.Join(owned, ", "))
+	}
+	return payload, managers, nil
+}
+
 func (s *sqlResourceService) UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, bool, *errors.ServiceError) {
 	logger := klog.FromContext(ctx).WithValues("resourceID", resource.ID)
 
@@ -187,6 +459,25 @@ func (s *sqlResourceService) UpdateStatus(ctx context.Context, resource *api.Res
 		return found, false, nil
 	}
 
+	// A drift report only carries the drift conditions of the resource, it is merged into the found status
+	// rather than replacing it, so it is not ordered with the status updates of the work agent.
+	if api.IsDriftReport(resource.Status) {
+		if len(found.Status) == 0 {
+			logger.Info("Reporting drift for resource without status; disregard it")
+			return found, false, nil
+		}
+		merged, err := api.MergeDriftReport(found.Status, resource.Status)
+		if err != nil {
+			return nil, false, errors.GeneralError("Unable to merge drift report into resource status: %s", err)
+		}
+		if reflect.DeepEqual(merged, found.Status) {
+			return found, false, nil
+		}
+		logger.Info("Updating resource drift", "drifted", api.DriftedSinceOf(merged) != nil)
+		found.Status = merged
+		return s.updateStatus(ctx, found)
+	}
+
 	// New status is not changed, the update status action is not needed.
 	if reflect.DeepEqual(resource.Status, found.Status) {
 		return found, false, nil
@@ -236,9 +527,18 @@ func (s *sqlResourceService) UpdateStatus(ctx context.Context, resource *api.Res
 		return found, false, nil
 	}
 
-	// Only update resource status
-	found.Status = resource.Status
-	updated, err := s.resourceDao.UpdateStatus(ctx, found)
+	// Only update resource status, the drift conditions reported by the agent are kept until it reports them again.
+	found.Status, err = api.KeepDriftConditions(resource.Status, found.Status)
+	if err != nil {
+		return nil, false, errors.GeneralError("Unable to keep drift conditions of resource status: %s", err)
+	}
+	return s.updateStatus(ctx, found)
+}
+
+// updateStatus stores the status of a resource and the time since the resource is drifted.
+func (s *sqlResourceService) updateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, bool, *errors.ServiceError) {
+	resource.DriftedSince = api.DriftedSinceOf(resource.Status)
+	updated, err := s.resourceDao.UpdateStatus(ctx, resource)
 	if err != nil {
 		return nil, false, handleUpdateError("Resource", err)
 	}
@@ -272,6 +572,12 @@ func (s *sqlResourceService) MarkAsDeleting(ctx context.Context, id string) *err
 		return errors.DatabaseAdvisoryLock(err)
 	}
 
+	if _, scoped := auth.SourcesFromContext(ctx); scoped {
+		if _, svcErr := s.getVisible(ctx, id); svcErr != nil {
+			return svcErr
+		}
+	}
+
 	if err := s.resourceDao.Delete(ctx, id, false); err != nil {
 		return handleDeleteError("Resource", errors.GeneralError("Unable to delete resource: %s", err))
 	}
@@ -311,6 +617,31 @@ func (s *sqlResourceService) FindBySource(ctx context.Context, source string) (a
 	return resources, nil
 }
 
+// FindByConsumerName returns the resources of the consumer, including the resources under deletion.
+func (s *sqlResourceService) FindByConsumerName(ctx context.Context, consumerName string) (api.ResourceList, *errors.ServiceError) {
+	resources, err := s.resourceDao.FindByConsumerName(ctx, consumerName)
+	if err != nil {
+		return nil, handleGetError("Resource", "consumer_name", consumerName, err)
+	}
+	return resources, nil
+}
+
+func (s *sqlResourceService) FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, *errors.ServiceError) {
+	resources, err := s.resourceDao.FindByPlacementID(ctx, placementID)
+	if err != nil {
+		return nil, handleGetError("Resource", "placement_id", placementID, err)
+	}
+	return resources, nil
+}
+
+func (s *sqlResourceService) FindPlacementIDs(ctx context.Context) ([]string, *errors.ServiceError) {
+	placementIDs, err := s.resourceDao.FindPlacementIDs(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find placement ids of resources: %s", err)
+	}
+	return placementIDs, nil
+}
+
 func (s *sqlResourceService) All(ctx context.Context) (api.ResourceList, *errors.ServiceError) {
 	resources, err := s.resourceDao.All(ctx)
 	if err != nil {
@@ -324,16 +655,41 @@ var _ cegeneric.Lister[*api.Resource] = &sqlResourceService{}
 // List implements the cegeneric.Lister interface, enabling the cloudevents source client to list resources for responding spec resync from the agent.
 // For more details, refer to the cegeneric.Lister interface:
 // https://github.com/open-cluster-management-io/sdk-go/blob/d3c47c228d7905ebb20f331f9b72bc5ff6a84789/pkg/cloudevents/generic/interface.go#L36-L39
+// The resources are limited to the resource type of the data type of the options if it is set.
 func (s *sqlResourceService) List(ctx context.Context, listOpts cetypes.ListOptions) ([]*api.Resource, error) {
 	resourceList, err := s.resourceDao.FindByConsumerName(ctx, listOpts.ClusterName)
 	if err != nil {
 		return nil, err
 	}
-	return resourceList, nil
+
+	var resourceType api.ResourceType
+	if listOpts.CloudEventsDataType != (cetypes.CloudEventsDataType{}) {
+		resourceType, err = api.ResourceTypeOf(listOpts.CloudEventsDataType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	visible := api.ResourceList{}
+	for _, resource := range resourceList {
+		if resourceType != "" && resource.GetResourceType() != resourceType {
+			continue
+		}
+		if !auth.SourceAllowed(ctx, resource.Source) {
+			continue
+		}
+		visible = append(visible, resource)
+	}
+	return visible, nil
 }
 
-// ListWithArgs lists resources based on the provided page and filter arguments.
+// ListWithArgs lists resources based on the provided page and filter arguments, the resources are scoped to
+// the sources of the tenant of ctx.
 func (s *sqlResourceService) ListWithArgs(ctx context.Context, username string, args *ListArguments, resources *[]api.Resource) (*api.PagingMeta, *errors.ServiceError) {
+	if sources, scoped := auth.SourcesFromContext(ctx); scoped {
+		args.Scope = squirrel.Eq{"resources.source": sources}
+	}
+
 	paging, serviceErr := s.generic.List(ctx, username, args, resources)
 	if serviceErr != nil {
 		return nil, serviceErr
@@ -347,6 +703,221 @@ func (s *sqlResourceService) ListWithArgs(ctx context.Context, username string,
 	return paging, nil
 }
 
+func (s *sqlResourceService) SyncStale(ctx context.Context, threshold time.Duration) (api.ResourceList, *errors.ServiceError) {
+	if err := s.resourceDao.ClearStale(ctx); err != nil {
+		return nil, errors.GeneralError("Unable to clear the stale resources: %s", err)
+	}
+	now := time.Now()
+	if err := s.resourceDao.MarkStale(ctx, now.Add(-threshold), now); err != nil {
+		return nil, errors.GeneralError("Unable to mark the stale resources: %s", err)
+	}
+	resources, err := s.resourceDao.FindStale(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find the stale resources: %s", err)
+	}
+	return resources, nil
+}
+
+func (s *sqlResourceService) Summarize(ctx context.Context, groupBy api.ResourceBundleSummaryGroupBy, label string) (*api.ResourceBundleSummary, *errors.ServiceError) {
+	switch groupBy {
+	case api.ResourceBundleSummaryByConsumer, api.ResourceBundleSummaryBySource:
+		if label != "" {
+			return nil, errors.BadRequest("the label is only supported when the resources are grouped by label")
+		}
+	case api.ResourceBundleSummaryByLabel:
+		if label == "" {
+			return nil, errors.BadRequest("the label is required when the resources are grouped by label")
+		}
+	default:
+		return nil, errors.BadRequest("unsupported group_by %q, must be one of consumer, source or label", groupBy)
+	}
+
+	var sources []string
+	if scopedSources, scoped := auth.SourcesFromContext(ctx); scoped {
+		sources = scopedSources
+	}
+
+	items, err := s.resourceDao.Summarize(ctx, groupBy, label, sources)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to summarize the resources: %s", err)
+	}
+	return &api.ResourceBundleSummary{
+		GroupBy: groupBy,
+		Label:   label,
+		Items:   items,
+	}, nil
+}
+
+func (s *sqlResourceService) LatestResourceVersion(ctx context.Context) (int64, *errors.ServiceError) {
+	resourceVersion, err := s.resourceDao.LatestResourceVersion(ctx)
+	if err != nil {
+		return 0, errors.GeneralError("Unable to get the latest resource version: %s", err)
+	}
+	return resourceVersion, nil
+}
+
+func (s *sqlResourceService) ListChangedSince(ctx context.Context, resourceVersion int64) ([]api.ResourceChange, *errors.ServiceError) {
+	compacted, err := s.resourceDao.CompactedResourceVersion(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to get the compacted resource version: %s", err)
+	}
+	if resourceVersion < compacted {
+		return nil, errors.Gone("the resource version %d is compacted, the oldest resource version is %d",
+			resourceVersion, compacted)
+	}
+
+	resources, err := s.resourceDao.FindChangedSince(ctx, resourceVersion)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find the changed resources: %s", err)
+	}
+	tombstones, err := s.resourceDao.FindTombstonesSince(ctx, resourceVersion)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find the deleted resources: %s", err)
+	}
+
+	// merge the changed and the deleted resources, both are ordered by their resource versions
+	changes := make([]api.ResourceChange, 0, len(resources)+len(tombstones))
+	for len(resources) > 0 || len(tombstones) > 0 {
+		var change api.ResourceChange
+		if len(tombstones) == 0 || (len(resources) > 0 && resources[0].ResourceVersion < tombstones[0].ID) {
+			change = api.ResourceChange{Resource: resources[0]}
+			resources = resources[1:]
+			s.syncTimestampsFromResourceMeta(change.Resource)
+		} else {
+			change = api.ResourceChange{Resource: tombstones[0].ToResource(), Deleted: true}
+			tombstones = tombstones[1:]
+		}
+		if !auth.SourceAllowed(ctx, change.Resource.Source) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (s *sqlResourceService) ListRevisions(ctx context.Context, id string) (api.ResourceRevisionList, *errors.ServiceError) {
+	if _, svcErr := s.getVisible(ctx, id); svcErr != nil {
+		return nil, svcErr
+	}
+
+	revisions, err := s.revisionDao.FindByResourceID(ctx, id)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to list revisions of Resource %s: %s", id, err)
+	}
+	return revisions, nil
+}
+
+func (s *sqlResourceService) GetRevision(ctx context.Context, id string, version int32) (*api.ResourceRevision, *errors.ServiceError) {
+	if _, scoped := auth.SourcesFromContext(ctx); scoped {
+		if _, svcErr := s.getVisible(ctx, id); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	revision, err := s.revisionDao.Get(ctx, id, version)
+	if err != nil {
+		return nil, handleGetError("ResourceRevision", "version", version, err)
+	}
+	return revision, nil
+}
+
+// Rollback re-applies the payload of an older revision of the resource. The rollback does not
+// restore the old version number, it is applied as a regular update, so the resource gets a new
+// version and a new revision recorded for it.
+func (s *sqlResourceService) Rollback(ctx context.Context, id string, toVersion int32) (*api.Resource, *errors.ServiceError) {
+	found, svcErr := s.getVisible(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if found.Version == toVersion {
+		return nil, errors.BadRequest("the resource is already at version %d", toVersion)
+	}
+
+	revision, svcErr := s.GetRevision(ctx, id, toVersion)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return s.Update(ctx, &api.Resource{
+		Meta:    api.Meta{ID: found.ID},
+		Version: found.Version,
+		Payload: revision.Payload,
+	})
+}
+
+// getVisible gets a resource that is visible to the tenant of ctx, the resources of the sources of other tenants
+// are not found, so that a tenant cannot tell them from the resources that do not exist.
+func (s *sqlResourceService) getVisible(ctx context.Context, id string) (*api.Resource, *errors.ServiceError) {
+	resource, err := s.resourceDao.Get(ctx, id)
+	if err != nil {
+		return nil, handleGetError("Resource", "id", id, err)
+	}
+	if !auth.SourceAllowed(ctx, resource.Source) {
+		return nil, handleGetError("Resource", "id", id, gorm.ErrRecordNotFound)
+	}
+	return resource, nil
+}
+
+// checkQuotas checks a resource that is created or updated against the quotas if they are enforced.
+func (s *sqlResourceService) checkQuotas(ctx context.Context, resource *api.Resource, create bool) *errors.ServiceError {
+	if s.quotas == nil {
+		return nil
+	}
+	return s.quotas.Check(ctx, resource, create)
+}
+
+// lockQuotas takes the advisory locks of the keys that a created resource is counted by against the quotas, so that
+// the resources of a consumer or a source are counted and created one at a time. The keys are locked in the same
+// order by all creations. It returns the owner ids of the locks that are taken.
+func (s *sqlResourceService) lockQuotas(ctx context.Context, resource *api.Resource) ([]string, *errors.ServiceError) {
+	if s.quotas == nil {
+		return nil, nil
+	}
+
+	lockOwnerIDs := []string{}
+	for _, key := range s.quotas.CountKeys(resource) {
+		lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, key, db.ResourceQuotas)
+		if lockOwnerID != "" {
+			lockOwnerIDs = append(lockOwnerIDs, lockOwnerID)
+		}
+		if err != nil {
+			return lockOwnerIDs, errors.DatabaseAdvisoryLock(err)
+		}
+	}
+	return lockOwnerIDs, nil
+}
+
+// createRevision records the payload of the current resource version.
+func (s *sqlResourceService) createRevision(ctx context.Context, resource *api.Resource) *errors.ServiceError {
+	if _, err := s.revisionDao.Create(ctx, &api.ResourceRevision{
+		ResourceID: resource.ID,
+		Version:    resource.Version,
+		Payload:    resource.Payload,
+	}); err != nil {
+		return handleCreateError("ResourceRevision", err)
+	}
+	return nil
+}
+
+// syncedPayload returns a copy of the payload of a resource with the timestamps synced as Get returns it, the
+// payload of the resource is not changed.
+func (s *sqlResourceService) syncedPayload(resource *api.Resource) datatypes.JSONMap {
+	payload := datatypes.JSONMap{}
+	for k, v := range resource.Payload {
+		payload[k] = v
+	}
+	if workMeta, ok := payload["metadata"].(map[string]interface{}); ok {
+		meta := map[string]interface{}{}
+		for k, v := range workMeta {
+			meta[k] = v
+		}
+		payload["metadata"] = meta
+	}
+	s.syncTimestampsFromResourceMeta(&api.Resource{Meta: resource.Meta, Payload: payload})
+	return payload
+}
+
 func (s *sqlResourceService) syncTimestampsFromResourceMeta(resource *api.Resource) {
 	// fill back the creationTimestamp and deletionTimestamp from resource meta to work metadata if it exists
 	workMetaValue, ok := resource.Payload["metadata"]
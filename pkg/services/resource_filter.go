@@ -0,0 +1,119 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+const (
+	resourceLabelsColumn     = `resources.payload->'metadata'->'labels'`
+	resourceConditionsColumn = `resources.status::jsonb->'data'->'conditions'`
+)
+
+// NewResourceFilterSqlizer compiles a resource filter to the conditions of a query. Every value of the filter,
+// including the label keys, is a parameter of the query, so a filter cannot inject SQL.
+func NewResourceFilterSqlizer(filter *api.ResourceFilter) (squirrel.Sqlizer, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	and := squirrel.And{}
+	if filter.Source != "" {
+		and = append(and, squirrel.Eq{"resources.source": filter.Source})
+	}
+	if len(filter.ConsumerNames) != 0 {
+		and = append(and, squirrel.Eq{"resources.consumer_name": filter.ConsumerNames})
+	}
+	if len(filter.Names) != 0 {
+		and = append(and, squirrel.Eq{"resources.name": filter.Names})
+	}
+	if filter.CreatedAfter != nil {
+		and = append(and, squirrel.GtOrEq{"resources.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		and = append(and, squirrel.Lt{"resources.created_at": *filter.CreatedBefore})
+	}
+	if filter.UpdatedAfter != nil {
+		and = append(and, squirrel.GtOrEq{"resources.updated_at": *filter.UpdatedAfter})
+	}
+	if filter.UpdatedBefore != nil {
+		and = append(and, squirrel.Lt{"resources.updated_at": *filter.UpdatedBefore})
+	}
+
+	for _, label := range filter.Labels {
+		value := resourceLabelsColumn + "->>?"
+		switch label.Operator {
+		case api.LabelOpIn:
+			and = append(and, squirrel.Expr(fmt.Sprintf("%s IN (%s)", value, squirrel.Placeholders(len(label.Values))),
+				append([]interface{}{label.Key}, toInterfaces(label.Values)...)...))
+		case api.LabelOpNotIn:
+			// a resource without the label is not in the values
+			and = append(and, squirrel.Expr(fmt.Sprintf("(%s IS NULL OR %s NOT IN (%s))", value, value,
+				squirrel.Placeholders(len(label.Values))),
+				append([]interface{}{label.Key, label.Key}, toInterfaces(label.Values)...)...))
+		case api.LabelOpExists:
+			and = append(and, squirrel.Expr(value+" IS NOT NULL", label.Key))
+		case api.LabelOpDoesNotExist:
+			and = append(and, squirrel.Expr(value+" IS NULL", label.Key))
+		}
+	}
+
+	for _, condition := range filter.Conditions {
+		contained, err := json.Marshal([]map[string]string{{"type": condition.Type, "status": string(condition.Status)}})
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, squirrel.Expr(resourceConditionsColumn+" @> ?::jsonb", string(contained)))
+	}
+
+	return and, nil
+}
+
+// resourceSelectableFields are the columns of the resources table that are selected by each field of a field
+// selector, the namespace of a resource bundle is its consumer name.
+var resourceSelectableFields = map[string]string{
+	"metadata.name":      "resources.name",
+	"metadata.namespace": "resources.consumer_name",
+	"consumer_name":      "resources.consumer_name",
+	"source":             "resources.source",
+}
+
+// NewFieldSelectorSqlizer compiles a Kubernetes-style field selector of the resources, e.g.
+// metadata.name=nginx,source!=maestro, to the parameterized conditions of a query.
+func NewFieldSelectorSqlizer(selector string) (squirrel.Sqlizer, error) {
+	fieldSelector, err := fields.ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	and := squirrel.And{}
+	for _, requirement := range fieldSelector.Requirements() {
+		column, ok := resourceSelectableFields[requirement.Field]
+		if !ok {
+			return nil, fmt.Errorf("unsupported field %q of the field selector", requirement.Field)
+		}
+		switch requirement.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			and = append(and, squirrel.Eq{column: requirement.Value})
+		case selection.NotEquals:
+			and = append(and, squirrel.NotEq{column: requirement.Value})
+		default:
+			return nil, fmt.Errorf("unsupported operator %s of the field selector", requirement.Operator)
+		}
+	}
+	return and, nil
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+func TestResourceFilterSqlizer(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		name         string
+		filter       *api.ResourceFilter
+		expectedSql  string
+		expectedArgs []interface{}
+		expectedErr  bool
+	}{
+		{
+			name:        "empty",
+			filter:      &api.ResourceFilter{},
+			expectedSql: "(1=1)",
+		},
+		{
+			name: "columns",
+			filter: &api.ResourceFilter{
+				Source:        "maestro",
+				ConsumerNames: []string{"cluster1", "cluster2"},
+				CreatedAfter:  &now,
+				UpdatedBefore: &now,
+			},
+			expectedSql: "(resources.source = ? AND resources.consumer_name IN (?,?) AND " +
+				"resources.created_at >= ? AND resources.updated_at < ?)",
+			expectedArgs: []interface{}{"maestro", "cluster1", "cluster2", now, now},
+		},
+		{
+			name: "labels",
+			filter: &api.ResourceFilter{Labels: []api.LabelRequirement{
+				{Key: "app", Operator: api.LabelOpIn, Values: []string{"nginx", "web"}},
+				{Key: "env", Operator: api.LabelOpNotIn, Values: []string{"prod"}},
+				{Key: "team", Operator: api.LabelOpExists},
+				{Key: "tier", Operator: api.LabelOpDoesNotExist},
+			}},
+			expectedSql: "(resources.payload->'metadata'->'labels'->>? IN (?,?) AND " +
+				"(resources.payload->'metadata'->'labels'->>? IS NULL OR resources.payload->'metadata'->'labels'->>? NOT IN (?)) AND " +
+				"resources.payload->'metadata'->'labels'->>? IS NOT NULL AND " +
+				"resources.payload->'metadata'->'labels'->>? IS NULL)",
+			expectedArgs: []interface{}{"app", "nginx", "web", "env", "env", "prod", "team", "tier"},
+		},
+		{
+			name: "injected label is a parameter",
+			filter: &api.ResourceFilter{Labels: []api.LabelRequirement{
+				{Key: "a') or 1=1 --", Operator: api.LabelOpIn, Values: []string{"b' or '1'='1"}},
+			}},
+			expectedSql:  "(resources.payload->'metadata'->'labels'->>? IN (?))",
+			expectedArgs: []interface{}{"a') or 1=1 --", "b' or '1'='1"},
+		},
+		{
+			name: "conditions",
+			filter: &api.ResourceFilter{Conditions: []api.ConditionRequirement{
+				{Type: "Applied", Status: metav1.ConditionTrue},
+			}},
+			expectedSql:  "(resources.status::jsonb->'data'->'conditions' @> ?::jsonb)",
+			expectedArgs: []interface{}{`[{"status":"True","type":"Applied"}]`},
+		},
+		{
+			name:        "invalid",
+			filter:      &api.ResourceFilter{Labels: []api.LabelRequirement{{Key: "app", Operator: api.LabelOpIn}}},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			sqlizer, err := NewResourceFilterSqlizer(c.filter)
+			if c.expectedErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			sql, args, err := sqlizer.ToSql()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sql).To(Equal(c.expectedSql))
+			if len(c.expectedArgs) == 0 {
+				Expect(args).To(BeEmpty())
+				return
+			}
+			Expect(args).To(Equal(c.expectedArgs))
+		})
+	}
+}
+
+func TestFieldSelectorSqlizer(t *testing.T) {
+	cases := []struct {
+		name         string
+		selector     string
+		expectedSql  string
+		expectedArgs []interface{}
+		expectedErr  bool
+	}{
+		{
+			name:         "equals",
+			selector:     "metadata.name=nginx,metadata.namespace==cluster1",
+			expectedSql:  "(resources.name = ? AND resources.consumer_name = ?)",
+			expectedArgs: []interface{}{"nginx", "cluster1"},
+		},
+		{
+			name:         "not equals",
+			selector:     "consumer_name=cluster1,source!=maestro",
+			expectedSql:  "(resources.consumer_name = ? AND resources.source <> ?)",
+			expectedArgs: []interface{}{"cluster1", "maestro"},
+		},
+		{
+			name:         "injected value is a parameter",
+			selector:     "metadata.name=a' or 1 --",
+			expectedSql:  "(resources.name = ?)",
+			expectedArgs: []interface{}{"a' or 1 --"},
+		},
+		{
+			name:        "unsupported field",
+			selector:    "status=applied",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid selector",
+			selector:    "metadata.name",
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			sqlizer, err := NewFieldSelectorSqlizer(c.selector)
+			if c.expectedErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			sql, args, err := sqlizer.ToSql()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sql).To(Equal(c.expectedSql))
+			Expect(args).To(Equal(c.expectedArgs))
+		})
+	}
+}
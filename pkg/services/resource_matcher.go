@@ -0,0 +1,95 @@
+package services
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yaacov/tree-search-language/pkg/tsl"
+	"github.com/yaacov/tree-search-language/pkg/walkers/semantics"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// ResourceMatcher evaluates the search and the filter of a resource list against a single resource in memory.
+// It filters the resource status changes that are streamed to a watch with the same search and filter as the
+// list. The search can use the columns of the resources table, the searches on JSONB fields are not supported,
+// the labels and the conditions are matched with the filter instead.
+type ResourceMatcher struct {
+	tree   *tsl.Node
+	filter *api.ResourceFilter
+}
+
+// NewResourceMatcher parses the search, an empty search and a nil filter match all resources.
+func NewResourceMatcher(search string, filter *api.ResourceFilter) (*ResourceMatcher, *errors.ServiceError) {
+	if filter != nil {
+		if err := filter.Validate(); err != nil {
+			return nil, errors.BadRequest("invalid filter: %s", err)
+		}
+	}
+
+	search = strings.Trim(search, " ")
+	if search == "" {
+		return &ResourceMatcher{filter: filter}, nil
+	}
+
+	if isJSONBSearch(search) {
+		return nil, errors.BadRequest("the search of JSONB fields is not supported by watch, use the filter instead: %s", search)
+	}
+
+	tree, err := tsl.ParseTSL(search)
+	if err != nil {
+		return nil, errors.BadRequest("Failed to parse search query: %s", search)
+	}
+	return &ResourceMatcher{tree: &tree, filter: filter}, nil
+}
+
+// Match returns true if the resource matches the search and the filter. The fields that are unknown to the
+// resource, e.g. the consumer name of a deleted resource, don't match any comparison.
+func (m *ResourceMatcher) Match(resource *api.Resource) bool {
+	if m.filter != nil && !m.filter.Match(resource) {
+		return false
+	}
+	if m.tree == nil {
+		return true
+	}
+
+	fields := resourceSearchFields(resource)
+	matched, err := semantics.Walk(*m.tree, func(name string) (interface{}, bool) {
+		value, ok := fields[strings.TrimPrefix(name, "resources.")]
+		return value, ok
+	})
+	return err == nil && matched
+}
+
+// resourceSearchFields returns the searchable columns of a resource by their column names.
+func resourceSearchFields(resource *api.Resource) map[string]interface{} {
+	fields := map[string]interface{}{
+		"id":     resource.ID,
+		"source": resource.Source,
+		"type":   string(resource.Type),
+	}
+
+	if resource.Name != "" {
+		fields["name"] = resource.Name
+	}
+	if resource.ConsumerName != "" {
+		fields["consumer_name"] = resource.ConsumerName
+	}
+	if resource.PlacementID != "" {
+		fields["placement_id"] = resource.PlacementID
+	}
+	if resource.Version != 0 {
+		fields["version"] = resource.Version
+	}
+	if !resource.CreatedAt.IsZero() {
+		fields["created_at"] = resource.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	if !resource.UpdatedAt.IsZero() {
+		fields["updated_at"] = resource.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	if resource.DeletedAt.Valid {
+		fields["deleted_at"] = resource.DeletedAt.Time.UTC().Format(time.RFC3339Nano)
+	}
+	return fields
+}
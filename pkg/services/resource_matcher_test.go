@@ -0,0 +1,123 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+func TestResourceMatcher(t *testing.T) {
+	resource := &api.Resource{
+		Meta:         api.Meta{ID: "resource-1"},
+		Version:      2,
+		Source:       "maestro",
+		ConsumerName: "cluster1",
+		Name:         "nginx",
+		Payload:      map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "nginx"}}},
+	}
+	// the resource of a status delete event only has the id, source and type
+	deleted := &api.Resource{
+		Meta:   api.Meta{ID: "resource-1"},
+		Source: "maestro",
+	}
+
+	cases := []struct {
+		name             string
+		search           string
+		filter           *api.ResourceFilter
+		resource         *api.Resource
+		expectedMatch    bool
+		expectedErrorMsg string
+	}{
+		{
+			name:          "empty search",
+			search:        "",
+			resource:      resource,
+			expectedMatch: true,
+		},
+		{
+			name:          "matched",
+			search:        "consumer_name = 'cluster1' and version > 1",
+			resource:      resource,
+			expectedMatch: true,
+		},
+		{
+			name:          "matched with table name",
+			search:        "resources.name in ('nginx', 'redis')",
+			resource:      resource,
+			expectedMatch: true,
+		},
+		{
+			name:          "not matched",
+			search:        "consumer_name = 'cluster2'",
+			resource:      resource,
+			expectedMatch: false,
+		},
+		{
+			name:          "unknown field",
+			search:        "consumer_name = 'cluster1'",
+			resource:      deleted,
+			expectedMatch: false,
+		},
+		{
+			name:          "type mismatch",
+			search:        "version = 'two'",
+			resource:      resource,
+			expectedMatch: false,
+		},
+		{
+			name:   "matched search and filter",
+			search: "version > 1",
+			filter: &api.ResourceFilter{
+				Labels: []api.LabelRequirement{{Key: "app", Operator: api.LabelOpIn, Values: []string{"nginx"}}},
+			},
+			resource:      resource,
+			expectedMatch: true,
+		},
+		{
+			name:   "mismatched filter",
+			search: "version > 1",
+			filter: &api.ResourceFilter{
+				Labels: []api.LabelRequirement{{Key: "app", Operator: api.LabelOpDoesNotExist}},
+			},
+			resource:      resource,
+			expectedMatch: false,
+		},
+		{
+			name: "invalid filter",
+			filter: &api.ResourceFilter{
+				Labels: []api.LabelRequirement{{Key: "app", Operator: "Equals"}},
+			},
+			expectedErrorMsg: "invalid filter",
+		},
+		{
+			name:             "invalid search",
+			search:           "garbage",
+			expectedErrorMsg: "Failed to parse search query: garbage",
+		},
+		{
+			name:             "jsonb search",
+			search:           "payload->'metadata'->>'name' = 'nginx'",
+			expectedErrorMsg: "the search of JSONB fields is not supported by watch",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			matcher, err := NewResourceMatcher(c.search, c.filter)
+			if c.expectedErrorMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), c.expectedErrorMsg) {
+					t.Errorf("expected error %q but got: %v", c.expectedErrorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if matched := matcher.Match(c.resource); matched != c.expectedMatch {
+				t.Errorf("expected match %v but got: %v", c.expectedMatch, matched)
+			}
+		})
+	}
+}
@@ -3,14 +3,24 @@ package services
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/bwmarrin/snowflake"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
 	gm "github.com/onsi/gomega"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
 
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/dao/mocks"
 	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/errors"
 )
 
 const (
@@ -25,7 +35,7 @@ func TestResourceFindByConsumerID(t *testing.T) {
 	resourceDAO := mocks.NewResourceDao()
 	events := NewEventService(mocks.NewEventDao())
 
-	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, events, nil)
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), events, nil, nil)
 
 	resources := api.ResourceList{
 		&api.Resource{ConsumerName: Fukuisaurus, Payload: newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"spec\":{\"replicas\":1,\"selector\":{\"matchLabels\":{\"app\":\"nginx\"}},\"template\":{\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"quay.io/nginx/nginx-unprivileged:latest\"}]},\"metadata\":{\"labels\":{\"app\":\"nginx\"}}}}}],\"deleteOption\":{\"propagationPolicy\":\"Foreground\"},\"manifestConfigs\":[{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"apps\",\"resource\":\"deployments\",\"namespace\":\"default\"}}]}}")},
@@ -57,7 +67,7 @@ func TestCreateInvalidResource(t *testing.T) {
 
 	resourceDAO := mocks.NewResourceDao()
 	events := NewEventService(mocks.NewEventDao())
-	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, events, nil)
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), events, nil, nil)
 
 	resource := &api.Resource{ConsumerName: "invalidation", Payload: newPayload(t, "{}")}
 
@@ -75,7 +85,7 @@ func TestResourceList(t *testing.T) {
 	resourceDAO := mocks.NewResourceDao()
 	events := NewEventService(mocks.NewEventDao())
 
-	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, events, nil)
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), events, nil, nil)
 	resources := api.ResourceList{
 		&api.Resource{ConsumerName: Fukuisaurus, Payload: newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"spec\":{\"replicas\":1,\"selector\":{\"matchLabels\":{\"app\":\"nginx\"}},\"template\":{\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"quay.io/nginx/nginx-unprivileged:latest\"}]},\"metadata\":{\"labels\":{\"app\":\"nginx\"}}}}}],\"deleteOption\":{\"propagationPolicy\":\"Foreground\"},\"manifestConfigs\":[{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"apps\",\"resource\":\"deployments\",\"namespace\":\"default\"}}]}}")},
 		&api.Resource{ConsumerName: Fukuisaurus, Payload: newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"spec\":{\"replicas\":1,\"selector\":{\"matchLabels\":{\"app\":\"nginx\"}},\"template\":{\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"quay.io/nginx/nginx-unprivileged:latest\"}]},\"metadata\":{\"labels\":{\"app\":\"nginx\"}}}}}],\"deleteOption\":{\"propagationPolicy\":\"Foreground\"},\"manifestConfigs\":[{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"apps\",\"resource\":\"deployments\",\"namespace\":\"default\"}}]}}")},
@@ -106,3 +116,456 @@ func TestResourceList(t *testing.T) {
 	gm.Expect(err).To(gm.BeNil())
 	gm.Expect(len(resources)).To(gm.Equal(1))
 }
+
+func TestResourceDryRun(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resourceDAO := mocks.NewResourceDao()
+	eventDAO := mocks.NewEventDao()
+	events := NewEventService(eventDAO)
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), events, nil, nil)
+
+	stored := newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}]}}")
+	proposed := newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.update_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"b\"}}]}}")
+
+	// dry-run create neither persists the resource nor emits an event
+	diff, svcErr := resourceService.DryRunCreate(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, ConsumerName: Fukuisaurus, Version: 0, Payload: stored})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.Manifests).To(gm.HaveLen(1))
+	gm.Expect(diff.Manifests[0].Change).To(gm.Equal(api.ManifestAdded))
+	all, err := resourceDAO.All(context.Background())
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(all).To(gm.BeEmpty())
+	evts, err := eventDAO.All(context.Background())
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(evts).To(gm.BeEmpty())
+
+	_, svcErr = resourceService.DryRunCreate(context.Background(), &api.Resource{
+		ConsumerName: Fukuisaurus, Payload: newPayload(t, "{}")})
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+
+	_, svcErr = resourceService.Create(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, ConsumerName: Fukuisaurus, Version: 1, Payload: stored})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	_, svcErr = resourceService.DryRunCreate(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, ConsumerName: Fukuisaurus, Payload: stored})
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+	gm.Expect(svcErr.IsConflict()).To(gm.BeTrue())
+
+	// dry-run update reports the modified manifest without updating the resource
+	diff, svcErr = resourceService.DryRunUpdate(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, ConsumerName: Fukuisaurus, Version: 1, Payload: proposed})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.Version).To(gm.Equal(int32(1)))
+	gm.Expect(diff.Manifests).To(gm.HaveLen(1))
+	gm.Expect(diff.Manifests[0].Change).To(gm.Equal(api.ManifestModified))
+	gm.Expect(diff.Manifests[0].Patch).To(gm.Equal(map[string]interface{}{"data": map[string]interface{}{"a": "b"}}))
+
+	found, svcErr := resourceService.Get(context.Background(), Breviceratops)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(found.Version).To(gm.Equal(int32(1)))
+	evts, err = eventDAO.All(context.Background())
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(evts).To(gm.HaveLen(1))
+
+	_, svcErr = resourceService.DryRunUpdate(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, ConsumerName: Fukuisaurus, Version: 2, Payload: proposed})
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+	gm.Expect(svcErr.IsConflict()).To(gm.BeTrue())
+}
+
+func TestResourceRollback(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resourceDAO := mocks.NewResourceDao()
+	events := NewEventService(mocks.NewEventDao())
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), events, nil, nil)
+
+	v1 := newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}]}}")
+	v2 := newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.update_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"data\":{\"a\":\"b\"}}]}}")
+
+	_, svcErr := resourceService.Create(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, ConsumerName: Fukuisaurus, Version: 1, Payload: v1})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// every spec change records a revision
+	_, svcErr = resourceService.Update(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, Version: 1, Payload: v2})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	revisions, svcErr := resourceService.ListRevisions(context.Background(), Breviceratops)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(revisions).To(gm.HaveLen(2))
+	gm.Expect(revisions[0].Version).To(gm.Equal(int32(2)))
+	gm.Expect(revisions[1].Version).To(gm.Equal(int32(1)))
+
+	// rollback re-applies the old payload as a new version
+	rolledBack, svcErr := resourceService.Rollback(context.Background(), Breviceratops, 1)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(rolledBack.Version).To(gm.Equal(int32(3)))
+	gm.Expect(rolledBack.Payload).To(gm.Equal(v1))
+
+	revision, svcErr := resourceService.GetRevision(context.Background(), Breviceratops, 3)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(revision.Payload).To(gm.Equal(v1))
+
+	_, svcErr = resourceService.Rollback(context.Background(), Breviceratops, 3)
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+
+	_, svcErr = resourceService.Rollback(context.Background(), Breviceratops, 10)
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+
+	_, svcErr = resourceService.ListRevisions(context.Background(), Seismosaurus)
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+}
+
+func TestResourceApply(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resourceDAO := mocks.NewResourceDao()
+	events := NewEventService(mocks.NewEventDao())
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), events, nil, nil)
+
+	configMap := map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap",
+		"metadata": map[string]interface{}{"name": "nginx", "namespace": "default"}}
+	secret := map[string]interface{}{"apiVersion": "v1", "kind": "Secret",
+		"metadata": map[string]interface{}{"name": "nginx", "namespace": "default"}}
+	changedSecret := map[string]interface{}{"apiVersion": "v1", "kind": "Secret",
+		"metadata": map[string]interface{}{"name": "nginx", "namespace": "default"}, "type": "Opaque"}
+
+	// the config map is created by controller a
+	payload, err := api.NewManifestBundle("grpc", Breviceratops, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{configMap},
+	})
+	gm.Expect(err).To(gm.BeNil())
+	managers, err := api.ManifestManagersOf(payload, "controller-a")
+	gm.Expect(err).To(gm.BeNil())
+	_, svcErr := resourceService.Create(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, ConsumerName: Fukuisaurus, Version: 1, Payload: payload, ManifestManagers: managers})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// controller b adds the secret without knowing the version, the config map is kept
+	applied, svcErr := resourceService.Apply(context.Background(), Breviceratops, 0, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{secret},
+	}, api.ApplyOptions{FieldManager: "controller-b"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(applied.Version).To(gm.Equal(int32(2)))
+	gm.Expect(applied.ManifestManagers).To(gm.Equal(datatypes.JSONMap{
+		"v1/ConfigMap/default/nginx": "controller-a",
+		"v1/Secret/default/nginx":    "controller-b",
+	}))
+	wrapper, err := api.DecodeManifestBundle(applied.Payload)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(wrapper.Manifests).To(gm.HaveLen(2))
+
+	// controller a cannot change the secret of controller b
+	diff, svcErr := resourceService.DryRunApply(context.Background(), Breviceratops, 0, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{configMap, changedSecret},
+	}, api.ApplyOptions{FieldManager: "controller-a"})
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+	gm.Expect(svcErr.IsConflict()).To(gm.BeTrue())
+	gm.Expect(diff).To(gm.BeNil())
+
+	_, svcErr = resourceService.Apply(context.Background(), Breviceratops, 0, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{configMap, changedSecret},
+	}, api.ApplyOptions{FieldManager: "controller-a"})
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+	gm.Expect(svcErr.IsConflict()).To(gm.BeTrue())
+
+	// a stale version is rejected
+	_, svcErr = resourceService.Apply(context.Background(), Breviceratops, 1, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{configMap},
+	}, api.ApplyOptions{FieldManager: "controller-a"})
+	gm.Expect(svcErr).ShouldNot(gm.BeNil())
+	gm.Expect(svcErr.IsConflict()).To(gm.BeTrue())
+
+	// controller a takes the ownership of the secret with force
+	diff, svcErr = resourceService.DryRunApply(context.Background(), Breviceratops, 2, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{configMap, changedSecret},
+	}, api.ApplyOptions{FieldManager: "controller-a", Force: true})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.Changed()).To(gm.BeTrue())
+
+	applied, svcErr = resourceService.Apply(context.Background(), Breviceratops, 2, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{configMap, changedSecret},
+	}, api.ApplyOptions{FieldManager: "controller-a", Force: true})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(applied.Version).To(gm.Equal(int32(3)))
+	gm.Expect(applied.ManifestManagers).To(gm.Equal(datatypes.JSONMap{
+		"v1/ConfigMap/default/nginx": "controller-a",
+		"v1/Secret/default/nginx":    "controller-a",
+	}))
+
+	// a regular update drops the owners of the removed manifests
+	updated, svcErr := resourceService.Update(context.Background(), &api.Resource{
+		Meta: api.Meta{ID: Breviceratops}, Version: 3, Payload: payload})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated.Version).To(gm.Equal(int32(4)))
+	gm.Expect(updated.ManifestManagers).To(gm.Equal(datatypes.JSONMap{"v1/ConfigMap/default/nginx": "controller-a"}))
+}
+
+func TestResourceTenantIsolation(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resourceDAO := mocks.NewResourceDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(),
+		NewEventService(mocks.NewEventDao()), nil, nil)
+
+	tenancy := &auth.Tenancy{
+		Admins:  auth.Subjects{Users: []string{"admin"}},
+		Tenants: []auth.Tenant{{Name: "tenant1", Subjects: auth.Subjects{Groups: []string{"team1"}}, Sources: []string{"source1"}}},
+	}
+	tenantCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "user1", []string{"team1"})
+	adminCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "admin", nil)
+
+	// the tenant cannot create the resources of the sources of other tenants
+	_, svcErr := resourceService.Create(tenantCtx, &api.Resource{Meta: api.Meta{ID: "resource1"}, ConsumerName: "cluster1",
+		Source: "source2", Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.IsForbidden()).To(gm.BeTrue())
+
+	for id, source := range map[string]string{"resource1": "source1", "resource2": "source2"} {
+		_, svcErr := resourceService.Create(adminCtx, &api.Resource{Meta: api.Meta{ID: id}, ConsumerName: "cluster1",
+			Source: source, Payload: newPayload(t, quotaTestPayload)})
+		gm.Expect(svcErr).To(gm.BeNil())
+	}
+
+	// the resources of other tenants are not found
+	_, svcErr = resourceService.Get(tenantCtx, "resource1")
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = resourceService.Get(tenantCtx, "resource2")
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+	_, svcErr = resourceService.Update(tenantCtx, &api.Resource{Meta: api.Meta{ID: "resource2"}, Version: 1,
+		Payload: newPayload(t, quotaTestPayload)})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+	svcErr = resourceService.MarkAsDeleting(tenantCtx, "resource2")
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+	_, svcErr = resourceService.Get(adminCtx, "resource2")
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// the resync of the agent lists the resources of the tenant only
+	resources, err := resourceService.List(tenantCtx, types.ListOptions{ClusterName: "cluster1"})
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(resources).To(gm.HaveLen(1))
+	gm.Expect(resources[0].ID).To(gm.Equal("resource1"))
+	resources, err = resourceService.List(adminCtx, types.ListOptions{ClusterName: "cluster1"})
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(resources).To(gm.HaveLen(2))
+}
+
+func TestResourceSummarize(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	resourceDAO := mocks.NewResourceDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), NewEventService(mocks.NewEventDao()), nil, nil)
+
+	labeled := func(env string) map[string]interface{} {
+		return map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"env": env}}}
+	}
+	resources := []*api.Resource{
+		{Meta: api.Meta{ID: "applied"}, ConsumerName: "cluster1", Source: "source1", Version: 1, Payload: labeled("prod"),
+			Status: consumerSetTestStatus(t, 1, metav1.ConditionTrue, metav1.ConditionTrue)},
+		{Meta: api.Meta{ID: "failed"}, ConsumerName: "cluster1", Source: "source2", Version: 1, Payload: labeled("prod"),
+			Status: consumerSetTestStatus(t, 1, metav1.ConditionFalse, metav1.ConditionFalse)},
+		// the status is reported for a previous version
+		{Meta: api.Meta{ID: "outdated"}, ConsumerName: "cluster2", Source: "source1", Version: 2, Payload: labeled("dev"),
+			Status: consumerSetTestStatus(t, 1, metav1.ConditionTrue, metav1.ConditionTrue)},
+		{Meta: api.Meta{ID: "pending"}, ConsumerName: "cluster2", Source: "source1", Version: 1},
+		{Meta: api.Meta{ID: "deleting", DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}}, ConsumerName: "cluster2",
+			Source: "source2", Version: 1, Status: consumerSetTestStatus(t, 1, metav1.ConditionTrue, metav1.ConditionFalse)},
+	}
+	for _, resource := range resources {
+		_, err := resourceDAO.Create(ctx, resource)
+		gm.Expect(err).To(gm.BeNil())
+	}
+
+	summary, svcErr := resourceService.Summarize(ctx, api.ResourceBundleSummaryByConsumer, "")
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(summary.Items).To(gm.Equal([]api.ResourceBundleSummaryItem{
+		{Key: "cluster1", Total: 2, Applied: 1, Available: 1, Degraded: 1},
+		{Key: "cluster2", Total: 3, Applied: 1, Deleting: 1, NotReported: 1},
+	}))
+	gm.Expect(summary.Total()).To(gm.Equal(api.ResourceBundleSummaryItem{Total: 5, Applied: 2, Available: 1, Degraded: 1, Deleting: 1, NotReported: 1}))
+
+	// the resource bundles without the label are grouped under an empty key
+	summary, svcErr = resourceService.Summarize(ctx, api.ResourceBundleSummaryByLabel, "env")
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(summary.Items).To(gm.Equal([]api.ResourceBundleSummaryItem{
+		{Key: "", Total: 2, Applied: 1, Deleting: 1, NotReported: 1},
+		{Key: "dev", Total: 1},
+		{Key: "prod", Total: 2, Applied: 1, Available: 1, Degraded: 1},
+	}))
+
+	// the resource bundles of the other tenants are not counted
+	tenancy := &auth.Tenancy{Tenants: []auth.Tenant{{Name: "tenant1", Subjects: auth.Subjects{Users: []string{"user1"}}, Sources: []string{"source1"}}}}
+	tenantCtx := auth.NewContextWithTenancy(ctx, tenancy, "user1", nil)
+	summary, svcErr = resourceService.Summarize(tenantCtx, api.ResourceBundleSummaryBySource, "")
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(summary.Items).To(gm.Equal([]api.ResourceBundleSummaryItem{
+		{Key: "source1", Total: 3, Applied: 1, Available: 1, NotReported: 1},
+	}))
+
+	for _, args := range []struct {
+		groupBy api.ResourceBundleSummaryGroupBy
+		label   string
+	}{
+		{groupBy: api.ResourceBundleSummaryByLabel},
+		{groupBy: api.ResourceBundleSummaryBySource, label: "env"},
+		{groupBy: "placement"},
+	} {
+		_, svcErr = resourceService.Summarize(ctx, args.groupBy, args.label)
+		gm.Expect(svcErr).NotTo(gm.BeNil())
+		gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorBadRequest))
+	}
+}
+
+func TestResourceUpdateStatusDrift(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	resourceDAO := mocks.NewResourceDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(), NewEventService(mocks.NewEventDao()), nil, nil)
+
+	_, err := resourceDAO.Create(ctx, &api.Resource{Meta: api.Meta{ID: Fukuisaurus}, ConsumerName: "cluster1", Version: 1})
+	gm.Expect(err).To(gm.BeNil())
+
+	node, err := snowflake.NewNode(1)
+	gm.Expect(err).To(gm.BeNil())
+	applied := metav1.Condition{Type: workv1.WorkApplied, Status: metav1.ConditionTrue, Reason: "AppliedManifestWorkComplete"}
+	drifted := metav1.Condition{Type: api.DriftedCondition, Status: metav1.ConditionTrue, Reason: api.DriftDetectedReason,
+		LastTransitionTime: metav1.NewTime(time.Now().Truncate(time.Second))}
+	notDrifted := metav1.Condition{Type: api.DriftedCondition, Status: metav1.ConditionFalse, Reason: api.NoDriftReason,
+		LastTransitionTime: metav1.NewTime(time.Now().Truncate(time.Second))}
+
+	// a drift report of a resource without status is disregarded
+	_, updated, svcErr := resourceService.UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: Fukuisaurus}, Version: 1,
+		Status: driftTestStatus(t, "", true, drifted)})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated).To(gm.BeFalse())
+
+	_, updated, svcErr = resourceService.UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: Fukuisaurus}, Version: 1,
+		Status: driftTestStatus(t, node.Generate().String(), false, applied)})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated).To(gm.BeTrue())
+
+	// the drift report is merged into the status of the work agent
+	resource, updated, svcErr := resourceService.UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: Fukuisaurus}, Version: 1,
+		Status: driftTestStatus(t, "", true, drifted)})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated).To(gm.BeTrue())
+	gm.Expect(resource.DriftedSince).NotTo(gm.BeNil())
+	gm.Expect(driftTestConditions(t, resource)).To(gm.ConsistOf(workv1.WorkApplied, api.DriftedCondition))
+
+	// the drift is kept when the work agent updates the status
+	resource, updated, svcErr = resourceService.UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: Fukuisaurus}, Version: 1,
+		Status: driftTestStatus(t, node.Generate().String(), false, applied, metav1.Condition{Type: workv1.WorkAvailable, Status: metav1.ConditionTrue, Reason: "ResourcesAvailable"})})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated).To(gm.BeTrue())
+	gm.Expect(resource.DriftedSince).NotTo(gm.BeNil())
+	gm.Expect(driftTestConditions(t, resource)).To(gm.ConsistOf(workv1.WorkApplied, workv1.WorkAvailable, api.DriftedCondition))
+
+	// the drift is cleared once the live objects match the manifests
+	resource, updated, svcErr = resourceService.UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: Fukuisaurus}, Version: 1,
+		Status: driftTestStatus(t, "", true, notDrifted)})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated).To(gm.BeTrue())
+	gm.Expect(resource.DriftedSince).To(gm.BeNil())
+}
+
+func driftTestStatus(t *testing.T, sequenceID string, report bool, conditions ...metav1.Condition) map[string]interface{} {
+	evt := cloudevents.NewEvent()
+	evt.SetID(uuid.NewString())
+	evt.SetSource("cluster1-work-agent")
+	evt.SetType("io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request")
+	evt.SetExtension(types.ExtensionResourceVersion, 1)
+	evt.SetExtension(types.ExtensionStatusUpdateSequenceID, sequenceID)
+	if report {
+		evt.SetExtension(api.ExtensionDriftReport, true)
+	}
+	if err := evt.SetData(cloudevents.ApplicationJSON, &payload.ManifestBundleStatus{Conditions: conditions}); err != nil {
+		t.Fatal(err)
+	}
+	status, err := api.CloudEventToJSONMap(&evt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return status
+}
+
+func driftTestConditions(t *testing.T, resource *api.Resource) []string {
+	status, err := api.DecodeResourceBundleStatus(resource.Status)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conditions := []string{}
+	for _, condition := range status.Conditions {
+		conditions = append(conditions, condition.Type)
+	}
+	return conditions
+}
+
+func TestResourceListChangedSince(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resourceDAO := mocks.NewResourceDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewResourceRevisionDao(),
+		NewEventService(mocks.NewEventDao()), nil, nil)
+
+	tenancy := &auth.Tenancy{
+		Admins:  auth.Subjects{Users: []string{"admin"}},
+		Tenants: []auth.Tenant{{Name: "tenant1", Subjects: auth.Subjects{Groups: []string{"team1"}}, Sources: []string{"source1"}}},
+	}
+	tenantCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "user1", []string{"team1"})
+	adminCtx := auth.NewContextWithTenancy(context.Background(), tenancy, "admin", nil)
+
+	for _, resource := range []struct{ id, source string }{
+		{id: "resource1", source: "source1"},
+		{id: "resource2", source: "source1"},
+		{id: "resource3", source: "source2"},
+	} {
+		_, svcErr := resourceService.Create(adminCtx, &api.Resource{Meta: api.Meta{ID: resource.id}, ConsumerName: "cluster1",
+			Source: resource.source, Payload: newPayload(t, quotaTestPayload)})
+		gm.Expect(svcErr).To(gm.BeNil())
+	}
+	listed, svcErr := resourceService.LatestResourceVersion(adminCtx)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(listed).To(gm.Equal(int64(3)))
+
+	// the changes after the listed resource version are returned in order, a deletion is returned from its tombstone
+	gm.Expect(resourceService.MarkAsDeleting(adminCtx, "resource2")).To(gm.BeNil())
+	gm.Expect(resourceService.Delete(adminCtx, "resource1")).To(gm.BeNil())
+	gm.Expect(resourceService.Delete(adminCtx, "resource3")).To(gm.BeNil())
+
+	changes, svcErr := resourceService.ListChangedSince(adminCtx, listed)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(changes).To(gm.HaveLen(3))
+	gm.Expect(changes[0].Resource.ID).To(gm.Equal("resource2"))
+	gm.Expect(changes[0].Deleted).To(gm.BeFalse())
+	gm.Expect(changes[0].Resource.DeletedAt.Valid).To(gm.BeTrue())
+	gm.Expect(changes[1].Resource.ID).To(gm.Equal("resource1"))
+	gm.Expect(changes[1].Deleted).To(gm.BeTrue())
+	gm.Expect(changes[1].Resource.ResourceVersion).To(gm.Equal(int64(5)))
+	gm.Expect(changes[2].Resource.ID).To(gm.Equal("resource3"))
+	gm.Expect(changes[2].Deleted).To(gm.BeTrue())
+
+	// the tenant only sees the changes of its sources
+	changes, svcErr = resourceService.ListChangedSince(tenantCtx, listed)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(changes).To(gm.HaveLen(2))
+
+	// nothing is changed after the latest resource version
+	latest, svcErr := resourceService.LatestResourceVersion(adminCtx)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(latest).To(gm.Equal(int64(6)))
+	changes, svcErr = resourceService.ListChangedSince(adminCtx, latest)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(changes).To(gm.BeEmpty())
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/dao"
@@ -19,6 +20,8 @@ type StatusEventService interface {
 	FindAllUnreconciledEvents(ctx context.Context) (api.StatusEventList, *errors.ServiceError)
 	DeleteAllReconciledEvents(ctx context.Context) *errors.ServiceError
 	DeleteAllEvents(ctx context.Context, eventIDs []string) *errors.ServiceError
+	DeleteEventsCreatedBefore(ctx context.Context, eventIDs []string, before time.Time) *errors.ServiceError
+	FindBySourceAfterSequence(ctx context.Context, source string, sequence int64) (api.StatusEventList, *errors.ServiceError)
 }
 
 func NewStatusEventService(statusEventDao dao.StatusEventDao) StatusEventService {
@@ -101,3 +104,18 @@ func (s *sqlStatusEventService) DeleteAllEvents(ctx context.Context, eventIDs []
 	}
 	return nil
 }
+
+func (s *sqlStatusEventService) DeleteEventsCreatedBefore(ctx context.Context, eventIDs []string, before time.Time) *errors.ServiceError {
+	if err := s.statusEventDao.DeleteEventsCreatedBefore(ctx, eventIDs, before); err != nil {
+		return handleDeleteError("StatusEvent", errors.GeneralError("Unable to delete events %s: %s", eventIDs, err))
+	}
+	return nil
+}
+
+func (s *sqlStatusEventService) FindBySourceAfterSequence(ctx context.Context, source string, sequence int64) (api.StatusEventList, *errors.ServiceError) {
+	statusEvents, err := s.statusEventDao.FindBySourceAfterSequence(ctx, source, sequence)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find status events of source %s after sequence %d: %s", source, sequence, err)
+	}
+	return statusEvents, nil
+}
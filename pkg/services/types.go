@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/Masterminds/squirrel"
 )
 
 // ListArguments are arguments relevant for listing objects.
@@ -15,6 +17,18 @@ type ListArguments struct {
 	Search   string
 	OrderBy  []string
 	Fields   []string
+	// Continue is the opaque token returned by a previous list to continue it from its last object, the page is
+	// ignored if it is set. It is not supported with OrderBy, the objects are listed by their creation time and ID.
+	Continue string
+	// Scope restricts the listed objects in addition to the search, it is set by the services, e.g. to the
+	// resources of the sources of a tenant, and is never read from the query parameters.
+	Scope squirrel.Sqlizer
+	// Filter restricts the listed objects in addition to the search, it is compiled from a structured filter,
+	// e.g. by NewResourceFilterSqlizer, so it is parameterized.
+	Filter squirrel.Sqlizer
+	// Columns restricts the columns that are loaded for the listed objects, all of the columns are loaded if it is
+	// empty. It is set by the handlers from the fields of the list, so the unused columns are not read.
+	Columns []string
 }
 
 // ~65500 is the maximum number of parameters that can be provided to a postgres WHERE IN clause
@@ -34,6 +48,9 @@ func NewListArguments(params url.Values) *ListArguments {
 	if v := strings.Trim(params.Get("size"), " "); v != "" {
 		listArgs.Size, _ = strconv.ParseInt(v, 10, 0)
 	}
+	if v := strings.Trim(params.Get("continue"), " "); v != "" {
+		listArgs.Continue = v
+	}
 	if listArgs.Size > MAX_LIST_SIZE || listArgs.Size < 0 {
 		// MAX_LIST_SIZE is the maximum number of *parameters* that can be provided to a postgres WHERE IN clause
 		// Use it as a sane max
@@ -41,6 +41,89 @@ func ValidateConsumer(consumer *api.Consumer) error {
 	return fmt.Errorf("%s", errs.ToAggregate().Error())
 }
 
+func ValidatePlacement(placement *api.Placement) error {
+	errs := field.ErrorList{}
+	for _, msg := range apivalidation.ValidateNamespaceName(placement.Name, false) {
+		errs = append(errs, field.Invalid(field.NewPath("placement").Child("name"), placement.Name, msg))
+	}
+
+	if len(placement.ConsumerSelector) == 0 {
+		errs = append(errs, field.Required(field.NewPath("placement").Child("consumer_selector"), ""))
+	} else if _, err := placement.Selector(); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("placement").Child("consumer_selector"), placement.ConsumerSelector, err.Error()))
+	}
+
+	strategyPath := field.NewPath("placement").Child("rollout_strategy")
+	strategy, err := placement.Rollout()
+	switch {
+	case err != nil:
+		errs = append(errs, field.Invalid(strategyPath, placement.RolloutStrategy, err.Error()))
+	case strategy.Type == api.AllRolloutStrategyType:
+	case strategy.Type == api.ProgressiveRolloutStrategyType:
+		if strategy.BatchSize < 1 {
+			errs = append(errs, field.Invalid(strategyPath.Child("batch_size"), strategy.BatchSize,
+				"must be greater than 0 for the Progressive rollout strategy"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(strategyPath.Child("type"), strategy.Type,
+			[]string{string(api.AllRolloutStrategyType), string(api.ProgressiveRolloutStrategyType)}))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", errs.ToAggregate().Error())
+}
+
+func ValidateConsumerSet(consumerSet *api.ConsumerSet) error {
+	errs := field.ErrorList{}
+	for _, msg := range apivalidation.ValidateNamespaceName(consumerSet.Name, false) {
+		errs = append(errs, field.Invalid(field.NewPath("consumer_set").Child("name"), consumerSet.Name, msg))
+	}
+
+	membersPath := field.NewPath("consumer_set").Child("members")
+	selectorPath := field.NewPath("consumer_set").Child("consumer_selector")
+	switch {
+	case len(consumerSet.Members) == 0 && len(consumerSet.ConsumerSelector) == 0:
+		errs = append(errs, field.Required(membersPath, "either members or consumer_selector is required"))
+	case len(consumerSet.Members) > 0 && len(consumerSet.ConsumerSelector) > 0:
+		errs = append(errs, field.Forbidden(selectorPath, "members and consumer_selector cannot be set at the same time"))
+	case len(consumerSet.Members) > 0:
+		for i, member := range consumerSet.Members {
+			for _, msg := range apivalidation.ValidateNamespaceName(member, false) {
+				errs = append(errs, field.Invalid(membersPath.Index(i), member, msg))
+			}
+		}
+	default:
+		if _, err := consumerSet.Selector(); err != nil {
+			errs = append(errs, field.Invalid(selectorPath, consumerSet.ConsumerSelector, err.Error()))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", errs.ToAggregate().Error())
+}
+
+// ValidateResourcePayload validates the payload of a resource with the codec of its resource type, the manifests
+// of a manifest bundle are validated as well.
+func ValidateResourcePayload(resourceType api.ResourceType, payload datatypes.JSONMap) error {
+	codec, err := api.ResourceTypeCodecFor(resourceType)
+	if err != nil {
+		return err
+	}
+	if err := codec.Validate(payload); err != nil {
+		return err
+	}
+	if resourceType == "" || resourceType == api.ManifestBundleResourceType {
+		return ValidateManifestBundle(payload)
+	}
+	return nil
+}
+
 func ValidateManifestBundle(manifestBundle datatypes.JSONMap) error {
 	manifestBundleWrapper, err := api.DecodeManifestBundle(manifestBundle)
 	if err != nil {
@@ -49,6 +49,100 @@ func TestValidateConsumer(t *testing.T) {
 	}
 }
 
+func TestValidatePlacement(t *testing.T) {
+	cases := []struct {
+		name             string
+		placement        *api.Placement
+		expectedErrorMsg string
+	}{
+		{
+			name: "validated",
+			placement: &api.Placement{
+				Name:             "test",
+				ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "prod"}},
+			},
+		},
+		{
+			name: "no consumer selector",
+			placement: &api.Placement{
+				Name: "test",
+			},
+			expectedErrorMsg: "placement.consumer_selector: Required value",
+		},
+		{
+			name: "wrong name",
+			placement: &api.Placement{
+				Name:             "_",
+				ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "prod"}},
+			},
+			expectedErrorMsg: "placement.name: Invalid value: \"_\": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidatePlacement(c.placement)
+			if c.expectedErrorMsg == "" && err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+			if c.expectedErrorMsg != "" && (err == nil || err.Error() != c.expectedErrorMsg) {
+				t.Errorf("expected %#v but got: %#v", c.expectedErrorMsg, err)
+			}
+		})
+	}
+}
+
+func TestValidateConsumerSet(t *testing.T) {
+	cases := []struct {
+		name             string
+		consumerSet      *api.ConsumerSet
+		expectedErrorMsg string
+	}{
+		{
+			name:        "static members",
+			consumerSet: &api.ConsumerSet{Name: "test", Members: []string{"cluster1", "cluster2"}},
+		},
+		{
+			name: "consumer selector",
+			consumerSet: &api.ConsumerSet{
+				Name:             "test",
+				ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "prod"}},
+			},
+		},
+		{
+			name:             "no members nor consumer selector",
+			consumerSet:      &api.ConsumerSet{Name: "test"},
+			expectedErrorMsg: "consumer_set.members: Required value: either members or consumer_selector is required",
+		},
+		{
+			name: "both members and consumer selector",
+			consumerSet: &api.ConsumerSet{
+				Name:             "test",
+				Members:          []string{"cluster1"},
+				ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "prod"}},
+			},
+			expectedErrorMsg: "consumer_set.consumer_selector: Forbidden: members and consumer_selector cannot be set at the same time",
+		},
+		{
+			name:             "wrong member",
+			consumerSet:      &api.ConsumerSet{Name: "test", Members: []string{"cluster1", "_"}},
+			expectedErrorMsg: "consumer_set.members[1]: Invalid value: \"_\": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidateConsumerSet(c.consumerSet)
+			if c.expectedErrorMsg == "" && err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+			if c.expectedErrorMsg != "" && (err == nil || err.Error() != c.expectedErrorMsg) {
+				t.Errorf("expected %#v but got: %#v", c.expectedErrorMsg, err)
+			}
+		})
+	}
+}
+
 func TestValidateResourceName(t *testing.T) {
 	cases := []struct {
 		name             string
@@ -45,7 +45,7 @@ var _ = Describe("Consumers", Ordered, Label("e2e-tests-consumers"), func() {
 
 		AfterAll(func() {
 			// delete the consumer
-			resp, err := apiClient.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumerA.Id).Execute()
+			_, resp, err := apiClient.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumerA.Id).Execute()
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
 
@@ -54,7 +54,7 @@ var _ = Describe("Consumers", Ordered, Label("e2e-tests-consumers"), func() {
 			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
 
 			// delete the consumer
-			resp, err = apiClient.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumerB.Id).Execute()
+			_, resp, err = apiClient.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumerB.Id).Execute()
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
 
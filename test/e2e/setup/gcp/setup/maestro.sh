@@ -145,6 +145,9 @@ server:
   https:
     enabled: false
   hostname: ""
+  http:
+    authn:
+      type: mock
 
 service:
   api:
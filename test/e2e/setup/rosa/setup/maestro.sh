@@ -327,6 +327,9 @@ server:
   https:
     enabled: false
   hostname: ""
+  http:
+    authn:
+      type: mock
 
 service:
   api:
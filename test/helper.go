@@ -260,20 +260,43 @@ func (helper *Helper) StartControllerManager(ctx context.Context) {
 			helper.Env().Services.StatusEvents(),
 			dao.NewInstanceDao(&helper.Env().Database.SessionFactory),
 			dao.NewEventInstanceDao(&helper.Env().Database.SessionFactory),
+			helper.Env().Config.EventServer.StatusEventRetention,
+		),
+		PlacementController: controllers.NewPlacementController(
+			helper.Env().Services.Placements(),
+			helper.Env().Services.Resources(),
+			helper.Env().Services.Consumers(),
+			db.NewAdvisoryLockFactory(helper.Env().Database.SessionFactory),
+			helper.Env().Config.Placement.ProgressDeadline,
+		),
+		OperationController: controllers.NewOperationController(
+			helper.Env().Services.Operations(),
+			helper.Env().Services.Resources(),
+			helper.Env().Services.Consumers(),
+			helper.Env().Services.Events(),
+			db.NewAdvisoryLockFactory(helper.Env().Database.SessionFactory),
+			helper.Env().Config.Operation.Timeout,
+		),
+		ConsumerLivenessController: controllers.NewConsumerLivenessController(
+			helper.Env().Services.Consumers(),
+			helper.EventBroadcaster,
+			server.ConnectedConsumers(helper.EventServer),
+			helper.Env().Config.ConsumerLiveness.SilenceThreshold,
+			helper.Env().Config.ConsumerLiveness.WebhookURL,
 		),
 	}
 
 	helper.ControllerManager.KindControllerManager.Add(&controllers.ControllerConfig{
 		Source: "Resources",
 		Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
-			api.CreateEventType: {helper.EventServer.OnCreate},
-			api.UpdateEventType: {helper.EventServer.OnUpdate},
-			api.DeleteEventType: {helper.EventServer.OnDelete},
+			api.CreateEventType: {helper.EventServer.OnCreate, helper.ControllerManager.OperationController.OnDispatch},
+			api.UpdateEventType: {helper.EventServer.OnUpdate, helper.ControllerManager.OperationController.OnDispatch},
+			api.DeleteEventType: {helper.EventServer.OnDelete, helper.ControllerManager.OperationController.OnDispatch},
 		},
 	})
 	helper.ControllerManager.StatusController.Add(map[api.StatusEventType][]controllers.StatusHandlerFunc{
-		api.StatusUpdateEventType: {helper.EventServer.OnStatusUpdate},
-		api.StatusDeleteEventType: {helper.EventServer.OnStatusUpdate},
+		api.StatusUpdateEventType: {helper.EventServer.OnStatusUpdate, helper.ControllerManager.PlacementController.OnStatusUpdate, helper.ControllerManager.OperationController.OnStatusUpdate},
+		api.StatusDeleteEventType: {helper.EventServer.OnStatusUpdate, helper.ControllerManager.OperationController.OnStatusDelete},
 	})
 
 	// start controller manager
@@ -515,7 +538,11 @@ func (helper *Helper) CleanDB() error {
 	for _, table := range []string{
 		"events",
 		"status_events",
+		"resource_revisions",
 		"resources",
+		"resource_tombstones",
+		"placements",
+		"operations",
 		"consumers",
 		"server_instances",
 	} {
@@ -0,0 +1,101 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestConsumerSet(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	env := "env-" + rand.String(5)
+	prod1, err := h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": env})
+	Expect(err).NotTo(HaveOccurred())
+	prod2, err := h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": env})
+	Expect(err).NotTo(HaveOccurred())
+	dev, err := h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": "dev"})
+	Expect(err).NotTo(HaveOccurred())
+
+	for _, consumerName := range []string{prod1.Name, prod1.Name, prod2.Name, dev.Name} {
+		_, err := h.CreateResource(uuid.NewString(), consumerName, "nginx-"+rand.String(5), "default", 1)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// a consumer set that selects the consumers by their labels
+	selected, resp, err := client.DefaultAPI.ApiMaestroV1ConsumerSetsPost(ctx).ConsumerSet(openapi.ConsumerSet{
+		Name:             openapi.PtrString("set-" + rand.String(5)),
+		ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": env}},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(*selected.Kind).To(Equal("ConsumerSet"))
+	Expect(*selected.Status.Members).To(Equal(int32(2)))
+	Expect(*selected.Status.ResourceBundles).To(Equal(int32(3)))
+
+	// a consumer set with static members, the members that do not exist are not counted
+	static, resp, err := client.DefaultAPI.ApiMaestroV1ConsumerSetsPost(ctx).ConsumerSet(openapi.ConsumerSet{
+		Name:    openapi.PtrString("set-" + rand.String(5)),
+		Members: []string{prod2.Name, dev.Name, "cluster-" + rand.String(5)},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(*static.Status.Members).To(Equal(int32(2)))
+	Expect(*static.Status.ResourceBundles).To(Equal(int32(2)))
+
+	// either the members or the consumer selector is required
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumerSetsPost(ctx).ConsumerSet(openapi.ConsumerSet{
+		Name: openapi.PtrString("set-" + rand.String(5)),
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// the membership follows the consumer labels
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdPatch(ctx, dev.ID).ConsumerPatchRequest(openapi.ConsumerPatchRequest{
+		Labels: &map[string]string{"env": env},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+	found, resp, err := client.DefaultAPI.ApiMaestroV1ConsumerSetsIdGet(ctx, *selected.Id).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*found.Status.Members).To(Equal(int32(3)))
+	Expect(*found.Status.ResourceBundles).To(Equal(int32(4)))
+
+	// the static set is changed to a selector set
+	updated, resp, err := client.DefaultAPI.ApiMaestroV1ConsumerSetsIdPatch(ctx, *static.Id).ConsumerSetPatchRequest(openapi.ConsumerSetPatchRequest{
+		ConsumerSelector: map[string]interface{}{"matchLabels": map[string]interface{}{"env": "dev"}},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(updated.Members).To(BeEmpty())
+
+	list, resp, err := client.DefaultAPI.ApiMaestroV1ConsumerSetsGet(ctx).Search("name = '" + *selected.Name + "'").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(list.Items).To(HaveLen(1))
+	Expect(*list.Items[0].Status.ResourceBundles).To(Equal(int32(4)))
+
+	// deleting the consumer set does not affect its consumers and their resource bundles
+	resp, err = client.DefaultAPI.ApiMaestroV1ConsumerSetsIdDelete(ctx, *selected.Id).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumerSetsIdGet(ctx, *selected.Id).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdGet(ctx, prod1.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/test"
 )
 
@@ -140,6 +142,32 @@ func TestConsumerPatch(t *testing.T) {
 	Expect(restyResp.StatusCode()).To(Equal(http.StatusBadRequest))
 }
 
+func TestConsumerReplaceKeepsLiveness(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+	consumers := h.Env().Services.Consumers()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	// the consumer is read before its agent is seen and replaced after
+	found, svcErr := consumers.Get(ctx, consumer.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(consumers.MarkSeen(ctx, []string{consumer.Name}, time.Now())).To(BeNil())
+
+	found.Labels = &db.StringMap{"foo": "bar"}
+	_, svcErr = consumers.Replace(ctx, found)
+	Expect(svcErr).To(BeNil())
+
+	// the labels are replaced without overwriting the liveness of the consumer
+	replaced, svcErr := consumers.Get(ctx, consumer.ID)
+	Expect(svcErr).To(BeNil())
+	Expect(*replaced.Labels).To(Equal(db.StringMap{"foo": "bar"}))
+	Expect(replaced.Connected).To(BeTrue())
+	Expect(replaced.LastSeen).NotTo(BeNil())
+}
+
 func TestConsumerDelete(t *testing.T) {
 	_, client := test.RegisterIntegration(t)
 	ctx := context.Background()
@@ -163,7 +191,7 @@ func TestConsumerDelete(t *testing.T) {
 	Expect(*got.Name).To(Equal(*consumer.Name))
 
 	// 204 Deleted
-	resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumer.Id).Execute()
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumer.Id).Execute()
 	Expect(err).NotTo(HaveOccurred())
 	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
 
@@ -193,7 +221,7 @@ func TestConsumerDeleteForbidden(t *testing.T) {
 	Expect(res.ID).ShouldNot(BeEmpty())
 
 	// 403 forbid deletion
-	resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumer.Id).Execute()
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumer.Id).Execute()
 	Expect(err).To(HaveOccurred())
 	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
 
@@ -202,7 +230,7 @@ func TestConsumerDeleteForbidden(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 
 	// still forbid deletion for the deleting resource
-	resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumer.Id).Execute()
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, *consumer.Id).Execute()
 	Expect(err).To(HaveOccurred())
 	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
 }
@@ -256,7 +284,7 @@ func TestConsumerDeleting(t *testing.T) {
 		wg.Add(1)
 		go func(name, id string) {
 			defer wg.Done()
-			resp, err := client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, id).Execute()
+			_, resp, err := client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, id).Execute()
 			consumerChan <- &Result{
 				consumerName: name,
 				consumerId:   id,
@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/services"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestEventGC(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+	statusEventDao := dao.NewStatusEventDao(&h.Env().Database.SessionFactory)
+	eventInstanceDao := dao.NewEventInstanceDao(&h.Env().Database.SessionFactory)
+	instanceDao := dao.NewInstanceDao(&h.Env().Database.SessionFactory)
+	g2 := h.Env().Database.SessionFactory.New(ctx)
+
+	// a maestro instance that is ready and one that stops sending heartbeats since two hours ago
+	readyInstance, err := instanceDao.Create(ctx, &api.ServerInstance{
+		Meta: api.Meta{ID: "ready-" + rand.String(5)}, Ready: true, LastHeartbeat: time.Now()})
+	Expect(err).NotTo(HaveOccurred())
+	deadInstance, err := instanceDao.Create(ctx, &api.ServerInstance{
+		Meta: api.Meta{ID: "dead-" + rand.String(5)}, LastHeartbeat: time.Now().Add(-2 * time.Hour)})
+	Expect(err).NotTo(HaveOccurred())
+
+	// an expired status event and a recent status event that is handled by both instances
+	expired, err := statusEventDao.Create(ctx, &api.StatusEvent{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(g2.Model(&api.StatusEvent{}).Where("id = ?", expired.ID).
+		UpdateColumn("created_at", time.Now().Add(-48*time.Hour)).Error).NotTo(HaveOccurred())
+	recent, err := statusEventDao.Create(ctx, &api.StatusEvent{})
+	Expect(err).NotTo(HaveOccurred())
+	for _, instance := range []*api.ServerInstance{readyInstance, deadInstance} {
+		_, err := eventInstanceDao.Create(ctx, &api.EventInstance{EventID: recent.ID, InstanceID: instance.ID})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// an operation that is completed two days ago and an operation that is running for two days
+	operationDao := dao.NewOperationDao(&h.Env().Database.SessionFactory)
+	twoDaysAgo := time.Now().Add(-48 * time.Hour)
+	completedOperation, err := operationDao.Create(ctx, &api.Operation{Type: api.DeleteResourceBundlesOperationType,
+		Phase: api.OperationSucceeded, CompletedAt: &twoDaysAgo})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(operationDao.AddResources(ctx, completedOperation.ID, []string{rand.String(10)})).NotTo(HaveOccurred())
+	runningOperation, err := operationDao.Create(ctx, &api.Operation{Type: api.DeleteResourceBundlesOperationType})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(g2.Model(&api.Operation{}).Where("id = ?", runningOperation.ID).
+		UpdateColumn("created_at", twoDaysAgo).Error).NotTo(HaveOccurred())
+
+	// a resource of the third version with the revisions of all its versions
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, "nginx-"+rand.String(5), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+	revisionDao := dao.NewResourceRevisionDao(&h.Env().Database.SessionFactory)
+	for _, version := range []int32{2, 3} {
+		_, err := revisionDao.Create(ctx, &api.ResourceRevision{
+			ResourceID: resource.ID, Version: version, Payload: resource.Payload})
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(g2.Model(&api.Resource{}).Where("id = ?", resource.ID).
+		UpdateColumn("version", 3).Error).NotTo(HaveOccurred())
+
+	eventGC := services.NewEventGCService(dao.NewEventGCDao(&h.Env().Database.SessionFactory), api.EventGCPolicy{
+		StatusEvents:            api.EventRetention{MaxAge: 24 * time.Hour},
+		Operations:              api.EventRetention{MaxAge: 24 * time.Hour},
+		ResourceRevisions:       api.EventRetention{MaxCount: 2},
+		DeadInstanceGracePeriod: time.Hour,
+	})
+
+	// nothing is purged in a dry run
+	results, svcErr := eventGC.Run(ctx, true)
+	Expect(svcErr).To(BeNil())
+	Expect(results).To(HaveLen(7))
+	Expect(results[1].Table).To(Equal(api.StatusEventsTable))
+	Expect(results[1].Expired).To(BeNumerically(">=", 1))
+	Expect(results[3].Table).To(Equal(api.EventInstancesTable))
+	Expect(results[3].Orphaned).To(BeNumerically(">=", 1))
+	Expect(results[4].Table).To(Equal(api.OperationsTable))
+	Expect(results[4].Expired).To(BeNumerically(">=", 1))
+	Expect(results[6].Table).To(Equal(api.ResourceRevisionsTable))
+	Expect(results[6].Exceeded).To(BeNumerically(">=", 1))
+	_, err = statusEventDao.Get(ctx, expired.ID)
+	Expect(err).NotTo(HaveOccurred())
+
+	results, svcErr = eventGC.Run(ctx, false)
+	Expect(svcErr).To(BeNil())
+	Expect(results[1].Expired).To(BeNumerically(">=", 1))
+	Expect(results[3].Orphaned).To(BeNumerically(">=", 1))
+
+	// the expired status event and the event instance of the dead instance are purged
+	_, err = statusEventDao.Get(ctx, expired.ID)
+	Expect(err).To(HaveOccurred())
+	_, err = statusEventDao.Get(ctx, recent.ID)
+	Expect(err).NotTo(HaveOccurred())
+	_, err = eventInstanceDao.Get(ctx, recent.ID, readyInstance.ID)
+	Expect(err).NotTo(HaveOccurred())
+	_, err = eventInstanceDao.Get(ctx, recent.ID, deadInstance.ID)
+	Expect(err).To(HaveOccurred())
+
+	// the expired completed operation is purged with its resources, the running operation is kept
+	_, err = operationDao.Get(ctx, completedOperation.ID)
+	Expect(err).To(HaveOccurred())
+	operationResources, err := operationDao.FindResources(ctx, completedOperation.ID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(operationResources).To(BeEmpty())
+	_, err = operationDao.Get(ctx, runningOperation.ID)
+	Expect(err).NotTo(HaveOccurred())
+
+	// the oldest revision exceeding the max count of the resource is purged, the current revision is kept
+	revisions, err := revisionDao.FindByResourceID(ctx, resource.ID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(revisions).To(HaveLen(2))
+	Expect(revisions[0].Version).To(Equal(int32(3)))
+	Expect(revisions[1].Version).To(Equal(int32(2)))
+}
@@ -0,0 +1,195 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestConsumerCascadeDelete(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		// give one second to terminate the work agent
+		time.Sleep(1 * time.Second)
+	}()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	h.StartWorkAgent(ctx, consumer.Name)
+
+	for i := 0; i < 2; i++ {
+		_, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// 403 forbidden, the consumer has resource bundles
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, consumer.ID).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+
+	// 202 accepted, the resource bundles are deleted before the consumer
+	operation, resp, err := client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, consumer.ID).Cascade(true).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+	Expect(*operation.Kind).To(Equal("Operation"))
+	Expect(*operation.Type).To(Equal("DeleteConsumer"))
+	Expect(*operation.TargetId).To(Equal(consumer.ID))
+	Expect(*operation.Total).To(Equal(int32(2)))
+
+	Eventually(func() error {
+		found, _, err := client.DefaultAPI.ApiMaestroV1OperationsIdGet(ctx, *operation.Id).Execute()
+		if err != nil {
+			return err
+		}
+		if *found.Phase != "Succeeded" || *found.Completed != 2 {
+			return fmt.Errorf("operation is not succeeded, phase=%s, completed=%d", *found.Phase, *found.Completed)
+		}
+		return nil
+	}, 20*time.Second, 1*time.Second).Should(Succeed())
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdGet(ctx, consumer.ID).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+}
+
+func TestResourceBundleBulkDelete(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		// give one second to terminate the work agent
+		time.Sleep(1 * time.Second)
+	}()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	h.StartWorkAgent(ctx, consumer.Name)
+
+	for i := 0; i < 3; i++ {
+		_, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// 400 bad request, the search is required
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesDelete(ctx).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// 400 bad request, the search matches more resource bundles than a bulk deletion deletes
+	crowded, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	_, err = h.CreateResourceList(crowded.Name, h.Env().Config.Operation.MaxResources+1)
+	Expect(err).NotTo(HaveOccurred())
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesDelete(ctx).
+		Search(fmt.Sprintf("consumer_name = '%s'", crowded.Name)).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	search := fmt.Sprintf("consumer_name = '%s'", consumer.Name)
+	operation, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesDelete(ctx).Search(search).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+	Expect(*operation.Type).To(Equal("DeleteResourceBundles"))
+	Expect(*operation.Search).To(Equal(search))
+	Expect(*operation.Total).To(Equal(int32(3)))
+
+	Eventually(func() error {
+		found, _, err := client.DefaultAPI.ApiMaestroV1OperationsIdGet(ctx, *operation.Id).Execute()
+		if err != nil {
+			return err
+		}
+		if *found.Phase != "Succeeded" || *found.Completed != 3 {
+			return fmt.Errorf("operation is not succeeded, phase=%s, completed=%d", *found.Phase, *found.Completed)
+		}
+		return nil
+	}, 20*time.Second, 1*time.Second).Should(Succeed())
+
+	list, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Search(search).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(list.Items).To(BeEmpty())
+
+	// the consumer without resource bundles can be deleted
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, consumer.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+}
+
+func TestResourceBundleOperation(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		// give one second to terminate the work agent
+		time.Sleep(1 * time.Second)
+	}()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	h.StartWorkAgent(ctx, consumer.Name)
+
+	manifest := map[string]interface{}{}
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(deployName, "default", 1)), &manifest)).NotTo(HaveOccurred())
+
+	waitForOperation := func(id string) *openapi.Operation {
+		var operation *openapi.Operation
+		Eventually(func() error {
+			found, _, err := client.DefaultAPI.ApiMaestroV1OperationsIdGet(ctx, id).Execute()
+			if err != nil {
+				return err
+			}
+			if *found.Phase != "Succeeded" {
+				return fmt.Errorf("operation is not succeeded, phase=%s", *found.Phase)
+			}
+			operation = found
+			return nil
+		}, 20*time.Second, 1*time.Second).Should(Succeed())
+		return operation
+	}
+
+	// the creation is tracked until the agent applies the resource bundle
+	created, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		ConsumerName: openapi.PtrString(consumer.Name),
+		Manifests:    []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	operationID := resp.Header.Get(api.OperationIDHeader)
+	Expect(operationID).NotTo(BeEmpty())
+
+	operation := waitForOperation(operationID)
+	Expect(*operation.Type).To(Equal("CreateResourceBundle"))
+	Expect(*operation.TargetId).To(Equal(*created.Id))
+	Expect(*operation.EventId).NotTo(BeEmpty())
+	Expect(*operation.ResourceVersion).To(Equal(int32(1)))
+	Expect(operation.DispatchedAt).NotTo(BeNil())
+	Expect(*operation.Completed).To(Equal(int32(1)))
+
+	// the deletion is tracked until the agent removes the resource bundle
+	resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdDelete(ctx, *created.Id).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+	operationID = resp.Header.Get(api.OperationIDHeader)
+	Expect(operationID).NotTo(BeEmpty())
+
+	operation = waitForOperation(operationID)
+	Expect(*operation.Type).To(Equal("DeleteResourceBundle"))
+	Expect(*operation.TargetId).To(Equal(*created.Id))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, *created.Id).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+}
@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/google/uuid"
@@ -64,19 +65,82 @@ func TestListSyncWorks(t *testing.T) {
 	logger, err := logging.NewStdLoggerBuilder().Build()
 	Expect(err).ShouldNot(HaveOccurred())
 
-	search1 := grpcsource.ToSyncSearch("maestro-1", []string{consumer1.Name})
-	works, _, err := grpcsource.PageList(ctx, logger, client, search1, metav1.ListOptions{})
+	filter1 := grpcsource.ToSyncFilter("maestro-1", []string{consumer1.Name})
+	works, _, err := grpcsource.PageList(ctx, logger, client, filter1, metav1.ListOptions{})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(len(works.Items)).To(Equal(1))
 
-	search2 := grpcsource.ToSyncSearch("maestro-2", []string{consumer1.Name, consumer2.Name})
-	works, _, err = grpcsource.PageList(ctx, logger, client, search2, metav1.ListOptions{})
+	filter2 := grpcsource.ToSyncFilter("maestro-2", []string{consumer1.Name, consumer2.Name})
+	works, _, err = grpcsource.PageList(ctx, logger, client, filter2, metav1.ListOptions{})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(len(works.Items)).To(Equal(2))
 
 	// has a watcher that watches all namespaces
-	search3 := grpcsource.ToSyncSearch("maestro-2", []string{consumer1.Name, metav1.NamespaceAll})
-	works, _, err = grpcsource.PageList(ctx, logger, client, search3, metav1.ListOptions{})
+	filter3 := grpcsource.ToSyncFilter("maestro-2", []string{consumer1.Name, metav1.NamespaceAll})
+	works, _, err = grpcsource.PageList(ctx, logger, client, filter3, metav1.ListOptions{})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(len(works.Items)).To(Equal(3))
 }
+
+func TestPageListContinue(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	source := "maestro-" + rand.String(5)
+	resourceService := h.Env().Services.Resources()
+	createWork := func() string {
+		work, err := h.NewResource(uuid.NewString(), consumer.Name, "nginx-"+rand.String(5), "default", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		work.Source = source
+		_, svcErr := resourceService.Create(ctx, work)
+		Expect(svcErr).To(BeNil())
+		return work.ID
+	}
+	for i := 0; i < 5; i++ {
+		createWork()
+	}
+
+	logger, err := logging.NewStdLoggerBuilder().Build()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// list the works in chunks, a work created during the list is listed in the last chunk
+	filter := grpcsource.ToSyncFilter(source, []string{consumer.Name})
+	works, next, err := grpcsource.PageList(ctx, logger, client, filter, metav1.ListOptions{Limit: 2})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(works.Items).To(HaveLen(2))
+	Expect(next).NotTo(BeEmpty())
+	listed := []string{}
+	for _, work := range works.Items {
+		listed = append(listed, work.GetId())
+	}
+
+	created := createWork()
+	for next != "" {
+		works, next, err = grpcsource.PageList(ctx, logger, client, filter, metav1.ListOptions{Limit: 2, Continue: next})
+		Expect(err).NotTo(HaveOccurred())
+		for _, work := range works.Items {
+			listed = append(listed, work.GetId())
+		}
+	}
+	Expect(listed).To(HaveLen(6))
+	Expect(listed).To(ContainElement(created))
+	Expect(listed[5]).To(Equal(created))
+
+	// the total counts all the works, the continue token is not supported with orderBy
+	search := fmt.Sprintf("source = '%s'", source)
+	list, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Search(search).Size(2).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(list.Total).To(Equal(int32(6)))
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Search(search).Size(2).Continue_(list.GetContinue()).OrderBy("id").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Continue_("invalid").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
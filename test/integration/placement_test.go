@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestPlacementFanOut(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	env := "env-" + rand.String(5)
+	prod1, err := h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": env})
+	Expect(err).NotTo(HaveOccurred())
+	prod2, err := h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": env})
+	Expect(err).NotTo(HaveOccurred())
+	_, err = h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": "dev"})
+	Expect(err).NotTo(HaveOccurred())
+
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	manifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(deployName, "default", 1)), &manifest)).NotTo(HaveOccurred())
+
+	placement, resp, err := client.DefaultAPI.ApiMaestroV1PlacementsPost(ctx).Placement(openapi.Placement{
+		Name: openapi.PtrString("placement-" + rand.String(5)),
+		ConsumerSelector: map[string]interface{}{
+			"matchLabels": map[string]interface{}{"env": env},
+		},
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(*placement.Kind).To(Equal("Placement"))
+	Expect(*placement.Source).To(Equal("maestro"))
+	Expect(*placement.Version).To(Equal(int32(1)))
+
+	placementSearch := fmt.Sprintf("placement_id = '%s'", *placement.Id)
+
+	// a resource bundle is created for each matching consumer
+	Eventually(func() error {
+		list, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Search(placementSearch).Execute()
+		if err != nil {
+			return err
+		}
+		if len(list.Items) != 2 {
+			return fmt.Errorf("expected 2 resource bundles, got %d", len(list.Items))
+		}
+		for _, rb := range list.Items {
+			if *rb.ConsumerName != prod1.Name && *rb.ConsumerName != prod2.Name {
+				return fmt.Errorf("unexpected consumer %s", *rb.ConsumerName)
+			}
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	Eventually(func() error {
+		found, _, err := client.DefaultAPI.ApiMaestroV1PlacementsIdGet(ctx, *placement.Id).Execute()
+		if err != nil {
+			return err
+		}
+		if *found.Status.Desired != 2 || *found.Status.Current != 2 || *found.Status.ObservedVersion != 1 {
+			return fmt.Errorf("unexpected placement status %v", found.Status)
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	// the resource bundle is deleted once the consumer loses the matching label
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdPatch(ctx, prod2.ID).ConsumerPatchRequest(openapi.ConsumerPatchRequest{
+		Labels: &map[string]string{"env": "dev"},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+	Eventually(func() error {
+		list, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Search(placementSearch).Execute()
+		if err != nil {
+			return err
+		}
+		for _, rb := range list.Items {
+			if *rb.ConsumerName == prod2.Name && rb.DeletedAt == nil {
+				return fmt.Errorf("the resource bundle of consumer %s is not deleted", prod2.Name)
+			}
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	// 409 conflict, the version is not the latest
+	_, resp, err = client.DefaultAPI.ApiMaestroV1PlacementsIdPatch(ctx, *placement.Id).PlacementPatchRequest(openapi.PlacementPatchRequest{
+		Version:   openapi.PtrInt32(5),
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+	// 400 bad request, the consumer selector is invalid
+	_, resp, err = client.DefaultAPI.ApiMaestroV1PlacementsPost(ctx).Placement(openapi.Placement{
+		Name: openapi.PtrString("placement-" + rand.String(5)),
+		ConsumerSelector: map[string]interface{}{
+			"matchExpressions": []interface{}{map[string]interface{}{"key": "env", "operator": "Unknown"}},
+		},
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// the resource bundles are deleted with the placement
+	resp, err = client.DefaultAPI.ApiMaestroV1PlacementsIdDelete(ctx, *placement.Id).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1PlacementsIdGet(ctx, *placement.Id).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+}
@@ -0,0 +1,68 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestQuotaConcurrentCreates(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+	sessionFactory := &h.Env().Database.SessionFactory
+	resourceDao := dao.NewResourceDao(sessionFactory)
+	resourceService := services.NewResourceService(
+		db.NewAdvisoryLockFactory(*sessionFactory),
+		resourceDao,
+		dao.NewResourceRevisionDao(sessionFactory),
+		h.Env().Services.Events(),
+		h.Env().Services.Generic(),
+		services.NewQuotaService(resourceDao, api.ResourceQuota{MaxResourcesPerConsumer: 3}),
+	)
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	// the concurrent creations of the resources of a consumer do not exceed its quota
+	var wg sync.WaitGroup
+	svcErrs := make(chan *errors.ServiceError, 10)
+	for i := 0; i < 10; i++ {
+		resource, err := h.NewResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%d", i), "default", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, svcErr := resourceService.Create(ctx, resource)
+			svcErrs <- svcErr
+		}()
+	}
+	wg.Wait()
+	close(svcErrs)
+
+	created := 0
+	for svcErr := range svcErrs {
+		if svcErr == nil {
+			created++
+			continue
+		}
+		Expect(svcErr.IsQuotaExceeded()).To(BeTrue())
+	}
+	Expect(created).To(Equal(3))
+
+	count, err := resourceDao.CountByConsumerName(ctx, consumer.Name)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(count).To(Equal(int64(3)))
+}
@@ -0,0 +1,87 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	workv1 "open-cluster-management.io/api/work/v1"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestResourceDrift(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, "nginx-"+rand.String(5), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	node, err := snowflake.NewNode(1)
+	Expect(err).NotTo(HaveOccurred())
+	newStatus := func(sequenceID string, report bool, condition metav1.Condition) map[string]interface{} {
+		evt := cloudevents.NewEvent()
+		evt.SetID(uuid.NewString())
+		evt.SetSource(consumer.Name + "-work-agent")
+		evt.SetType("io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request")
+		evt.SetExtension(cetypes.ExtensionResourceVersion, resource.Version)
+		evt.SetExtension(cetypes.ExtensionStatusUpdateSequenceID, sequenceID)
+		if report {
+			evt.SetExtension(api.ExtensionDriftReport, true)
+		}
+		Expect(evt.SetData(cloudevents.ApplicationJSON, &workpayload.ManifestBundleStatus{
+			Conditions: []metav1.Condition{condition},
+		})).To(Succeed())
+		status, err := api.CloudEventToJSONMap(&evt)
+		Expect(err).NotTo(HaveOccurred())
+		return status
+	}
+
+	_, _, svcErr := h.Env().Services.Resources().UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: resource.ID},
+		Version: resource.Version, Status: newStatus(node.Generate().String(), false, metav1.Condition{
+			Type: workv1.WorkApplied, Status: metav1.ConditionTrue, Reason: "AppliedManifestWorkComplete"})})
+	Expect(svcErr).To(BeNil())
+
+	// the agent reports that the live objects are edited
+	_, _, svcErr = h.Env().Services.Resources().UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: resource.ID},
+		Version: resource.Version, Status: newStatus("", true, metav1.Condition{
+			Type: api.DriftedCondition, Status: metav1.ConditionTrue, Reason: api.DriftDetectedReason,
+			LastTransitionTime: metav1.NewTime(time.Now())})})
+	Expect(svcErr).To(BeNil())
+
+	// the drifted resource bundles are queryable and their status has both the Applied and Drifted conditions
+	list, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Search("drifted_since is not null").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(list.Items).To(HaveLen(1))
+	Expect(*list.Items[0].Id).To(Equal(resource.ID))
+	Expect(list.Items[0].Status["conditions"]).To(ContainElements(
+		HaveKeyWithValue("type", workv1.WorkApplied),
+		HaveKeyWithValue("type", api.DriftedCondition),
+	))
+
+	// the drift is cleared once the live objects match the manifests again
+	_, _, svcErr = h.Env().Services.Resources().UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: resource.ID},
+		Version: resource.Version, Status: newStatus("", true, metav1.Condition{
+			Type: api.DriftedCondition, Status: metav1.ConditionFalse, Reason: api.NoDriftReason,
+			LastTransitionTime: metav1.NewTime(time.Now())})})
+	Expect(svcErr).To(BeNil())
+	list, _, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Search("drifted_since is not null").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(list.Items).To(BeEmpty())
+}
@@ -0,0 +1,167 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/bwmarrin/snowflake"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"github.com/openshift-online/ocm-sdk-go/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	workv1 "open-cluster-management.io/api/work/v1"
+	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/client/cloudevents/grpcsource"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestResourceListFilter(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	resourceService := h.Env().Services.Resources()
+	createResource := func(labels map[string]interface{}) *api.Resource {
+		resource, err := h.NewResource(uuid.NewString(), consumer.Name, "nginx-"+rand.String(5), "default", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		if labels != nil {
+			resource.Payload["metadata"] = map[string]interface{}{"labels": labels}
+		}
+		created, svcErr := resourceService.Create(ctx, resource)
+		Expect(svcErr).To(BeNil())
+		return created
+	}
+	nginx := createResource(map[string]interface{}{"app": "nginx"})
+	web := createResource(map[string]interface{}{"app": "web"})
+	unlabeled := createResource(nil)
+
+	// the nginx resource bundle is applied
+	node, err := snowflake.NewNode(1)
+	Expect(err).NotTo(HaveOccurred())
+	evt := cloudevents.NewEvent()
+	evt.SetID(uuid.NewString())
+	evt.SetSource(consumer.Name + "-work-agent")
+	evt.SetType("io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request")
+	evt.SetExtension(cetypes.ExtensionResourceVersion, nginx.Version)
+	evt.SetExtension(cetypes.ExtensionStatusUpdateSequenceID, node.Generate().String())
+	Expect(evt.SetData(cloudevents.ApplicationJSON, &workpayload.ManifestBundleStatus{
+		Conditions: []metav1.Condition{{Type: workv1.WorkApplied, Status: metav1.ConditionTrue, Reason: "Applied"}},
+	})).To(Succeed())
+	status, err := api.CloudEventToJSONMap(&evt)
+	Expect(err).NotTo(HaveOccurred())
+	_, _, svcErr := resourceService.UpdateStatus(ctx, &api.Resource{Meta: api.Meta{ID: nginx.ID},
+		Version: nginx.Version, Status: status})
+	Expect(svcErr).To(BeNil())
+
+	list := func(filter openapi.ResourceBundleFilter) []string {
+		filter.ConsumerNames = []string{consumer.Name}
+		data, err := json.Marshal(filter)
+		Expect(err).NotTo(HaveOccurred())
+		list, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Filter(string(data)).Execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		ids := []string{}
+		for _, item := range list.Items {
+			ids = append(ids, item.GetId())
+		}
+		return ids
+	}
+
+	Expect(list(openapi.ResourceBundleFilter{})).To(ConsistOf(nginx.ID, web.ID, unlabeled.ID))
+	Expect(list(openapi.ResourceBundleFilter{Labels: []openapi.LabelSelectorRequirement{
+		{Key: "app", Operator: "In", Values: []string{"nginx"}},
+	}})).To(ConsistOf(nginx.ID))
+	Expect(list(openapi.ResourceBundleFilter{Labels: []openapi.LabelSelectorRequirement{
+		{Key: "app", Operator: "NotIn", Values: []string{"nginx"}},
+	}})).To(ConsistOf(web.ID, unlabeled.ID))
+	Expect(list(openapi.ResourceBundleFilter{Labels: []openapi.LabelSelectorRequirement{
+		{Key: "app", Operator: "DoesNotExist"},
+	}})).To(ConsistOf(unlabeled.ID))
+	Expect(list(openapi.ResourceBundleFilter{Conditions: []openapi.ConditionRequirement{
+		{Type: workv1.WorkApplied, Status: string(metav1.ConditionTrue)},
+	}})).To(ConsistOf(nginx.ID))
+
+	// the values of a filter are parameters of the query, they are never interpreted as SQL
+	Expect(list(openapi.ResourceBundleFilter{Labels: []openapi.LabelSelectorRequirement{
+		{Key: "app", Operator: "In", Values: []string{"nginx' or '1'='1"}},
+	}})).To(BeEmpty())
+	Expect(list(openapi.ResourceBundleFilter{Labels: []openapi.LabelSelectorRequirement{
+		{Key: "app' or 1=1 --", Operator: "Exists"},
+	}})).To(BeEmpty())
+
+	// an invalid filter is rejected
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Filter("{").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Filter(`{"labels":[{"key":"app","operator":"In"}]}`).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
+
+func TestResourceListFieldsAndFieldSelector(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resources, err := h.CreateResourceList(consumer.Name, 3)
+	Expect(err).NotTo(HaveOccurred())
+
+	// the fields are projected from the columns they are presented from, the payload is not loaded
+	fieldSelector := fmt.Sprintf("consumer_name=%s,metadata.name!=%s", consumer.Name, resources[0].Name)
+	list, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		FieldSelector(fieldSelector).Fields("name,status.conditions").Size(1).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(list.Total).To(Equal(int32(2)))
+	Expect(list.Items).To(HaveLen(1))
+	Expect(list.GetContinue()).NotTo(BeEmpty())
+	Expect(list.Items[0].GetId()).To(BeElementOf(resources[1].ID, resources[2].ID))
+	Expect(list.Items[0].GetName()).NotTo(BeEmpty())
+	Expect(list.Items[0].ConsumerName).To(BeNil())
+	Expect(list.Items[0].Manifests).To(BeEmpty())
+
+	rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resources[0].ID).
+		Fields("consumer_name,manifests.kind").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(rb.GetId()).To(Equal(resources[0].ID))
+	Expect(rb.GetConsumerName()).To(Equal(consumer.Name))
+	Expect(rb.Name).To(BeNil())
+	Expect(rb.Manifests).To(Equal([]map[string]interface{}{{"kind": "Deployment"}}))
+
+	// the gRPC source client selects the works with the field selector of the list options
+	logger, err := logging.NewStdLoggerBuilder().Build()
+	Expect(err).ShouldNot(HaveOccurred())
+	works, _, err := grpcsource.PageList(ctx, logger, client, grpcsource.ToSyncFilter("maestro", []string{consumer.Name}),
+		metav1.ListOptions{FieldSelector: "metadata.name=" + resources[2].Name})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(works.Items).To(HaveLen(1))
+	Expect(works.Items[0].GetId()).To(Equal(resources[2].ID))
+
+	// the unknown fields and the unsupported field selectors are rejected
+	// the raw SQL of a search on JSONB fields is refused
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Search("payload->'metadata'->'labels'->>'app' = 'nginx'").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Fields("unknown").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).FieldSelector("status=applied").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
@@ -0,0 +1,56 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestResourceBundleSummary(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	for i := 0; i < 2; i++ {
+		_, err := h.CreateResource(uuid.NewString(), consumer.Name, "nginx-"+rand.String(5), "default", 1)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// the resource bundles have no status until the agent reports it
+	summary, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesSummaryGet(ctx).GroupBy("consumer").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*summary.Kind).To(Equal("ResourceBundleSummary"))
+	Expect(summary.Items).To(ContainElement(openapi.ResourceBundleSummaryItem{
+		Key:         openapi.PtrString(consumer.Name),
+		Total:       openapi.PtrInt64(2),
+		Applied:     openapi.PtrInt64(0),
+		Available:   openapi.PtrInt64(0),
+		Degraded:    openapi.PtrInt64(0),
+		Deleting:    openapi.PtrInt64(0),
+		NotReported: openapi.PtrInt64(2),
+	}))
+	Expect(*summary.Total.Total).To(BeNumerically(">=", 2))
+
+	// the resource bundles without the label are grouped under an empty key
+	summary, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesSummaryGet(ctx).GroupBy("label").Label("label-" + rand.String(5)).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(summary.Items).To(HaveLen(1))
+	Expect(*summary.Items[0].Key).To(BeEmpty())
+	Expect(*summary.Items[0].Total).To(Equal(*summary.Total.Total))
+
+	// the label is required to group by label
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesSummaryGet(ctx).GroupBy("label").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
@@ -1,10 +1,13 @@
 package integration
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -28,6 +31,7 @@ import (
 
 	"github.com/openshift-online/maestro/cmd/maestro/server"
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/services"
@@ -79,6 +83,295 @@ func TestResourceBundleGet(t *testing.T) {
 	// }
 }
 
+func TestResourceBundlePost(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	manifest := map[string]interface{}{}
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(deployName, "default", 1)), &manifest)).NotTo(HaveOccurred())
+
+	rb := openapi.ResourceBundle{
+		Name:         openapi.PtrString(deployName),
+		ConsumerName: openapi.PtrString(consumer.Name),
+		Metadata:     map[string]interface{}{"name": deployName},
+		Manifests:    []map[string]interface{}{manifest},
+		DeleteOption: map[string]interface{}{"propagationPolicy": "Orphan"},
+	}
+
+	// 201 Created
+	created, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(rb).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error posting object:  %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(*created.Id).NotTo(BeEmpty(), "Expected ID assigned on creation")
+	Expect(*created.Kind).To(Equal("ResourceBundle"))
+	Expect(*created.Href).To(Equal(fmt.Sprintf("/api/maestro/v1/resource-bundles/%s", *created.Id)))
+	Expect(*created.Name).To(Equal(deployName))
+	Expect(*created.ConsumerName).To(Equal(consumer.Name))
+	Expect(*created.Source).To(Equal("maestro"))
+	Expect(*created.Version).To(Equal(int32(1)))
+	Expect(created.Manifests).To(HaveLen(1))
+	Expect(created.DeleteOption).To(Equal(rb.DeleteOption))
+
+	resource, svcErr := h.Env().Services.Resources().Get(ctx, *created.Id)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(resource.ConsumerName).To(Equal(consumer.Name))
+
+	// 400 bad request, the manifest is invalid
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		ConsumerName: openapi.PtrString(consumer.Name),
+		Manifests:    []map[string]interface{}{{"kind": "ConfigMap"}},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// 400 bad request, the consumer name is required
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// 409 conflict, the name is already used
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(rb).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+}
+
+func TestResourceBundlePatch(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, deployName, "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	manifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(deployName, "default", 2)), &manifest)).NotTo(HaveOccurred())
+
+	// 400 bad request, the version is required
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// 404 not found
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, "foo").ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   openapi.PtrInt32(1),
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	// 200 updated, only the manifests are changed
+	patched, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   openapi.PtrInt32(resource.Version),
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching object:  %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*patched.Id).To(Equal(resource.ID))
+	Expect(*patched.Version).To(Equal(resource.Version + 1))
+	Expect(patched.Manifests).To(HaveLen(1))
+	Expect(patched.Manifests[0]["spec"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(2))
+	Expect(patched.ManifestConfigs).To(HaveLen(1))
+	Expect(patched.DeleteOption).To(Equal(map[string]interface{}{"propagationPolicy": "Foreground"}))
+
+	// 409 conflict, the version is not the latest
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:      openapi.PtrInt32(resource.Version),
+		DeleteOption: map[string]interface{}{"propagationPolicy": "Orphan"},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+	// 400 bad request, the manifest is invalid
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   patched.Version,
+		Manifests: []map[string]interface{}{{"kind": "ConfigMap"}},
+	}).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// 200 updated, the metadata is set
+	patched, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:  patched.Version,
+		Metadata: map[string]interface{}{"name": deployName},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*patched.Version).To(Equal(resource.Version + 2))
+
+	// 200 not changed, the timestamps synced to the metadata do not change the version
+	unchanged, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   patched.Version,
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*unchanged.Version).To(Equal(*patched.Version))
+}
+
+func TestResourceBundleDryRun(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, deployName, "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	manifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(deployName, "default", 2)), &manifest)).NotTo(HaveOccurred())
+
+	decodeDiff := func(resp *http.Response) *openapi.ResourceBundleDiff {
+		diff := &openapi.ResourceBundleDiff{}
+		Expect(json.NewDecoder(resp.Body).Decode(diff)).NotTo(HaveOccurred())
+		return diff
+	}
+
+	// 200 dry-run create, the resource bundle is not created
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		ConsumerName: openapi.PtrString(consumer.Name),
+		Manifests:    []map[string]interface{}{manifest},
+	}).DryRun(true).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	diff := decodeDiff(resp)
+	Expect(*diff.Changed).To(BeTrue())
+	Expect(diff.Manifests).To(HaveLen(1))
+	Expect(*diff.Manifests[0].Change).To(Equal(string(api.ManifestAdded)))
+
+	list, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
+		Search(fmt.Sprintf("consumer_name = '%s'", consumer.Name)).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(list.Items).To(HaveLen(1))
+
+	// 200 dry-run patch, the modified manifest is reported and the resource bundle is not updated
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   openapi.PtrInt32(resource.Version),
+		Manifests: []map[string]interface{}{manifest},
+	}).DryRun(true).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	diff = decodeDiff(resp)
+	Expect(*diff.ResourceId).To(Equal(resource.ID))
+	Expect(*diff.Version).To(Equal(resource.Version))
+	Expect(diff.Manifests).To(HaveLen(1))
+	Expect(*diff.Manifests[0].Change).To(Equal(string(api.ManifestModified)))
+	Expect(diff.Manifests[0].Patch["spec"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(2))
+
+	found, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*found.Version).To(Equal(resource.Version))
+
+	// 409 conflict, the dry-run runs the same checks as the update
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   openapi.PtrInt32(resource.Version + 1),
+		Manifests: []map[string]interface{}{manifest},
+	}).DryRun(true).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+	// 400 bad request, the manifest is invalid
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   openapi.PtrInt32(resource.Version),
+		Manifests: []map[string]interface{}{{"kind": "ConfigMap"}},
+	}).DryRun(true).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
+
+func TestResourceBundleApply(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	newManifest := func(name string, replicas int) map[string]interface{} {
+		manifest := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(h.NewManifestJSON(name, "default", replicas)), &manifest)).NotTo(HaveOccurred())
+		return manifest
+	}
+	nameA, nameB := fmt.Sprintf("nginx-a-%s", rand.String(5)), fmt.Sprintf("nginx-b-%s", rand.String(5))
+	keyA, keyB := "apps/v1/Deployment/default/"+nameA, "apps/v1/Deployment/default/"+nameB
+
+	// 201 created, the manifests are owned by controller a
+	created, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		ConsumerName: openapi.PtrString(consumer.Name),
+		Manifests:    []map[string]interface{}{newManifest(nameA, 1)},
+	}).FieldManager("controller-a").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(created.GetManifestManagers()).To(Equal(map[string]string{keyA: "controller-a"}))
+
+	// 200 applied without a version, the manifests of controller a are kept
+	applied, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, *created.Id).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Manifests: []map[string]interface{}{newManifest(nameB, 1)},
+	}).FieldManager("controller-b").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*applied.Version).To(Equal(*created.Version + 1))
+	Expect(applied.Manifests).To(HaveLen(2))
+	Expect(applied.GetManifestManagers()).To(Equal(map[string]string{keyA: "controller-a", keyB: "controller-b"}))
+
+	// 409 conflict, controller a changes the manifest of controller b
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, *created.Id).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Manifests: []map[string]interface{}{newManifest(nameA, 1), newManifest(nameB, 2)},
+	}).FieldManager("controller-a").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+	// 200 dry-run apply with force, the resource bundle is not updated
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, *created.Id).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Manifests: []map[string]interface{}{newManifest(nameA, 1), newManifest(nameB, 2)},
+	}).FieldManager("controller-a").Force(true).DryRun(true).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	diff := &openapi.ResourceBundleDiff{}
+	Expect(json.NewDecoder(resp.Body).Decode(diff)).NotTo(HaveOccurred())
+	Expect(*diff.Changed).To(BeTrue())
+
+	// 200 applied with force, controller a takes the ownership of the manifest of controller b
+	applied, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, *created.Id).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Manifests: []map[string]interface{}{newManifest(nameA, 1), newManifest(nameB, 2)},
+	}).FieldManager("controller-a").Force(true).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*applied.Version).To(Equal(*created.Version + 2))
+	Expect(applied.GetManifestManagers()).To(Equal(map[string]string{keyA: "controller-a", keyB: "controller-a"}))
+
+	// 200 applied, controller a removes the manifests it no longer applies
+	applied, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, *created.Id).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   applied.Version,
+		Manifests: []map[string]interface{}{newManifest(nameA, 1)},
+	}).FieldManager("controller-a").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(applied.Manifests).To(HaveLen(1))
+	Expect(applied.GetManifestManagers()).To(Equal(map[string]string{keyA: "controller-a"}))
+
+	// 400 bad request, force requires a field manager
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, *created.Id).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   applied.Version,
+		Manifests: []map[string]interface{}{newManifest(nameA, 1)},
+	}).Force(true).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
+
 func TestResourcePaging(t *testing.T) {
 	h, client := test.RegisterIntegration(t)
 
@@ -847,3 +1140,308 @@ func TestStatusEventProcessingLatencyMetric(t *testing.T) {
 		return fmt.Errorf("metric %s not found with correct labels (id=%s, consumer=%s, source=%s)", metricName, resource.ID, consumer.Name, resource.Source)
 	}, 5*time.Second, 100*time.Millisecond).Should(Succeed())
 }
+
+func TestResourceBundleRevisionsAndRollback(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, deployName, "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	manifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(deployName, "default", 2)), &manifest)).NotTo(HaveOccurred())
+
+	updated, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+		Version:   openapi.PtrInt32(resource.Version),
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*updated.Version).To(Equal(int32(2)))
+
+	// a revision is recorded for every version, the newest first
+	revisions, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(revisions.Kind).To(Equal("ResourceBundleRevisionList"))
+	Expect(revisions.Items).To(HaveLen(2))
+	Expect(*revisions.Items[0].Version).To(Equal(int32(2)))
+	Expect(*revisions.Items[1].Version).To(Equal(int32(1)))
+
+	revision, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsVersionGet(ctx, resource.ID, 1).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*revision.ResourceId).To(Equal(resource.ID))
+	Expect(*revision.Href).To(Equal(fmt.Sprintf("/api/maestro/v1/resource-bundles/%s/revisions/1", resource.ID)))
+	Expect(revision.Manifests).To(HaveLen(1))
+	Expect(revision.Manifests[0]["spec"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(1))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsVersionGet(ctx, resource.ID, 5).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	// rollback re-applies the first revision as a new version
+	rolledBack, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, resource.ID).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(1)).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rolledBack.Version).To(Equal(int32(3)))
+	Expect(rolledBack.Manifests[0]["spec"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(1))
+
+	revisions, _, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(revisions.Items).To(HaveLen(3))
+
+	// 404 not found, no revision with the version
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, resource.ID).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(10)).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	// 400 bad request, invalid version
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, resource.ID).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(0)).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+}
+
+// watchEvent is a Server-Sent Event of a resource bundle watch.
+type watchEvent struct {
+	id        string
+	eventType string
+	bundle    openapi.ResourceBundle
+}
+
+// startWatch starts a watch of the resource bundles with the given query, and returns the resume token
+// that is sent when the watch starts and the channel of the watch events.
+func startWatch(ctx context.Context, h *test.Helper, query url.Values) (string, <-chan watchEvent) {
+	query.Set("watch", "true")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.RestURL("/resource-bundles?"+query.Encode()), nil)
+	Expect(err).NotTo(HaveOccurred())
+	resp, err := http.DefaultClient.Do(req)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+
+	// the first event only has the resume token
+	Expect(scanner.Scan()).To(BeTrue())
+	Expect(scanner.Text()).To(HavePrefix("id: "))
+	token := strings.TrimPrefix(scanner.Text(), "id: ")
+
+	events := make(chan watchEvent, 10)
+	go func() {
+		defer resp.Body.Close()
+		defer close(events)
+		evt := watchEvent{}
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				evt.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				evt.eventType = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &evt.bundle); err != nil {
+					return
+				}
+			case line == "" && evt.eventType != "":
+				events <- evt
+				evt = watchEvent{}
+			}
+		}
+	}()
+	return token, events
+}
+
+func TestResourceBundleWatch(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		// give one second to terminate the work agent
+		time.Sleep(1 * time.Second)
+	}()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	h.StartWorkAgent(ctx, consumer.Name)
+
+	search := fmt.Sprintf("consumer_name = '%s'", consumer.Name)
+	token, events := startWatch(ctx, h, url.Values{"search": []string{search}})
+
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, deployName, "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	// the status update of the resource bundle is streamed
+	var modified watchEvent
+	Eventually(events, 20*time.Second).Should(Receive(&modified))
+	Expect(modified.eventType).To(Equal("MODIFIED"))
+	Expect(*modified.bundle.Id).To(Equal(resource.ID))
+	Expect(*modified.bundle.ConsumerName).To(Equal(consumer.Name))
+	Expect(modified.bundle.Status).NotTo(BeEmpty())
+	Expect(modified.id > token).To(BeTrue())
+
+	// the watch that resumes from the first token replays the resource bundle
+	_, resumed := startWatch(ctx, h, url.Values{"search": []string{search}, "resumeToken": []string{token}})
+	var replayed watchEvent
+	Eventually(resumed, 5*time.Second).Should(Receive(&replayed))
+	Expect(replayed.eventType).To(Equal("MODIFIED"))
+	Expect(*replayed.bundle.Id).To(Equal(resource.ID))
+
+	// the resource bundles of other consumers are not streamed, and the deletion of the resource bundle is
+	otherConsumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	h.StartWorkAgent(ctx, otherConsumer.Name)
+	other, err := h.CreateResource(uuid.NewString(), otherConsumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(h.DeleteResource(resource.ID)).NotTo(HaveOccurred())
+
+	Eventually(func() error {
+		select {
+		case evt := <-events:
+			if *evt.bundle.Id == other.ID {
+				return StopTrying(fmt.Sprintf("the resource bundle %s of another consumer is streamed", other.ID))
+			}
+			if evt.eventType != "DELETED" {
+				return fmt.Errorf("the resource bundle %s is not deleted", resource.ID)
+			}
+			Expect(*evt.bundle.Id).To(Equal(resource.ID))
+			return nil
+		default:
+			return fmt.Errorf("no watch event is received")
+		}
+	}, 20*time.Second, 100*time.Millisecond).Should(Succeed())
+
+	// 400 bad request, the resume token or the search is invalid
+	for _, query := range []string{"watch=true&resumeToken=foo", "watch=true&search=" + url.QueryEscape("payload @> '{}'")} {
+		resp, err := http.Get(h.RestURL("/resource-bundles?" + query))
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	}
+}
+
+func TestResourceBundleWatchReplayPages(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	search := fmt.Sprintf("consumer_name = '%s'", consumer.Name)
+	token, _ := startWatch(ctx, h, url.Values{"search": []string{search}})
+
+	// more resource bundles are changed than a page of the replay
+	created := map[string]bool{}
+	for i := 0; i < 501; i++ {
+		resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%d", i), "default", 1)
+		Expect(err).NotTo(HaveOccurred())
+		created[resource.ID] = true
+	}
+
+	// the watch that resumes from the token replays all of them
+	_, resumed := startWatch(ctx, h, url.Values{"search": []string{search}, "resumeToken": []string{token}})
+	replayed := map[string]bool{}
+	Eventually(func() int {
+		for {
+			select {
+			case evt := <-resumed:
+				replayed[*evt.bundle.Id] = true
+			default:
+				return len(replayed)
+			}
+		}
+	}, 20*time.Second, 100*time.Millisecond).Should(Equal(len(created)))
+	Expect(replayed).To(Equal(created))
+}
+
+func TestResourceBundleWatchFromResourceVersion(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resources, err := h.CreateResourceList(consumer.Name, 2)
+	Expect(err).NotTo(HaveOccurred())
+
+	search := fmt.Sprintf("consumer_name = '%s'", consumer.Name)
+	list, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).Search(search).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(list.Items).To(HaveLen(2))
+	listVersion, err := strconv.ParseInt(list.GetResourceVersion(), 10, 64)
+	Expect(err).NotTo(HaveOccurred())
+	for _, item := range list.Items {
+		itemVersion, err := strconv.ParseInt(item.GetResourceVersion(), 10, 64)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(itemVersion).To(BeNumerically("<=", listVersion))
+	}
+
+	// the changes after the list are replayed by the watch from its resource version, then a bookmark is sent
+	created, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(h.Env().Services.Resources().Delete(ctx, resources[0].ID)).To(BeNil())
+
+	_, events := startWatch(ctx, h, url.Values{
+		"search":          []string{search},
+		"resourceVersion": []string{list.GetResourceVersion()},
+	})
+	received := []watchEvent{}
+	for i := 0; i < 3; i++ {
+		var evt watchEvent
+		Eventually(events, 5*time.Second).Should(Receive(&evt))
+		received = append(received, evt)
+	}
+	Expect(received[0].eventType).To(Equal("MODIFIED"))
+	Expect(received[0].bundle.GetId()).To(Equal(created.ID))
+	Expect(received[1].eventType).To(Equal("DELETED"))
+	Expect(received[1].bundle.GetId()).To(Equal(resources[0].ID))
+	Expect(received[2].eventType).To(Equal("BOOKMARK"))
+	Expect(received[2].bundle.GetResourceVersion()).NotTo(Equal(list.GetResourceVersion()))
+
+	// the watch from the resource version of the bookmark has no changes to replay
+	_, events = startWatch(ctx, h, url.Values{
+		"search":          []string{search},
+		"resourceVersion": []string{received[2].bundle.GetResourceVersion()},
+	})
+	var bookmark watchEvent
+	Eventually(events, 5*time.Second).Should(Receive(&bookmark))
+	Expect(bookmark.eventType).To(Equal("BOOKMARK"))
+	Expect(bookmark.bundle.GetResourceVersion()).To(Equal(received[2].bundle.GetResourceVersion()))
+
+	// the changes of the bookkeeping columns are not assigned a resource version
+	resourceVersion := func(id string) int64 {
+		var version int64
+		Expect(h.DBFactory.DirectDB().QueryRow("SELECT resource_version FROM resources WHERE id = $1", id).
+			Scan(&version)).NotTo(HaveOccurred())
+		return version
+	}
+	before := resourceVersion(created.ID)
+	_, err = h.DBFactory.DirectDB().Exec("UPDATE resources SET stale_since = now() WHERE id = $1", created.ID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resourceVersion(created.ID)).To(Equal(before))
+
+	// 410 gone, the changes after the resource version are compacted
+	_, err = h.DBFactory.DirectDB().Exec("UPDATE resource_compactions SET resource_version = $1",
+		received[2].bundle.GetResourceVersion())
+	Expect(err).NotTo(HaveOccurred())
+	defer func() {
+		_, err := h.DBFactory.DirectDB().Exec("UPDATE resource_compactions SET resource_version = 0")
+		Expect(err).NotTo(HaveOccurred())
+	}()
+	query := url.Values{"watch": []string{"true"}, "resourceVersion": []string{list.GetResourceVersion()}}
+	resp, err = http.Get(h.RestURL("/resource-bundles?" + query.Encode()))
+	Expect(err).NotTo(HaveOccurred())
+	resp.Body.Close()
+	Expect(resp.StatusCode).To(Equal(http.StatusGone))
+}
@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestStaleStatus(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	deployName := "nginx-" + rand.String(5)
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, deployName, "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	// the status of the resource is not reported since an hour ago
+	g2 := h.Env().Database.SessionFactory.New(ctx)
+	Expect(g2.Model(&api.Resource{}).Where("id = ?", resource.ID).
+		UpdateColumn("spec_updated_at", time.Now().Add(-time.Hour)).Error).NotTo(HaveOccurred())
+
+	stale, svcErr := h.Env().Services.Resources().SyncStale(ctx, 10*time.Minute)
+	Expect(svcErr).To(BeNil())
+	Expect(stale).To(ContainElement(HaveField("ID", resource.ID)))
+
+	// the stale resource bundle has the Stale condition
+	rb, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(rb.Status["conditions"]).To(ContainElement(HaveKeyWithValue("type", api.StaleCondition)))
+
+	// a new version of the resource is not stale until it lags behind again
+	updated, err := h.NewResource(resource.ID, consumer.Name, deployName, "default", 2, resource.Version)
+	Expect(err).NotTo(HaveOccurred())
+	_, svcErr = h.Env().Services.Resources().Update(ctx, updated)
+	Expect(svcErr).To(BeNil())
+	stale, svcErr = h.Env().Services.Resources().SyncStale(ctx, 10*time.Minute)
+	Expect(svcErr).To(BeNil())
+	Expect(stale).NotTo(ContainElement(HaveField("ID", resource.ID)))
+}
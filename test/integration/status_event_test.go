@@ -0,0 +1,146 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/apimachinery/pkg/util/rand"
+	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
+	grpcprotocol "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protocol"
+	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/test"
+)
+
+// replayedStatus is a resource status that is received by a status subscriber.
+type replayedStatus struct {
+	resourceID string
+	sequence   int64
+}
+
+// subscribeStatus subscribes the resource status of the source from the given sequence, the received resource
+// status are sent to the returned channel.
+func subscribeStatus(ctx context.Context, h *test.Helper, source string, sequence int64) <-chan replayedStatus {
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("%s:%s", h.Env().Config.HTTPServer.Hostname, h.Env().Config.GRPCServer.ServerBindPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	subCtx := metadata.AppendToOutgoingContext(ctx, api.StatusSequenceHeader, strconv.FormatInt(sequence, 10))
+	subClient, err := pbv1.NewCloudEventServiceClient(conn).Subscribe(subCtx, &pbv1.SubscriptionRequest{Source: source})
+	Expect(err).NotTo(HaveOccurred())
+
+	statuses := make(chan replayedStatus, 100)
+	go func() {
+		for {
+			pbEvt, err := subClient.Recv()
+			if err != nil {
+				return
+			}
+			evt, err := binding.ToEvent(ctx, grpcprotocol.NewMessage(pbEvt))
+			if err != nil || evt.Type() == cetypes.HeartbeatCloudEventsType {
+				continue
+			}
+
+			status := replayedStatus{}
+			status.resourceID, _ = evt.Extensions()[cetypes.ExtensionResourceID].(string)
+			if value, ok := evt.Extensions()[api.ExtensionStatusSequence].(string); ok {
+				status.sequence, _ = strconv.ParseInt(value, 10, 64)
+			}
+			statuses <- status
+		}
+	}()
+
+	return statuses
+}
+
+func TestStatusEventReplay(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		// give one second to terminate the work agent
+		time.Sleep(1 * time.Second)
+	}()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	h.StartWorkAgent(ctx, consumer.Name)
+
+	source := "replay-" + rand.String(5)
+	resource, err := h.NewResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1, 1)
+	Expect(err).NotTo(HaveOccurred())
+	resource.Source = source
+	resource, svcErr := h.Env().Services.Resources().Create(ctx, resource)
+	Expect(svcErr).NotTo(HaveOccurred())
+
+	// the status of the resource is logged with status events while no subscriber is connected
+	Eventually(func() error {
+		statusEvents, svcErr := h.Env().Services.StatusEvents().FindBySourceAfterSequence(ctx, source, 0)
+		if svcErr != nil {
+			return svcErr
+		}
+		if len(statusEvents) == 0 {
+			return fmt.Errorf("no status event of the source %s is found", source)
+		}
+		return nil
+	}, 20*time.Second, 1*time.Second).Should(Succeed())
+
+	// the subscriber that resumes from the beginning replays the status of the resource
+	var replayed replayedStatus
+	Eventually(subscribeStatus(ctx, h, source, 0), 10*time.Second).Should(Receive(&replayed))
+	Expect(replayed.resourceID).To(Equal(resource.ID))
+	Expect(replayed.sequence).To(BeNumerically(">", 0))
+
+	// the subscriber that resumes from the replayed sequence receives the later status events
+	statuses := subscribeStatus(ctx, h, source, replayed.sequence)
+	Expect(h.DeleteResource(resource.ID)).NotTo(HaveOccurred())
+	var deleted replayedStatus
+	Eventually(statuses, 20*time.Second).Should(Receive(&deleted))
+	Expect(deleted.resourceID).To(Equal(resource.ID))
+	Expect(deleted.sequence).To(BeNumerically(">", replayed.sequence))
+}
+
+func TestStatusEventSequenceAtCommit(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+	ctx := context.Background()
+
+	source := "sequence-" + rand.String(5)
+	insert := func() (*sql.Tx, string) {
+		tx, err := h.DBFactory.DirectDB().BeginTx(ctx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		id := uuid.NewString()
+		_, err = tx.Exec("INSERT INTO status_events (id, created_at, updated_at, resource_id, resource_source, "+
+			"status_event_type) VALUES ($1, now(), now(), $2, $3, $4)",
+			id, uuid.NewString(), source, api.StatusUpdateEventType)
+		Expect(err).NotTo(HaveOccurred())
+		return tx, id
+	}
+
+	// the status event that is inserted first but committed last has the greater sequence
+	first, firstID := insert()
+	second, secondID := insert()
+	Expect(second.Commit()).To(Succeed())
+	Expect(first.Commit()).To(Succeed())
+
+	statusEvents, svcErr := h.Env().Services.StatusEvents().FindBySourceAfterSequence(ctx, source, 0)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(statusEvents).To(HaveLen(2))
+	Expect(statusEvents[0].ID).To(Equal(secondID))
+	Expect(statusEvents[1].ID).To(Equal(firstID))
+}